@@ -122,6 +122,60 @@ func CreateRebaseArgParser() *argparser.ArgParser {
 	return ap
 }
 
+func CreateBisectArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("bisect")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "One of start, bad, good, skip, run, or reset."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"rev", "The commits the subcommand applies to. For run, the dolt_tests test group or test name used to classify commits."})
+	return ap
+}
+
+func CreateNotesArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("notes")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "One of add, show, list, or remove."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"commit", "The commits the subcommand applies to. Defaults to HEAD."})
+	ap.SupportsString(MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} as the note message.")
+	ap.SupportsFlag(ForceFlag, "f", "When adding a note to a commit which already has one, replace it.")
+	ap.SupportsString(AuthorParam, "", "author", "Specify an explicit author using the standard A U Thor {{.LessThan}}author@example.com{{.GreaterThan}} format.")
+	return ap
+}
+
+func CreateRerereArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("rerere")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "One of status, forget, or clear."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"table", "The tables whose recorded resolutions are forgotten."})
+	return ap
+}
+
+func CreatePullRequestOpenArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs("pr_open", 2)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"source", "The branch whose changes are proposed."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"target", "The branch the changes are proposed to be merged into."})
+	ap.SupportsString(MessageArg, "m", "title", "Use the given {{.LessThan}}title{{.GreaterThan}} as the title of the pull request.")
+	ap.SupportsString(DescriptionParam, "", "description", "Use the given {{.LessThan}}description{{.GreaterThan}} as the description of the pull request.")
+	ap.SupportsString(ReviewersParam, "", "users", "A comma-separated list of the users asked to review the pull request.")
+	return ap
+}
+
+func CreatePullRequestReviewArgParser(name string) *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(name, 1)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"id", "The id of the pull request."})
+	ap.SupportsString(MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} as the comment, or as the message of the merge commit.")
+	return ap
+}
+
+func CreateApplyArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("apply")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"patch", "The patch files to apply, in order. Defaults to reading a patch from standard input."})
+	return ap
+}
+
+func CreateAmArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("am")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"patch", "The patch files to commit, in order. Defaults to reading patches from standard input."})
+	ap.SupportsFlag(SkipVerificationFlag, "", "Skip commit verification before each commit")
+	return ap
+}
+
 func CreatePushArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithVariableArgs("push")
 	ap.SupportsString(UserFlag, "", "user", "User name to use when authenticating with the remote. Gets password from the environment variable {{.EmphasisLeft}}DOLT_REMOTE_PASSWORD{{.EmphasisRight}}.")
@@ -129,6 +183,7 @@ func CreatePushArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(ForceFlag, "f", "Update the remote with local history, overwriting any conflicting history in the remote.")
 	ap.SupportsFlag(AllFlag, "", "Push all branches.")
 	ap.SupportsFlag(SilentFlag, "", "Suppress progress information.")
+	ap.SupportsFlag(SkipVerificationFlag, "", "Skip commit verification before push")
 	return ap
 }
 
@@ -306,12 +361,14 @@ func CreateLogArgParser(isTableFunction bool) *argparser.ArgParser {
 	ap.SupportsStringList(NotFlag, "", "revision", "Excludes commits from revision.")
 	ap.SupportsFlag(AllFlag, "", "Automatically select every branch in database")
 	ap.SupportsFlag(ShowSignatureFlag, "", "Shows the signature of each commit.")
+	ap.SupportsFlag(NotesFlag, "", "Shows the note attached to each commit.")
 	if isTableFunction {
 		ap.SupportsStringList(TablesFlag, "t", "table", "Restricts the log to commits that modified the specified tables.")
 	} else {
 		ap.SupportsFlag(OneLineFlag, "", "Shows logs in a compact format.")
 		ap.SupportsFlag(StatFlag, "", "Shows the diffstat for each commit.")
 		ap.SupportsFlag(GraphFlag, "", "Shows the commit graph.")
+		ap.SupportsFlag(RowFlag, "", "Shows the history of a single row, given its table and primary key values, instead of commits.")
 	}
 	return ap
 }
@@ -34,6 +34,7 @@ const (
 	DecorateFlag           = "decorate"
 	DeleteFlag             = "delete"
 	DeleteForceFlag        = "D"
+	DescriptionParam       = "description"
 	DepthFlag              = "depth"
 	DryRunFlag             = "dry-run"
 	EmptyParam             = "empty"
@@ -49,6 +50,7 @@ const (
 	JobFlag                = "job"
 	ListFlag               = "list"
 	MergesFlag             = "merges"
+	MergeTextLinesFlag     = "merge-text-lines"
 	MessageArg             = "message"
 	MinParentsFlag         = "min-parents"
 	MoveFlag               = "move"
@@ -61,6 +63,7 @@ const (
 	NoTLSFlag              = "no-tls"
 	NoJsonMergeFlag        = "dont-merge-json"
 	NotFlag                = "not"
+	NotesFlag              = "notes"
 	NumberFlag             = "number"
 	OneLineFlag            = "oneline"
 	OursFlag               = "ours"
@@ -74,6 +77,8 @@ const (
 	QuietFlag              = "quiet"
 	RebaseParam            = "rebase"
 	RemoteParam            = "remote"
+	ReviewersParam         = "reviewers"
+	RowFlag                = "row"
 	SetUpstreamFlag        = "set-upstream"
 	SetUpstreamToFlag      = "set-upstream-to"
 	ShallowFlag            = "shallow"
@@ -0,0 +1,128 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/patch"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var amDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Commit a series of patches written by dolt format-patch",
+	LongDesc: `Applies each patch written by {{.EmphasisLeft}}dolt format-patch{{.EmphasisRight}} in order, and commits it with
+the author, date and message recorded in the patch. The committer is the current user. This requires your working
+tree to be clean. When no patch files are given, patches are read from standard input, such as the output of
+{{.EmphasisLeft}}dolt format-patch --stdout{{.EmphasisRight}}.
+
+Patches are applied the same way {{.EmphasisLeft}}dolt apply{{.EmphasisRight}} applies them, falling back to a three-way
+merge when a patch doesn't apply directly. If the merge has conflicts or constraint violations, the patches before it
+stay committed and the command stops. Resolve the conflicts and use {{.EmphasisLeft}}dolt cherry-pick --continue{{.EmphasisRight}}
+to commit the patch, then run {{.EmphasisLeft}}dolt am{{.EmphasisRight}} again with the remaining patches.
+`,
+	Synopsis: []string{
+		`[--skip-verification] [{{.LessThan}}patch{{.GreaterThan}}...]`,
+	},
+}
+
+var ErrAmConflictsOrViolations = errors.NewKind("error: Unable to apply patch '%s' cleanly due to conflicts " +
+	"or constraint violations. Please resolve the conflicts and/or constraint violations, then use `dolt add` " +
+	"to add the tables to the staged set, and `dolt cherry-pick --continue` to commit the patch. \n" +
+	"Then run `dolt am` again with the remaining patches.\n" +
+	"To undo all changes from this patch, use `dolt cherry-pick --abort`.\n" +
+	"For more information on handling conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts")
+
+type AmCmd struct{}
+
+var _ cli.Command = AmCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd AmCmd) Name() string {
+	return "am"
+}
+
+// Description returns a description of the command
+func (cmd AmCmd) Description() string {
+	return amDocs.ShortDesc
+}
+
+func (cmd AmCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(amDocs, ap)
+}
+
+func (cmd AmCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateAmArgParser()
+}
+
+// Exec executes the command
+func (cmd AmCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, amDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	patchTexts, err := readPatchFiles(apr.Args)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	var patches []*patch.Patch
+	for _, text := range patchTexts {
+		parsed, err := patch.Parse(text)
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		patches = append(patches, parsed...)
+	}
+
+	var flags []string
+	if apr.Contains(cli.SkipVerificationFlag) {
+		flags = append(flags, "--"+cli.SkipVerificationFlag)
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	// Each patch is committed by its own call, so that the ones before a conflict stay committed.
+	for _, p := range patches {
+		cli.Println("Applying: " + p.Subject())
+		rows, err := callPatchProcedure(queryist.Queryist, queryist.Context, "DOLT_AM", flags, []string{p.String()})
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		if len(rows) != 1 {
+			err = fmt.Errorf("error: unexpected number of rows returned from dolt_am: %d", len(rows))
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		for _, col := range rows[0][1:] {
+			count, err := getInt64ColAsInt64(col)
+			if err != nil {
+				return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+			}
+			if count > 0 {
+				return HandleVErrAndExitCode(errhand.VerboseErrorFromError(ErrAmConflictsOrViolations.New(p.Subject())), usage)
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var applyDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Apply SQL patches to the working set",
+	LongDesc: `Applies the changes in one or more patch files to the working set, without committing them. A patch is either
+written by {{.EmphasisLeft}}dolt format-patch{{.EmphasisRight}}, or is plain SQL such as the output of
+{{.EmphasisLeft}}dolt diff -r sql{{.EmphasisRight}} or {{.EmphasisLeft}}dolt_patch(){{.EmphasisRight}}. When no
+patch files are given, the patch is read from standard input.
+
+The patch's statements are first run directly against the working set. If a statement fails or an update or delete
+changes no rows, or if a table the patch changes has been modified since the patch's parent commit, and that commit is
+in the database, the patch is replayed on its parent commit and three-way merged into the working set instead. Any
+conflicts or constraint violations are recorded the same way {{.EmphasisLeft}}dolt merge{{.EmphasisRight}} records them.
+Resolve them and use {{.EmphasisLeft}}dolt commit{{.EmphasisRight}} to commit the merge, or
+{{.EmphasisLeft}}dolt merge --abort{{.EmphasisRight}} to undo it. To commit a patch with its original author and
+message instead, use {{.EmphasisLeft}}dolt am{{.EmphasisRight}}.
+
+Plain SQL patches have no parent commit, so they can only be applied directly.
+`,
+	Synopsis: []string{
+		`[{{.LessThan}}patch{{.GreaterThan}}...]`,
+	},
+}
+
+var ErrApplyConflictsOrViolations = errors.NewKind("error: Unable to apply patch cleanly due to conflicts " +
+	"or constraint violations. Please resolve the conflicts and/or constraint violations, then use `dolt add` " +
+	"to add the tables to the staged set, and `dolt commit` to commit the merge. \n" +
+	"To undo all changes from this patch, use `dolt merge --abort`.\n" +
+	"For more information on handling conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts")
+
+type ApplyCmd struct{}
+
+var _ cli.Command = ApplyCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd ApplyCmd) Name() string {
+	return "apply"
+}
+
+// Description returns a description of the command
+func (cmd ApplyCmd) Description() string {
+	return applyDocs.ShortDesc
+}
+
+func (cmd ApplyCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(applyDocs, ap)
+}
+
+func (cmd ApplyCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateApplyArgParser()
+}
+
+// Exec executes the command
+func (cmd ApplyCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, applyDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	patchTexts, err := readPatchFiles(apr.Args)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	rows, err := callPatchProcedure(queryist.Queryist, queryist.Context, "DOLT_APPLY", nil, patchTexts)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if len(rows) != 1 {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(fmt.Errorf("error: unexpected number of rows returned from dolt_apply: %d", len(rows))), usage)
+	}
+	for _, col := range rows[0] {
+		count, err := getInt64ColAsInt64(col)
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		if count > 0 {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(ErrApplyConflictsOrViolations.New()), usage)
+		}
+	}
+	return 0
+}
+
+// readPatchFiles returns the contents of each file in |paths|, or of standard input if |paths| is empty.
+func readPatchFiles(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("error: unable to read patch from standard input: %w", err)
+		}
+		return []string{string(data)}, nil
+	}
+
+	texts := make([]string, len(paths))
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error: unable to read patch '%s': %w", path, err)
+		}
+		texts[i] = string(data)
+	}
+	return texts, nil
+}
+
+// callPatchProcedure calls the patch procedure |procName| with |flags| followed by |patchTexts|. Conflicts from a
+// three-way merge are left in the working set to be resolved, like merge and cherry-pick do.
+func callPatchProcedure(queryist cli.Queryist, sqlCtx *sql.Context, procName string, flags []string, patchTexts []string) ([]sql.Row, error) {
+	_, err := cli.GetRowsForSql(queryist, sqlCtx, "set @@dolt_allow_commit_conflicts = 1")
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to set @@dolt_allow_commit_conflicts: %w", err)
+	}
+	_, err = cli.GetRowsForSql(queryist, sqlCtx, "set @@dolt_force_transaction_commit = 1")
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to set @@dolt_force_transaction_commit: %w", err)
+	}
+
+	// Patches are passed after --, so that text starting with a SQL comment isn't read as an option.
+	procArgs := make([]string, 0, len(flags)+1+len(patchTexts))
+	procArgs = append(append(append(procArgs, flags...), "--"), patchTexts...)
+	q, err := interpolateStoredProcedureCall(procName, procArgs)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to interpolate query: %w", err)
+	}
+	return cli.GetRowsForSql(queryist, sqlCtx, q)
+}
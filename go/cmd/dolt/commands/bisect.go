@@ -0,0 +1,125 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var bisectDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Use binary search to find the commit that introduced a bug",
+	LongDesc: `Performs a binary search through the commit history of the current branch to find the commit that first 
+caused a test to fail. Start a bisect with {{.EmphasisLeft}}dolt bisect start{{.EmphasisRight}}, then mark a commit 
+where the behavior is broken as bad, and a commit where it worked as good. Dolt checks out a commit in between them, 
+which you then test and mark as good or bad, until the first bad commit is found. Commits that can't be tested can be 
+marked with {{.EmphasisLeft}}dolt bisect skip{{.EmphasisRight}}.
+
+Because Dolt does not support a detached HEAD, commits are checked out on a temporary branch named 
+{{.EmphasisLeft}}dolt_bisect_<branch>{{.EmphasisRight}}. Use {{.EmphasisLeft}}dolt bisect reset{{.EmphasisRight}} to 
+end the bisect, delete the temporary branch, and return to the branch the bisect was started from.
+
+{{.EmphasisLeft}}dolt bisect run{{.EmphasisRight}} automates the search by running the tests in the 
+{{.EmphasisLeft}}dolt_tests{{.EmphasisRight}} table with the given group or test name against each candidate commit. A 
+commit is good if every test passes, bad if any test fails, and is skipped if the tests can't be run. The tests are 
+always taken from the branch the bisect was started from, so they can be used to bisect history from before the tests 
+were written.
+`,
+	Synopsis: []string{
+		`start [{{.LessThan}}bad{{.GreaterThan}} [{{.LessThan}}good{{.GreaterThan}}...]]`,
+		`(bad | good | skip) [{{.LessThan}}rev{{.GreaterThan}}...]`,
+		`run {{.LessThan}}test_group_or_name{{.GreaterThan}}`,
+		`reset`,
+	},
+}
+
+type BisectCmd struct{}
+
+var _ cli.Command = BisectCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectCmd) Name() string {
+	return "bisect"
+}
+
+// Description returns a description of the command
+func (cmd BisectCmd) Description() string {
+	return bisectDocs.ShortDesc
+}
+
+func (cmd BisectCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectDocs, ap)
+}
+
+func (cmd BisectCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateBisectArgParser()
+}
+
+// Exec executes the command
+func (cmd BisectCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bisectDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if queryist.IsRemote {
+		msg := fmt.Sprintf(cli.RemoteUnsupportedMsg, commandStr)
+		cli.Println(msg)
+		return 1
+	}
+
+	query, err := interpolateStoredProcedureCall("DOLT_BISECT", args)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	rows, err := cli.GetRowsForSql(queryist.Queryist, queryist.Context, query)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	status, err := getInt64ColAsInt64(rows[0][0])
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if status != 0 {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+rows[0][1].(string))), usage)
+	}
+
+	// Every subcommand may move the session to a different branch, either the temporary bisect branch or back to the
+	// branch the bisect was started from, so make sure the CLI follows it.
+	if err = syncCliBranchToSqlSessionBranch(queryist.Context, dEnv); err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	if message, ok := rows[0][1].(string); ok && message != "" {
+		cli.Println(message)
+	}
+	return 0
+}
@@ -32,14 +32,18 @@ import (
 )
 
 const (
-	blameQueryTemplate = "SELECT * FROM dolt_blame_%s AS OF '%s'"
+	blameQueryTemplate     = "SELECT * FROM dolt_blame_%s AS OF '%s'"
+	cellBlameQueryTemplate = "SELECT * FROM dolt_cell_blame_%s AS OF '%s'"
+	cellsFlag              = "cells"
 )
 
 var blameDocs = cli.CommandDocumentationContent{
 	ShortDesc: `Show what revision and author last modified each row of a table`,
-	LongDesc:  `Annotates each row in the given table with information from the revision which last modified the row. Optionally, start annotating from the given revision.`,
+	LongDesc: `Annotates each row in the given table with information from the revision which last modified the row. Optionally, start annotating from the given revision.
+
+With {{.EmphasisLeft}}--cells{{.EmphasisRight}}, annotates each column of each row instead, with the revision which last modified the value in that column. Columns are followed through renames, so a renamed column is attributed to the revision that last changed its values rather than to the rename.`,
 	Synopsis: []string{
-		`[{{.LessThan}}rev{{.GreaterThan}}] {{.LessThan}}tablename{{.GreaterThan}}`,
+		`[--cells] [{{.LessThan}}rev{{.GreaterThan}}] {{.LessThan}}tablename{{.GreaterThan}}`,
 	},
 }
 
@@ -62,6 +66,7 @@ func (cmd BlameCmd) Docs() *cli.CommandDocumentation {
 
 func (cmd BlameCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 2)
+	ap.SupportsFlag(cellsFlag, "", "Shows the revision which last modified each column of each row, instead of each row.")
 	return ap
 }
 
@@ -102,10 +107,15 @@ func (cmd BlameCmd) Exec(ctx context.Context, commandStr string, args []string,
 		return 1
 	}
 
+	queryTemplate := blameQueryTemplate
+	if apr.Contains(cellsFlag) {
+		queryTemplate = cellBlameQueryTemplate
+	}
+
 	var schema sql.Schema
 	var ri sql.RowIter
 	if apr.NArg() == 1 {
-		schema, ri, _, err = queryist.Queryist.Query(queryist.Context, fmt.Sprintf(blameQueryTemplate, apr.Arg(0), "HEAD"))
+		schema, ri, _, err = queryist.Queryist.Query(queryist.Context, fmt.Sprintf(queryTemplate, apr.Arg(0), "HEAD"))
 	} else {
 		// validate input
 		ref := apr.Arg(0)
@@ -114,7 +124,7 @@ func (cmd BlameCmd) Exec(ctx context.Context, commandStr string, args []string,
 			return 1
 		}
 
-		schema, ri, _, err = queryist.Queryist.Query(queryist.Context, fmt.Sprintf(blameQueryTemplate, apr.Arg(1), apr.Arg(0)))
+		schema, ri, _, err = queryist.Queryist.Query(queryist.Context, fmt.Sprintf(queryTemplate, apr.Arg(1), apr.Arg(0)))
 	}
 	if err != nil {
 		iohelp.WriteLine(cli.CliOut, err.Error())
@@ -0,0 +1,270 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	bundleCreateCmd    = "create"
+	bundleVerifyCmd    = "verify"
+	bundleListHeadsCmd = "list-heads"
+
+	bundleBaseParam = "base"
+)
+
+var bundleDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Move objects and refs by archive",
+	LongDesc: `Create, verify and inspect bundles, single files holding some or all of the history of a repository.
+
+Bundles are used to move data between machines that can't connect to each other, for example into an air-gapped
+network. A bundle can be used as a remote: {{.EmphasisLeft}}dolt clone repo.bundle{{.EmphasisRight}} clones it, and
+{{.EmphasisLeft}}dolt remote add origin /path/to/repo.bundle{{.EmphasisRight}} followed by
+{{.EmphasisLeft}}dolt fetch{{.EmphasisRight}} or {{.EmphasisLeft}}dolt pull{{.EmphasisRight}} reads from it. Any remote
+url without a scheme ending in {{.EmphasisLeft}}.bundle{{.EmphasisRight}} names a bundle file, as does a url with the
+{{.EmphasisLeft}}bundle://{{.EmphasisRight}} scheme. Bundles are read-only, so they can't be pushed to.
+
+{{.EmphasisLeft}}create [--base {{.LessThan}}commit{{.GreaterThan}}] {{.LessThan}}file{{.GreaterThan}} (--all | {{.LessThan}}ref{{.GreaterThan}}...){{.EmphasisRight}}
+Write a bundle to {{.LessThan}}file{{.GreaterThan}} holding each {{.LessThan}}ref{{.GreaterThan}}, which can be a
+branch, a tag or a fully qualified ref, and everything reachable from them. With {{.EmphasisLeft}}--all{{.EmphasisRight}},
+every branch and tag is included. With {{.EmphasisLeft}}--base{{.EmphasisRight}}, an incremental bundle is created which
+leaves out everything reachable from {{.LessThan}}commit{{.GreaterThan}}. Incremental bundles can't be cloned, and can
+only be fetched into repositories which already have {{.LessThan}}commit{{.GreaterThan}}.
+
+{{.EmphasisLeft}}verify {{.LessThan}}file{{.GreaterThan}}{{.EmphasisRight}}
+Check that {{.LessThan}}file{{.GreaterThan}} is a valid bundle which can be fetched into the current repository, listing
+its refs and the commits it requires.
+
+{{.EmphasisLeft}}list-heads {{.LessThan}}file{{.GreaterThan}}{{.EmphasisRight}}
+List the refs stored in {{.LessThan}}file{{.GreaterThan}}, along with the hash each one points to.
+`,
+	Synopsis: []string{
+		`create [--base {{.LessThan}}commit{{.GreaterThan}}] {{.LessThan}}file{{.GreaterThan}} (--all | {{.LessThan}}ref{{.GreaterThan}}...)`,
+		`verify {{.LessThan}}file{{.GreaterThan}}`,
+		`list-heads {{.LessThan}}file{{.GreaterThan}}`,
+	},
+}
+
+type BundleCmd struct{}
+
+var _ cli.Command = BundleCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BundleCmd) Name() string {
+	return "bundle"
+}
+
+// Description returns a description of the command
+func (cmd BundleCmd) Description() string {
+	return bundleDocs.ShortDesc
+}
+
+func (cmd BundleCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bundleDocs, ap)
+}
+
+func (cmd BundleCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "One of create, verify, or list-heads."})
+	ap.SupportsString(bundleBaseParam, "", "commit", "Create an incremental bundle which leaves out everything reachable from {{.LessThan}}commit{{.GreaterThan}}.")
+	ap.SupportsFlag(cli.AllFlag, "a", "Include every branch and tag in the bundle.")
+	return ap
+}
+
+// Exec executes the command
+func (cmd BundleCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bundleDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+
+	var verr errhand.VerboseError
+	switch subcommand := strings.ToLower(apr.Arg(0)); subcommand {
+	case bundleCreateCmd:
+		verr = createBundle(ctx, dEnv, apr)
+	case bundleVerifyCmd:
+		verr = verifyBundle(ctx, dEnv, apr)
+	case bundleListHeadsCmd:
+		verr = listBundleHeads(apr)
+	default:
+		verr = errhand.BuildDError("error: unknown bundle subcommand '%s'", apr.Arg(0)).SetPrintUsage().Build()
+	}
+
+	return HandleVErrAndExitCode(verr, usage)
+}
+
+func createBundle(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	all := apr.Contains(cli.AllFlag)
+	if apr.NArg() < 2 || (apr.NArg() == 2 && !all) || (apr.NArg() > 2 && all) {
+		return errhand.BuildDError("error: dolt bundle create takes a file and either --all or at least one ref").SetPrintUsage().Build()
+	}
+	path := apr.Arg(1)
+
+	ddb := dEnv.DoltDB(ctx)
+	refs, verr := resolveBundleRefs(ctx, dEnv, ddb, apr.Args[2:], all)
+	if verr != nil {
+		return verr
+	}
+
+	var bases []hash.Hash
+	if baseSpec, ok := apr.GetValue(bundleBaseParam); ok {
+		cs, err := doltdb.NewCommitSpec(baseSpec)
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		optCmt, err := ddb.Resolve(ctx, cs, dEnv.RepoState.CWBHeadRef())
+		if err != nil {
+			return errhand.BuildDError("error: invalid base commit '%s'", baseSpec).AddCause(err).Build()
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return errhand.BuildDError("error: base commit '%s' is not available in this shallow clone", baseSpec).Build()
+		}
+		h, err := cm.HashOf()
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		bases = append(bases, h)
+	}
+
+	tmpDir, err := dEnv.TempTableFilesDir()
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	header, err := actions.CreateBundle(ctx, ddb, tmpDir, path, refs, bases)
+	if err != nil {
+		return errhand.BuildDError("error: failed to create bundle").AddCause(err).Build()
+	}
+
+	cli.Printf("Created bundle '%s' with %s\n", path, pluralize("ref", "refs", uint64(len(header.Refs))))
+	return nil
+}
+
+// resolveBundleRefs returns the refs of |ddb| named by |names|, or every branch and tag if |all| is set. Names can be
+// HEAD, a branch name, a tag name, or a fully qualified ref.
+func resolveBundleRefs(ctx context.Context, dEnv *env.DoltEnv, ddb *doltdb.DoltDB, names []string, all bool) ([]doltdb.RefWithHash, errhand.VerboseError) {
+	refsWithHashes, err := ddb.GetRefsWithHashes(ctx)
+	if err != nil {
+		return nil, errhand.VerboseErrorFromError(err)
+	}
+
+	if all {
+		var refs []doltdb.RefWithHash
+		for _, r := range refsWithHashes {
+			if t := r.Ref.GetType(); t == ref.BranchRefType || t == ref.TagRefType {
+				refs = append(refs, r)
+			}
+		}
+		return refs, nil
+	}
+
+	byName := make(map[string]doltdb.RefWithHash, len(refsWithHashes))
+	for _, r := range refsWithHashes {
+		byName[r.Ref.String()] = r
+	}
+
+	var refs []doltdb.RefWithHash
+	seen := make(map[string]struct{})
+	for _, name := range names {
+		if strings.EqualFold(name, "HEAD") {
+			name = dEnv.RepoState.CWBHeadRef().String()
+		}
+		r, ok := byName[name]
+		if !ok {
+			r, ok = byName[ref.NewBranchRef(name).String()]
+		}
+		if !ok {
+			r, ok = byName[ref.NewTagRef(name).String()]
+		}
+		if !ok {
+			return nil, errhand.BuildDError("error: '%s' is not a branch, tag, or ref", name).Build()
+		}
+		if _, ok := seen[r.Ref.String()]; ok {
+			continue
+		}
+		seen[r.Ref.String()] = struct{}{}
+		refs = append(refs, r)
+	}
+	return refs, nil
+}
+
+func verifyBundle(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 2 {
+		return errhand.BuildDError("error: dolt bundle verify takes exactly one file").SetPrintUsage().Build()
+	}
+	path := apr.Arg(1)
+
+	header, missing, err := actions.VerifyBundle(ctx, dEnv.DoltDB(ctx), path)
+	if err != nil {
+		return errhand.BuildDError("error: '%s' is not a valid bundle", path).AddCause(err).Build()
+	}
+
+	cli.Printf("The bundle contains %s:\n", pluralize("ref", "refs", uint64(len(header.Refs))))
+	for _, r := range header.Refs {
+		cli.Printf("%s %s\n", r.Hash, r.Name)
+	}
+	if header.IsIncremental() {
+		cli.Printf("The bundle requires %s:\n", pluralize("commit", "commits", uint64(len(header.Prerequisites))))
+		for _, h := range header.Prerequisites {
+			cli.Println(h)
+		}
+	} else {
+		cli.Println("The bundle records a complete history.")
+	}
+
+	if len(missing) > 0 {
+		bdr := errhand.BuildDError("error: the repository lacks these prerequisite commits:")
+		for _, h := range missing {
+			bdr.AddDetails("%s", h.String())
+		}
+		return bdr.Build()
+	}
+
+	cli.Printf("%s is okay\n", path)
+	return nil
+}
+
+func listBundleHeads(apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 2 {
+		return errhand.BuildDError("error: dolt bundle list-heads takes exactly one file").SetPrintUsage().Build()
+	}
+	path := apr.Arg(1)
+
+	header, err := bundle.ReadHeader(path)
+	if err != nil {
+		return errhand.BuildDError("error: '%s' is not a valid bundle", path).AddCause(err).Build()
+	}
+	for _, r := range header.Refs {
+		cli.Printf("%s %s\n", r.Hash, r.Name)
+	}
+	return nil
+}
@@ -88,6 +88,7 @@ func (cmd CherryPickCmd) EventType() eventsapi.ClientEventType {
 func (cmd CherryPickCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
 	ap := cli.CreateCherryPickArgParser()
 	ap.SupportsFlag(cli.NoJsonMergeFlag, "", "Do not attempt to automatically resolve multiple changes to the same JSON value, report a conflict instead.")
+	ap.SupportsFlag(cli.MergeTextLinesFlag, "", "Attempt to automatically resolve multiple changes to the same TEXT value line by line, report a conflict only when the same lines changed.")
 	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, cherryPickDocs, ap))
 	apr := cli.ParseArgsOrDie(ap, args, help)
 
@@ -127,6 +128,14 @@ func (cmd CherryPickCmd) Exec(ctx context.Context, commandStr string, args []str
 		}
 	}
 
+	if apr.Contains(cli.MergeTextLinesFlag) {
+		_, _, _, err = queryist.Queryist.Query(queryist.Context, "set @@dolt_merge_text_lines = 1")
+		if err != nil {
+			cli.Println(err.Error())
+			return 1
+		}
+	}
+
 	// TODO : support single commit cherry-pick only for now
 	if apr.NArg() == 0 {
 		usage()
@@ -27,15 +27,43 @@ import (
 )
 
 // runDoltTestStep evaluates a Dolt Test step per selection rules and requires all selected tests to PASS.
-// It returns a human-readable summary of individual test results and an error aggregating any failures.
-func runDoltTestStep(sqlCtx *sql.Context, queryist cli.Queryist, dt *dolt_ci.DoltTestStep) (string, error) {
-	spec := deriveSelectionSpec(dt)
-
-	rows, err := resolveDoltTestRows(sqlCtx, queryist, dt, spec)
+// It returns the dolt_test_run rows of the selected tests, a human-readable summary of individual test results and an
+// error aggregating any failures.
+func runDoltTestStep(sqlCtx *sql.Context, queryist cli.Queryist, dt *dolt_ci.DoltTestStep) ([]sql.Row, string, error) {
+	rows, err := dolt_ci.DoltTestStepRows(sqlCtx, queryist.Query, dt)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	return summarizeDoltTestRows(sqlCtx, rows)
+	details, err := summarizeDoltTestRows(sqlCtx, rows)
+	return rows, details, err
+}
+
+// failedDoltTestAssertions returns the expected and actual values of the failed tests in |rows|, as reported by
+// dolt_test_run. Each value is prefixed with the name of its test, and values are separated by "; ".
+func failedDoltTestAssertions(sqlCtx *sql.Context, rows []sql.Row) (string, string, error) {
+	var expected, actual []string
+	for _, row := range rows {
+		tName, err := getStringColAsString(sqlCtx, row[0])
+		if err != nil {
+			return "", "", err
+		}
+		status, err := getStringColAsString(sqlCtx, row[3])
+		if err != nil {
+			return "", "", err
+		}
+		if strings.EqualFold(status, "PASS") {
+			continue
+		}
+		assertion, value, err := dolt_ci.DoltTestAssertion(sqlCtx, row)
+		if err != nil {
+			return "", "", err
+		}
+		expected = append(expected, fmt.Sprintf("%s: %s", tName, assertion))
+		if value != "" {
+			actual = append(actual, fmt.Sprintf("%s: %s", tName, value))
+		}
+	}
+	return strings.Join(expected, "; "), strings.Join(actual, "; "), nil
 }
 
 type selectionSpec struct {
@@ -60,37 +88,6 @@ func hasWildcard(nodes []yaml.Node) bool {
 	return false
 }
 
-func resolveDoltTestRows(sqlCtx *sql.Context, queryist cli.Queryist, dt *dolt_ci.DoltTestStep, spec selectionSpec) ([]sql.Row, error) {
-	switch {
-	case !spec.testsProvided && !spec.groupsProvided:
-		return getAllDoltTestRunRows(sqlCtx, queryist)
-
-	case spec.testsProvided && !spec.groupsProvided:
-		if spec.testsWildcard {
-			return getAllDoltTestRunRows(sqlCtx, queryist)
-		}
-		return collectRowsForSelectors(sqlCtx, queryist, "test", nodesToValues(dt.Tests))
-
-	case spec.groupsProvided && !spec.testsProvided:
-		if spec.groupsWildcard {
-			return getAllDoltTestRunRows(sqlCtx, queryist)
-		}
-		return collectRowsForSelectors(sqlCtx, queryist, "group", nodesToValues(dt.TestGroups))
-
-	default: // both provided
-		if spec.testsWildcard && !spec.groupsWildcard {
-			// All tests in specified groups
-			return collectRowsForSelectors(sqlCtx, queryist, "group", nodesToValues(dt.TestGroups))
-		}
-		if spec.groupsWildcard && !spec.testsWildcard {
-			// Only specified test names across all groups
-			return collectRowsForSelectors(sqlCtx, queryist, "test", nodesToValues(dt.Tests))
-		}
-		// Neither wildcard: intersection
-		return collectIntersectionRows(sqlCtx, queryist, nodesToValues(dt.Tests), nodesToValues(dt.TestGroups))
-	}
-}
-
 func nodesToValues(nodes []yaml.Node) []string {
 	var vals []string
 	for _, n := range nodes {
@@ -99,70 +96,6 @@ func nodesToValues(nodes []yaml.Node) []string {
 	return vals
 }
 
-// collectRowsForSelectors fetches rows for each selector using dolt_test_run('<selector>').
-// kind should be "test" or "group" to produce specific error messages if an empty result is somehow returned without error.
-func collectRowsForSelectors(sqlCtx *sql.Context, queryist cli.Queryist, kind string, selectors []string) ([]sql.Row, error) {
-	var allRows []sql.Row
-	for _, sel := range selectors {
-		rows, err := fetchDoltTestRunRows(sqlCtx, queryist, sel)
-		if err != nil {
-			return nil, err
-		}
-		if len(rows) == 0 {
-			// dolt_test_run should return an error in this scenario; this is a defensive fallback
-			if kind == "test" {
-				return nil, fmt.Errorf("test '%s' not found", sel)
-			}
-			return nil, fmt.Errorf("group '%s' not found", sel)
-		}
-		allRows = append(allRows, rows...)
-	}
-	return allRows, nil
-}
-
-// collectIntersectionRows returns only the rows for the specified tests within each specified group.
-// It also verifies that each named test exists within every specified group.
-func collectIntersectionRows(sqlCtx *sql.Context, queryist cli.Queryist, testNames, groupNames []string) ([]sql.Row, error) {
-	var allRows []sql.Row
-	for _, group := range groupNames {
-		rows, err := fetchDoltTestRunRows(sqlCtx, queryist, group)
-		if err != nil {
-			return nil, err
-		}
-		if len(rows) == 0 {
-			return nil, fmt.Errorf("group '%s' not found", group)
-		}
-		groupTests := make(map[string]bool)
-		for _, r := range rows {
-			tName, err := getStringColAsString(sqlCtx, r[0])
-			if err != nil {
-				return nil, err
-			}
-			groupTests[tName] = true
-		}
-		// verify requested tests exist in this group
-		for _, t := range testNames {
-			if !groupTests[t] {
-				return nil, fmt.Errorf("test '%s' not found in group '%s'", t, group)
-			}
-		}
-		// filter rows to only requested tests
-		for _, r := range rows {
-			tName, err := getStringColAsString(sqlCtx, r[0])
-			if err != nil {
-				return nil, err
-			}
-			for _, t := range testNames {
-				if tName == t {
-					allRows = append(allRows, r)
-					break
-				}
-			}
-		}
-	}
-	return allRows, nil
-}
-
 // summarizeDoltTestRows formats and returns details and an aggregated error if any failures occurred.
 func summarizeDoltTestRows(sqlCtx *sql.Context, rows []sql.Row) (string, error) {
 	details, failures, err := formatDoltTestRows(sqlCtx, rows)
@@ -175,17 +108,6 @@ func summarizeDoltTestRows(sqlCtx *sql.Context, rows []sql.Row) (string, error)
 	return details, nil
 }
 
-// fetchDoltTestRunRows runs dolt_test_run for the provided selector (test or group value)
-func fetchDoltTestRunRows(sqlCtx *sql.Context, queryist cli.Queryist, selector string) ([]sql.Row, error) {
-	q := fmt.Sprintf("SELECT * FROM dolt_test_run('%s')", strings.ReplaceAll(selector, "'", "''"))
-	return cli.GetRowsForSql(queryist, sqlCtx, q)
-}
-
-// getAllDoltTestRunRows runs dolt_test_run() with no arguments to return all rows
-func getAllDoltTestRunRows(sqlCtx *sql.Context, queryist cli.Queryist) ([]sql.Row, error) {
-	return cli.GetRowsForSql(queryist, sqlCtx, "SELECT * FROM dolt_test_run()")
-}
-
 // formatDoltTestRows returns a formatted summary of all tests and a list of failure messages
 func formatDoltTestRows(sqlCtx *sql.Context, rows []sql.Row) (string, []string, error) {
 	var lines []string
@@ -47,6 +47,9 @@ Workflow YAML Specification:
       branches: [<branch-name>, ...]
       activities: [opened, closed, reopened, synchronized]
     workflow_dispatch: {}
+    schedule:
+      - cron: <cron-expression>   # five fields or a macro like @daily, in UTC
+        branches: [<branch-name>, ...]   # optional, defaults to the default branch
 
   jobs:
     - name: <job-name>
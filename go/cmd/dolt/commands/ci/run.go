@@ -16,9 +16,10 @@ package ci
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/fatih/color"
@@ -31,11 +32,18 @@ import (
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 )
 
+const (
+	reportFlag     = "report"
+	reportFileFlag = "report-file"
+)
+
 var runDocs = cli.CommandDocumentationContent{
 	ShortDesc: "Run a Dolt CI workflow",
-	LongDesc:  "Run a Dolt CI workflow by executing all saved queries and validating their results",
+	LongDesc: `Run a Dolt CI workflow by executing all saved queries and validating their results.
+
+With {{.EmphasisLeft}}--report-file{{.EmphasisRight}}, a report of the run is also written to the given file, in the format given by {{.EmphasisLeft}}--report{{.EmphasisRight}}, which is either {{.EmphasisLeft}}junit{{.EmphasisRight}} (the default) or {{.EmphasisLeft}}json{{.EmphasisRight}}. The report has a test suite for each job of the workflow, with a test case for each step, including its duration and, for failed steps, the failure message and the expected and actual values of its assertions.`,
 	Synopsis: []string{
-		"{{.LessThan}}workflow name{{.GreaterThan}}",
+		"[--report junit|json --report-file {{.LessThan}}path{{.GreaterThan}}] {{.LessThan}}workflow name{{.GreaterThan}}",
 	},
 }
 
@@ -65,6 +73,7 @@ func (cmd RunCmd) Docs() *cli.CommandDocumentation {
 // ArgParser implements cli.Command.
 func (cmd RunCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	AddReportArgs(ap, "workflow run")
 	return ap
 }
 
@@ -72,12 +81,17 @@ func (cmd RunCmd) ArgParser() *argparser.ArgParser {
 func (cmd RunCmd) Exec(ctx context.Context, commandStr string, args []string, _ *env.DoltEnv, cliCtx cli.CliContext) int {
 	ap := cmd.ArgParser()
 	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, runDocs, ap))
-	_ = cli.ParseArgsOrDie(ap, args, help)
+	apr := cli.ParseArgsOrDie(ap, args, help)
 
-	if len(args) == 0 {
+	if apr.NArg() == 0 {
 		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(fmt.Errorf("must specify workflow name")), usage)
 	}
-	workflowName := args[0]
+	workflowName := apr.Arg(0)
+
+	reportFormat, reportFile, verr := ParseReportArgs(apr)
+	if verr != nil {
+		return commands.HandleVErrAndExitCode(verr, usage)
+	}
 
 	queryist, err := cliCtx.QueryEngine(ctx)
 	if err != nil {
@@ -109,8 +123,21 @@ func (cmd RunCmd) Exec(ctx context.Context, commandStr string, args []string, _
 	if err != nil {
 		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
 	}
+	var report *dolt_ci.Report
+	if reportFile != "" {
+		report = dolt_ci.NewReport(workflowName)
+	}
 	cli.Println(color.CyanString("Running workflow: %s", workflowName))
-	failed := queryAndPrint(queryist.Context, queryist.Queryist, config, savedQueries)
+	failed, err := queryAndPrint(queryist.Context, queryist.Queryist, config, savedQueries, report)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	if report != nil {
+		if err = WriteReportFile(report, reportFormat, reportFile); err != nil {
+			return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	}
 
 	if failed {
 		return 1
@@ -118,8 +145,47 @@ func (cmd RunCmd) Exec(ctx context.Context, commandStr string, args []string, _
 	return 0
 }
 
-// queryAndPrint iterates through the jobs and steps for the given config, then runs each saved query and given assertion
-func queryAndPrint(sqlCtx *sql.Context, queryist cli.Queryist, config *dolt_ci.WorkflowConfig, savedQueries map[string]string) bool {
+// AddReportArgs adds the --report and --report-file flags to |ap|, for a command whose report is of a |subject|.
+func AddReportArgs(ap *argparser.ArgParser, subject string) {
+	ap.SupportsString(reportFlag, "", "format", "The format of the report written to --report-file, either junit or json. Defaults to junit.")
+	ap.SupportsString(reportFileFlag, "", "path", fmt.Sprintf("Writes a report of the %s to the given file.", subject))
+}
+
+// ParseReportArgs returns the report format and file of a command with --report and --report-file flags. The report
+// format defaults to junit, and the file is empty if no report should be written.
+func ParseReportArgs(apr *argparser.ArgParseResults) (string, string, errhand.VerboseError) {
+	reportFile, hasFile := apr.GetValue(reportFileFlag)
+	reportFormat, hasFormat := apr.GetValue(reportFlag)
+	if !hasFormat {
+		reportFormat = dolt_ci.ReportFormatJUnit
+	} else if !hasFile {
+		return "", "", errhand.BuildDError("--%s requires --%s", reportFlag, reportFileFlag).SetPrintUsage().Build()
+	}
+	reportFormat = strings.ToLower(reportFormat)
+	if err := dolt_ci.ValidateReportFormat(reportFormat); err != nil {
+		return "", "", errhand.VerboseErrorFromError(err)
+	}
+	return reportFormat, reportFile, nil
+}
+
+// WriteReportFile writes |report| to the file at |path| in |format|.
+func WriteReportFile(report *dolt_ci.Report, format, path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		rerr := f.Close()
+		if err == nil {
+			err = rerr
+		}
+	}()
+	return report.Write(f, format)
+}
+
+// queryAndPrint iterates through the jobs and steps for the given config, then runs each saved query and given assertion.
+// If |report| is not nil, the result of each step is recorded in it.
+func queryAndPrint(sqlCtx *sql.Context, queryist cli.Queryist, config *dolt_ci.WorkflowConfig, savedQueries map[string]string, report *dolt_ci.Report) (bool, error) {
 	// returns true if any job had failures
 	overallFailed := false
 	for _, job := range config.Jobs {
@@ -134,21 +200,43 @@ func queryAndPrint(sqlCtx *sql.Context, queryist cli.Queryist, config *dolt_ci.W
 
 			var err error
 			var details string
+			start := time.Now()
+			reportCase := &dolt_ci.ReportCase{Name: step.GetName()}
 			if sq, ok := step.(*dolt_ci.SavedQueryStep); ok {
 				query := savedQueries[sq.SavedQueryName.Value]
 				rows, qErr := runCIQuery(queryist, sqlCtx, sq, query)
 				if qErr == nil {
-					err = assertQueries(rows, sq.ExpectedRows.Value, sq.ExpectedColumns.Value, query)
+					err = dolt_ci.AssertSavedQueryResults(rows, sq.ExpectedRows.Value, sq.ExpectedColumns.Value)
+					reportCase.Expected, reportCase.Actual = dolt_ci.SavedQueryAssertions(rows, sq.ExpectedRows.Value, sq.ExpectedColumns.Value)
 				} else {
 					err = qErr
 				}
+				reportCase.Query = query
+				reportCase.Duration = time.Since(start)
 				details = formatSavedQueryDetails(sq.SavedQueryName.Value, query, err)
 			} else if dt, ok := step.(*dolt_ci.DoltTestStep); ok {
-				details, err = runDoltTestStep(sqlCtx, queryist, dt)
+				var rows []sql.Row
+				var rErr error
+				rows, details, err = runDoltTestStep(sqlCtx, queryist, dt)
+				reportCase.Duration = time.Since(start)
+				if report != nil && err != nil && len(rows) > 0 {
+					if reportCase.Expected, reportCase.Actual, rErr = failedDoltTestAssertions(sqlCtx, rows); rErr != nil {
+						return false, rErr
+					}
+				}
 			} else {
 				panic("unsupported step type")
 			}
 
+			if report != nil {
+				reportCase.Status = dolt_ci.WorkflowRunStatusPassed
+				if err != nil {
+					reportCase.Status = dolt_ci.WorkflowRunStatusFailed
+					reportCase.Message = err.Error()
+				}
+				report.AddCase(job.Name.Value, reportCase)
+			}
+
 			// Print details for DoltTest and SavedQuery steps; they do not emit PASS/FAIL inline
 			if (isDoltTest || isSavedQuery) && details != "" {
 				cli.Println(indentLines(details, "  "))
@@ -166,7 +254,7 @@ func queryAndPrint(sqlCtx *sql.Context, queryist cli.Queryist, config *dolt_ci.W
 			cli.Println(color.CyanString("Result of '%s':", job.Name.Value) + " " + color.GreenString("PASS"))
 		}
 	}
-	return overallFailed
+	return overallFailed, nil
 }
 
 // indentLines prefixes every line in s with the given prefix.
@@ -235,36 +323,3 @@ func runCIQuery(queryist cli.Queryist, sqlCtx *sql.Context, step *dolt_ci.SavedQ
 
 	return rows, nil
 }
-
-// assertQueries takes in the result of a saved query execution, and the unparsed assertions,
-// then returns if the assertions failed
-func assertQueries(rows []sql.Row, expectedRowsAndComparison string, expectedColumnsAndComparison string, query string) error {
-	var colCount int64
-	var errs []string
-	rowCount := int64(len(rows))
-	if rowCount > 0 {
-		colCount = int64(len(rows[0]))
-	}
-
-	colCompType, expectedCols, err := dolt_ci.ParseSavedQueryExpectedResultString(expectedColumnsAndComparison)
-	if colCompType != dolt_ci.WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified {
-		err = dolt_ci.ValidateQueryExpectedRowOrColumnCount(colCount, expectedCols, colCompType, "column")
-		if err != nil {
-			errStr := fmt.Sprintf("Assertion failed: %s", err.Error())
-			errs = append(errs, errStr)
-		}
-	}
-	rowCompType, expectedRows, err := dolt_ci.ParseSavedQueryExpectedResultString(expectedRowsAndComparison)
-	if rowCompType != dolt_ci.WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified {
-		err = dolt_ci.ValidateQueryExpectedRowOrColumnCount(rowCount, expectedRows, rowCompType, "row")
-		if err != nil {
-			errStr := fmt.Sprintf("Assertion failed: %s", err.Error())
-			errs = append(errs, errStr)
-		}
-	}
-
-	if len(errs) > 0 {
-		return errors.New(strings.Join(errs, "\n"))
-	}
-	return nil
-}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/creds"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
@@ -135,6 +136,12 @@ func clone(ctx context.Context, apr *argparser.ArgParseResults, dEnv *env.DoltEn
 	if err != nil {
 		return errhand.BuildDError("error: '%s' is not valid.", urlStr).Build()
 	}
+	if scheme == dbfactory.BundleScheme {
+		if verr = checkBundleCloneable(remoteUrl); verr != nil {
+			return verr
+		}
+	}
+
 	var params map[string]string
 	params, verr = parseRemoteArgs(apr, scheme, remoteUrl)
 	if verr != nil {
@@ -225,6 +232,12 @@ func parseArgs(apr *argparser.ArgParseResults) (string, string, errhand.VerboseE
 		} else if dir == "/" {
 			return "", "", errhand.BuildDError("Could not infer repo name.  Please explicitly define a directory for this url").Build()
 		}
+		if strings.HasSuffix(dir, bundle.FileExt) {
+			dir = strings.TrimSuffix(dir, bundle.FileExt)
+			if dir == "" {
+				return "", "", errhand.BuildDError("Could not infer repo name.  Please explicitly define a directory for this url").Build()
+			}
+		}
 		if strings.HasSuffix(dir, ".git") {
 			dir = strings.TrimSuffix(dir, ".git")
 			if dir == "" {
@@ -236,6 +249,23 @@ func parseArgs(apr *argparser.ArgParseResults) (string, string, errhand.VerboseE
 	return dir, urlStr, nil
 }
 
+// checkBundleCloneable returns an error if the bundle at |bundleUrl| is incremental, since incremental bundles don't
+// contain the history needed to create a new repository.
+func checkBundleCloneable(bundleUrl string) errhand.VerboseError {
+	u, err := earl.Parse(bundleUrl)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+	header, err := bundle.ReadHeader(u.Host + u.Path)
+	if err != nil {
+		return errhand.BuildDError("error: '%s' is not a valid bundle", u.Host+u.Path).AddCause(err).Build()
+	}
+	if header.IsIncremental() {
+		return errhand.BuildDError("error: cannot clone an incremental bundle; fetch it into a repository which has its prerequisites instead").Build()
+	}
+	return nil
+}
+
 func createRemote(ctx context.Context, remoteName, remoteUrl string, params map[string]string, dEnv *env.DoltEnv, cloneRoot string) (env.Remote, *doltdb.DoltDB, errhand.VerboseError) {
 	cli.Printf("cloning %s\n", remoteUrl)
 
@@ -315,7 +315,7 @@ func (s sqlDiffWriter) RowWriter(ctx context.Context, fromTableInfo, toTableInfo
 	}
 
 	// TODO: schema names
-	return sqlexport.NewSqlDiffWriter(tds.ToTableName.Name, targetSch, iohelp.NopWrCloser(cli.CliOut)), nil
+	return sqlexport.NewSqlDiffWriter(tds.ToTableName.Name, targetSch, iohelp.NopWrCloser(cli.CliOut), false), nil
 }
 
 type jsonDiffWriter struct {
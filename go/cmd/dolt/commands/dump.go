@@ -53,6 +53,8 @@ const (
 	noAutocommitFlag = "no-autocommit"
 	schemaOnlyFlag   = "schema-only"
 	noCreateDbFlag   = "no-create-db"
+	dumpFromParam    = "from"
+	dumpToParam      = "to"
 
 	sqlFileExt     = "sql"
 	csvFileExt     = "csv"
@@ -69,10 +71,19 @@ If a dump file already exists then the operation will fail, unless the {{.Emphas
 is provided. The force flag forces the existing dump file to be overwritten. The {{.EmphasisLeft}}-r{{.EmphasisRight}} flag 
 is used to support different file formats of the dump. In the case of non .sql files each table is written to a separate
 csv,json or parquet file. 
+
+With {{.EmphasisLeft}}--from{{.EmphasisRight}}, an incremental dump is written instead, which contains only the schema and 
+data changes between the {{.EmphasisLeft}}--from{{.EmphasisRight}} revision and the {{.EmphasisLeft}}--to{{.EmphasisRight}} revision, which defaults to 
+{{.EmphasisLeft}}WORKING{{.EmphasisRight}}. An incremental .sql file brings a database at the {{.EmphasisLeft}}--from{{.EmphasisRight}} revision up to date with the 
+{{.EmphasisLeft}}--to{{.EmphasisRight}} revision, with the same DDL as {{.EmphasisLeft}}dolt schema migrate{{.EmphasisRight}} followed by INSERT, UPDATE and DELETE 
+statements for the rows that changed. Tables whose rows cannot be diffed, for example because their primary key 
+changed, are deleted and written in full. In the case of non .sql files, each table with changed rows is written to a 
+separate file that contains the changed rows and a diff_type column, which is one of added, modified or removed. 
 `,
 
 	Synopsis: []string{
 		"[-f] [-r {{.LessThan}}result-format{{.GreaterThan}}] [-fn {{.LessThan}}file_name{{.GreaterThan}}]  [-d {{.LessThan}}directory{{.GreaterThan}}] [--batch] [--no-batch] [--no-autocommit] [--no-create-db] ",
+		"--from {{.LessThan}}revision{{.GreaterThan}} [--to {{.LessThan}}revision{{.GreaterThan}}] [-f] [-r {{.LessThan}}result-format{{.GreaterThan}}] [-fn {{.LessThan}}file_name{{.GreaterThan}}]  [-d {{.LessThan}}directory{{.GreaterThan}}] [--no-autocommit] [--schema-only] [--no-create-db]",
 	},
 }
 
@@ -105,6 +116,8 @@ func (cmd DumpCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(noAutocommitFlag, "na", "Turn off autocommit for each dumped table. Useful for speeding up loading of output SQL file.")
 	ap.SupportsFlag(schemaOnlyFlag, "", "Dump a table's schema, without including any data, to the output SQL file.")
 	ap.SupportsFlag(noCreateDbFlag, "", "Do not write `CREATE DATABASE` statements in SQL files.")
+	ap.SupportsString(dumpFromParam, "", "revision", "Write an incremental dump of the changes since this revision.")
+	ap.SupportsString(dumpToParam, "", "revision", "The revision an incremental dump brings the --from revision up to date with. Defaults to WORKING.")
 	return ap
 }
 
@@ -128,7 +141,10 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 	if err != nil {
 		return HandleVErrAndExitCode(errhand.BuildDError("error: failed to get tables").AddCause(err).Build(), usage)
 	}
-	if len(tblNames) == 0 {
+
+	fromRev, incremental := apr.GetValue(dumpFromParam)
+	toRev := apr.GetValueOrDefault(dumpToParam, "WORKING")
+	if !incremental && len(tblNames) == 0 {
 		cli.Println("No tables to export.")
 		return 0
 	}
@@ -192,6 +208,14 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 			return HandleVErrAndExitCode(err, usage)
 		}
 
+		if incremental {
+			err = dumpIncrementalSql(sqlCtx, engine, dEnv.FS, fromRev, toRev, apr.Contains(noAutocommitFlag), schemaOnly, fPath)
+			if err != nil {
+				return HandleVErrAndExitCode(err, usage)
+			}
+			break
+		}
+
 		for _, tbl := range tblNames {
 			tblOpts := newTableArgs(tbl, dumpOpts.dest, !apr.Contains(noBatchFlag), apr.Contains(noAutocommitFlag), schemaOnly)
 			err = dumpTable(sqlCtx, dEnv, engine.GetUnderlyingEngine(), root, tblOpts, fPath)
@@ -205,7 +229,11 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 			return HandleVErrAndExitCode(err, usage)
 		}
 	case csvFileExt, jsonFileExt, parquetFileExt:
-		err = dumpNonSqlTables(sqlCtx, engine.GetUnderlyingEngine(), root, dEnv, force, tblNames, resFormat, outputFileOrDirName, false)
+		if incremental {
+			err = dumpIncrementalNonSqlTables(sqlCtx, engine, dEnv, force, fromRev, toRev, resFormat, outputFileOrDirName)
+		} else {
+			err = dumpNonSqlTables(sqlCtx, engine.GetUnderlyingEngine(), root, dEnv, force, tblNames, resFormat, outputFileOrDirName, false)
+		}
 		if err != nil {
 			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
 		}
@@ -714,6 +742,10 @@ func validateDumpArgs(apr *argparser.ArgParseResults) (string, errhand.VerboseEr
 	dn, dnOk := apr.GetValue(directoryFlag)
 	snOk := apr.Contains(schemaOnlyFlag)
 
+	if apr.Contains(dumpToParam) && !apr.Contains(dumpFromParam) {
+		return emptyStr, errhand.BuildDError("--%s requires --%s", dumpToParam, dumpFromParam).SetPrintUsage().Build()
+	}
+
 	if fnOk && dnOk {
 		return emptyStr, errhand.BuildDError("cannot pass both directory and file names").SetPrintUsage().Build()
 	}
@@ -0,0 +1,481 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/fatih/color"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/sqlexport"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// migrationStatement is a statement returned by the dolt_schema_migration table function
+type migrationStatement struct {
+	objectType string
+	statement  string
+}
+
+// dumpIncrementalSql writes the schema and data changes between the |fromRev| and |toRev| revisions to the file at
+// |path|, as statements that bring a database at |fromRev| up to date with |toRev|. Schema changes are written first,
+// then the data changes of each table, and last the views, triggers, events and procedures that were created or
+// changed. Triggers that exist in both revisions on the tables whose data changed are dropped before the data changes
+// and created again after them, so that no trigger fires while the data is loaded.
+func dumpIncrementalSql(ctx *sql.Context, queryist cli.Queryist, fs filesys.Filesys, fromRev, toRev string, autocommitOff, schemaOnly bool, path string) errhand.VerboseError {
+	tableStmts, objectStmts, err := getSchemaMigrationStatements(queryist, ctx, fromRev, toRev)
+	if err != nil {
+		return errhand.BuildDError("error: unable to get schema changes from %s to %s", fromRev, toRev).AddCause(err).Build()
+	}
+
+	if !schemaOnly {
+		names, creates, err := getReplayedTriggers(queryist, ctx, fromRev, toRev)
+		if err != nil {
+			return errhand.BuildDError("error: unable to get triggers from %s to %s", fromRev, toRev).AddCause(err).Build()
+		}
+		for _, name := range names {
+			drop := fmt.Sprintf("DROP TRIGGER IF EXISTS %s;", sqlfmt.QuoteIdentifier(ctx, name))
+			tableStmts = append(tableStmts, migrationStatement{objectType: sqlfmt.SchemaObjectTrigger, statement: drop})
+		}
+		objectStmts = append(creates, objectStmts...)
+	}
+
+	if verr := writeMigrationStatements(fs, path, tableStmts); verr != nil {
+		return verr
+	}
+
+	if !schemaOnly {
+		newWriter := func(tableName string, toSch schema.Schema) (diff.SqlRowDiffWriter, error) {
+			wr, err := fs.OpenForWriteAppend(path, os.ModePerm)
+			if err != nil {
+				return nil, err
+			}
+			return sqlexport.NewSqlDiffWriter(tableName, toSch, wr, autocommitOff), nil
+		}
+
+		if verr := dumpTableDataDiffs(ctx, queryist, fromRev, toRev, true, newWriter); verr != nil {
+			return verr
+		}
+	}
+
+	return writeMigrationStatements(fs, path, objectStmts)
+}
+
+// dumpIncrementalNonSqlTables writes the rows that changed between the |fromRev| and |toRev| revisions to one file
+// per table in the directory |dirName|, in the result format |rf|. Each row is written with the columns of the table
+// at |toRev| and a diff_type column that is one of added, modified or removed. Schema changes are not written.
+func dumpIncrementalNonSqlTables(ctx *sql.Context, queryist cli.Queryist, dEnv *env.DoltEnv, force bool, fromRev, toRev, rf, dirName string) errhand.VerboseError {
+	if dirName == emptyStr {
+		dirName = "doltdump/"
+	} else if !strings.HasSuffix(dirName, "/") {
+		dirName = fmt.Sprintf("%s/", dirName)
+	}
+
+	root, verr := GetWorkingWithVErr(dEnv)
+	if verr != nil {
+		return verr
+	}
+
+	newWriter := func(tableName string, toSch schema.Schema) (diff.SqlRowDiffWriter, error) {
+		fName := fmt.Sprintf("%s%s.%s", dirName, tableName, rf)
+		dumpOpts := getDumpOptions(fName, rf, false)
+
+		fPath, verr := checkAndCreateOpenDestFile(ctx, root, dEnv, force, dumpOpts, fName)
+		if verr != nil {
+			return nil, verr
+		}
+
+		cols := toSch.GetAllCols().GetColumns()
+		cols = append(cols, schema.NewColumn("diff_type", schema.DiffTypeTag, types.StringKind, false))
+		outSch, err := schema.SchemaFromCols(schema.NewColCollection(cols...))
+		if err != nil {
+			return nil, err
+		}
+
+		tblOpts := newTableArgs(tableName, dumpOpts.dest, false, false, false)
+		wr, verr := getTableWriter(ctx, dEnv, tblOpts, outSch, fPath)
+		if verr != nil {
+			return nil, verr
+		}
+
+		return diffTypeRowWriter{wr: wr}, nil
+	}
+
+	return dumpTableDataDiffs(ctx, queryist, fromRev, toRev, false, newWriter)
+}
+
+// getSchemaMigrationStatements returns the statements that migrate the schema of |fromRev| to |toRev|, split into the
+// statements that are run before the table data is written and the statements that create views, triggers, events
+// and procedures, which are run after it.
+func getSchemaMigrationStatements(queryist cli.Queryist, sqlCtx *sql.Context, fromRev, toRev string) (tableStmts, objectStmts []migrationStatement, err error) {
+	q, err := dbr.InterpolateForDialect(
+		"select object_type, statement from dolt_schema_migration(?, ?) where direction = ? order by statement_order",
+		[]interface{}{fromRev, toRev, dtablefunctions.SchemaMigrationUp},
+		dialect.MySQL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := cli.GetRowsForSql(queryist, sqlCtx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, row := range rows {
+		stmt := migrationStatement{objectType: fmt.Sprint(row[0]), statement: fmt.Sprint(row[1])}
+		if isSchemaObjectCreate(stmt) {
+			objectStmts = append(objectStmts, stmt)
+		} else {
+			tableStmts = append(tableStmts, stmt)
+		}
+	}
+
+	return tableStmts, objectStmts, nil
+}
+
+// getReplayedTriggers returns the names and the CREATE statements of the triggers that exist unchanged in the
+// |fromRev| and |toRev| revisions on the tables whose data changed between them, in the order they were created. The
+// schema migration leaves them in place, so they would fire again while the data changes are loaded.
+func getReplayedTriggers(queryist cli.Queryist, sqlCtx *sql.Context, fromRev, toRev string) (names []string, creates []migrationStatement, err error) {
+	q, err := dbr.InterpolateForDialect(
+		"select from_table_name, to_table_name from dolt_diff_summary(?, ?) where data_change",
+		[]interface{}{fromRev, toRev},
+		dialect.MySQL)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := cli.GetRowsForSql(queryist, sqlCtx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	changed := make(map[string]struct{})
+	for _, row := range rows {
+		for _, name := range row {
+			changed[strings.ToLower(fmt.Sprint(name))] = struct{}{}
+		}
+	}
+	if len(changed) == 0 {
+		return nil, nil, nil
+	}
+
+	q, err = dbr.InterpolateForDialect(
+		"select t.name, t.fragment from dolt_schemas as of ? f join dolt_schemas as of ? t "+
+			"on f.type = t.type and f.name = t.name and f.fragment = t.fragment "+
+			"where t.type = 'trigger' order by json_extract(t.extra, '$.CreatedAt'), t.name",
+		[]interface{}{fromRev, toRev},
+		dialect.MySQL)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err = cli.GetRowsForSql(queryist, sqlCtx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, row := range rows {
+		name, stmt := fmt.Sprint(row[0]), fmt.Sprint(row[1])
+		parsed, err := sqlparser.Parse(stmt)
+		if err != nil {
+			return nil, nil, err
+		}
+		ddl, ok := parsed.(*sqlparser.DDL)
+		if !ok {
+			continue
+		}
+		if _, ok = changed[strings.ToLower(ddl.Table.Name.String())]; ok {
+			names = append(names, name)
+			creates = append(creates, migrationStatement{objectType: sqlfmt.SchemaObjectTrigger, statement: stmt + ";"})
+		}
+	}
+	return names, creates, nil
+}
+
+// isSchemaObjectCreate returns whether |stmt| creates a view, trigger, event or procedure
+func isSchemaObjectCreate(stmt migrationStatement) bool {
+	switch stmt.objectType {
+	case sqlfmt.SchemaObjectView, sqlfmt.SchemaObjectTrigger, sqlfmt.SchemaObjectEvent, sqlfmt.SchemaObjectProcedure:
+		return strings.HasPrefix(strings.ToUpper(stmt.statement), "CREATE")
+	default:
+		return false
+	}
+}
+
+// writeMigrationStatements appends |stmts| to the file at |path|, delimited like the statements of a migration script.
+func writeMigrationStatements(fs filesys.Filesys, path string, stmts []migrationStatement) errhand.VerboseError {
+	if len(stmts) == 0 {
+		return nil
+	}
+
+	writer, err := fs.OpenForWriteAppend(path, os.ModePerm)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	for _, stmt := range stmts {
+		err = iohelp.WriteLine(writer, sqlfmt.DelimitMigrationStatement(stmt.objectType, stmt.statement))
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	return nil
+}
+
+// dumpTableDataDiffs writes the rows of every user table that changed between |fromRev| and |toRev| to the row writer
+// returned by |newWriter| for the table. Tables whose rows cannot be diffed because their primary key or column types
+// changed are rewritten in full when |rewriteIncompatible| is true, and are skipped with a warning otherwise.
+func dumpTableDataDiffs(
+	sqlCtx *sql.Context,
+	queryist cli.Queryist,
+	fromRev, toRev string,
+	rewriteIncompatible bool,
+	newWriter func(tableName string, toSch schema.Schema) (diff.SqlRowDiffWriter, error),
+) errhand.VerboseError {
+	// tables whose rows cannot be diffed are only reported as schema changes, so both kinds of changes are needed
+	deltas, err := getDeltasBetweenRefs(queryist, sqlCtx, fromRev, toRev)
+	if err != nil {
+		return errhand.BuildDError("error: unable to get diff summary").AddCause(err).Build()
+	}
+
+	for _, delta := range deltas {
+		tableName := delta.ToTableName.Name
+		if delta.IsDrop() || !isIncrementallyDumpedTable(tableName) {
+			continue
+		}
+
+		var fromSch schema.Schema
+		if !delta.IsAdd() {
+			fromInfo, err := getTableInfoAtRef(queryist, sqlCtx, delta.FromTableName.Name, fromRev)
+			if err != nil {
+				return errhand.VerboseErrorFromError(err)
+			}
+			fromSch = fromInfo.Sch
+		}
+
+		toInfo, err := getTableInfoAtRef(queryist, sqlCtx, tableName, toRev)
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+
+		diffable := schema.ArePrimaryKeySetsDiffable(fromSch, toInfo.Sch) && areValueSetsDiffable(fromSch, toInfo.Sch)
+		if diffable && !delta.DataChange {
+			continue
+		} else if !diffable && !rewriteIncompatible {
+			cli.PrintErrln(color.YellowString("Incompatible schema change, skipping data changes for table '%s'", tableName))
+			continue
+		}
+
+		wr, err := newWriter(tableName, toInfo.Sch)
+		if err != nil {
+			return errhand.BuildDError("Error creating writer for %s.", tableName).AddCause(err).Build()
+		}
+
+		if diffable {
+			err = dumpTableDataDiff(sqlCtx, queryist, fromRev, toRev, delta, fromSch, toInfo.Sch, wr)
+		} else {
+			err = dumpTableRewrite(sqlCtx, queryist, toRev, tableName, toInfo.Sch, wr)
+		}
+
+		closeErr := wr.Close(sqlCtx)
+		if err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return errhand.BuildDError("Error with dumping %s.", tableName).AddCause(err).Build()
+		}
+	}
+
+	return nil
+}
+
+// isIncrementallyDumpedTable returns whether the data of the table named |name| is part of an incremental dump. Like
+// a full dump, system tables are left out, and the rows of dolt_schemas and dolt_procedures are written as the
+// statements that create the schema objects instead.
+func isIncrementallyDumpedTable(name string) bool {
+	return !doltdb.HasDoltPrefix(name) && !doltdb.HasDoltCIPrefix(name) && !doltdb.IsFullTextTable(name) &&
+		!strings.HasPrefix(name, diff.DBPrefix)
+}
+
+// dumpTableDataDiff writes the rows of the table in |summary| that changed between |fromRev| and |toRev| to |wr|,
+// using the columns of the table at |toRev|.
+func dumpTableDataDiff(
+	sqlCtx *sql.Context,
+	queryist cli.Queryist,
+	fromRev, toRev string,
+	summary diff.TableDeltaSummary,
+	fromSch, toSch schema.Schema,
+	wr diff.SqlRowDiffWriter,
+) error {
+	tableName := summary.ToTableName.Name
+	targetSch, err := sqlutil.FromDoltSchema(sqlCtx, sqlCtx.GetCurrentDatabase(), tableName, toSch)
+	if err != nil {
+		return err
+	}
+
+	var fromTableInfo *diff.TableInfo
+	if fromSch != nil {
+		fromTableInfo = &diff.TableInfo{Name: summary.FromTableName.Name, Sch: fromSch}
+	}
+	toTableInfo := &diff.TableInfo{Name: tableName, Sch: toSch}
+
+	columnNames, format := getColumnNames(fromTableInfo, toTableInfo)
+	query := fmt.Sprintf("select %s ? from dolt_diff(?, ?, ?)", format)
+	var params []interface{}
+	for _, col := range columnNames {
+		params = append(params, dbr.I(col))
+	}
+	params = append(params, dbr.I("diff_type"), fromRev, toRev, tableName)
+
+	interpolatedQuery, err := dbr.InterpolateForDialect(query, params, dialect.MySQL)
+	if err != nil {
+		return err
+	}
+
+	sch, rowIter, _, err := queryist.Query(sqlCtx, interpolatedQuery)
+	if err != nil {
+		return err
+	}
+	defer rowIter.Close(sqlCtx)
+
+	ds, err := diff.NewDiffSplitter(sch, targetSch.Schema)
+	if err != nil {
+		return err
+	}
+
+	for {
+		r, err := rowIter.Next(sqlCtx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		oldRow, newRow, err := ds.SplitDiffResultRow(sqlCtx, r)
+		if err != nil {
+			return err
+		}
+
+		// the old half of a modified row is only needed to find the columns that changed
+		if oldRow.Row != nil && oldRow.RowDiff != diff.ModifiedOld {
+			if err = wr.WriteRow(sqlCtx, oldRow.Row, oldRow.RowDiff, oldRow.ColDiffs); err != nil {
+				return err
+			}
+		}
+		if newRow.Row != nil {
+			if err = wr.WriteRow(sqlCtx, newRow.Row, newRow.RowDiff, newRow.ColDiffs); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// dumpTableRewrite writes a DELETE statement for every row of the table |tableName|, followed by all of its rows at
+// |toRev|. It is used for tables whose rows cannot be diffed, such as tables whose primary key changed.
+func dumpTableRewrite(sqlCtx *sql.Context, queryist cli.Queryist, toRev, tableName string, toSch schema.Schema, wr diff.SqlRowDiffWriter) error {
+	diffWr, ok := wr.(*sqlexport.SqlDiffWriter)
+	if !ok {
+		return fmt.Errorf("unable to rewrite table '%s' with a %T", tableName, wr)
+	}
+
+	err := diffWr.WriteStatement(fmt.Sprintf("DELETE FROM %s;", sqlfmt.QuoteIdentifier(sqlCtx, tableName)))
+	if err != nil {
+		return err
+	}
+
+	q, err := dbr.InterpolateForDialect("select * from ? as of ?", []interface{}{dbr.I(tableName), toRev}, dialect.MySQL)
+	if err != nil {
+		return err
+	}
+
+	_, rowIter, _, err := queryist.Query(sqlCtx, q)
+	if err != nil {
+		return err
+	}
+	defer rowIter.Close(sqlCtx)
+
+	colDiffs := make([]diff.ChangeType, toSch.GetAllCols().Size())
+	for i := range colDiffs {
+		colDiffs[i] = diff.Added
+	}
+
+	for {
+		r, err := rowIter.Next(sqlCtx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err = wr.WriteRow(sqlCtx, r, diff.Added, colDiffs); err != nil {
+			return err
+		}
+	}
+}
+
+// diffTypeRowWriter writes the rows of a data diff to a table.SqlRowWriter, with the type of the change appended as
+// the last column of each row.
+type diffTypeRowWriter struct {
+	wr table.SqlRowWriter
+}
+
+var _ diff.SqlRowDiffWriter = diffTypeRowWriter{}
+
+func (w diffTypeRowWriter) WriteRow(ctx *sql.Context, row sql.Row, rowDiffType diff.ChangeType, _ []diff.ChangeType) error {
+	var diffType string
+	switch rowDiffType {
+	case diff.Added:
+		diffType = "added"
+	case diff.Removed:
+		diffType = "removed"
+	case diff.ModifiedNew:
+		diffType = "modified"
+	default:
+		return fmt.Errorf("unexpected row diff type: %v", rowDiffType)
+	}
+
+	return w.wr.WriteSqlRow(ctx, append(row, diffType))
+}
+
+func (w diffTypeRowWriter) WriteCombinedRow(ctx *sql.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	return fmt.Errorf("%T is unable to write combined rows", w)
+}
+
+func (w diffTypeRowWriter) Close(ctx context.Context) error {
+	return w.wr.Close(ctx)
+}
@@ -86,6 +86,9 @@ type SqlEngineConfig struct {
 	SystemVariables            SystemVariables
 	ClusterController          *cluster.Controller
 	AutoGCController           *sqle.AutoGCController
+	ChangeSink                 *sqle.ChangeSink
+	WebhookDispatcher          *sqle.WebhookDispatcher
+	CIRunner                   *sqle.CIRunner
 	BinlogReplicaController    binlogreplication.BinlogReplicaController
 	EventSchedulerStatus       eventscheduler.SchedulerStatus
 	BranchActivityTracking     bool
@@ -269,6 +272,37 @@ func NewSqlEngine(
 		})
 	}
 
+	if config.ChangeSink != nil {
+		if err = config.ChangeSink.ApplyCommitHooks(ctx, mrEnv, dbs...); err != nil {
+			return nil, err
+		}
+		pro.InitDatabaseHooks = append(pro.InitDatabaseHooks, config.ChangeSink.InitDatabaseHook())
+		if err = config.ChangeSink.RunBackgroundThread(bThreads, sqlEngine.NewDefaultContext); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.WebhookDispatcher != nil {
+		if err = config.WebhookDispatcher.ApplyCommitHooks(ctx, mrEnv, dbs...); err != nil {
+			return nil, err
+		}
+		pro.InitDatabaseHooks = append(pro.InitDatabaseHooks, config.WebhookDispatcher.InitDatabaseHook())
+		if err = config.WebhookDispatcher.RunBackgroundThread(bThreads); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.CIRunner != nil {
+		if err = config.CIRunner.ApplyCommitHooks(ctx, mrEnv, dbs...); err != nil {
+			return nil, err
+		}
+		pro.InitDatabaseHooks = append(pro.InitDatabaseHooks, config.CIRunner.InitDatabaseHook())
+		pro.CIRunner = config.CIRunner
+		if err = config.CIRunner.RunBackgroundThread(bThreads, sqlEngine.NewDefaultContext); err != nil {
+			return nil, err
+		}
+	}
+
 	var statsPro sql.StatsProvider
 	_, enabled, _ := sql.SystemVariables.GetGlobal(dsess.DoltStatsEnabled)
 	if enabled.(int8) == 1 {
@@ -451,13 +485,35 @@ func (se *SqlEngine) NewDoltSession(_ context.Context, mysqlSess *sql.BaseSessio
 
 // Query execute a SQL statement and return values for printing.
 func (se *SqlEngine) Query(ctx *sql.Context, query string) (sql.Schema, sql.RowIter, *sql.QueryFlags, error) {
-	return se.engine.Query(ctx, query)
+	return se.QueryWithBindings(ctx, query, nil, nil, nil)
 }
 
 func (se *SqlEngine) QueryWithBindings(ctx *sql.Context, query string, parsed sqlparser.Statement, bindings map[string]sqlparser.Expr, qFlags *sql.QueryFlags) (sql.Schema, sql.RowIter, *sql.QueryFlags, error) {
+	query, parsed, err := se.rewriteSystemTime(ctx, query, parsed)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	return se.engine.QueryWithBindings(ctx, query, parsed, bindings, qFlags)
 }
 
+// rewriteSystemTime rewrites the tables of |query| with a FOR SYSTEM_TIME period to calls of dolt_system_time, which
+// the engine can't plan. Statements the caller already parsed are rewritten and then parsed again by the engine, so
+// that definitions stored from the query's text, like the ones of views, use the rewritten query. This isn't done by
+// overriding the engine's parser, since the planner treats any parser other than the MySQL one as another dialect.
+func (se *SqlEngine) rewriteSystemTime(ctx *sql.Context, query string, parsed sqlparser.Statement) (string, sqlparser.Statement, error) {
+	if parsed == nil {
+		query, err := sqle.RewriteSystemTime(ctx, query, sql.LoadSqlMode(ctx).ParserOptions())
+		return query, nil, err
+	}
+	rewritten, err := sqle.RewriteSystemTimeStatement(parsed)
+	if err != nil {
+		return "", nil, err
+	} else if rewritten {
+		return sqlparser.String(parsed), nil, nil
+	}
+	return query, parsed, nil
+}
+
 // Analyze analyzes a node.
 func (se *SqlEngine) Analyze(ctx *sql.Context, n sql.Node, qFlags *sql.QueryFlags) (sql.Node, error) {
 	return se.engine.Analyzer.Analyze(ctx, n, nil, qFlags)
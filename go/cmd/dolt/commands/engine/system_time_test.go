@@ -0,0 +1,127 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/config"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+)
+
+// TestSystemTime runs queries with FOR SYSTEM_TIME clauses through the engine, which rewrites their tables to calls of
+// dolt_system_time before they're planned.
+func TestSystemTime(t *testing.T) {
+	ctx := context.Background()
+	fs, err := filesys.LocalFS.WithWorkingDir(t.TempDir())
+	require.NoError(t, err)
+	home := t.TempDir()
+	rootEnv := env.LoadWithoutDB(ctx, func() (string, error) { return home, nil }, fs, doltdb.LocalDirDoltDB, "test")
+	rootEnv.Config.WriteableConfig().SetStrings(map[string]string{
+		config.UserNameKey:  "test",
+		config.UserEmailKey: "test@example.com",
+	})
+	mrEnv, err := env.MultiEnvForDirectory(ctx, fs, rootEnv)
+	require.NoError(t, err)
+
+	se, err := NewSqlEngine(ctx, mrEnv, &SqlEngineConfig{ServerUser: "root", ServerHost: "localhost", Autocommit: true})
+	require.NoError(t, err)
+	defer se.Close()
+	sqlCtx, err := se.NewLocalContext(ctx)
+	require.NoError(t, err)
+
+	query := func(q string) []sql.Row {
+		_, iter, _, err := se.Query(sqlCtx, q)
+		require.NoError(t, err, q)
+		rows, err := sql.RowIterToRows(sqlCtx, iter)
+		require.NoError(t, err, q)
+		return rows
+	}
+	for _, q := range []string{
+		"CREATE DATABASE testdb;",
+		"USE testdb;",
+		"CREATE TABLE t (pk int primary key, v varchar(20));",
+		"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+		"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+		"UPDATE t SET v = 'a2' WHERE pk = 1;",
+		"CALL DOLT_COMMIT('-am', 'update one', '--date', '2021-01-01T00:00:00');",
+		"DELETE FROM t WHERE pk = 2;",
+		"INSERT INTO t VALUES (3, 'c');",
+		"CALL DOLT_COMMIT('-am', 'delete two, insert three', '--date', '2022-01-01T00:00:00');",
+		"CREATE VIEW current_versions AS SELECT pk, v FROM t FOR SYSTEM_TIME ALL WHERE valid_to IS NULL;",
+	} {
+		query(q)
+	}
+
+	tests := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			query:    "SELECT pk, v FROM t FOR SYSTEM_TIME ALL ORDER BY pk, valid_from;",
+			expected: []sql.Row{{int32(1), "a"}, {int32(1), "a2"}, {int32(2), "b"}, {int32(3), "c"}},
+		},
+		{
+			query:    "SELECT pk, v FROM t FOR SYSTEM_TIME FROM '2020-06-01' TO '2021-01-01' ORDER BY pk;",
+			expected: []sql.Row{{int32(1), "a"}, {int32(2), "b"}},
+		},
+		{
+			query:    "SELECT pk, v FROM t FOR SYSTEM_TIME BETWEEN '2020-06-01' AND '2021-01-01' ORDER BY pk, v;",
+			expected: []sql.Row{{int32(1), "a"}, {int32(1), "a2"}, {int32(2), "b"}},
+		},
+		{
+			query:    "SELECT pk, v FROM t FOR SYSTEM_TIME CONTAINED IN ('2020-01-01', '2022-01-01') ORDER BY pk;",
+			expected: []sql.Row{{int32(1), "a"}, {int32(2), "b"}},
+		},
+		{
+			query:    "SELECT h.v FROM t FOR SYSTEM_TIME ALL AS h JOIN t ON h.pk = t.pk WHERE h.valid_to IS NOT NULL;",
+			expected: []sql.Row{{"a"}},
+		},
+		{
+			// the view is stored with the rewritten query
+			query:    "SELECT * FROM current_versions ORDER BY pk;",
+			expected: []sql.Row{{int32(1), "a2"}, {int32(3), "c"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			assert.Equal(t, test.expected, query(test.query))
+		})
+	}
+
+	t.Run("parsed statement", func(t *testing.T) {
+		q := "SELECT count(*) FROM t FOR SYSTEM_TIME ALL;"
+		parsed, err := sqlparser.Parse(q)
+		require.NoError(t, err)
+		_, iter, _, err := se.QueryWithBindings(sqlCtx, q, parsed, nil, nil)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(sqlCtx, iter)
+		require.NoError(t, err)
+		assert.Equal(t, []sql.Row{{int64(4)}}, rows)
+	})
+
+	t.Run("qualified table", func(t *testing.T) {
+		_, _, _, err := se.Query(sqlCtx, "SELECT * FROM testdb.t FOR SYSTEM_TIME ALL;")
+		assert.ErrorContains(t, err, "FOR SYSTEM_TIME is only supported on tables of the current database")
+	})
+}
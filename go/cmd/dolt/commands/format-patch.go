@@ -0,0 +1,183 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/fatih/color"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/patch"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const (
+	outputDirectoryParam = "output-directory"
+	stdoutFlag           = "stdout"
+)
+
+var formatPatchDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Write commits out as SQL patch files",
+	LongDesc: `Writes each commit in {{.LessThan}}revision-range{{.GreaterThan}} to its own patch file, oldest first. A patch
+holds the commit's author, date and message, the hash of its parent, and the SQL statements that make the commit's
+schema and data changes, the same statements {{.EmphasisLeft}}dolt_patch(){{.EmphasisRight}} generates. The metadata is
+written as a SQL comment, so a patch can be run with {{.EmphasisLeft}}dolt sql{{.EmphasisRight}} as well as applied with
+{{.EmphasisLeft}}dolt apply{{.EmphasisRight}} or committed with {{.EmphasisLeft}}dolt am{{.EmphasisRight}}.
+
+{{.LessThan}}revision-range{{.GreaterThan}} is either a range like {{.EmphasisLeft}}main..feature{{.EmphasisRight}}, or a
+single commit, in which case every commit after it up to {{.EmphasisLeft}}HEAD{{.EmphasisRight}} is written. Merge commits
+are skipped.
+
+Files are named after each commit's number in the series and its subject, such as
+{{.EmphasisLeft}}0001-add-users-table.sql{{.EmphasisRight}}, and the name of each file written is printed.
+`,
+	Synopsis: []string{
+		`[-o {{.LessThan}}dir{{.GreaterThan}} | --stdout] {{.LessThan}}revision-range{{.GreaterThan}}`,
+	},
+}
+
+type FormatPatchCmd struct{}
+
+var _ cli.Command = FormatPatchCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd FormatPatchCmd) Name() string {
+	return "format-patch"
+}
+
+// Description returns a description of the command
+func (cmd FormatPatchCmd) Description() string {
+	return formatPatchDocs.ShortDesc
+}
+
+func (cmd FormatPatchCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(formatPatchDocs, ap)
+}
+
+func (cmd FormatPatchCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"revision-range", "The commits to write, as a range like {{.LessThan}}from{{.GreaterThan}}..{{.LessThan}}to{{.GreaterThan}} or a single commit to write everything after."})
+	ap.SupportsString(outputDirectoryParam, "o", "dir", "Write the patch files into {{.LessThan}}dir{{.GreaterThan}} instead of the current directory.")
+	ap.SupportsFlag(stdoutFlag, "", "Print all patches to standard output instead of writing files.")
+	return ap
+}
+
+// Exec executes the command
+func (cmd FormatPatchCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, formatPatchDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if apr.NArg() != 1 {
+		usage()
+		return 1
+	}
+	if apr.Contains(outputDirectoryParam) && apr.Contains(stdoutFlag) {
+		return HandleVErrAndExitCode(errhand.BuildDError("error: --stdout and --output-directory are mutually exclusive").Build(), usage)
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	patches, err := formatPatches(queryist.Queryist, queryist.Context, apr.Arg(0))
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	if apr.Contains(stdoutFlag) {
+		for _, p := range patches {
+			cli.Print(p.String())
+		}
+		return 0
+	}
+
+	dir := apr.GetValueOrDefault(outputDirectoryParam, ".")
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	for i, p := range patches {
+		path := filepath.Join(dir, p.FileName(i+1))
+		if err = os.WriteFile(path, []byte(p.String()), 0644); err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		cli.Println(path)
+	}
+	return 0
+}
+
+// formatPatches returns a patch for each non-merge commit in |revRange|, oldest first.
+func formatPatches(queryist cli.Queryist, sqlCtx *sql.Context, revRange string) ([]*patch.Patch, error) {
+	if !strings.Contains(revRange, "..") {
+		revRange += "..HEAD"
+	}
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "SELECT commit_hash FROM dolt_log(?)", revRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var patches []*patch.Patch
+	for i := len(rows) - 1; i >= 0; i-- {
+		commitHash := rows[i][0].(string)
+		commit, err := getCommitInfo(sqlCtx, queryist, commitHash)
+		if err != nil {
+			return nil, err
+		} else if commit == nil {
+			return nil, fmt.Errorf("error: unable to read commit %s", commitHash)
+		}
+		if len(commit.parentHashes) != 1 {
+			continue
+		}
+
+		stmtRows, err := InterpolateAndRunQuery(queryist, sqlCtx,
+			"SELECT statement FROM dolt_patch(?, ?) ORDER BY statement_order", commit.parentHashes[0], commitHash)
+		if err != nil {
+			return nil, err
+		}
+		stmts := make([]string, len(stmtRows))
+		for j, row := range stmtRows {
+			stmts[j] = row[0].(string)
+		}
+		// dolt_patch skips data changes it can't express as SQL, so pass along its warnings about the patch being
+		// incomplete.
+		warnings, err := cli.GetRowsForSql(queryist, sqlCtx, "SHOW WARNINGS")
+		if err != nil {
+			return nil, err
+		}
+		for _, warning := range warnings {
+			cli.PrintErrln(color.YellowString("warning: commit %s: %v", commitHash, warning[2]))
+		}
+
+		patches = append(patches, &patch.Patch{
+			CommitHash: commitHash,
+			ParentHash: commit.parentHashes[0],
+			Author:     commit.commitMeta.Author.Name,
+			Email:      commit.commitMeta.Author.Email,
+			Date:       commit.commitMeta.Author.Date.Time(),
+			Message:    commit.commitMeta.Description,
+			Statements: stmts,
+		})
+	}
+	return patches, nil
+}
@@ -1013,6 +1013,8 @@ func getRawReferencesFromStoreRoot(ctx context.Context, cs chunks.ChunkStore, er
 				if commitHash, ok := resolveTagToCommit(ctx, cs, name, addr, errs); ok {
 					refs[commitHash] = append(refs[commitHash], name)
 				}
+			case ref.NotesRefType:
+				// Notes are keyed by commit hash but don't reference the commits, so there's nothing to walk from here.
 			default:
 				return fmt.Errorf("unexpected ref type (%s) from ref: %s", refType, name)
 			}
@@ -57,9 +57,13 @@ The command takes options to control what is shown and how.
 	
 {{.EmphasisLeft}}dolt log <revisionB>...<revisionA>{{.EmphasisRight}}
 {{.EmphasisLeft}}dolt log <revisionA> <revisionB> --not $(dolt merge-base <revisionA> <revisionB>){{.EmphasisRight}}
-  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.`,
+  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.
+
+{{.EmphasisLeft}}dolt log --row <table> <pk>...{{.EmphasisRight}}
+  Lists the commits from HEAD that added, modified or removed the row of table with the given primary key values, in the order of the table's primary key columns, along with each version of the row.`,
 	Synopsis: []string{
 		`[-n {{.LessThan}}num_commits{{.GreaterThan}}] [{{.LessThan}}revision-range{{.GreaterThan}}] [[--] {{.LessThan}}table{{.GreaterThan}}]`,
+		`[-n {{.LessThan}}num_commits{{.GreaterThan}}] --row {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}pk{{.GreaterThan}}...`,
 	},
 }
 
@@ -106,6 +110,10 @@ func (cmd LogCmd) logWithLoggerFunc(ctx context.Context, commandStr string, args
 		return handleErrAndExit(err)
 	}
 
+	if apr.Contains(cli.RowFlag) {
+		return handleErrAndExit(logRowHistory(apr, queryist.Queryist, queryist.Context))
+	}
+
 	query, err := constructInterpolatedDoltLogQuery(apr, queryist.Queryist, queryist.Context)
 	if err != nil {
 		return handleErrAndExit(err)
@@ -297,7 +305,7 @@ func getExistingTables(revisions []string, queryist cli.Queryist, sqlCtx *sql.Co
 
 // logCommits takes a list of sql rows that have only 1 column, commit hash, and retrieves the commit info for each hash to be printed to std out
 func logCommits(apr *argparser.ArgParseResults, commitHashes []sql.Row, queryist cli.Queryist, sqlCtx *sql.Context) error {
-	opts := commitInfoOptions{showSignature: apr.Contains(cli.ShowSignatureFlag)}
+	opts := commitInfoOptions{showSignature: apr.Contains(cli.ShowSignatureFlag), showNotes: apr.Contains(cli.NotesFlag)}
 	var commitsInfo []CommitInfo
 	for _, hash := range commitHashes {
 		cmHash := hash[0].(string)
@@ -318,6 +326,102 @@ func logCommits(apr *argparser.ArgParseResults, commitHashes []sql.Row, queryist
 	return logToStdOut(apr, commitsInfo, sqlCtx, queryist, decoration)
 }
 
+// rowHistoryCommitCols is the number of columns dolt_row_history() returns after the table's columns.
+const rowHistoryCommitCols = 6
+
+// logRowHistory prints each commit that changed the row whose table and primary key values are the arguments in
+// |apr|, along with the version of the row in that commit.
+func logRowHistory(apr *argparser.ArgParseResults, queryist cli.Queryist, sqlCtx *sql.Context) error {
+	if apr.NArg() < 2 {
+		return fmt.Errorf("error: --%s requires a table and the primary key values of a row", cli.RowFlag)
+	}
+
+	params := make([]interface{}, apr.NArg())
+	for i, arg := range apr.Args {
+		params[i] = arg
+	}
+	query := "select * from dolt_row_history(?" + strings.Repeat(", ?", apr.NArg()-1) + ")"
+	if numLines, hasNumLines := apr.GetValue(cli.NumberFlag); hasNumLines {
+		num, err := strconv.Atoi(numLines)
+		if err != nil || num < 0 {
+			return fmt.Errorf("fatal: invalid --number argument: %s", numLines)
+		}
+		query += " limit " + numLines
+	}
+	query, err := dbr.InterpolateForDialect(query, params, dialect.MySQL)
+	if err != nil {
+		return err
+	}
+
+	sch, rowIter, _, err := queryist.Query(sqlCtx, query)
+	if err != nil {
+		return err
+	}
+	rows, err := sql.RowIterToRows(sqlCtx, rowIter)
+	if err != nil {
+		return err
+	}
+	numCols := len(sch) - rowHistoryCommitCols
+
+	var commits []CommitInfo
+	if !apr.Contains(cli.OneLineFlag) {
+		opts := commitInfoOptions{showSignature: apr.Contains(cli.ShowSignatureFlag), showNotes: apr.Contains(cli.NotesFlag)}
+		for _, row := range rows {
+			commit, err := getCommitInfoWithOptions(sqlCtx, queryist, row[numCols].(string), opts)
+			if err != nil {
+				return err
+			} else if commit == nil {
+				return fmt.Errorf("no commits found for ref %s", row[numCols])
+			}
+			commits = append(commits, *commit)
+		}
+	}
+
+	// Resolve auto before opening the pager, like logCommits does.
+	decoration := resolveDecorateAuto(apr.GetValueOrDefault(cli.DecorateFlag, cli.DecorateAuto))
+
+	if cli.ExecuteWithStdioRestored == nil {
+		return nil
+	}
+	cli.ExecuteWithStdioRestored(func() {
+		pager := outputpager.Start()
+		defer pager.Stop()
+		color.NoColor = false
+		for i, row := range rows {
+			diffType := row[numCols+5].(string)
+			if apr.Contains(cli.OneLineFlag) {
+				desc := strings.Replace(row[numCols+4].(string), "\n", " ", -1)
+				pager.Writer.Write([]byte(color.YellowString("%s ", row[numCols]) + colorRowDiffType(diffType) + " " + desc + "\n"))
+				continue
+			}
+
+			PrintCommitInfo(pager, 0, false, apr.Contains(cli.ShowSignatureFlag), decoration, &commits[i])
+			pager.Writer.Write([]byte("Row " + colorRowDiffType(diffType) + ":\n"))
+			for j := 0; j < numCols; j++ {
+				v := row[j]
+				if v == nil {
+					v = "NULL"
+				}
+				pager.Writer.Write([]byte(fmt.Sprintf("\t%s: %v\n", sch[j].Name, v)))
+			}
+			pager.Writer.Write([]byte("\n"))
+		}
+	})
+	return nil
+}
+
+// colorRowDiffType returns |diffType| colored the way diffs color added, modified and removed rows.
+func colorRowDiffType(diffType string) string {
+	switch diffType {
+	case "added":
+		return color.GreenString(diffType)
+	case "removed":
+		return color.RedString(diffType)
+	default:
+		return color.YellowString(diffType)
+	}
+}
+
 // resolveDecorateAuto returns DecorateShort when stdout is a terminal and DecorateNo
 // otherwise. Other |decorate| values pass through unchanged.
 func resolveDecorateAuto(decorate string) string {
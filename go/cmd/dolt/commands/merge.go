@@ -91,6 +91,7 @@ func (cmd MergeCmd) EventType() eventsapi.ClientEventType {
 func (cmd MergeCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
 	ap := cli.CreateMergeArgParser()
 	ap.SupportsFlag(cli.NoJsonMergeFlag, "", "Do not attempt to automatically resolve multiple changes to the same JSON value, report a conflict instead.")
+	ap.SupportsFlag(cli.MergeTextLinesFlag, "", "Attempt to automatically resolve multiple changes to the same TEXT value line by line, report a conflict only when the same lines changed.")
 	apr, usage, terminate, status := ParseArgsOrPrintHelp(ap, commandStr, args, mergeDocs)
 	if terminate {
 		return status
@@ -127,6 +128,14 @@ func (cmd MergeCmd) Exec(ctx context.Context, commandStr string, args []string,
 		}
 	}
 
+	if apr.Contains(cli.MergeTextLinesFlag) {
+		_, _, _, err = queryist.Queryist.Query(queryist.Context, "set @@dolt_merge_text_lines = 1")
+		if err != nil {
+			cli.Println(err.Error())
+			return 1
+		}
+	}
+
 	query, err := constructInterpolatedDoltMergeQuery(apr, cliCtx)
 	if err != nil {
 		cli.Println(err.Error())
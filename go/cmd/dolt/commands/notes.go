@@ -0,0 +1,164 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/fatih/color"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var notesDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Add, show, list, or remove notes attached to commits",
+	LongDesc: `Attaches notes to existing commits without changing them. A note records who wrote it, when, and a
+message, such as an audit sign-off or a link to a ticket. Notes are stored outside of the commit graph under
+{{.EmphasisLeft}}refs/notes/commits{{.EmphasisRight}}, so adding, replacing or removing a note never changes a commit's
+hash. Each commit can have at most one note.
+
+{{.EmphasisLeft}}dolt notes add{{.EmphasisRight}} attaches the message given with {{.EmphasisLeft}}-m{{.EmphasisRight}}
+to a commit, which defaults to {{.EmphasisLeft}}HEAD{{.EmphasisRight}}. Use {{.EmphasisLeft}}-f{{.EmphasisRight}} to
+replace a note the commit already has.
+
+{{.EmphasisLeft}}dolt notes show{{.EmphasisRight}} prints the note attached to a commit, and
+{{.EmphasisLeft}}dolt notes list{{.EmphasisRight}} prints every annotated commit along with the first line of its note.
+
+{{.EmphasisLeft}}dolt notes remove{{.EmphasisRight}} removes the notes attached to the given commits.
+
+Notes are pushed and fetched along with the rest of a database. When both sides have a note for the same commit, the
+most recently written one is kept. Use {{.EmphasisLeft}}dolt log --notes{{.EmphasisRight}} to show notes in the log,
+and the {{.EmphasisLeft}}dolt_notes{{.EmphasisRight}} system table to query them.
+`,
+	Synopsis: []string{
+		`add [-f] [--author {{.LessThan}}author{{.GreaterThan}}] -m {{.LessThan}}msg{{.GreaterThan}} [{{.LessThan}}commit{{.GreaterThan}}]`,
+		`show [{{.LessThan}}commit{{.GreaterThan}}]`,
+		`list`,
+		`remove [{{.LessThan}}commit{{.GreaterThan}}...]`,
+	},
+}
+
+type NotesCmd struct{}
+
+var _ cli.Command = NotesCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd NotesCmd) Name() string {
+	return "notes"
+}
+
+// Description returns a description of the command
+func (cmd NotesCmd) Description() string {
+	return notesDocs.ShortDesc
+}
+
+func (cmd NotesCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(notesDocs, ap)
+}
+
+func (cmd NotesCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateNotesArgParser()
+}
+
+// Exec executes the command
+func (cmd NotesCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, notesDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	switch strings.ToLower(apr.Arg(0)) {
+	case "add", "remove":
+		err = modifyNotes(queryist.Queryist, queryist.Context, args)
+	case "show":
+		err = showNote(queryist.Queryist, queryist.Context, apr)
+	case "list":
+		err = listNotes(queryist.Queryist, queryist.Context, apr)
+	default:
+		err = fmt.Errorf("error: unknown notes subcommand '%s'", apr.Arg(0))
+	}
+	return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+}
+
+// modifyNotes runs an add or remove subcommand through the dolt_notes procedure.
+func modifyNotes(queryist cli.Queryist, sqlCtx *sql.Context, args []string) error {
+	query, err := interpolateStoredProcedureCall("DOLT_NOTES", args)
+	if err != nil {
+		return err
+	}
+	_, err = cli.GetRowsForSql(queryist, sqlCtx, query)
+	return err
+}
+
+func showNote(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) error {
+	if apr.Contains(cli.MessageArg) || apr.Contains(cli.ForceFlag) || apr.Contains(cli.AuthorParam) {
+		return errors.New("error: show does not take -m, -f, or --author")
+	} else if apr.NArg() > 2 {
+		return errors.New("error: too many arguments, show takes at most one commit")
+	}
+
+	rev := "HEAD"
+	if apr.NArg() > 1 {
+		rev = apr.Arg(1)
+	}
+	commitHash, err := getHashOf(queryist, sqlCtx, rev)
+	if err != nil {
+		return err
+	}
+
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "SELECT note FROM dolt_notes WHERE commit_hash = ?", commitHash)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("error: no note found for commit %s", commitHash)
+	}
+	cli.Println(rows[0][0].(string))
+	return nil
+}
+
+func listNotes(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) error {
+	if apr.Contains(cli.MessageArg) || apr.Contains(cli.ForceFlag) || apr.Contains(cli.AuthorParam) {
+		return errors.New("error: list does not take -m, -f, or --author")
+	} else if apr.NArg() > 1 {
+		return errors.New("error: too many arguments, list takes no commits")
+	}
+
+	rows, err := cli.GetRowsForSql(queryist, sqlCtx, "SELECT commit_hash, note FROM dolt_notes ORDER BY commit_hash")
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		summary, _, _ := strings.Cut(row[1].(string), "\n")
+		cli.Println(color.YellowString("%s", row[0].(string)) + " " + summary)
+	}
+	return nil
+}
@@ -49,10 +49,12 @@ When the command line does not specify what to push with {{.LessThan}}refspec{{.
 A remote's branch can be deleted by pushing an empty source ref: ` + "`dolt push origin :branch`" + `
 
 When neither the command-line does not specify what to push, the default behavior is used, which corresponds to the current branch being pushed to the corresponding upstream branch, but as a safety measure, the push is aborted if the upstream branch does not have the same name as the local one.
+
+When commit verification is enabled with the {{.EmphasisLeft}}dolt_commit_verification_groups{{.EmphasisRight}} system variable, the change assertions of the verification test groups are run against the commits being pushed to each branch, from its remote tracking branch, and the push is aborted if any of them fail. Use {{.EmphasisLeft}}--skip-verification{{.EmphasisRight}} to push without running them.
 `,
 
 	Synopsis: []string{
-		"[-u | --set-upstream] [{{.LessThan}}remote{{.GreaterThan}}] [{{.LessThan}}refspec{{.GreaterThan}}]",
+		"[-u | --set-upstream] [--skip-verification] [{{.LessThan}}remote{{.GreaterThan}}] [{{.LessThan}}refspec{{.GreaterThan}}]",
 	},
 }
 
@@ -168,6 +170,9 @@ func constructInterpolatedDoltPushQuery(apr *argparser.ArgParseResults) (string,
 	if all := apr.Contains(cli.AllFlag); all {
 		args = append(args, fmt.Sprintf("'--%s'", cli.AllFlag))
 	}
+	if apr.Contains(cli.SkipVerificationFlag) {
+		args = append(args, fmt.Sprintf("'--%s'", cli.SkipVerificationFlag))
+	}
 	for _, arg := range apr.Args {
 		args = append(args, "?")
 		params = append(params, arg)
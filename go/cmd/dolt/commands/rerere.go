@@ -0,0 +1,135 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/fatih/color"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var rerereDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Reuse recorded resolutions of conflicting merges",
+	LongDesc: `When {{.EmphasisLeft}}@@dolt_rerere_enabled{{.EmphasisRight}} is set, Dolt records how each conflicting row is
+resolved, and resolves the same conflict the same way the next time a merge, cherry-pick or rebase produces it. A
+conflict is the same when the row has the same primary key and the same base, ours and theirs values in a table with
+the same schema.
+
+Conflicts are recorded as pending when a merge produces them, and their resolutions are recorded once the merge is
+committed. Conflicts that are resolved automatically are removed from the conflicts tables, and the merge only stops
+if other conflicts remain. Conflicts in keyless tables are never recorded, nor are resolutions of rows with TEXT, BLOB or
+JSON values large enough to be stored out of the row.
+
+{{.EmphasisLeft}}dolt rerere status{{.EmphasisRight}} prints the pending conflicts of the current branch whose
+resolutions will be recorded.
+
+{{.EmphasisLeft}}dolt rerere forget{{.EmphasisRight}} forgets the recorded resolutions and pending conflicts of the
+given tables.
+
+{{.EmphasisLeft}}dolt rerere clear{{.EmphasisRight}} forgets the pending conflicts of the current branch, so that
+their resolutions aren't recorded. This is done automatically when a merge is aborted.
+
+Recorded resolutions are stored locally, and are not pushed or fetched. Use the
+{{.EmphasisLeft}}dolt_rerere{{.EmphasisRight}} system table to query them.
+`,
+	Synopsis: []string{
+		`status`,
+		`forget {{.LessThan}}table{{.GreaterThan}}...`,
+		`clear`,
+	},
+}
+
+type RerereCmd struct{}
+
+var _ cli.Command = RerereCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd RerereCmd) Name() string {
+	return "rerere"
+}
+
+// Description returns a description of the command
+func (cmd RerereCmd) Description() string {
+	return rerereDocs.ShortDesc
+}
+
+func (cmd RerereCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(rerereDocs, ap)
+}
+
+func (cmd RerereCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateRerereArgParser()
+}
+
+// Exec executes the command
+func (cmd RerereCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, rerereDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	switch strings.ToLower(apr.Arg(0)) {
+	case "forget", "clear":
+		err = modifyRerere(queryist.Queryist, queryist.Context, args)
+	case "status":
+		err = rerereStatus(queryist.Queryist, queryist.Context, apr)
+	default:
+		err = fmt.Errorf("error: unknown rerere subcommand '%s'", apr.Arg(0))
+	}
+	return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+}
+
+// modifyRerere runs a forget or clear subcommand through the dolt_rerere procedure.
+func modifyRerere(queryist cli.Queryist, sqlCtx *sql.Context, args []string) error {
+	query, err := interpolateStoredProcedureCall("DOLT_RERERE", args)
+	if err != nil {
+		return err
+	}
+	_, err = cli.GetRowsForSql(queryist, sqlCtx, query)
+	return err
+}
+
+func rerereStatus(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) error {
+	if apr.NArg() > 1 {
+		return errors.New("error: too many arguments, status takes no tables")
+	}
+
+	rows, err := cli.GetRowsForSql(queryist, sqlCtx, "SELECT table_name, row_key FROM dolt_rerere WHERE status = 'pending'")
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		cli.Println(color.YellowString("%s", row[0].(string)) + " (" + row[1].(string) + ")")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schcmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	eventsapi "github.com/dolthub/eventsapi_schema/dolt/services/eventsapi/v1alpha1"
+)
+
+const (
+	migrateFromParam = "from"
+	migrateToParam   = "to"
+	migrateDownFlag  = "down"
+)
+
+var schemaMigrateDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Generates the DDL script that migrates the schema of one revision to another.",
+	LongDesc: `{{.EmphasisLeft}}dolt schema migrate{{.EmphasisRight}} prints an ordered, executable DDL script that migrates the schema of the {{.EmphasisLeft}}--from{{.EmphasisRight}} revision to the schema of the {{.EmphasisLeft}}--to{{.EmphasisRight}} revision, including tables, indexes, foreign keys, checks, views, triggers, events and procedures. The script can be used to roll out schema changes to another MySQL database, such as a replica.
+
+Columns are matched by their tags, so renamed columns are renamed rather than dropped and added back, and their data is kept. Foreign keys are dropped before the tables and columns they reference change and are added back afterward.
+
+With {{.EmphasisLeft}}--down{{.EmphasisRight}}, the reverse script is printed instead, which migrates the schema of the {{.EmphasisLeft}}--to{{.EmphasisRight}} revision back to the schema of the {{.EmphasisLeft}}--from{{.EmphasisRight}} revision.
+
+The {{.EmphasisLeft}}--from{{.EmphasisRight}} revision defaults to {{.EmphasisLeft}}HEAD{{.EmphasisRight}} and the {{.EmphasisLeft}}--to{{.EmphasisRight}} revision defaults to {{.EmphasisLeft}}WORKING{{.EmphasisRight}}. The same statements are returned by the {{.EmphasisLeft}}dolt_schema_migration(){{.EmphasisRight}} table function.`,
+	Synopsis: []string{
+		"[--from {{.LessThan}}revision{{.GreaterThan}}] [--to {{.LessThan}}revision{{.GreaterThan}}] [--down]",
+	},
+}
+
+// MigrateCmd implements the cli.Command interface for the schema migrate command.
+type MigrateCmd struct{}
+
+var _ cli.Command = MigrateCmd{}
+
+// Name implements the cli.Command interface.
+func (cmd MigrateCmd) Name() string {
+	return "migrate"
+}
+
+// Description implements the cli.Command interface.
+func (cmd MigrateCmd) Description() string {
+	return "Generates the DDL script that migrates the schema of one revision to another."
+}
+
+// Docs implements the cli.Command interface.
+func (cmd MigrateCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(schemaMigrateDocs, ap)
+}
+
+// ArgParser implements the cli.Command interface.
+func (cmd MigrateCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
+	ap.SupportsString(migrateFromParam, "", "revision", "The revision whose schema is migrated from. Defaults to HEAD.")
+	ap.SupportsString(migrateToParam, "", "revision", "The revision whose schema is migrated to. Defaults to WORKING.")
+	ap.SupportsFlag(migrateDownFlag, "", "Print the script that migrates the schema of the --to revision back to the --from revision.")
+	return ap
+}
+
+// EventType implements the cli.Command interface.
+func (cmd MigrateCmd) EventType() eventsapi.ClientEventType {
+	return eventsapi.ClientEventType_SCHEMA
+}
+
+// Exec implements the cli.Command interface.
+func (cmd MigrateCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, schemaMigrateDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	from := apr.GetValueOrDefault(migrateFromParam, "HEAD")
+	to := apr.GetValueOrDefault(migrateToParam, "WORKING")
+	direction := dtablefunctions.SchemaMigrationUp
+	if apr.Contains(migrateDownFlag) {
+		direction = dtablefunctions.SchemaMigrationDown
+	}
+
+	query, err := dbr.InterpolateForDialect(
+		"SELECT object_type, statement FROM dolt_schema_migration(?, ?) WHERE direction = ? ORDER BY statement_order",
+		[]interface{}{from, to, direction}, dialect.MySQL)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	rows, err := cli.GetRowsForSql(queryist.Queryist, queryist.Context, query)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	for _, row := range rows {
+		cli.Println(sqlfmt.DelimitMigrationStatement(fmt.Sprint(row[0]), fmt.Sprint(row[1])))
+	}
+
+	return 0
+}
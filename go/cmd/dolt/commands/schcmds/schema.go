@@ -27,6 +27,7 @@ var Commands = cli.NewSubCommandHandler("schema", "Commands for showing and impo
 	TagsCmd{},
 	UpdateTagCmd{},
 	CopyTagsCmd{},
+	MigrateCmd{},
 })
 
 // ValidateTableNameForCreate validates the given table name for creation as a user table, returning an error if the
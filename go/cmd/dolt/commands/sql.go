@@ -59,6 +59,8 @@ var sqlDocs = cli.CommandDocumentationContent{
 
 Multiple SQL statements must be separated by semicolons. Use {{.EmphasisLeft}}-b{{.EmphasisRight}} to enable batch mode to speed up large batches of INSERT / UPDATE statements. Pipe SQL files to dolt sql (no {{.EmphasisLeft}}-q{{.EmphasisRight}}) to execute a SQL import or update script. 
 
+Saved queries can declare parameters in the {{.EmphasisLeft}}dolt_query_catalog_parameters{{.EmphasisRight}} system table, which they reference as {{.EmphasisLeft}}:name{{.EmphasisRight}} placeholders. Use {{.EmphasisLeft}}-x {{.LessThan}}name{{.GreaterThan}} --param {{.LessThan}}parameter{{.GreaterThan}}={{.LessThan}}value{{.GreaterThan}}{{.EmphasisRight}} once for each parameter to run a saved query with values for its parameters, or the {{.EmphasisLeft}}dolt_query_run(){{.EmphasisRight}} table function to run it from SQL.
+
 By default this command uses the dolt database in the current working directory. If you would prefer to use a different directory, user the {{.EmphasisLeft}}--data-dir <directory>{{.EmphasisRight}} argument before the sql subcommand.
 
 If a server is running for the database in question, then the query will go through the server automatically. If connecting to a remote server is preferred, used the {{.EmphasisLeft}}--host <host>{{.EmphasisRight}} and {{.EmphasisLeft}}--port <port>{{.EmphasisRight}} global arguments. See 'dolt --help' for more information about global arguments.`,
@@ -67,7 +69,7 @@ If a server is running for the database in question, then the query will go thro
 		"",
 		"< script.sql",
 		"-q {{.LessThan}}query{{.GreaterThan}} [-r {{.LessThan}}result format{{.GreaterThan}}] [-s {{.LessThan}}name{{.GreaterThan}} -m {{.LessThan}}message{{.GreaterThan}}] [-b]",
-		"-x {{.LessThan}}name{{.GreaterThan}}",
+		"-x {{.LessThan}}name{{.GreaterThan}} [--param {{.LessThan}}parameter{{.GreaterThan}}={{.LessThan}}value{{.GreaterThan}}]...",
 		"--list-saved",
 	},
 }
@@ -81,6 +83,7 @@ const (
 	executeFlag           = "execute"
 	listSavedFlag         = "list-saved"
 	messageFlag           = "message"
+	paramFlag             = "param"
 	BatchFlag             = "batch"
 	DataDirFlag           = "data-dir"
 	MultiDBDirFlag        = "multi-db-dir"
@@ -151,6 +154,7 @@ func (cmd SqlCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsString(executeFlag, "x", "saved query name", "Executes a saved query with the given name.")
 	ap.SupportsFlag(listSavedFlag, "l", "List all saved queries.")
 	ap.SupportsString(messageFlag, "m", "saved query description", "Used with --query and --save, saves the query with the descriptive message given. See also `--name`.")
+	ap.SupportsRepeatableString(paramFlag, "", "parameter=value", "Used with --execute, binds a parameter of the saved query, as declared in the dolt_query_catalog_parameters system table. Can be given once for each parameter.")
 	ap.SupportsFlag(BatchFlag, "b", "Use to enable more efficient batch processing for large SQL import scripts. This mode is no longer supported and this flag is a no-op. To speed up your SQL imports, use either LOAD DATA, or structure your SQL import script to insert many rows per statement.")
 	ap.SupportsFlag(continueFlag, "c", "Continue running queries on an error. Used for batch mode only.")
 	ap.SupportsString(fileInputFlag, "f", "input file", "Execute statements from the file given.")
@@ -247,7 +251,8 @@ func (cmd SqlCmd) Exec(ctx context.Context, commandStr string, args []string, dE
 		}
 		return queryMode(queryist.Context, queryist.Queryist, apr, query, format, usage, binaryAsHex)
 	} else if savedQueryName, exOk := apr.GetValue(executeFlag); exOk {
-		return executeSavedQuery(queryist.Context, queryist.Queryist, savedQueryName, format, usage, binaryAsHex)
+		params, _ := apr.GetRepeatedValues(paramFlag)
+		return executeSavedQuery(queryist.Context, queryist.Queryist, savedQueryName, params, format, usage, binaryAsHex)
 	} else if apr.Contains(listSavedFlag) {
 		return listSavedQueries(queryist.Context, queryist.Queryist, format, usage)
 	} else {
@@ -365,7 +370,7 @@ func listSavedQueries(ctx *sql.Context, qryist cli.Queryist, format engine.Print
 	return sqlHandleVErrAndExitCode(qryist, execSingleQuery(ctx, qryist, query, format, false), usage)
 }
 
-func executeSavedQuery(ctx *sql.Context, qryist cli.Queryist, savedQueryName string, format engine.PrintResultFormat, usage cli.UsagePrinter, binaryAsHex bool) int {
+func executeSavedQuery(ctx *sql.Context, qryist cli.Queryist, savedQueryName string, params []string, format engine.PrintResultFormat, usage cli.UsagePrinter, binaryAsHex bool) int {
 	var buffer bytes.Buffer
 	buffer.WriteString("SELECT query FROM dolt_query_catalog where id = ?")
 	searchQuery, err := dbr.InterpolateForDialect(buffer.String(), []interface{}{savedQueryName}, dialect.MySQL)
@@ -391,9 +396,43 @@ func executeSavedQuery(ctx *sql.Context, qryist cli.Queryist, savedQueryName str
 	}
 
 	cli.PrintErrf("Executing saved query '%s':\n%s\n", savedQueryName, query)
+
+	// Saved queries which declare parameters are run with dolt_query_run, which binds their parameters
+	paramsQuery, err := dbr.InterpolateForDialect("SELECT COUNT(*) FROM dolt_query_catalog_parameters where query_id = ?", []interface{}{savedQueryName}, dialect.MySQL)
+	if err != nil {
+		return sqlHandleVErrAndExitCode(qryist, errhand.VerboseErrorFromError(err), usage)
+	}
+	rows, err = cli.GetRowsForSql(qryist, ctx, paramsQuery)
+	if err != nil {
+		return sqlHandleVErrAndExitCode(qryist, errhand.VerboseErrorFromError(err), usage)
+	}
+	declaresParams := len(rows) > 0 && fmt.Sprintf("%v", rows[0][0]) != "0"
+	if declaresParams || len(params) > 0 {
+		query, err = constructInterpolatedDoltQueryRunQuery(savedQueryName, params)
+		if err != nil {
+			return sqlHandleVErrAndExitCode(qryist, errhand.VerboseErrorFromError(err), usage)
+		}
+	}
+
 	return sqlHandleVErrAndExitCode(qryist, execSingleQuery(ctx, qryist, query, format, binaryAsHex), usage)
 }
 
+// constructInterpolatedDoltQueryRunQuery generates the query which runs the saved query |savedQueryName| with
+// dolt_query_run, passing each of |params|, given as parameter=value, as a named argument.
+func constructInterpolatedDoltQueryRunQuery(savedQueryName string, params []string) (string, error) {
+	args := []string{"?"}
+	values := []interface{}{savedQueryName}
+	for _, param := range params {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok || name == "" {
+			return "", fmt.Errorf("invalid --%s %s: expected parameter=value", paramFlag, param)
+		}
+		args = append(args, "?", "?")
+		values = append(values, "--"+name, value)
+	}
+	return dbr.InterpolateForDialect(fmt.Sprintf("SELECT * FROM dolt_query_run(%s)", strings.Join(args, ", ")), values, dialect.MySQL)
+}
+
 func queryMode(
 	ctx *sql.Context,
 	qryist cli.Queryist,
@@ -559,6 +598,7 @@ func validateSqlArgs(apr *argparser.ArgParseResults) error {
 	_, msg := apr.GetValue(messageFlag)
 	_, list := apr.GetValue(listSavedFlag)
 	_, execute := apr.GetValue(executeFlag)
+	_, param := apr.GetValue(paramFlag)
 	_, dataDir := apr.GetValue(DataDirFlag)
 	_, multiDbDir := apr.GetValue(MultiDBDirFlag)
 
@@ -584,6 +624,10 @@ func validateSqlArgs(apr *argparser.ArgParseResults) error {
 		}
 	}
 
+	if param && !execute {
+		return errhand.BuildDError("Invalid Argument: --%s is only used with --execute|-x", paramFlag).Build()
+	}
+
 	if list {
 		if execute {
 			return errhand.BuildDError("Invalid Argument: --list-saved is not compatible with --executed|x").Build()
@@ -44,6 +44,7 @@ type commandLineServerConfig struct {
 	autoCommit              bool
 	doltTransactionCommit   bool
 	branchActivityTracking  bool
+	runCIWorkflows          bool
 	maxConnections          uint64
 	maxWaitConnections      uint32
 	maxWaitConnsTimeout     time.Duration
@@ -91,6 +92,7 @@ func DefaultCommandLineServerConfig() *commandLineServerConfig {
 		logFormat:               servercfg.DefaultLogFormat,
 		autoCommit:              servercfg.DefaultAutoCommit,
 		branchActivityTracking:  servercfg.DefaultBranchActivityTracking,
+		runCIWorkflows:          servercfg.DefaultRunCIWorkflows,
 		maxConnections:          servercfg.DefaultMaxConnections,
 		maxWaitConnections:      servercfg.DefaultMaxWaitConnections,
 		maxWaitConnsTimeout:     servercfg.DefaultMaxWaitConnectionsTimeout,
@@ -292,6 +294,11 @@ func (cfg *commandLineServerConfig) BranchActivityTracking() bool {
 	return cfg.branchActivityTracking
 }
 
+// RunCIWorkflows enables or disables running the dolt_ci workflows of the server's databases. The default is false.
+func (cfg *commandLineServerConfig) RunCIWorkflows() bool {
+	return cfg.runCIWorkflows
+}
+
 // MaxConnections returns the maximum number of simultaneous connections the server will allow.  The default is 1
 func (cfg *commandLineServerConfig) MaxConnections() uint64 {
 	return cfg.maxConnections
@@ -401,6 +408,14 @@ func (cfg *commandLineServerConfig) ClusterConfig() servercfg.ClusterConfig {
 	return nil
 }
 
+func (cfg *commandLineServerConfig) ChangeDataCapture() servercfg.ChangeDataCaptureConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) Webhooks() []servercfg.WebhookConfig {
+	return nil
+}
+
 // PrivilegeFilePath returns the path to the file which contains all needed privilege information in the form of a
 // JSON string.
 func (cfg *commandLineServerConfig) PrivilegeFilePath() string {
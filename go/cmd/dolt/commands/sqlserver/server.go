@@ -69,6 +69,9 @@ const (
 	ApiSqleContextKey   = "__sqle_context__"
 )
 
+// webhooksDir is the directory of the config dir the deliveries of webhooks are persisted to
+const webhooksDir = "webhooks"
+
 // sqlServerHeartbeatIntervalEnvVar is the duration between heartbeats sent to the remote server, used for testing
 const sqlServerHeartbeatIntervalEnvVar = "DOLT_SQL_SERVER_HEARTBEAT_INTERVAL"
 
@@ -315,6 +318,42 @@ func ConfigureServices(
 	}
 	controller.Register(InitAutoGCController)
 
+	InitChangeSink := &svcs.AnonService{
+		InitF: func(context.Context) error {
+			if cdc := cfg.ServerConfig.ChangeDataCapture(); cdc != nil {
+				config.ChangeSink = sqle.NewChangeSink(cdc.Path(), cdc.Branches())
+			}
+			return nil
+		},
+	}
+	controller.Register(InitChangeSink)
+
+	InitWebhookDispatcher := &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			cfgWebhooks := cfg.ServerConfig.Webhooks()
+			if len(cfgWebhooks) == 0 {
+				return nil
+			}
+			webhooks := make([]sqle.Webhook, len(cfgWebhooks))
+			for i, wh := range cfgWebhooks {
+				webhooks[i] = sqle.Webhook{Name: wh.Name(), URL: wh.URL(), Refs: wh.Refs(), Secret: wh.Secret()}
+			}
+			config.WebhookDispatcher, err = sqle.NewWebhookDispatcher(filepath.Join(cfg.ServerConfig.CfgDir(), webhooksDir), webhooks)
+			return err
+		},
+	}
+	controller.Register(InitWebhookDispatcher)
+
+	InitCIRunner := &svcs.AnonService{
+		InitF: func(context.Context) error {
+			if cfg.ServerConfig.RunCIWorkflows() {
+				config.CIRunner = sqle.NewCIRunner()
+			}
+			return nil
+		},
+	}
+	controller.Register(InitCIRunner)
+
 	// mySQLServer is going to be populated down below once further services
 	// are initialized. However, we want to block Controller shutdown on all
 	// connections being fully drained from the Server. Stopping the
@@ -866,16 +905,21 @@ func ConfigureServices(
 					newSessionBuilder(sqlEngine, cfg.ServerConfig),
 					metListener,
 					func(h mysql.Handler) (mysql.Handler, error) {
+						h, err := newSystemTimeHandler(h)
+						if err != nil {
+							return nil, err
+						}
 						return golden.NewValidatingHandler(h, v.GoldenMysqlConnectionString(), logrus.StandardLogger())
 					},
 				)
 			} else {
-				mySQLServer, err = server.NewServer(
+				mySQLServer, err = server.NewServerWithHandler(
 					serverConf,
 					sqlEngine.GetUnderlyingEngine(),
 					sqlEngine.ContextFactory,
 					newSessionBuilder(sqlEngine, cfg.ServerConfig),
 					metListener,
+					newSystemTimeHandler,
 				)
 			}
 			if errors.Is(err, server.UnixSocketInUseError) {
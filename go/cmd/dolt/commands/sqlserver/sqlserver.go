@@ -152,6 +152,12 @@ SUPPORTED CONFIG FILE FIELDS:
 
 {{.EmphasisLeft}}cluster{{.EmphasisRight}}: Settings related to running this server in a replicated cluster. For information on setting these values, see https://docs.dolthub.com/sql-reference/server/replication
 
+{{.EmphasisLeft}}change_data_capture.path{{.EmphasisRight}}: A file or named pipe to which the server continuously writes the row changes of new commits, one JSON change event per line. The events are the same as the ones returned by the {{.EmphasisLeft}}dolt_changes{{.EmphasisRight}} table function.
+
+{{.EmphasisLeft}}change_data_capture.branches{{.EmphasisRight}}: The branches whose commits are written to {{.EmphasisLeft}}change_data_capture.path{{.EmphasisRight}}. Defaults to all branches.
+
+{{.EmphasisLeft}}webhooks{{.EmphasisRight}}: A list of HTTP endpoints which are POSTed a JSON payload when a branch is committed to, merged into, created or deleted, or when a tag is created. Each webhook has a {{.EmphasisLeft}}url{{.EmphasisRight}}, an optional {{.EmphasisLeft}}name{{.EmphasisRight}}, optional {{.EmphasisLeft}}refs{{.EmphasisRight}} patterns such as {{.EmphasisLeft}}refs/heads/main{{.EmphasisRight}} or {{.EmphasisLeft}}refs/tags/*{{.EmphasisRight}} limiting the updates delivered, and an optional {{.EmphasisLeft}}secret{{.EmphasisRight}} used to sign the payloads in the X-Dolt-Signature-256 header. Failed deliveries are retried, and the deliveries of a database are shown in its {{.EmphasisLeft}}dolt_webhook_deliveries{{.EmphasisRight}} system table.
+
 If a config file is not provided many of these settings may be configured on the command line.`,
 	Synopsis: []string{
 		"--config {{.LessThan}}file{{.GreaterThan}}",
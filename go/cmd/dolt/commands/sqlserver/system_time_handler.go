@@ -0,0 +1,96 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/vitess/go/mysql"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+)
+
+// systemTimeHandler is a mysql.Handler which rewrites the tables of queries with a FOR SYSTEM_TIME period to calls
+// of dolt_system_time before they're run by the handler it wraps.
+type systemTimeHandler struct {
+	mysql.Handler
+}
+
+var _ mysql.Handler = systemTimeHandler{}
+var _ mysql.BinlogReplicaHandler = systemTimeHandler{}
+
+// newSystemTimeHandler is a server.HandlerWrapper which returns a systemTimeHandler wrapping |h|.
+func newSystemTimeHandler(h mysql.Handler) (mysql.Handler, error) {
+	return systemTimeHandler{Handler: h}, nil
+}
+
+func (h systemTimeHandler) rewrite(ctx context.Context, c *mysql.Conn, query string) (string, error) {
+	options, err := h.Handler.ParserOptionsForConnection(c)
+	if err != nil {
+		return "", err
+	}
+	return sqle.RewriteSystemTime(ctx, query, options)
+}
+
+// ComQuery implements mysql.Handler
+func (h systemTimeHandler) ComQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) error {
+	query, err := h.rewrite(ctx, c, query)
+	if err != nil {
+		return err
+	}
+	return h.Handler.ComQuery(ctx, c, query, callback)
+}
+
+// ComMultiQuery implements mysql.Handler
+func (h systemTimeHandler) ComMultiQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) (string, error) {
+	query, err := h.rewrite(ctx, c, query)
+	if err != nil {
+		return "", err
+	}
+	return h.Handler.ComMultiQuery(ctx, c, query, callback)
+}
+
+// ComPrepare implements mysql.Handler. The statement executed later is the rewritten one.
+func (h systemTimeHandler) ComPrepare(ctx context.Context, c *mysql.Conn, query string, prepare *mysql.PrepareData) ([]*querypb.Field, error) {
+	rewritten, err := h.rewrite(ctx, c, query)
+	if err != nil {
+		return nil, err
+	}
+	if rewritten != query {
+		query = rewritten
+		prepare.PrepareStmt = rewritten
+	}
+	return h.Handler.ComPrepare(ctx, c, query, prepare)
+}
+
+// ComRegisterReplica implements mysql.BinlogReplicaHandler
+func (h systemTimeHandler) ComRegisterReplica(c *mysql.Conn, replicaHost string, replicaPort uint16, replicaUser string, replicaPassword string) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComRegisterReplica(c, replicaHost, replicaPort, replicaUser, replicaPassword)
+}
+
+// ComBinlogDumpGTID implements mysql.BinlogReplicaHandler
+func (h systemTimeHandler) ComBinlogDumpGTID(c *mysql.Conn, logFile string, logPos uint64, gtidSet mysql.GTIDSet) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComBinlogDumpGTID(c, logFile, logPos, gtidSet)
+}
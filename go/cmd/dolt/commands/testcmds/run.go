@@ -0,0 +1,306 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testcmds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/fatih/color"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/ci"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+// ungroupedSuite is the name of the report suite of tests without a group
+const ungroupedSuite = "ungrouped"
+
+const isolatedFlag = "isolated"
+
+var runDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Run the tests defined in dolt_tests",
+	LongDesc: `Runs the tests defined in the {{.EmphasisLeft}}dolt_tests{{.EmphasisRight}} system table with {{.EmphasisLeft}}dolt_test_run(){{.EmphasisRight}}, and prints whether each of them passed.
+
+Each argument is the name of a test, or the name of a test group to run every test in the group. With no arguments, or with {{.EmphasisLeft}}*{{.EmphasisRight}}, every test is run. The command exits with a non-zero status if any test fails.
+
+With {{.EmphasisLeft}}--isolated{{.EmphasisRight}}, each test group is run on a temporary branch created from the HEAD commit of the current branch, which is deleted afterward, so that it doesn't see the uncommitted changes of the working set. The changes made by the setup queries of a group in {{.EmphasisLeft}}dolt_test_fixtures{{.EmphasisRight}} are always discarded after its tests run.
+
+With {{.EmphasisLeft}}--report-file{{.EmphasisRight}}, a report of the run is also written to the given file, in the format given by {{.EmphasisLeft}}--report{{.EmphasisRight}}, which is either {{.EmphasisLeft}}junit{{.EmphasisRight}} (the default) or {{.EmphasisLeft}}json{{.EmphasisRight}}. The report has a test suite for each test group, including the time it took to run, with a test case for each test, including, for failed tests, the failure message and the expected and actual values of its assertion.`,
+	Synopsis: []string{
+		"[--isolated] [--report junit|json --report-file {{.LessThan}}path{{.GreaterThan}}] [{{.LessThan}}test or group{{.GreaterThan}}...]",
+	},
+}
+
+type RunCmd struct{}
+
+// Name implements cli.Command.
+func (cmd RunCmd) Name() string {
+	return "run"
+}
+
+// Description implements cli.Command.
+func (cmd RunCmd) Description() string {
+	return runDocs.ShortDesc
+}
+
+// RequiresRepo implements cli.Command.
+func (cmd RunCmd) RequiresRepo() bool {
+	return true
+}
+
+// Docs implements cli.Command.
+func (cmd RunCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(runDocs, ap)
+}
+
+// ArgParser implements cli.Command.
+func (cmd RunCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	ap.SupportsFlag(isolatedFlag, "", "Run each test group on a temporary branch created from HEAD.")
+	ci.AddReportArgs(ap, "test run")
+	return ap
+}
+
+// doltTest is a test selected from dolt_tests.
+type doltTest struct {
+	name  string
+	group string
+}
+
+// Exec implements cli.Command.
+func (cmd RunCmd) Exec(ctx context.Context, commandStr string, args []string, _ *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, runDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	reportFormat, reportFile, verr := ci.ParseReportArgs(apr)
+	if verr != nil {
+		return commands.HandleVErrAndExitCode(verr, usage)
+	}
+
+	queryist, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	sqlCtx := queryist.Context
+
+	tests, err := selectTests(sqlCtx, queryist.Queryist, apr.Args)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	report := dolt_ci.NewReport(doltdb.TestsTableName)
+	for _, group := range groupTests(tests) {
+		cases, duration, err := runTestGroup(sqlCtx, queryist.Queryist, group, apr.Contains(isolatedFlag))
+		if err != nil {
+			return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+
+		suite := group[0].group
+		if suite == "" {
+			suite = ungroupedSuite
+		}
+		for i, test := range group {
+			report.AddCase(suite, cases[i])
+			printCase(test, cases[i])
+		}
+		report.AddDuration(suite, duration)
+	}
+
+	passed := report.Tests - report.Failures
+	summary := fmt.Sprintf("%d passed, %d failed", passed, report.Failures)
+	if report.Failures > 0 {
+		cli.Println(color.RedString(summary))
+	} else {
+		cli.Println(color.GreenString(summary))
+	}
+
+	if reportFile != "" {
+		if err = ci.WriteReportFile(report, reportFormat, reportFile); err != nil {
+			return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	}
+
+	if report.Failures > 0 {
+		return 1
+	}
+	return 0
+}
+
+// selectTests returns the tests of dolt_tests selected by |args|, in the order they're selected. Like dolt_test_run,
+// each argument is a test name or a test group, and no arguments or a * selects every test.
+func selectTests(sqlCtx *sql.Context, queryist cli.Queryist, args []string) ([]doltTest, error) {
+	rows, err := cli.GetRowsForSql(queryist, sqlCtx, fmt.Sprintf("SELECT test_name, test_group FROM %s ORDER BY test_name", doltdb.TestsTableName))
+	if err != nil {
+		return nil, err
+	}
+	var all []doltTest
+	for _, row := range rows {
+		var t doltTest
+		if t.name, err = columnString(sqlCtx, row[0]); err != nil {
+			return nil, err
+		}
+		if t.group, err = columnString(sqlCtx, row[1]); err != nil {
+			return nil, err
+		}
+		all = append(all, t)
+	}
+
+	if len(args) == 0 {
+		return all, nil
+	}
+	var selected []doltTest
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		var matches []doltTest
+		if arg == "*" {
+			matches = all
+		} else {
+			for _, t := range all {
+				if t.name == arg {
+					matches = append(matches, t)
+				}
+			}
+			if len(matches) == 0 {
+				for _, t := range all {
+					if t.group == arg {
+						matches = append(matches, t)
+					}
+				}
+			}
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("could not find tests for argument: %s", arg)
+		}
+		for _, t := range matches {
+			if !seen[t.name] {
+				seen[t.name] = true
+				selected = append(selected, t)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// groupTests groups |tests| by their test group, in the order the groups first appear.
+func groupTests(tests []doltTest) [][]doltTest {
+	var groups [][]doltTest
+	idx := make(map[string]int)
+	for _, t := range tests {
+		i, ok := idx[t.group]
+		if !ok {
+			i = len(groups)
+			idx[t.group] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], t)
+	}
+	return groups
+}
+
+// runTestGroup runs |tests|, which are all in the same test group, with a single call of dolt_test_run, so that the
+// fixtures of the group, and its temporary branch if |isolated| is set, are only set up once. It returns the report
+// case of each test, in the same order, and how long the run took.
+func runTestGroup(sqlCtx *sql.Context, queryist cli.Queryist, tests []doltTest, isolated bool) ([]*dolt_ci.ReportCase, time.Duration, error) {
+	args := []string{"'--details'"}
+	if isolated {
+		args = append(args, "'--isolated'")
+	}
+	names := make([]interface{}, len(tests))
+	for i, test := range tests {
+		args = append(args, "?")
+		names[i] = test.name
+	}
+	query, err := dbr.InterpolateForDialect(fmt.Sprintf("SELECT * FROM dolt_test_run(%s)", strings.Join(args, ", ")), names, dialect.MySQL)
+	if err != nil {
+		return nil, 0, err
+	}
+	start := time.Now()
+	rows, err := cli.GetRowsForSql(queryist, sqlCtx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	duration := time.Since(start)
+
+	byName := make(map[string]*dolt_ci.ReportCase, len(rows))
+	for _, row := range rows {
+		vals := make([]string, len(row))
+		for i := range row {
+			if vals[i], err = columnString(sqlCtx, row[i]); err != nil {
+				return nil, 0, err
+			}
+		}
+		expected, actual, err := dolt_ci.DoltTestAssertion(sqlCtx, row)
+		if err != nil {
+			return nil, 0, err
+		}
+		c := &dolt_ci.ReportCase{
+			Name:     vals[0],
+			Query:    vals[2],
+			Status:   dolt_ci.WorkflowRunStatusPassed,
+			Expected: expected,
+		}
+		if !strings.EqualFold(vals[3], "PASS") {
+			c.Status = dolt_ci.WorkflowRunStatusFailed
+			c.Message = vals[4]
+			c.Actual = actual
+		}
+		byName[c.Name] = c
+	}
+
+	cases := make([]*dolt_ci.ReportCase, len(tests))
+	for i, test := range tests {
+		c, ok := byName[test.name]
+		if !ok {
+			return nil, 0, fmt.Errorf("no result for test %s", test.name)
+		}
+		cases[i] = c
+	}
+	return cases, duration, nil
+}
+
+func printCase(test doltTest, c *dolt_ci.ReportCase) {
+	group := test.group
+	if group == "" {
+		group = ungroupedSuite
+	}
+	if c.Status == dolt_ci.WorkflowRunStatusPassed {
+		cli.Printf("- test: %s (group: %s) - %s\n", test.name, group, color.GreenString("PASS"))
+		return
+	}
+	cli.Printf("- test: %s (group: %s) - %s\n", test.name, group, color.RedString("FAIL"))
+	cli.Printf("  - error: %s\n", color.RedString(c.Message))
+}
+
+// columnString returns the string value of a column of a row returned by a queryist.
+func columnString(sqlCtx *sql.Context, v interface{}) (string, error) {
+	v, err := sql.UnwrapAny(sqlCtx, v)
+	if err != nil {
+		return "", err
+	}
+	return cli.QueryValueAsString(v)
+}
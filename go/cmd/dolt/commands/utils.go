@@ -61,6 +61,7 @@ type CommitInfo struct {
 	localBranchNames  []string
 	remoteBranchNames []string
 	tagNames          []string
+	note              string
 }
 
 var fwtStageName = "fwt"
@@ -625,6 +626,11 @@ func PrintCommitInfo(pager *outputpager.Pager, minParents int, showParents, show
 	formattedDesc := "\n\n\t" + strings.Replace(comm.commitMeta.Description, "\n", "\n\t", -1) + "\n\n"
 	pager.Writer.Write([]byte(fmt.Sprintf("%s", formattedDesc)))
 
+	if len(comm.note) > 0 {
+		formattedNote := "Notes:\n\t" + strings.Replace(comm.note, "\n", "\n\t", -1) + "\n\n"
+		pager.Writer.Write([]byte(formattedNote))
+	}
+
 }
 
 // printRefs prints the refs associated with the commit in the formatting used by log and show.
@@ -679,6 +685,8 @@ func printRefs(pager *outputpager.Pager, comm *CommitInfo, decoration string) {
 type commitInfoOptions struct {
 	// showSignature requests the signature column be populated with gpg verifier output.
 	showSignature bool
+	// showNotes requests the notes column be populated with the note attached to the commit.
+	showNotes bool
 }
 
 // getCommitInfo reads the commit at |ref| through the dolt_log table function on |queryist|.
@@ -699,6 +707,9 @@ func getCommitInfoWithOptions(sqlCtx *sql.Context, queryist cli.Queryist, ref st
 	if opts.showSignature {
 		flags += ", '--show-signature'"
 	}
+	if opts.showNotes {
+		flags += ", '--notes'"
+	}
 	q, err := dbr.InterpolateForDialect("select * from dolt_log(?, "+flags+")", []interface{}{ref}, dialect.MySQL)
 	if err != nil {
 		return nil, fmt.Errorf("error interpolating query: %v", err)
@@ -782,6 +793,15 @@ func getCommitInfoWithOptions(sqlCtx *sql.Context, queryist cli.Queryist, ref st
 		return nil, fmt.Errorf("error parsing author timestamp '%v': %w", row[11], err)
 	}
 
+	// notes is NULL when --notes was not requested or the commit has no note, and is missing
+	// entirely from servers which predate it.
+	var note string
+	if len(row) > 12 {
+		if n, ok := row[12].(string); ok {
+			note = n
+		}
+	}
+
 	commitMeta := &datas.CommitMeta{
 		Author: datas.CommitIdent{
 			Name:  authorName,
@@ -819,6 +839,7 @@ func getCommitInfoWithOptions(sqlCtx *sql.Context, queryist cli.Queryist, ref st
 		localBranchNames:  localBranches,
 		remoteBranchNames: remoteBranches,
 		tagNames:          tags,
+		note:              note,
 	}, nil
 }
 
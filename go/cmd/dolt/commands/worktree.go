@@ -0,0 +1,237 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const (
+	worktreeAddCmd    = "add"
+	worktreeListCmd   = "list"
+	worktreeRemoveCmd = "remove"
+	worktreePruneCmd  = "prune"
+)
+
+var worktreeDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Manage multiple working trees",
+	LongDesc: `Manage multiple working trees attached to the same repository.
+
+A repository can have multiple working trees, allowing you to have more than one branch checked out at a time. With
+{{.EmphasisLeft}}dolt worktree add{{.EmphasisRight}}, a new working tree is created in a separate directory. The new
+working tree has its own {{.EmphasisLeft}}.dolt{{.EmphasisRight}} directory, which records the branch it has checked
+out, but it shares the chunk store of the main repository, so no data is copied. Commits, branches and tags created
+in any working tree are visible in all of them.
+
+A branch can only be checked out in one working tree at a time, since the working set of a branch is shared by every
+working tree. {{.EmphasisLeft}}dolt worktree add{{.EmphasisRight}}, {{.EmphasisLeft}}dolt checkout{{.EmphasisRight}}
+and {{.EmphasisLeft}}dolt branch -d{{.EmphasisRight}} refuse to operate on a branch that is checked out in another
+working tree.
+
+{{.EmphasisLeft}}add <path> [<branch>]{{.EmphasisRight}}
+Create a working tree at {{.LessThan}}path{{.GreaterThan}} and check out {{.LessThan}}branch{{.GreaterThan}} in it.
+With {{.EmphasisLeft}}-b{{.EmphasisRight}}, a new branch is created at HEAD, or at {{.LessThan}}branch{{.GreaterThan}}
+if it is given, and checked out. If neither is given, a branch named after the last component of
+{{.LessThan}}path{{.GreaterThan}} is checked out, and created at HEAD if it doesn't already exist.
+
+{{.EmphasisLeft}}list{{.EmphasisRight}}
+List the main working tree followed by each linked working tree, along with the branch each has checked out. Working
+trees whose directories no longer exist are annotated as prunable.
+
+{{.EmphasisLeft}}remove <worktree>{{.EmphasisRight}}
+Remove a working tree, deleting its directory. The working tree can be given by its path or its name. Working trees
+with uncommitted changes are only removed with {{.EmphasisLeft}}--force{{.EmphasisRight}}. The main working tree
+can't be removed.
+
+{{.EmphasisLeft}}prune{{.EmphasisRight}}
+Forget working trees whose directories were deleted without using {{.EmphasisLeft}}dolt worktree remove{{.EmphasisRight}}.
+`,
+	Synopsis: []string{
+		`add [-b {{.LessThan}}new-branch{{.GreaterThan}}] {{.LessThan}}path{{.GreaterThan}} [{{.LessThan}}branch{{.GreaterThan}}]`,
+		`list`,
+		`remove [-f] {{.LessThan}}worktree{{.GreaterThan}}`,
+		`prune`,
+	},
+}
+
+type WorktreeCmd struct{}
+
+var _ cli.Command = WorktreeCmd{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd WorktreeCmd) Name() string {
+	return "worktree"
+}
+
+// Description returns a description of the command
+func (cmd WorktreeCmd) Description() string {
+	return worktreeDocs.ShortDesc
+}
+
+func (cmd WorktreeCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(worktreeDocs, ap)
+}
+
+func (cmd WorktreeCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "One of add, list, remove, or prune."})
+	ap.SupportsString(cli.BranchParam, "b", "new-branch", "Create a new branch named {{.LessThan}}new-branch{{.GreaterThan}} and check it out in the new working tree.")
+	ap.SupportsFlag(cli.ForceFlag, "f", "Remove a working tree even if it has uncommitted changes.")
+	return ap
+}
+
+// Exec executes the command
+func (cmd WorktreeCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, worktreeDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+
+	var verr errhand.VerboseError
+	switch subcommand := strings.ToLower(apr.Arg(0)); subcommand {
+	case worktreeAddCmd:
+		verr = addWorktree(ctx, dEnv, cliCtx, apr)
+	case worktreeListCmd:
+		verr = listWorktrees(dEnv, apr)
+	case worktreeRemoveCmd:
+		verr = removeWorktree(ctx, dEnv, apr)
+	case worktreePruneCmd:
+		verr = pruneWorktrees(dEnv, apr)
+	default:
+		verr = errhand.BuildDError("error: unknown worktree subcommand '%s'", apr.Arg(0)).SetPrintUsage().Build()
+	}
+
+	return HandleVErrAndExitCode(verr, usage)
+}
+
+func addWorktree(ctx context.Context, dEnv *env.DoltEnv, cliCtx cli.CliContext, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() < 2 || apr.NArg() > 3 {
+		return errhand.BuildDError("error: dolt worktree add takes a path and an optional branch").SetPrintUsage().Build()
+	}
+	path := apr.Arg(1)
+
+	// Work out which branch to check out, and whether it has to be created first
+	var branch, startPoint string
+	newBranch, createBranch := apr.GetValue(cli.BranchParam)
+	switch {
+	case createBranch:
+		branch, startPoint = newBranch, "HEAD"
+		if apr.NArg() == 3 {
+			startPoint = apr.Arg(2)
+		}
+	case apr.NArg() == 3:
+		branch = apr.Arg(2)
+	default:
+		branch = filepath.Base(filepath.Clean(path))
+		_, ok, err := dEnv.DoltDB(ctx).HasBranch(ctx, branch)
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		if !ok {
+			createBranch, startPoint = true, "HEAD"
+		}
+	}
+
+	if createBranch {
+		queryist, err := cliCtx.QueryEngine(ctx)
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		if queryist.IsRemote {
+			return errhand.BuildDError(cli.RemoteUnsupportedMsg, "dolt worktree add -b").Build()
+		}
+		query, err := interpolateStoredProcedureCall("DOLT_BRANCH", []string{branch, startPoint})
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		if _, err = cli.GetRowsForSql(queryist.Queryist, queryist.Context, query); err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+	}
+
+	wt, err := dEnv.AddWorktree(ctx, path, branch)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	cli.Printf("Created worktree '%s' at '%s' with branch '%s' checked out\n", wt.Name, wt.Path, branch)
+	return nil
+}
+
+func listWorktrees(dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 1 {
+		return errhand.BuildDError("error: dolt worktree list does not take any arguments").SetPrintUsage().Build()
+	}
+
+	statuses, err := dEnv.WorktreeStatuses()
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	width := 0
+	for _, status := range statuses {
+		width = max(width, len(status.Path))
+	}
+	for _, status := range statuses {
+		line := fmt.Sprintf("%-*s", width, status.Path)
+		if status.Head != nil {
+			line += fmt.Sprintf("  [%s]", status.Head.GetPath())
+		}
+		if status.Prunable {
+			line += "  prunable"
+		}
+		cli.Println(line)
+	}
+	return nil
+}
+
+func removeWorktree(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 2 {
+		return errhand.BuildDError("error: dolt worktree remove takes exactly one worktree").SetPrintUsage().Build()
+	}
+
+	_, err := dEnv.RemoveWorktree(ctx, apr.Arg(1), apr.Contains(cli.ForceFlag))
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+	return nil
+}
+
+func pruneWorktrees(dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 1 {
+		return errhand.BuildDError("error: dolt worktree prune does not take any arguments").SetPrintUsage().Build()
+	}
+
+	pruned, err := dEnv.PruneWorktrees()
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+	for _, wt := range pruned {
+		cli.Printf("Removing worktree '%s': '%s' no longer exists\n", wt.Name, wt.Path)
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/schcmds"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/sqlserver"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/tblcmds"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/testcmds"
 	"github.com/dolthub/dolt/go/cmd/dolt/doltversion"
 )
 
@@ -87,8 +88,17 @@ var doltSubCommands = []cli.Command{
 	commands.QueryDiff{},
 	commands.ReflogCmd{},
 	commands.RebaseCmd{},
+	commands.BisectCmd{},
+	commands.WorktreeCmd{},
+	commands.BundleCmd{},
+	commands.NotesCmd{},
+	commands.RerereCmd{},
+	commands.FormatPatchCmd{},
+	commands.ApplyCmd{},
+	commands.AmCmd{},
 	commands.ArchiveCmd{},
 	ci.Commands,
+	testcmds.Commands,
 	commands.DebugCmd{},
 	commands.RmCmd{},
 	commands.TransferCmd{},
@@ -43,6 +43,8 @@ const StatisticFileID = "STAT"
 const DoltgresRootValueFileID = "DGRV"
 const TupleFileID = "TUPL"
 const VectorIndexNodeFileID = "IVFF"
+const NotesFileID = "NOTS"
+const NoteFileID = "NOTE"
 
 const MessageTypesKind int = 27
 
@@ -0,0 +1,194 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/bits"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// ErrBadCommitIsAncestorOfGood is returned when the commit marked as bad is an ancestor of, or the same commit as,
+// one of the commits marked as good, which means there is no range of commits left to search.
+var ErrBadCommitIsAncestorOfGood = errors.New("the bad commit is an ancestor of a good commit; " +
+	"check that the good and bad commits were not swapped")
+
+// Step describes what a bisect should do next, given the commits that have been marked good, bad, and skipped so far.
+// Exactly one of Candidate, FirstBad, or PossibleFirstBad is set.
+type Step struct {
+	// Candidate is the next commit that should be tested.
+	Candidate *doltdb.Commit
+	// CandidateHash is the hash of Candidate.
+	CandidateHash hash.Hash
+	// Remaining is the approximate number of commits left to test after Candidate.
+	Remaining int
+	// Steps is the approximate number of steps left after Candidate.
+	Steps int
+
+	// FirstBad is the first bad commit, set once the bisect has narrowed the range down to a single commit.
+	FirstBad *doltdb.Commit
+	// FirstBadHash is the hash of FirstBad.
+	FirstBadHash hash.Hash
+
+	// PossibleFirstBad is set when only skipped commits are left to test, and holds every commit that could be the
+	// first bad commit.
+	PossibleFirstBad []hash.Hash
+}
+
+// Done returns whether the bisect has finished, either because the first bad commit was found or because only
+// skipped commits are left to test.
+func (s *Step) Done() bool {
+	return s.Candidate == nil
+}
+
+// NextStep computes the next commit to test for the bisect described by |bad|, |good| and |skipped|. The search range
+// is every commit reachable from |bad| that is not reachable from any of the |good| commits. The commit chosen is the
+// one that most evenly splits that range, so that marking it good or bad eliminates as many commits as possible.
+func NextStep(ctx context.Context, ddb *doltdb.DoltDB, bad *doltdb.Commit, good []*doltdb.Commit, skipped []hash.Hash) (*Step, error) {
+	badHash, err := bad.HashOf()
+	if err != nil {
+		return nil, err
+	}
+
+	goodAncestors := make(map[hash.Hash]struct{})
+	itr := doltdb.CommitItrForRoots[context.Context](ddb, good...)
+	for {
+		h, _, _, _, err := itr.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		goodAncestors[h] = struct{}{}
+	}
+
+	if _, ok := goodAncestors[badHash]; ok {
+		return nil, ErrBadCommitIsAncestorOfGood
+	}
+
+	// Collect every commit in the search range, in the order the commit iterator visits them, along with its parents.
+	var candidates []hash.Hash
+	parents := make(map[hash.Hash][]hash.Hash)
+	commits := make(map[hash.Hash]*doltdb.Commit)
+	itr = doltdb.CommitItrForRoots[context.Context](ddb, bad)
+	for {
+		h, optCmt, _, _, err := itr.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if _, ok := goodAncestors[h]; ok {
+			continue
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			// Ghost commits can't be tested, and their parents aren't available to us, so they bound the search range
+			continue
+		}
+		parentHashes, err := cm.ParentHashes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, h)
+		parents[h] = parentHashes
+		commits[h] = cm
+	}
+
+	if len(candidates) == 1 {
+		return &Step{FirstBad: bad, FirstBadHash: badHash}, nil
+	}
+
+	skippedSet := make(map[hash.Hash]struct{}, len(skipped))
+	for _, h := range skipped {
+		skippedSet[h] = struct{}{}
+	}
+
+	total := len(candidates)
+	var best hash.Hash
+	bestScore, bestReach := -1, 0
+	for _, h := range candidates {
+		if h == badHash {
+			continue
+		}
+		if _, ok := skippedSet[h]; ok {
+			continue
+		}
+		reach := countReachable(h, parents)
+		score := min(reach, total-reach)
+		if score > bestScore {
+			best, bestScore, bestReach = h, score, reach
+		}
+	}
+
+	if bestScore < 0 {
+		// Only skipped commits are left, so any of them could have introduced the change, as could the bad commit.
+		var possible []hash.Hash
+		for _, h := range candidates {
+			if _, ok := skippedSet[h]; ok || h == badHash {
+				possible = append(possible, h)
+			}
+		}
+		return &Step{PossibleFirstBad: possible}, nil
+	}
+
+	return &Step{
+		Candidate:     commits[best],
+		CandidateHash: best,
+		Remaining:     max(total-bestReach-1, 0),
+		Steps:         estimateSteps(total),
+	}, nil
+}
+
+// countReachable returns the number of commits in |parents| that are reachable from |start|, including |start|
+// itself. Parents that are not keys in |parents| are outside the search range and are not counted.
+func countReachable(start hash.Hash, parents map[hash.Hash][]hash.Hash) int {
+	seen := map[hash.Hash]struct{}{start: {}}
+	stack := []hash.Hash{start}
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, p := range parents[curr] {
+			if _, ok := parents[p]; !ok {
+				continue
+			}
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			stack = append(stack, p)
+		}
+	}
+	return len(seen)
+}
+
+// estimateSteps returns roughly how many more steps a bisect over |total| commits will take, using the same estimate
+// as Git: log2(total), rounded down when |total| is close to the power of two below it.
+func estimateSteps(total int) int {
+	if total < 3 {
+		return 0
+	}
+	n := bits.Len(uint(total)) - 1
+	e := 1 << n
+	x := total - e
+	if e < 3*x {
+		return n
+	}
+	return n - 1
+}
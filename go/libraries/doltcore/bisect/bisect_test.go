@@ -0,0 +1,62 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func TestEstimateSteps(t *testing.T) {
+	tests := []struct {
+		total    int
+		expected int
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 0},
+		{3, 1},
+		{4, 1},
+		{6, 2},
+		{7, 2},
+		{8, 2},
+		{11, 3},
+		{100, 6},
+		{1024, 9},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, estimateSteps(test.total), "total: %d", test.total)
+	}
+}
+
+func TestCountReachable(t *testing.T) {
+	a, b, c, d, outside := hash.Of([]byte("a")), hash.Of([]byte("b")), hash.Of([]byte("c")), hash.Of([]byte("d")), hash.Of([]byte("outside"))
+
+	// d is a merge of b and c, which both have a as a parent. a's parent is outside the search range.
+	parents := map[hash.Hash][]hash.Hash{
+		a: {outside},
+		b: {a},
+		c: {a},
+		d: {b, c},
+	}
+
+	assert.Equal(t, 1, countReachable(a, parents))
+	assert.Equal(t, 2, countReachable(b, parents))
+	assert.Equal(t, 2, countReachable(c, parents))
+	assert.Equal(t, 4, countReachable(d, parents))
+}
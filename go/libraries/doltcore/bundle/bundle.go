@@ -0,0 +1,241 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle implements the bundle file format, which packs the chunks reachable from a set of refs into a single
+// file so that a repository, or part of its history, can be moved to a machine with no network access to it.
+//
+// A bundle file starts with |Magic|, followed by the length of a JSON encoded |Header| as a big endian uint32, the
+// header itself, and then the contents of every blob listed in the header, in order. The blobs are the manifest and
+// table files of a blobstore backed chunk store, so a bundle can be opened in place, without unpacking it, as a
+// read-only chunk store over |Open|'s blobstore.
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	// Magic is the sequence of bytes every bundle file starts with.
+	Magic = "DOLTBNDL"
+
+	// FileExt is the extension used for bundle files. Remote urls without a scheme that end in this extension are
+	// treated as bundles.
+	FileExt = ".bundle"
+
+	// ManifestKey is the key the manifest of a blobstore backed chunk store is stored under.
+	ManifestKey = "manifest"
+
+	formatVersion = 1
+	maxHeaderLen  = 64 * 1024 * 1024
+)
+
+var ErrNotABundle = errors.New("not a bundle file")
+var ErrCorruptBundle = errors.New("bundle file is corrupt")
+
+// Header describes the contents of a bundle file.
+type Header struct {
+	Version int `json:"version"`
+	// NomsBinFormat is the storage format of the repository the bundle was created from.
+	NomsBinFormat string `json:"nbf"`
+	// Refs are the refs the bundle was created for, along with the address of the value each of them points to.
+	Refs []Ref `json:"refs"`
+	// Prerequisites are the commits whose chunks were left out of an incremental bundle. A repository needs all of
+	// them before the bundle can be fetched into it.
+	Prerequisites []string `json:"prerequisites,omitempty"`
+	// Blobs are the blobs stored after the header, in the order they are stored.
+	Blobs []Blob `json:"blobs"`
+}
+
+// Ref is a single ref stored in a bundle.
+type Ref struct {
+	Name string `json:"ref"`
+	Hash string `json:"hash"`
+}
+
+// Blob is the key and size of a single blob stored in a bundle.
+type Blob struct {
+	Key  string `json:"key"`
+	Size int64  `json:"size"`
+}
+
+// IsIncremental returns whether the bundle has prerequisites, and so can't be cloned.
+func (h *Header) IsIncremental() bool {
+	return len(h.Prerequisites) > 0
+}
+
+// PrerequisiteHashes returns the parsed hashes of the bundle's prerequisites.
+func (h *Header) PrerequisiteHashes() ([]hash.Hash, error) {
+	hashes := make([]hash.Hash, len(h.Prerequisites))
+	for i, s := range h.Prerequisites {
+		var ok bool
+		hashes[i], ok = hash.MaybeParse(s)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid prerequisite '%s'", ErrCorruptBundle, s)
+		}
+	}
+	return hashes, nil
+}
+
+// ReadHeader reads the header of the bundle file at |path|.
+func ReadHeader(path string) (*Header, error) {
+	header, _, err := readHeader(path)
+	return header, err
+}
+
+// Open reads the header of the bundle file at |path|, and returns it along with a read-only blobstore holding the
+// blobs stored in the bundle.
+func Open(path string) (*Header, *blobstore.PackedBlobstore, error) {
+	header, offset, err := readHeader(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobs := make(map[string]blobstore.PackedBlob, len(header.Blobs))
+	for _, blob := range header.Blobs {
+		blobs[blob.Key] = blobstore.PackedBlob{Offset: offset, Size: blob.Size}
+		offset += blob.Size
+	}
+	return header, blobstore.NewPackedBlobstore(path, blobs), nil
+}
+
+// readHeader reads the header of the bundle file at |path|, returning it along with the offset of the first blob.
+func readHeader(path string) (*Header, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	var prefix [len(Magic) + 4]byte
+	if _, err = io.ReadFull(f, prefix[:]); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, 0, fmt.Errorf("%w: %s", ErrNotABundle, path)
+		}
+		return nil, 0, err
+	}
+	if string(prefix[:len(Magic)]) != Magic {
+		return nil, 0, fmt.Errorf("%w: %s", ErrNotABundle, path)
+	}
+
+	headerLen := binary.BigEndian.Uint32(prefix[len(Magic):])
+	if headerLen > maxHeaderLen {
+		return nil, 0, fmt.Errorf("%w: header is too large", ErrCorruptBundle)
+	}
+	headerBytes := make([]byte, headerLen)
+	if _, err = io.ReadFull(f, headerBytes); err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrCorruptBundle, err.Error())
+	}
+
+	var header Header
+	if err = json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrCorruptBundle, err.Error())
+	}
+	if header.Version != formatVersion {
+		return nil, 0, fmt.Errorf("unsupported bundle version %d", header.Version)
+	}
+
+	offset := int64(len(prefix)) + int64(headerLen)
+	size := offset
+	for _, blob := range header.Blobs {
+		size += blob.Size
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	if info.Size() != size {
+		return nil, 0, fmt.Errorf("%w: expected %d bytes but found %d", ErrCorruptBundle, size, info.Size())
+	}
+
+	return &header, offset, nil
+}
+
+// Write writes a bundle file to |path| with the header |header|, containing the blobs in |bs| with the given |keys|.
+// The blobs of |header| are replaced by |keys| and their sizes.
+func Write(ctx context.Context, path string, header Header, bs blobstore.Blobstore, keys []string) (err error) {
+	header.Version = formatVersion
+	header.Blobs = make([]Blob, len(keys))
+	for i, key := range keys {
+		rc, size, _, err := bs.Get(ctx, key, blobstore.AllRange)
+		if err != nil {
+			return err
+		}
+		if err = rc.Close(); err != nil {
+			return err
+		}
+		header.Blobs[i] = Blob{Key: key, Size: int64(size)}
+	}
+
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	var buf bytes.Buffer
+	buf.WriteString(Magic)
+	if err = binary.Write(&buf, binary.BigEndian, uint32(len(headerBytes))); err != nil {
+		return err
+	}
+	buf.Write(headerBytes)
+	if _, err = f.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	for _, blob := range header.Blobs {
+		if err = copyBlob(ctx, f, bs, blob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyBlob(ctx context.Context, w io.Writer, bs blobstore.Blobstore, blob Blob) error {
+	rc, _, _, err := bs.Get(ctx, blob.Key, blobstore.AllRange)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	n, err := io.Copy(w, rc)
+	if err != nil {
+		return err
+	}
+	if n != blob.Size {
+		return fmt.Errorf("blob %s changed size while writing bundle", blob.Key)
+	}
+	return nil
+}
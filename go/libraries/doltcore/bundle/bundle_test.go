@@ -0,0 +1,96 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func TestWriteAndOpen(t *testing.T) {
+	ctx := context.Background()
+	src := blobstore.NewInMemoryBlobstore("")
+	_, err := blobstore.PutBytes(ctx, src, ManifestKey, []byte("manifest contents"))
+	require.NoError(t, err)
+	_, err = blobstore.PutBytes(ctx, src, "table", []byte("table file contents"))
+	require.NoError(t, err)
+	_, err = blobstore.PutBytes(ctx, src, "unused", []byte("left out of the bundle"))
+	require.NoError(t, err)
+
+	prereq := hash.Of([]byte("base"))
+	path := filepath.Join(t.TempDir(), "test"+FileExt)
+	err = Write(ctx, path, Header{
+		NomsBinFormat: "__DOLT__",
+		Refs:          []Ref{{Name: "refs/heads/main", Hash: hash.Of([]byte("main")).String()}},
+		Prerequisites: []string{prereq.String()},
+	}, src, []string{ManifestKey, "table"})
+	require.NoError(t, err)
+
+	header, bs, err := Open(path)
+	require.NoError(t, err)
+	assert.Equal(t, "__DOLT__", header.NomsBinFormat)
+	assert.Equal(t, []Ref{{Name: "refs/heads/main", Hash: hash.Of([]byte("main")).String()}}, header.Refs)
+	assert.True(t, header.IsIncremental())
+	prereqs, err := header.PrerequisiteHashes()
+	require.NoError(t, err)
+	assert.Equal(t, []hash.Hash{prereq}, prereqs)
+
+	data, _, err := blobstore.GetBytes(ctx, bs, ManifestKey, blobstore.AllRange)
+	require.NoError(t, err)
+	assert.Equal(t, "manifest contents", string(data))
+	data, _, err = blobstore.GetBytes(ctx, bs, "table", blobstore.NewBlobRange(-8, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+	ok, err := bs.Exists(ctx, "unused")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestReadHeaderErrors(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	notABundle := filepath.Join(dir, "not"+FileExt)
+	require.NoError(t, os.WriteFile(notABundle, []byte("this is not a bundle file"), 0644))
+	_, err := ReadHeader(notABundle)
+	assert.ErrorIs(t, err, ErrNotABundle)
+
+	empty := filepath.Join(dir, "empty"+FileExt)
+	require.NoError(t, os.WriteFile(empty, nil, 0644))
+	_, err = ReadHeader(empty)
+	assert.ErrorIs(t, err, ErrNotABundle)
+
+	src := blobstore.NewInMemoryBlobstore("")
+	_, err = blobstore.PutBytes(ctx, src, ManifestKey, []byte("manifest contents"))
+	require.NoError(t, err)
+	path := filepath.Join(dir, "truncated"+FileExt)
+	require.NoError(t, Write(ctx, path, Header{NomsBinFormat: "__DOLT__"}, src, []string{ManifestKey}))
+	_, err = ReadHeader(path)
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+	_, err = ReadHeader(path)
+	assert.ErrorIs(t, err, ErrCorruptBundle)
+}
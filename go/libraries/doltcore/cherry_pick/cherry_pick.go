@@ -258,6 +258,9 @@ func AbortCherryPick(ctx *sql.Context, dbName string) error {
 	if err != nil {
 		return fmt.Errorf("fatal: unable to abort merge: %v", err)
 	}
+	if err = dsess.ClearRecordedConflicts(ctx, dbName); err != nil {
+		return err
+	}
 
 	return doltSession.SetWorkingSet(ctx, dbName, newWs)
 }
@@ -486,6 +489,9 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 	if err != nil {
 		return result, "", nil, err
 	}
+	if err = dsess.ReuseRecordedResolutions(ctx, dbName, result); err != nil {
+		return nil, "", nil, err
+	}
 
 	workingRootHash, err = result.Root.HashOf()
 	if err != nil {
@@ -57,6 +57,9 @@ const (
 	// LocalFS Blobstore Scheme
 	LocalBSScheme = "localbs"
 
+	// BundleScheme is the scheme of a bundle file written by `dolt bundle create`
+	BundleScheme = "bundle"
+
 	OSSScheme = "oss"
 
 	// Git remote dbfactory schemes (Git remotes as Dolt remotes)
@@ -88,6 +91,7 @@ var DBFactories = map[string]DBFactory{
 	FileScheme:     FileFactory{},
 	MemScheme:      MemFactory{},
 	LocalBSScheme:  LocalBSFactory{},
+	BundleScheme:   BundleFactory{},
 	HTTPScheme:     NewDoltRemoteFactory(true),
 	HTTPSScheme:    NewDoltRemoteFactory(false),
 	GitFileScheme:  GitRemoteFactory{},
@@ -57,7 +57,7 @@ func Stat(ctx context.Context, ch chan DiffStatProgress, from, to durable.Index,
 		return fmt.Errorf("cannot perform a diff between keyless and keyed schema")
 	}
 
-	return diffProllyTrees(ctx, ch, keyless, from, to, fromSch, toSch)
+	return diffProllyTrees(ctx, ch, keyless, from, to, fromSch, toSch, nil)
 }
 
 // StatForTableDelta pushes diff stat progress messages for the table delta given to the channel given
@@ -93,10 +93,10 @@ func StatForTableDelta(ctx context.Context, ch chan DiffStatProgress, td TableDe
 		return err
 	}
 
-	return diffProllyTrees(ctx, ch, keyless, fromRows, toRows, fromSch, toSch)
+	return diffProllyTrees(ctx, ch, keyless, fromRows, toRows, fromSch, toSch, td.IgnoredColumnsFilter())
 }
 
-func diffProllyTrees(ctx context.Context, ch chan DiffStatProgress, keyless bool, from, to durable.Index, fromSch, toSch schema.Schema) error {
+func diffProllyTrees(ctx context.Context, ch chan DiffStatProgress, keyless bool, from, to durable.Index, fromSch, toSch schema.Schema, filter *IgnoredColumnsFilter) error {
 	_, vMapping, err := schema.MapSchemaBasedOnTagAndName(fromSch, toSch)
 	if err != nil {
 		return err
@@ -154,6 +154,9 @@ func diffProllyTrees(ctx context.Context, ch chan DiffStatProgress, keyless bool
 	// count as modifications in the diff.
 	considerAllRowsModified := false
 	err = prolly.DiffMaps(ctx, f, t, considerAllRowsModified, func(ctx context.Context, diff tree.Diff) error {
+		if diff.Type == tree.ModifiedDiff && filter.OnlyIgnoredChanged(val.Tuple(diff.From), val.Tuple(diff.To)) {
+			return nil
+		}
 		return rpr(ctx, vMapping, fVD, tVD, diff, ch)
 	})
 	if err != nil && err != io.EOF {
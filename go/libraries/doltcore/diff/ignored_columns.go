@@ -0,0 +1,175 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+var errUnignoredChange = errors.New("unignored change")
+
+// IgnoredColumnsFilter identifies modified rows whose changes are confined to columns ignored by the
+// dolt_ignore_columns table.
+type IgnoredColumnsFilter struct {
+	fromD, toD *val.TupleDesc
+	// fromIdx and toIdx are the positions of each column that isn't ignored in the from and to value tuples
+	fromIdx, toIdx []int
+}
+
+// NewIgnoredColumnsFilter returns a filter for rows of a table whose schema changed from |fromSch| to |toSch|, which
+// ignores changes to the columns in |ignored|. It returns nil if no columns are ignored, or if the columns that aren't
+// ignored changed between the schemas, in which case every change to a row should be reported.
+func NewIgnoredColumnsFilter(fromSch, toSch schema.Schema, ignored doltdb.IgnoredColumns, ns tree.NodeStore) *IgnoredColumnsFilter {
+	if len(ignored) == 0 || fromSch == nil || toSch == nil || schema.IsKeyless(fromSch) || schema.IsKeyless(toSch) {
+		return nil
+	}
+
+	fromPositions := storedPositions(fromSch, ignored)
+	toPositions := storedPositions(toSch, ignored)
+	if len(fromPositions) != len(toPositions) {
+		return nil
+	}
+
+	f := &IgnoredColumnsFilter{
+		fromD: fromSch.GetValueDescriptor(ns),
+		toD:   toSch.GetValueDescriptor(ns),
+	}
+	for tag, fromPos := range fromPositions {
+		toPos, ok := toPositions[tag]
+		if !ok || f.fromD.Types[fromPos].Enc != f.toD.Types[toPos].Enc {
+			return nil
+		}
+		f.fromIdx = append(f.fromIdx, fromPos)
+		f.toIdx = append(f.toIdx, toPos)
+	}
+	return f
+}
+
+// storedPositions returns the positions in the value tuples of |sch| of the columns that aren't ignored, by tag.
+func storedPositions(sch schema.Schema, ignored doltdb.IgnoredColumns) map[uint64]int {
+	positions := make(map[uint64]int)
+	pos := 0
+	for _, col := range sch.GetNonPKCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		if !ignored.Contains(col.Name) {
+			positions[col.Tag] = pos
+		}
+		pos++
+	}
+	return positions
+}
+
+// OnlyIgnoredChanged returns whether the values |from| and |to| of a modified row only differ in ignored columns.
+func (f *IgnoredColumnsFilter) OnlyIgnoredChanged(from, to val.Tuple) bool {
+	if f == nil {
+		return false
+	}
+	for i := range f.fromIdx {
+		if !bytes.Equal(f.fromD.GetField(f.fromIdx[i], from), f.toD.GetField(f.toIdx[i], to)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyIgnoredColumns sets the ignored columns of each of |deltas| from the dolt_ignore_columns table on |root|.
+func ApplyIgnoredColumns(ctx context.Context, root doltdb.RootValue, deltas []TableDelta) error {
+	patterns := make(map[string]doltdb.IgnoredColumnPatterns)
+	for i := range deltas {
+		td := &deltas[i]
+		name, sch := td.ToName, td.ToSch
+		if td.ToTable == nil {
+			name, sch = td.FromName, td.FromSch
+		}
+		if name.Name == "" {
+			continue
+		}
+
+		ps, ok := patterns[name.Schema]
+		if !ok {
+			var err error
+			ps, err = doltdb.GetIgnoredColumnPatterns(ctx, root, name.Schema)
+			if err != nil {
+				return err
+			}
+			patterns[name.Schema] = ps
+		}
+		ignored, err := ps.IgnoredColumns(name.Name, sch)
+		if err != nil {
+			return err
+		}
+		td.IgnoredColumns = ignored
+	}
+	return nil
+}
+
+// IgnoredColumnsFilter returns a filter for the modified rows of this table delta, or nil if it has no ignored columns.
+func (td TableDelta) IgnoredColumnsFilter() *IgnoredColumnsFilter {
+	if td.FromTable == nil || td.ToTable == nil {
+		return nil
+	}
+	return NewIgnoredColumnsFilter(td.FromSch, td.ToSch, td.IgnoredColumns, td.ToTable.NodeStore())
+}
+
+// hasUnignoredDataChanges returns whether any row of this table delta was added or removed, or modified outside of
+// its ignored columns.
+func (td TableDelta) hasUnignoredDataChanges(ctx context.Context) (bool, error) {
+	filter := td.IgnoredColumnsFilter()
+	if filter == nil {
+		return true, nil
+	}
+
+	fromIdx, err := td.FromTable.GetRowData(ctx)
+	if err != nil {
+		return false, err
+	}
+	toIdx, err := td.ToTable.GetRowData(ctx)
+	if err != nil {
+		return false, err
+	}
+	from, err := durable.ProllyMapFromIndex(fromIdx)
+	if err != nil {
+		return false, err
+	}
+	to, err := durable.ProllyMapFromIndex(toIdx)
+	if err != nil {
+		return false, err
+	}
+
+	err = prolly.DiffMaps(ctx, from, to, false, func(ctx context.Context, d tree.Diff) error {
+		if d.Type == tree.ModifiedDiff && filter.OnlyIgnoredChanged(val.Tuple(d.From), val.Tuple(d.To)) {
+			return nil
+		}
+		return errUnignoredChange
+	})
+	if err == errUnignoredChange {
+		return true, nil
+	} else if err != nil && err != io.EOF {
+		return false, err
+	}
+	return false, nil
+}
@@ -204,3 +204,53 @@ func DiffForeignKeys(fromFks, toFKs []doltdb.ForeignKey) (diffs []ForeignKeyDiff
 	}
 	return diffs
 }
+
+type CheckDifference struct {
+	DiffType SchemaChangeType
+	From     schema.Check
+	To       schema.Check
+}
+
+// DiffSchChecks matches two sets of check constraints by name.
+// It returns matched and unmatched checks as a slice of CheckDifferences.
+func DiffSchChecks(fromSch, toSch schema.Schema) (diffs []CheckDifference) {
+	toChecks := make(map[string]schema.Check)
+	for _, check := range toSch.Checks().AllChecks() {
+		toChecks[check.Name()] = check
+	}
+
+	seen := make(map[string]struct{})
+	for _, from := range fromSch.Checks().AllChecks() {
+		to, ok := toChecks[from.Name()]
+		if !ok {
+			diffs = append(diffs, CheckDifference{
+				DiffType: SchDiffRemoved,
+				From:     from,
+			})
+			continue
+		}
+
+		seen[to.Name()] = struct{}{}
+		d := CheckDifference{
+			DiffType: SchDiffModified,
+			From:     from,
+			To:       to,
+		}
+		if from.Expression() == to.Expression() && from.Enforced() == to.Enforced() {
+			d.DiffType = SchDiffNone
+		}
+		diffs = append(diffs, d)
+	}
+
+	for _, to := range toSch.Checks().AllChecks() {
+		if _, ok := seen[to.Name()]; ok {
+			continue
+		}
+		diffs = append(diffs, CheckDifference{
+			DiffType: SchDiffAdded,
+			To:       to,
+		})
+	}
+
+	return diffs
+}
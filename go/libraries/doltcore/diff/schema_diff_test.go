@@ -66,3 +66,50 @@ func TestDiffSchemas(t *testing.T) {
 		t.Error(diffs, "!=", expected)
 	}
 }
+
+func TestDiffSchChecks(t *testing.T) {
+	cols := schema.NewColCollection(
+		schema.NewColumn("pk", 0, types.IntKind, true, schema.NotNullConstraint{}),
+		schema.NewColumn("c", 1, types.IntKind, false),
+	)
+
+	oldSch, err := schema.SchemaFromCols(cols)
+	require.NoError(t, err)
+	_, err = oldSch.Checks().AddCheck("unchanged", "(c > 0)", true)
+	require.NoError(t, err)
+	_, err = oldSch.Checks().AddCheck("dropped", "(c < 100)", true)
+	require.NoError(t, err)
+	_, err = oldSch.Checks().AddCheck("expression_changed", "(c <> 5)", true)
+	require.NoError(t, err)
+	_, err = oldSch.Checks().AddCheck("enforced_changed", "(c <> 6)", true)
+	require.NoError(t, err)
+
+	newSch, err := schema.SchemaFromCols(cols)
+	require.NoError(t, err)
+	_, err = newSch.Checks().AddCheck("unchanged", "(c > 0)", true)
+	require.NoError(t, err)
+	_, err = newSch.Checks().AddCheck("expression_changed", "(c <> 7)", true)
+	require.NoError(t, err)
+	_, err = newSch.Checks().AddCheck("enforced_changed", "(c <> 6)", false)
+	require.NoError(t, err)
+	_, err = newSch.Checks().AddCheck("added", "(c < 1000)", true)
+	require.NoError(t, err)
+
+	diffs := DiffSchChecks(oldSch, newSch)
+	actual := make(map[string]SchemaChangeType)
+	for _, d := range diffs {
+		if d.From != nil {
+			actual[d.From.Name()] = d.DiffType
+		} else {
+			actual[d.To.Name()] = d.DiffType
+		}
+	}
+
+	require.Equal(t, map[string]SchemaChangeType{
+		"unchanged":          SchDiffNone,
+		"dropped":            SchDiffRemoved,
+		"expression_changed": SchDiffModified,
+		"enforced_changed":   SchDiffModified,
+		"added":              SchDiffAdded,
+	}, actual)
+}
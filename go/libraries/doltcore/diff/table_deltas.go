@@ -78,6 +78,8 @@ type TableDelta struct {
 	ToFks            []doltdb.ForeignKey
 	ToFksParentSch   map[doltdb.TableName]schema.Schema
 	FromFksParentSch map[doltdb.TableName]schema.Schema
+	// IgnoredColumns are the columns whose changes are ignored by the dolt_ignore_columns table, if any
+	IgnoredColumns doltdb.IgnoredColumns
 }
 
 type TableDeltaSummary struct {
@@ -523,7 +525,14 @@ func (td TableDelta) HasChangesIgnoringColumnTags(ctx context.Context) (bool, er
 			return false, err
 		}
 		if !fromRowDataHash.Equal(toRowDataHash) {
-			return true, nil
+			if len(td.IgnoredColumns) == 0 {
+				return true, nil
+			}
+			// Changes confined to ignored columns don't count
+			changed, err := td.hasUnignoredDataChanges(ctx)
+			if err != nil || changed {
+				return changed, err
+			}
 		}
 
 		// If neither data nor schema hashes have changed, the table is the same
@@ -590,7 +599,13 @@ func (td TableDelta) HasDataChanged(ctx context.Context) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	return !fromRowDataHash.Equal(toRowDataHash), nil
+	if fromRowDataHash.Equal(toRowDataHash) {
+		return false, nil
+	}
+	if len(td.IgnoredColumns) > 0 {
+		return td.hasUnignoredDataChanges(ctx)
+	}
+	return true, nil
 }
 
 func (td TableDelta) HasPrimaryKeySetChanged() bool {
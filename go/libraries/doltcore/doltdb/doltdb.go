@@ -1373,6 +1373,40 @@ func (ddb *DoltDB) GetTuple(ctx context.Context, key string) ([]byte, bool, erro
 	return tup.Bytes(), true, nil
 }
 
+// maxTupleUpdateRetries is the number of times UpdateTuple retries an update that raced with another writer.
+const maxTupleUpdateRetries = 16
+
+// UpdateTuple reads the value of the tuple |key|, which is nil if it has none, and replaces it with the value |update|
+// returns for it. When |update| returns false the tuple is left unchanged. If another writer sets the tuple before
+// the new value is written, the update is retried with the value it wrote.
+func (ddb *DoltDB) UpdateTuple(ctx context.Context, key string, update func(value []byte) ([]byte, bool, error)) error {
+	for i := 0; i < maxTupleUpdateRetries; i++ {
+		ds, err := ddb.db.GetDataset(ctx, ref.NewTupleRef(key).String())
+		if err != nil {
+			return err
+		}
+
+		var value []byte
+		if ds.HasHead() {
+			tup, err := datas.LoadTuple(ctx, ddb.NodeStore(), ddb.ValueReadWriter(), ds)
+			if err != nil {
+				return err
+			}
+			value = tup.Bytes()
+		}
+
+		value, ok, err := update(value)
+		if err != nil || !ok {
+			return err
+		}
+		_, err = ddb.db.UpdateTuple(ctx, ds, value)
+		if !errors.Is(err, datas.ErrOptimisticLockFailed) {
+			return err
+		}
+	}
+	return datas.ErrOptimisticLockFailed
+}
+
 var workspacesRefFilter = map[ref.RefType]struct{}{ref.WorkspaceRefType: {}}
 
 // GetWorkspaces returns a list of all workspaces in the database.
@@ -2210,6 +2244,11 @@ func (ddb *DoltDB) PrependCommitHooks(ctx context.Context, hooks ...CommitHook)
 	return ddb
 }
 
+// PostCommitHooks returns the commit hooks of the database.
+func (ddb *DoltDB) PostCommitHooks() []CommitHook {
+	return ddb.db.PostCommitHooks()
+}
+
 func (ddb *DoltDB) ExecuteCommitHooks(ctx context.Context, datasetId string) error {
 	ds, err := ddb.db.GetDataset(ctx, datasetId)
 	if err != nil {
@@ -16,8 +16,10 @@ package doltdb
 
 import (
 	"context"
+	"strings"
 	"sync"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
@@ -74,7 +76,21 @@ func (db hooksDatabase) PostCommitHooks() []CommitHook {
 	return toret
 }
 
+// TestRunBranchPrefix is the prefix of the temporary branches which dolt_test_run runs isolated test groups on. They're
+// deleted once their tests have run, so updates to them don't execute commit hooks.
+const TestRunBranchPrefix = "dolt_test_run_"
+
+// isTestRunDataset returns whether |ds| is the head or working set of a temporary dolt_test_run branch.
+func isTestRunDataset(ds datas.Dataset) bool {
+	branch := ref.NewBranchRef(TestRunBranchPrefix)
+	ws := ref.NewWorkingSetRef(string(branch.GetType()) + "/" + TestRunBranchPrefix)
+	return strings.HasPrefix(ds.ID(), branch.String()) || strings.HasPrefix(ds.ID(), ws.String())
+}
+
 func (db hooksDatabase) ExecuteCommitHooks(ctx context.Context, ds datas.Dataset, onlyWS bool, replicaWrite bool) {
+	if isTestRunDataset(ds) {
+		return
+	}
 	var wg sync.WaitGroup
 	rsc := db.rsc
 	var ioff int
@@ -196,6 +212,14 @@ func (db hooksDatabase) Tag(ctx context.Context, ds datas.Dataset, commitAddr ha
 	return ds, err
 }
 
+func (db hooksDatabase) UpdateTuple(ctx context.Context, ds datas.Dataset, val []byte) (datas.Dataset, error) {
+	ds, err := db.Database.UpdateTuple(ctx, ds, val)
+	if err == nil {
+		db.ExecuteCommitHooks(ctx, ds, false, false)
+	}
+	return ds, err
+}
+
 func (db hooksDatabase) SetTuple(ctx context.Context, ds datas.Dataset, val []byte) (datas.Dataset, error) {
 	ds, err := db.Database.SetTuple(ctx, ds, val)
 	if err == nil {
@@ -0,0 +1,136 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+)
+
+// IgnoredColumnPattern is a row of the dolt_ignore_columns table. Changes to the columns whose names match
+// ColumnPattern, in the tables whose names match TablePattern, don't show up in diffs and never conflict in a merge.
+// Patterns use the same syntax as dolt_ignore patterns, and are matched case-insensitively.
+type IgnoredColumnPattern struct {
+	TablePattern  string
+	ColumnPattern string
+}
+
+type IgnoredColumnPatterns []IgnoredColumnPattern
+
+// IgnoredColumns is a set of the lowercased names of a table's ignored columns.
+type IgnoredColumns map[string]struct{}
+
+// Contains returns whether the column named |colName| is ignored.
+func (ic IgnoredColumns) Contains(colName string) bool {
+	_, ok := ic[strings.ToLower(colName)]
+	return ok
+}
+
+// GetIgnoredColumnPatterns returns the patterns in the dolt_ignore_columns table on |root| in the schema |schema|, or
+// nothing if the table doesn't exist.
+func GetIgnoredColumnPatterns(ctx context.Context, root RootValue, schema string) (IgnoredColumnPatterns, error) {
+	table, found, err := root.GetTable(ctx, TableName{Name: IgnoreColumnsTableName, Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	index, err := table.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := durable.MapFromIndex(index)
+	keyDesc, _ := sch.GetMapDescriptors(m.NodeStore())
+
+	iter, err := m.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var patterns IgnoredColumnPatterns
+	for {
+		k, _, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var p IgnoredColumnPattern
+		var ok bool
+		if p.TablePattern, ok = keyDesc.GetString(0, k); !ok {
+			return nil, fmt.Errorf("failed to read column ignore pattern")
+		}
+		if p.ColumnPattern, ok = keyDesc.GetString(1, k); !ok {
+			return nil, fmt.Errorf("failed to read column ignore pattern")
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns, nil
+}
+
+// IgnoredColumns returns the columns of the table |tableName| with the schema |sch| which match any of the patterns.
+// Primary key columns are never ignored.
+func (ps IgnoredColumnPatterns) IgnoredColumns(tableName string, sch schema.Schema) (IgnoredColumns, error) {
+	if len(ps) == 0 || sch == nil {
+		return nil, nil
+	}
+
+	var ignored IgnoredColumns
+	for _, p := range ps {
+		matches, err := MatchTablePattern(strings.ToLower(p.TablePattern), strings.ToLower(tableName))
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+		for _, col := range sch.GetNonPKCols().GetColumns() {
+			name := strings.ToLower(col.Name)
+			matches, err = MatchTablePattern(strings.ToLower(p.ColumnPattern), name)
+			if err != nil {
+				return nil, err
+			}
+			if matches {
+				if ignored == nil {
+					ignored = make(IgnoredColumns)
+				}
+				ignored[name] = struct{}{}
+			}
+		}
+	}
+	return ignored, nil
+}
+
+// GetIgnoredColumns returns the columns of the table |tableName| with the schema |sch| which are ignored by the
+// dolt_ignore_columns table on |root|.
+func GetIgnoredColumns(ctx context.Context, root RootValue, tableName TableName, sch schema.Schema) (IgnoredColumns, error) {
+	patterns, err := GetIgnoredColumnPatterns(ctx, root, tableName.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return patterns.IgnoredColumns(tableName.Name, sch)
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+)
+
+const (
+	// MergePolicyPreferOurs resolves a conflicting cell with the value on our side of the merge
+	MergePolicyPreferOurs = "prefer_ours"
+	// MergePolicyPreferTheirs resolves a conflicting cell with the value on their side of the merge
+	MergePolicyPreferTheirs = "prefer_theirs"
+	// MergePolicyMax resolves a conflicting cell with the greater of the two values
+	MergePolicyMax = "max"
+	// MergePolicyMin resolves a conflicting cell with the lesser of the two values
+	MergePolicyMin = "min"
+	// MergePolicySumDeltas resolves a conflicting numeric cell by applying the changes made on both sides to the value
+	// in the merge base, that is ours + theirs - base
+	MergePolicySumDeltas = "sum_deltas"
+	// MergePolicyLatestTimestamp resolves a conflicting cell with the value from the side whose timestamp column, named
+	// by the policy's expression, is later. Without an expression, the column's own values are compared.
+	MergePolicyLatestTimestamp = "latest_timestamp"
+	// MergePolicyExpression resolves a conflicting cell with the result of the policy's SQL expression, which can
+	// refer to the values on each side as ours, theirs and base
+	MergePolicyExpression = "expression"
+
+	// MergePoliciesAllColumns is the column name of policies that apply to every column of a table that doesn't have a
+	// policy of its own
+	MergePoliciesAllColumns = "*"
+)
+
+// MergePolicies are all the valid merge policies.
+var MergePolicies = []string{
+	MergePolicyPreferOurs,
+	MergePolicyPreferTheirs,
+	MergePolicyMax,
+	MergePolicyMin,
+	MergePolicySumDeltas,
+	MergePolicyLatestTimestamp,
+	MergePolicyExpression,
+}
+
+// MergePolicy is a row of the dolt_merge_policies table, declaring how conflicting changes to a column of a table are
+// resolved during a merge.
+type MergePolicy struct {
+	TableName  string
+	ColumnName string
+	Policy     string
+	Expression string
+}
+
+// GetMergePolicies returns the merge policies in the dolt_merge_policies table on |root| in the schema |schema|, or
+// nothing if the table doesn't exist.
+func GetMergePolicies(ctx context.Context, root RootValue, schema string) ([]MergePolicy, error) {
+	table, found, err := root.GetTable(ctx, TableName{Name: MergePoliciesTableName, Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	index, err := table.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := durable.MapFromIndex(index)
+	keyDesc, valDesc := sch.GetMapDescriptors(m.NodeStore())
+
+	iter, err := m.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var policies []MergePolicy
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var p MergePolicy
+		var ok bool
+		if p.TableName, ok = keyDesc.GetString(0, k); !ok {
+			return nil, fmt.Errorf("failed to read merge policy")
+		}
+		if p.ColumnName, ok = keyDesc.GetString(1, k); !ok {
+			return nil, fmt.Errorf("failed to read merge policy")
+		}
+		p.Policy, _ = valDesc.GetString(0, v)
+		p.Expression, _ = valDesc.GetString(1, v)
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// ErrNoteExists is returned when adding a note to a commit which already has one, without asking to replace it.
+var ErrNoteExists = errors.New("commit already has a note")
+
+// CommitNote is a note along with the hash of the commit it's attached to.
+type CommitNote struct {
+	CommitHash hash.Hash
+	Note       *datas.Note
+}
+
+// DefaultNotesRef is the ref notes are stored under.
+var DefaultNotesRef = ref.NewNotesRef(ref.DefaultNotesName)
+
+func (ddb *DoltDB) loadNotes(ctx context.Context) (datas.Dataset, *datas.Notes, error) {
+	ds, err := ddb.db.GetDataset(ctx, DefaultNotesRef.String())
+	if err != nil {
+		return datas.Dataset{}, nil, err
+	}
+	notes, err := datas.LoadNotes(ctx, ddb.NodeStore(), ddb.vrw, ds)
+	if err != nil {
+		return datas.Dataset{}, nil, err
+	}
+	return ds, notes, nil
+}
+
+// GetNote returns the note attached to the commit with hash |commit|, or datas.ErrNoteNotFound if it has none.
+func (ddb *DoltDB) GetNote(ctx context.Context, commit hash.Hash) (*datas.Note, error) {
+	_, notes, err := ddb.loadNotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := notes.Get(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	return datas.LoadNote(ctx, ddb.vrw, addr)
+}
+
+// GetNotes returns every note in the database, ordered by the hash of the commit it's attached to.
+func (ddb *DoltDB) GetNotes(ctx context.Context) ([]CommitNote, error) {
+	_, notes, err := ddb.loadNotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var commitNotes []CommitNote
+	err = notes.IterAll(ctx, func(commit, addr hash.Hash) error {
+		note, err := datas.LoadNote(ctx, ddb.vrw, addr)
+		if err != nil {
+			return err
+		}
+		commitNotes = append(commitNotes, CommitNote{CommitHash: commit, Note: note})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return commitNotes, nil
+}
+
+// AddNote attaches |note| to the commit with hash |commit|. If the commit already has a note, it's replaced when
+// |force| is set, and ErrNoteExists is returned otherwise. Notes are stored outside the commit graph, so the commit's
+// hash doesn't change.
+func (ddb *DoltDB) AddNote(ctx context.Context, commit hash.Hash, note *datas.Note, force bool) error {
+	ds, notes, err := ddb.loadNotes(ctx)
+	if err != nil {
+		return err
+	}
+	if !force {
+		_, err = notes.Get(ctx, commit)
+		if err == nil {
+			return ErrNoteExists
+		} else if !errors.Is(err, datas.ErrNoteNotFound) {
+			return err
+		}
+	}
+
+	noteAddr, err := datas.WriteNote(ctx, ddb.vrw, note)
+	if err != nil {
+		return err
+	}
+	notesAddr, err := notes.Update(ctx, ddb.vrw, map[hash.Hash]hash.Hash{commit: noteAddr}, nil)
+	if err != nil {
+		return err
+	}
+	_, err = ddb.db.UpdateNotes(ctx, ds, notesAddr)
+	return err
+}
+
+// RemoveNote removes the note attached to the commit with hash |commit|, returning datas.ErrNoteNotFound if it has
+// none.
+func (ddb *DoltDB) RemoveNote(ctx context.Context, commit hash.Hash) error {
+	ds, notes, err := ddb.loadNotes(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err = notes.Get(ctx, commit); err != nil {
+		return err
+	}
+
+	notesAddr, err := notes.Update(ctx, ddb.vrw, nil, []hash.Hash{commit})
+	if err != nil {
+		return err
+	}
+	_, err = ddb.db.UpdateNotes(ctx, ds, notesAddr)
+	return err
+}
+
+// GetNotesAddr returns the address of the database's notes, and false if it has none.
+func (ddb *DoltDB) GetNotesAddr(ctx context.Context) (hash.Hash, bool, error) {
+	ds, err := ddb.db.GetDataset(ctx, DefaultNotesRef.String())
+	if err != nil {
+		return hash.Hash{}, false, err
+	}
+	addr, ok := ds.MaybeHeadAddr()
+	return addr, ok, nil
+}
+
+// MergeNotes merges the notes at |addr|, which must already be in this database, into its own notes. Notes attached
+// to commits which have no note here are added. Where both sides have a note for the same commit, the most recently
+// written one is kept. Notes which were removed on one side are not removed from the other.
+func (ddb *DoltDB) MergeNotes(ctx context.Context, addr hash.Hash) error {
+	ds, notes, err := ddb.loadNotes(ctx)
+	if err != nil {
+		return err
+	}
+	if notes.Addr() == addr {
+		return nil
+	}
+	theirs, err := datas.LoadNotesAddr(ctx, ddb.NodeStore(), ddb.vrw, addr)
+	if err != nil {
+		return err
+	}
+
+	updates := make(map[hash.Hash]hash.Hash)
+	err = theirs.IterAll(ctx, func(commit, theirAddr hash.Hash) error {
+		ourAddr, err := notes.Get(ctx, commit)
+		if errors.Is(err, datas.ErrNoteNotFound) {
+			updates[commit] = theirAddr
+			return nil
+		} else if err != nil {
+			return err
+		} else if ourAddr == theirAddr {
+			return nil
+		}
+
+		ours, err := datas.LoadNote(ctx, ddb.vrw, ourAddr)
+		if err != nil {
+			return err
+		}
+		their, err := datas.LoadNote(ctx, ddb.vrw, theirAddr)
+		if err != nil {
+			return err
+		}
+		if their.Timestamp > ours.Timestamp {
+			updates[commit] = theirAddr
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	notesAddr, err := notes.Update(ctx, ddb.vrw, updates, nil)
+	if err != nil {
+		return err
+	}
+	_, err = ddb.db.UpdateNotes(ctx, ds, notesAddr)
+	return err
+}
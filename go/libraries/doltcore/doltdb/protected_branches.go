@@ -0,0 +1,81 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+)
+
+// ProtectedBranch is a row of the dolt_protected_branches table. A protected branch can only be merged into by a pull
+// request with at least RequiredApprovals approvals.
+type ProtectedBranch struct {
+	BranchName        string
+	RequiredApprovals int
+}
+
+// GetProtectedBranches returns the protected branches in the dolt_protected_branches table on |root| in the schema
+// |schema|, or nothing if the table doesn't exist.
+func GetProtectedBranches(ctx context.Context, root RootValue, schema string) ([]ProtectedBranch, error) {
+	table, found, err := root.GetTable(ctx, TableName{Name: ProtectedBranchesTableName, Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	index, err := table.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := durable.MapFromIndex(index)
+	keyDesc, valDesc := sch.GetMapDescriptors(m.NodeStore())
+
+	iter, err := m.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var branches []ProtectedBranch
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var pb ProtectedBranch
+		var ok bool
+		if pb.BranchName, ok = keyDesc.GetString(0, k); !ok {
+			return nil, fmt.Errorf("failed to read protected branch")
+		}
+		approvals, ok := valDesc.GetInt32(0, v)
+		if !ok {
+			return nil, fmt.Errorf("failed to read protected branch %s", pb.BranchName)
+		}
+		pb.RequiredApprovals = int(approvals)
+		branches = append(branches, pb)
+	}
+	return branches, nil
+}
@@ -126,7 +126,12 @@ var getWriteableSystemTables = func() []string {
 		IgnoreTableName,
 		GetRebaseTableName(),
 		GetQueryCatalogTableName(),
+		QueryCatalogParametersTableName,
 		GetTestsTableName(),
+		TestFixturesTableName,
+		MergePoliciesTableName,
+		IgnoreColumnsTableName,
+		ProtectedBranchesTableName,
 
 		// TODO: find way to make these writable by the dolt process
 		// TODO: but not by user
@@ -159,6 +164,14 @@ func GeneratedSystemTableNames() []string {
 		GetHelpTableName(),
 		GetBackupsTableName(),
 		GetStashesTableName(),
+		GetNotesTableName(),
+		GetRerereTableName(),
+		GetWebhookDeliveriesTableName(),
+		GetPullRequestsTableName(),
+		GetPullRequestReviewsTableName(),
+		GetPullRequestEventsTableName(),
+		GetCIRunsTableName(),
+		GetCIStepResultsTableName(),
 		GetBranchActivityTableName(),
 		// [dtables.StatusTable] now uses [adapters.DoltTableAdapterRegistry] in its constructor for Doltgres.
 		StatusTableName,
@@ -220,6 +233,29 @@ const (
 	QueryCatalogDescriptionCol = "description"
 )
 
+const (
+	// QueryCatalogParametersTableName is the name of the table of parameters declared by the queries of the query catalog
+	QueryCatalogParametersTableName = "dolt_query_catalog_parameters"
+
+	// QueryCatalogParametersQueryIdCol is the name of the column containing the id of the query a parameter belongs to
+	QueryCatalogParametersQueryIdCol = "query_id"
+
+	// QueryCatalogParametersNameCol is the name of the column containing the name of a parameter, which the query
+	// references as a :name placeholder
+	QueryCatalogParametersNameCol = "param_name"
+
+	// QueryCatalogParametersOrderCol is the name of the column containing the position of a parameter in the
+	// positional arguments of dolt_query_run
+	QueryCatalogParametersOrderCol = "param_order"
+
+	// QueryCatalogParametersTypeCol is the name of the column containing the SQL type of a parameter
+	QueryCatalogParametersTypeCol = "param_type"
+
+	// QueryCatalogParametersDefaultCol is the name of the column containing the default value of a parameter. A
+	// parameter without a default value must be given a value.
+	QueryCatalogParametersDefaultCol = "default_value"
+)
+
 const (
 	// NonlocalTableName is the name of the query catalog table
 	NonlocalTableName = "dolt_nonlocal_tables"
@@ -233,6 +269,65 @@ const (
 	NonlocalTablesOptionsCol = "options"
 )
 
+const (
+	// MergePoliciesTableName is the name of the table of merge policies, which declare how to resolve concurrent changes
+	// to the same cell during a merge
+	MergePoliciesTableName = "dolt_merge_policies"
+
+	// MergePoliciesTableNameCol is the name of the column containing the name of the table a policy applies to
+	MergePoliciesTableNameCol = "table_name"
+
+	// MergePoliciesColumnNameCol is the name of the column containing the name of the column a policy applies to, or
+	// MergePoliciesAllColumns for all of a table's columns
+	MergePoliciesColumnNameCol = "column_name"
+
+	// MergePoliciesPolicyCol is the name of the column containing the policy
+	MergePoliciesPolicyCol = "policy"
+
+	// MergePoliciesExpressionCol is the name of the column containing the policy's argument, if it takes one
+	MergePoliciesExpressionCol = "expression"
+)
+
+const (
+	// IgnoreColumnsTableName is the name of the table of column ignore rules, which exclude changes to matching columns
+	// from diffs and merge conflicts
+	IgnoreColumnsTableName = "dolt_ignore_columns"
+
+	// IgnoreColumnsTableNameCol is the name of the column containing the pattern of table names a rule applies to
+	IgnoreColumnsTableNameCol = "table_name"
+
+	// IgnoreColumnsColumnNameCol is the name of the column containing the pattern of column names a rule applies to
+	IgnoreColumnsColumnNameCol = "column_name"
+)
+
+const (
+	// ProtectedBranchesTableName is the name of the table of protected branches, which can only be merged into by
+	// approved pull requests
+	ProtectedBranchesTableName = "dolt_protected_branches"
+
+	// ProtectedBranchesBranchNameCol is the name of the column containing the name of a protected branch
+	ProtectedBranchesBranchNameCol = "branch_name"
+
+	// ProtectedBranchesRequiredApprovalsCol is the name of the column containing the number of approvals a pull request
+	// needs before it can be merged into the branch
+	ProtectedBranchesRequiredApprovalsCol = "required_approvals"
+)
+
+const (
+	// TestFixturesTableName is the name of the table of test fixtures, the setup and teardown queries run around the
+	// tests of a dolt_tests group
+	TestFixturesTableName = "dolt_test_fixtures"
+
+	// TestFixturesTestGroupCol is the name of the column containing the test group a fixture applies to
+	TestFixturesTestGroupCol = "test_group"
+
+	// TestFixturesSetupCol is the name of the column containing the queries run before the tests of the group
+	TestFixturesSetupCol = "setup_query"
+
+	// TestFixturesTeardownCol is the name of the column containing the queries run after the tests of the group
+	TestFixturesTeardownCol = "teardown_query"
+)
+
 const (
 	// SchemasTableName is the name of the dolt schema fragment table
 	SchemasTableName = "dolt_schemas"
@@ -290,6 +385,8 @@ const (
 const (
 	// DoltBlameViewPrefix is the prefix assigned to all the generated blame tables
 	DoltBlameViewPrefix = "dolt_blame_"
+	// DoltCellBlameTablePrefix is the prefix assigned to all the generated cell blame tables
+	DoltCellBlameTablePrefix = "dolt_cell_blame_"
 	// DoltHistoryTablePrefix is the prefix assigned to all the generated history tables
 	DoltHistoryTablePrefix = "dolt_history_"
 	// DoltDiffTablePrefix is the prefix assigned to all the generated diff tables
@@ -387,6 +484,38 @@ var GetStashesTableName = func() string {
 	return StashesTableName
 }
 
+var GetNotesTableName = func() string {
+	return NotesTableName
+}
+
+var GetRerereTableName = func() string {
+	return RerereTableName
+}
+
+var GetWebhookDeliveriesTableName = func() string {
+	return WebhookDeliveriesTableName
+}
+
+var GetPullRequestsTableName = func() string {
+	return PullRequestsTableName
+}
+
+var GetPullRequestReviewsTableName = func() string {
+	return PullRequestReviewsTableName
+}
+
+var GetPullRequestEventsTableName = func() string {
+	return PullRequestEventsTableName
+}
+
+var GetCIRunsTableName = func() string {
+	return CIRunsTableName
+}
+
+var GetCIStepResultsTableName = func() string {
+	return CIStepResultsTableName
+}
+
 var GetQueryCatalogTableName = func() string { return DoltQueryCatalogTableName }
 
 var GetNonlocalTablesTableName = func() string { return NonlocalTableName }
@@ -457,6 +586,30 @@ const (
 	// StashesTableName is the stashes system table name
 	StashesTableName = "dolt_stashes"
 
+	// NotesTableName is the commit notes system table name
+	NotesTableName = "dolt_notes"
+
+	// RerereTableName is the recorded conflict resolutions system table name
+	RerereTableName = "dolt_rerere"
+
+	// WebhookDeliveriesTableName is the webhook deliveries system table name
+	WebhookDeliveriesTableName = "dolt_webhook_deliveries"
+
+	// PullRequestsTableName is the pull requests system table name
+	PullRequestsTableName = "dolt_pull_requests"
+
+	// PullRequestReviewsTableName is the pull request comments and approvals system table name
+	PullRequestReviewsTableName = "dolt_pull_request_reviews"
+
+	// PullRequestEventsTableName is the pull request activities system table name
+	PullRequestEventsTableName = "dolt_pull_request_events"
+
+	// CIRunsTableName is the dolt CI workflow runs system table name
+	CIRunsTableName = "dolt_ci_runs"
+
+	// CIStepResultsTableName is the dolt CI workflow run step results system table name
+	CIStepResultsTableName = "dolt_ci_step_results"
+
 	// TestsTableName is the tests system table name
 	TestsTableName = "dolt_tests"
 
@@ -484,6 +637,14 @@ var DoltGeneratedTableNames = []string{
 	StatusIgnoredTableName,
 	MergeStatusTableName,
 	TagsTableName,
+	NotesTableName,
+	RerereTableName,
+	WebhookDeliveriesTableName,
+	PullRequestsTableName,
+	PullRequestReviewsTableName,
+	PullRequestEventsTableName,
+	CIRunsTableName,
+	CIStepResultsTableName,
 }
 
 const (
@@ -511,6 +672,9 @@ const (
 	// WorkflowEventsEventTypeColName is the name of the event type column in the workflow events table.
 	WorkflowEventsEventTypeColName = "event_type"
 
+	// WorkflowEventsCronColName is the name of the cron expression column of schedule events in the workflow events table.
+	WorkflowEventsCronColName = "cron"
+
 	// WorkflowEventTriggersTableName is the dolt CI workflow event triggers system table name
 	WorkflowEventTriggersTableName = "dolt_ci_workflow_event_triggers"
 
@@ -126,6 +126,67 @@ func (rs RebaseState) SkipVerification() bool {
 	return rs.skipVerification
 }
 
+// BisectState tracks the state of an in-progress bisect. It records the branch that was checked out when the bisect
+// started, the commit most recently marked as bad, and the commits that have been marked as good or skipped. Commit
+// hashes are stored as strings, in the same form they are displayed to users.
+type BisectState struct {
+	branch  string
+	bad     string
+	good    []string
+	skipped []string
+}
+
+// Branch returns the name of the branch that was checked out when the bisect was started. This is the branch that
+// is checked out again when the bisect is reset.
+func (bs BisectState) Branch() string {
+	return bs.branch
+}
+
+// BadCommit returns the hash of the commit most recently marked as bad, or the empty string if no commit has been
+// marked as bad yet.
+func (bs BisectState) BadCommit() string {
+	return bs.bad
+}
+
+// GoodCommits returns the hashes of the commits that have been marked as good.
+func (bs BisectState) GoodCommits() []string {
+	return bs.good
+}
+
+// SkippedCommits returns the hashes of the commits that have been marked to be skipped.
+func (bs BisectState) SkippedCommits() []string {
+	return bs.skipped
+}
+
+// WithBadCommit returns a copy of this BisectState with |commitHash| recorded as the bad commit.
+func (bs BisectState) WithBadCommit(commitHash string) *BisectState {
+	bs.bad = commitHash
+	return &bs
+}
+
+// WithGoodCommit returns a copy of this BisectState with |commitHash| added to the good commits.
+func (bs BisectState) WithGoodCommit(commitHash string) *BisectState {
+	bs.good = appendUniqueHash(bs.good, commitHash)
+	return &bs
+}
+
+// WithSkippedCommit returns a copy of this BisectState with |commitHash| added to the skipped commits.
+func (bs BisectState) WithSkippedCommit(commitHash string) *BisectState {
+	bs.skipped = appendUniqueHash(bs.skipped, commitHash)
+	return &bs
+}
+
+func appendUniqueHash(hashes []string, h string) []string {
+	for _, existing := range hashes {
+		if existing == h {
+			return hashes
+		}
+	}
+	ret := make([]string, len(hashes), len(hashes)+1)
+	copy(ret, hashes)
+	return append(ret, h)
+}
+
 type MergeState struct {
 	// the source commit
 	commit *Commit
@@ -308,6 +369,7 @@ type WorkingSet struct {
 	stagedRoot  RootValue
 	mergeState  *MergeState
 	rebaseState *RebaseState
+	bisectState *BisectState
 }
 
 var _ Rootish = &WorkingSet{}
@@ -339,6 +401,11 @@ func (ws WorkingSet) WithRebaseState(rebaseState *RebaseState) *WorkingSet {
 	return &ws
 }
 
+func (ws WorkingSet) WithBisectState(bisectState *BisectState) *WorkingSet {
+	ws.bisectState = bisectState
+	return &ws
+}
+
 func (ws WorkingSet) WithUnmergableTables(tables []TableName) *WorkingSet {
 	ws.mergeState.unmergableTables = tables
 	return &ws
@@ -392,6 +459,16 @@ func (ws WorkingSet) StartRebase(ctx *sql.Context, ontoCommit *Commit, branch st
 	return &ws, nil
 }
 
+// StartBisect adds bisect tracking metadata to a new working set instance and returns it. |branch| is the branch that
+// was checked out when the bisect started. Callers must then persist the returned working set in a session in order
+// for the new working set to be recorded.
+func (ws WorkingSet) StartBisect(branch string) *WorkingSet {
+	ws.bisectState = &BisectState{
+		branch: branch,
+	}
+	return &ws
+}
+
 // StartCherryPick creates and returns a new working set based off of the current |ws| with the specified |commit|
 // and |commitSpecStr| referring to the commit being cherry-picked. |preMergeHeadCommit| indicates the current HEAD of the
 // active branch, and may be needed to cleanly roll back an aborted cherry-pick to the original working set state
@@ -451,6 +528,11 @@ func (ws WorkingSet) ClearRebase() *WorkingSet {
 	return &ws
 }
 
+func (ws WorkingSet) ClearBisect() *WorkingSet {
+	ws.bisectState = nil
+	return &ws
+}
+
 func (ws *WorkingSet) WorkingRoot() RootValue {
 	return ws.workingRoot
 }
@@ -467,6 +549,10 @@ func (ws *WorkingSet) RebaseState() *RebaseState {
 	return ws.rebaseState
 }
 
+func (ws *WorkingSet) BisectState() *BisectState {
+	return ws.bisectState
+}
+
 func (ws *WorkingSet) MergeActive() bool {
 	return ws.mergeState != nil
 }
@@ -475,6 +561,10 @@ func (ws *WorkingSet) RebaseActive() bool {
 	return ws.rebaseState != nil
 }
 
+func (ws *WorkingSet) BisectActive() bool {
+	return ws.bisectState != nil
+}
+
 // MergeCommitParents returns true if there is an active merge in progress and
 // the recorded commit being merged into the active branch should be included as
 // a second parent of the created commit. This is the expected behavior for a
@@ -644,6 +734,16 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 		}
 	}
 
+	var bisectState *BisectState
+	if dsws.BisectState != nil {
+		bisectState = &BisectState{
+			branch:  dsws.BisectState.Branch(),
+			bad:     dsws.BisectState.BadCommitHash(),
+			good:    dsws.BisectState.GoodCommitHashes(),
+			skipped: dsws.BisectState.SkippedCommitHashes(),
+		}
+	}
+
 	addr, _ := ds.MaybeHeadAddr()
 
 	return &WorkingSet{
@@ -654,6 +754,7 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 		stagedRoot:  stagedRoot,
 		mergeState:  mergeState,
 		rebaseState: rebaseState,
+		bisectState: bisectState,
 	}, nil
 }
 
@@ -753,11 +854,17 @@ func (ws *WorkingSet) writeValues(ctx context.Context, db *DoltDB, meta *datas.W
 			ws.rebaseState.lastAttemptedStep, ws.rebaseState.rebasingStarted, ws.rebaseState.skipVerification)
 	}
 
+	var bisectState *datas.BisectState
+	if ws.bisectState != nil {
+		bisectState = datas.NewBisectState(ws.bisectState.branch, ws.bisectState.bad, ws.bisectState.good, ws.bisectState.skipped)
+	}
+
 	return &datas.WorkingSetSpec{
 		Meta:        meta,
 		WorkingRoot: workingRoot,
 		StagedRoot:  stagedRoot,
 		MergeState:  mergeState,
 		RebaseState: rebaseState,
+		BisectState: bisectState,
 	}, nil
 }
@@ -0,0 +1,217 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/memlimit"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/nbs"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// CreateBundle writes a bundle file to |path| holding |refs| and every chunk of |srcDB| reachable from them. If
+// |bases| is not empty, the bundle is incremental: chunks reachable from any of the |bases| commits are left out, and
+// the bundle can only be fetched into a repository that already has those commits.
+func CreateBundle(ctx context.Context, srcDB *doltdb.DoltDB, tempTableDir, path string, refs []doltdb.RefWithHash, bases []hash.Hash) (*bundle.Header, error) {
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("refusing to create an empty bundle")
+	}
+
+	var skip hash.HashSet
+	if len(bases) > 0 {
+		var err error
+		skip, _, err = walkReachableChunks(ctx, chunkStoreForDoltDB(srcDB), srcDB.Format(), hash.NewHashSet(bases...))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header := bundle.Header{NomsBinFormat: srcDB.Format().VersionString()}
+	targets := make([]hash.Hash, 0, len(refs))
+	for _, r := range refs {
+		if skip.Has(r.Hash) {
+			return nil, fmt.Errorf("ref '%s' is already contained in the base of the bundle", r.Ref.String())
+		}
+		header.Refs = append(header.Refs, bundle.Ref{Name: r.Ref.String(), Hash: r.Hash.String()})
+		targets = append(targets, r.Hash)
+	}
+	for _, h := range bases {
+		header.Prerequisites = append(header.Prerequisites, h.String())
+	}
+
+	dir, err := os.MkdirTemp(tempTableDir, "bundle")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	bs := blobstore.NewLocalBlobstore(dir)
+	cs, err := nbs.NewBSStore(ctx, srcDB.Format().VersionString(), bs, memlimit.MemtableSize(), nbs.NewUnlimitedMemQuotaProvider())
+	if err != nil {
+		return nil, err
+	}
+	bundleDB, err := doltdb.DoltDBFromCS(cs, "")
+	if err != nil {
+		return nil, err
+	}
+	defer bundleDB.Close()
+
+	err = bundleDB.PullChunks(ctx, tempTableDir, srcDB, targets, nil, skip)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range refs {
+		if err = bundleDB.SetHead(ctx, r.Ref, r.Hash); err != nil {
+			return nil, err
+		}
+	}
+
+	sources, err := cs.Sources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{bundle.ManifestKey}
+	for _, tf := range sources.TableFiles {
+		keys = append(keys, tf.FileID()+tf.LocationSuffix())
+	}
+
+	if err = bundle.Write(ctx, path, header, bs, keys); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// VerifyBundle checks that the bundle file at |path| is intact, and that every chunk reachable from its refs is either
+// in the bundle or in |ddb|. It returns the bundle's header along with any of its prerequisites that |ddb| is missing.
+// The bundle can be fetched into |ddb| if no error is returned and no prerequisites are missing.
+func VerifyBundle(ctx context.Context, ddb *doltdb.DoltDB, path string) (*bundle.Header, []hash.Hash, error) {
+	header, bs, err := bundle.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prereqs, err := header.PrerequisiteHashes()
+	if err != nil {
+		return nil, nil, err
+	}
+	localCS := chunkStoreForDoltDB(ddb)
+	absentPrereqs, err := localCS.HasMany(ctx, hash.NewHashSet(prereqs...))
+	if err != nil {
+		return nil, nil, err
+	}
+	var missing []hash.Hash
+	for _, h := range prereqs {
+		if absentPrereqs.Has(h) {
+			missing = append(missing, h)
+		}
+	}
+	if len(missing) > 0 {
+		return header, missing, nil
+	}
+
+	cs, err := nbs.NewBSStore(ctx, header.NomsBinFormat, bs, memlimit.MemtableSize(), nbs.NewUnlimitedMemQuotaProvider())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", bundle.ErrCorruptBundle, err.Error())
+	}
+	defer cs.Close()
+
+	roots := hash.NewHashSet()
+	for _, r := range header.Refs {
+		h, ok := hash.MaybeParse(r.Hash)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: invalid hash '%s' for ref '%s'", bundle.ErrCorruptBundle, r.Hash, r.Name)
+		}
+		roots.Insert(h)
+	}
+	nbf, err := types.GetFormatForVersionString(header.NomsBinFormat)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, absent, err := walkReachableChunks(ctx, cs, nbf, roots)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	absent, err = localCS.HasMany(ctx, absent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if absent.Size() > 0 {
+		return nil, nil, fmt.Errorf("%w: missing %d chunks, including %s", bundle.ErrCorruptBundle, absent.Size(), absent.ToSlice()[0].String())
+	}
+	return header, nil, nil
+}
+
+func chunkStoreForDoltDB(ddb *doltdb.DoltDB) chunks.ChunkStore {
+	return datas.ChunkStoreFromDatabase(doltdb.ExposeDatabaseFromDoltDB(ddb))
+}
+
+// walkReachableChunks walks every chunk in |cs| that is reachable from |roots|. It returns the addresses of the
+// chunks it visited, and of any reachable chunks that are not in |cs|, which it does not descend into.
+func walkReachableChunks(ctx context.Context, cs chunks.ChunkStore, nbf *types.NomsBinFormat, roots hash.HashSet) (visited, absent hash.HashSet, err error) {
+	visited, absent = hash.NewHashSet(), hash.NewHashSet()
+	walkAddrs := types.WalkAddrsForNBF(nbf, nil)
+
+	next := roots.Copy()
+	for next.Size() > 0 {
+		var mu sync.Mutex
+		var walkErr error
+		children := hash.NewHashSet()
+		found := hash.NewHashSet()
+		err = cs.GetMany(ctx, next, func(ctx context.Context, c *chunks.Chunk) {
+			mu.Lock()
+			defer mu.Unlock()
+			found.Insert(c.Hash())
+			if err := walkAddrs(*c, func(h hash.Hash, _ bool) error {
+				children.Insert(h)
+				return nil
+			}); err != nil && walkErr == nil {
+				walkErr = err
+			}
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if walkErr != nil {
+			return nil, nil, walkErr
+		}
+
+		for h := range next {
+			if found.Has(h) {
+				visited.Insert(h)
+			} else {
+				absent.Insert(h)
+			}
+		}
+
+		next = hash.NewHashSet()
+		for h := range children {
+			if !visited.Has(h) && !absent.Has(h) {
+				next.Insert(h)
+			}
+		}
+	}
+	return visited, absent, nil
+}
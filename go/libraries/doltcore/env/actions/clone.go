@@ -320,6 +320,18 @@ func fullClone(ctx context.Context, srcDB *doltdb.DoltDB, dEnv *env.DoltEnv, src
 		}
 	}
 
+	// Notes aren't a commit ref, but their chunks were cloned along with everything else, so they only need to be
+	// pointed at again.
+	notesAddr, ok, err := srcDB.GetNotesAddr(ctx)
+	if err != nil {
+		return nil, err
+	} else if ok {
+		err = dEnv.DoltDB(ctx).MergeNotes(ctx, notesAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cm, nil
 }
 
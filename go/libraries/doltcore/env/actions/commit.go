@@ -194,6 +194,31 @@ func GetCommitStaged(
 // If any tests fail, it returns ErrCommitVerificationFailed wrapping the failure details.
 // Callers can use errors.Is(err, ErrCommitVerificationFailed) to detect this case.
 func runCommitVerification(ctx *sql.Context, testGroups []string) error {
+	engine, err := newVerificationEngine(ctx)
+	if err != nil {
+		return err
+	}
+	return runTestsUsingDtablefunctions(ctx, engine, testGroups)
+}
+
+// RunChangeVerification runs the change assertions of the commit verification test groups against the change from
+// the |from| revision to the |to| revision, for operations which add commits to a branch without creating them, such
+// as fast-forward merges and pushes. If any tests fail, it returns ErrCommitVerificationFailed wrapping the failure
+// details.
+func RunChangeVerification(ctx *sql.Context, from, to string) error {
+	testGroups := getCommitRunTestGroups()
+	if len(testGroups) == 0 {
+		return nil
+	}
+	engine, err := newVerificationEngine(ctx)
+	if err != nil {
+		return err
+	}
+	return runTestsUsingDtablefunctions(ctx, engine, testGroups, "--changes", "--from", from, "--to", to)
+}
+
+// newVerificationEngine returns an engine for running verification tests with the database provider of the session.
+func newVerificationEngine(ctx *sql.Context) (*gms.Engine, error) {
 	type sessionInterface interface {
 		sql.Session
 		GenericProvider() sql.MutableDatabaseProvider
@@ -201,17 +226,15 @@ func runCommitVerification(ctx *sql.Context, testGroups []string) error {
 
 	session, ok := ctx.Session.(sessionInterface)
 	if !ok {
-		return fmt.Errorf("session does not provide database provider interface")
+		return nil, fmt.Errorf("session does not provide database provider interface")
 	}
 
-	provider := session.GenericProvider()
-	engine := gms.NewDefault(provider)
-
-	return runTestsUsingDtablefunctions(ctx, engine, testGroups)
+	return gms.NewDefault(session.GenericProvider()), nil
 }
 
-// runTestsUsingDtablefunctions runs tests using the dtablefunctions package against the staged root
-func runTestsUsingDtablefunctions(ctx *sql.Context, engine *gms.Engine, testGroups []string) error {
+// runTestsUsingDtablefunctions runs tests using the dtablefunctions package against the staged root, passing |options|
+// to dolt_test_run ahead of each test group
+func runTestsUsingDtablefunctions(ctx *sql.Context, engine *gms.Engine, testGroups []string, options ...string) error {
 	if len(testGroups) == 0 {
 		return nil
 	}
@@ -219,7 +242,12 @@ func runTestsUsingDtablefunctions(ctx *sql.Context, engine *gms.Engine, testGrou
 	var allFailures []string
 
 	for _, group := range testGroups {
-		query := fmt.Sprintf("SELECT * FROM dolt_test_run('%s')", group)
+		var args []string
+		for _, option := range options {
+			args = append(args, fmt.Sprintf("'%s'", option))
+		}
+		args = append(args, fmt.Sprintf("'%s'", group))
+		query := fmt.Sprintf("SELECT * FROM dolt_test_run(%s)", strings.Join(args, ", "))
 		_, iter, _, err := engine.Query(ctx, query)
 		if err != nil {
 			return fmt.Errorf("failed to run dolt_test_run for group %s: %w", group, err)
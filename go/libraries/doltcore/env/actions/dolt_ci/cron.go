@@ -0,0 +1,176 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression, with the minutes, hours, days of the month, months and days of the week it
+// matches. Cron expressions are evaluated in UTC.
+type CronSchedule struct {
+	minutes, hours, daysOfMonth, months, daysOfWeek uint64
+	// daysOfMonthAny and daysOfWeekAny are set for a day of the month or day of the week starting with *, like * or
+	// */2. When both are restricted, a day matches if either of them does.
+	daysOfMonthAny, daysOfWeekAny bool
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var cronDayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: cronMonthNames},
+	// 7 is also sunday
+	{name: "day of week", min: 0, max: 7, names: cronDayNames},
+}
+
+// ParseCronSchedule parses a standard five field cron expression: minute, hour, day of month, month and day of week.
+// Fields may be *, values, ranges and lists, with an optional /step, and months and days of the week may be named.
+// The @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly macros are also supported.
+func ParseCronSchedule(expr string) (*CronSchedule, error) {
+	spec := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("invalid cron expression '%s': expected %d fields, found %d", expr, len(cronFields), len(fields))
+	}
+
+	var bits [5]uint64
+	for i, f := range cronFields {
+		b, err := parseCronField(fields[i], f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression '%s': %w", expr, err)
+		}
+		bits[i] = b
+	}
+	// fold sunday as 7 into sunday as 0
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return &CronSchedule{
+		minutes:        bits[0],
+		hours:          bits[1],
+		daysOfMonth:    bits[2],
+		months:         bits[3],
+		daysOfWeek:     bits[4],
+		daysOfMonthAny: strings.HasPrefix(fields[2], "*"),
+		daysOfWeekAny:  strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		i := strings.Index(part, "/")
+		if i >= 0 {
+			var err error
+			rng = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field: %s", f.name, part)
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case rng == "*":
+			lo, hi = f.min, f.max
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = cronFieldValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = cronFieldValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range in %s field: %s", f.name, rng)
+			}
+		default:
+			var err error
+			if lo, err = cronFieldValue(rng, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			// a value with a step, like 5/15, runs from the value to the end of the field's range
+			if i >= 0 {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func cronFieldValue(s string, f cronField) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value in %s field: %s", f.name, s)
+	}
+	return v, nil
+}
+
+// Matches returns whether the schedule runs in the minute of |t|, in UTC.
+func (cs *CronSchedule) Matches(t time.Time) bool {
+	t = t.UTC()
+	if cs.minutes&(1<<uint(t.Minute())) == 0 || cs.hours&(1<<uint(t.Hour())) == 0 || cs.months&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := cs.daysOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := cs.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if cs.daysOfMonthAny || cs.daysOfWeekAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronSchedule(t *testing.T) {
+	// Monday, October 19th 2026
+	monday := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr    string
+		matches []time.Time
+		misses  []time.Time
+	}{
+		{
+			expr:    "* * * * *",
+			matches: []time.Time{monday, monday.Add(37 * time.Minute)},
+		},
+		{
+			expr:    "@daily",
+			matches: []time.Time{monday, monday.AddDate(0, 0, 3)},
+			misses:  []time.Time{monday.Add(time.Minute), monday.Add(time.Hour)},
+		},
+		{
+			expr:    "*/15 9-17 * * mon-fri",
+			matches: []time.Time{monday.Add(9 * time.Hour), monday.Add(17*time.Hour + 45*time.Minute)},
+			misses:  []time.Time{monday.Add(9*time.Hour + 5*time.Minute), monday.Add(18 * time.Hour), monday.AddDate(0, 0, 5).Add(9 * time.Hour)},
+		},
+		{
+			expr:    "5/20 0 * * *",
+			matches: []time.Time{monday.Add(5 * time.Minute), monday.Add(25 * time.Minute), monday.Add(45 * time.Minute)},
+			misses:  []time.Time{monday, monday.Add(20 * time.Minute)},
+		},
+		{
+			expr:    "58/1 0 * * *",
+			matches: []time.Time{monday.Add(58 * time.Minute), monday.Add(59 * time.Minute)},
+			misses:  []time.Time{monday.Add(57 * time.Minute)},
+		},
+		{
+			// a stepped day of the week is unrestricted like *, so only the day of the month has to match
+			expr:    "0 0 1 * */2",
+			matches: []time.Time{time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+			misses:  []time.Time{monday.AddDate(0, 0, 1)},
+		},
+		{
+			// sunday as 7
+			expr:    "0 0 * * 7",
+			matches: []time.Time{monday.AddDate(0, 0, 6)},
+			misses:  []time.Time{monday},
+		},
+		{
+			// a day of the month or a day of the week
+			expr:    "0 0 1,15 oct 1",
+			matches: []time.Time{monday, time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC)},
+			misses:  []time.Time{monday.AddDate(0, 0, 1), time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			// times are matched in UTC
+			expr:    "0 0 * * *",
+			matches: []time.Time{monday.In(time.FixedZone("UTC-5", -5*60*60))},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			cs, err := ParseCronSchedule(test.expr)
+			require.NoError(t, err)
+			for _, m := range test.matches {
+				assert.True(t, cs.Matches(m), "expected a match at %s", m)
+			}
+			for _, m := range test.misses {
+				assert.False(t, cs.Matches(m), "expected no match at %s", m)
+			}
+		})
+	}
+}
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "@often"} {
+		_, err := ParseCronSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// ReportFormatJUnit is the JUnit XML report format
+	ReportFormatJUnit = "junit"
+	// ReportFormatJSON is the JSON report format
+	ReportFormatJSON = "json"
+)
+
+// Report is a machine-readable report of the results of a workflow run or a run of dolt tests. Workflow runs have a
+// suite per job with a case per step, and dolt test runs have a suite per test group with a case per test.
+type Report struct {
+	Name     string         `json:"name"`
+	Tests    int            `json:"tests"`
+	Failures int            `json:"failures"`
+	Duration time.Duration  `json:"-"`
+	Suites   []*ReportSuite `json:"suites"`
+}
+
+// ReportSuite is a suite of cases of a Report.
+type ReportSuite struct {
+	Name     string        `json:"name"`
+	Tests    int           `json:"tests"`
+	Failures int           `json:"failures"`
+	Duration time.Duration `json:"-"`
+	Cases    []*ReportCase `json:"cases"`
+}
+
+// ReportCase is the result of a step or a dolt test in a Report.
+type ReportCase struct {
+	Name     string        `json:"name"`
+	Query    string        `json:"query,omitempty"`
+	Status   string        `json:"status"`
+	Duration time.Duration `json:"-"`
+	// Message describes why the case failed, and is empty for cases which passed
+	Message string `json:"message,omitempty"`
+	// Expected is the assertion of the case, and Actual is the value the assertion was checked against, when known
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+// ValidateReportFormat returns an error if |format| isn't a supported report format.
+func ValidateReportFormat(format string) error {
+	switch format {
+	case ReportFormatJUnit, ReportFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid report format '%s', expected one of: %s, %s", format, ReportFormatJUnit, ReportFormatJSON)
+	}
+}
+
+// NewReport returns an empty report named |name|.
+func NewReport(name string) *Report {
+	return &Report{Name: name}
+}
+
+// Suite returns the suite of |r| named |name|, adding it if it doesn't exist yet.
+func (r *Report) Suite(name string) *ReportSuite {
+	for _, s := range r.Suites {
+		if s.Name == name {
+			return s
+		}
+	}
+	s := &ReportSuite{Name: name}
+	r.Suites = append(r.Suites, s)
+	return s
+}
+
+// AddCase records |c| in the suite of |r| named |suite|, and updates the totals of both.
+func (r *Report) AddCase(suite string, c *ReportCase) {
+	s := r.Suite(suite)
+	s.Cases = append(s.Cases, c)
+	s.Tests++
+	s.Duration += c.Duration
+	r.Tests++
+	r.Duration += c.Duration
+	if c.Status != WorkflowRunStatusPassed {
+		s.Failures++
+		r.Failures++
+	}
+}
+
+// AddDuration adds |d| to the durations of the suite of |r| named |suite| and of |r|, for suites whose cases are run
+// together, so that the durations of their cases aren't known.
+func (r *Report) AddDuration(suite string, d time.Duration) {
+	r.Suite(suite).Duration += d
+	r.Duration += d
+}
+
+// Write writes |r| to |w| in |format|.
+func (r *Report) Write(w io.Writer, format string) error {
+	switch format {
+	case ReportFormatJUnit:
+		return r.writeJUnit(w)
+	case ReportFormatJSON:
+		return r.writeJSON(w)
+	default:
+		return ValidateReportFormat(format)
+	}
+}
+
+type jsonReport struct {
+	*Report
+	DurationMs int64             `json:"duration_ms"`
+	Suites     []jsonReportSuite `json:"suites"`
+}
+
+type jsonReportSuite struct {
+	*ReportSuite
+	DurationMs int64            `json:"duration_ms"`
+	Cases      []jsonReportCase `json:"cases"`
+}
+
+type jsonReportCase struct {
+	*ReportCase
+	DurationMs int64 `json:"duration_ms"`
+}
+
+func (r *Report) writeJSON(w io.Writer) error {
+	jr := jsonReport{Report: r, DurationMs: r.Duration.Milliseconds(), Suites: []jsonReportSuite{}}
+	for _, s := range r.Suites {
+		js := jsonReportSuite{ReportSuite: s, DurationMs: s.Duration.Milliseconds(), Cases: []jsonReportCase{}}
+		for _, c := range s.Cases {
+			js.Cases = append(js.Cases, jsonReportCase{ReportCase: c, DurationMs: c.Duration.Milliseconds()})
+		}
+		jr.Suites = append(jr.Suites, js)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	// assertions are written as they're defined, e.g. "expected_rows > 0", rather than escaped for HTML
+	enc.SetEscapeHTML(false)
+	return enc.Encode(jr)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	ClassName  string           `xml:"classname,attr"`
+	Time       string           `xml:"time,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Failure    *junitFailure    `xml:"failure,omitempty"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",cdata"`
+}
+
+func (r *Report) writeJUnit(w io.Writer) error {
+	ts := junitTestSuites{Name: r.Name, Tests: r.Tests, Failures: r.Failures, Time: junitTime(r.Duration)}
+	for _, s := range r.Suites {
+		js := junitTestSuite{Name: s.Name, Tests: s.Tests, Failures: s.Failures, Time: junitTime(s.Duration)}
+		for _, c := range s.Cases {
+			jc := junitTestCase{Name: c.Name, ClassName: s.Name, Time: junitTime(c.Duration)}
+			var props []junitProperty
+			if c.Query != "" {
+				props = append(props, junitProperty{Name: "query", Value: c.Query})
+			}
+			if c.Expected != "" {
+				props = append(props, junitProperty{Name: "expected", Value: c.Expected})
+			}
+			if c.Actual != "" {
+				props = append(props, junitProperty{Name: "actual", Value: c.Actual})
+			}
+			if len(props) > 0 {
+				jc.Properties = &junitProperties{Properties: props}
+			}
+			if c.Status != WorkflowRunStatusPassed {
+				jc.Failure = &junitFailure{Message: c.Message, Type: "AssertionFailure", Text: junitFailureText(c)}
+			}
+			js.Cases = append(js.Cases, jc)
+		}
+		ts.Suites = append(ts.Suites, js)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(ts); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+func junitFailureText(c *ReportCase) string {
+	var lines []string
+	if c.Message != "" {
+		lines = append(lines, c.Message)
+	}
+	if c.Query != "" {
+		lines = append(lines, "query: "+c.Query)
+	}
+	if c.Expected != "" {
+		lines = append(lines, "expected: "+c.Expected)
+	}
+	if c.Actual != "" {
+		lines = append(lines, "actual: "+c.Actual)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// doltTestExpectedCol and doltTestActualCol are the columns of the rows of dolt_test_run with the --details option
+// with the expected and actual values of the assertion of a test.
+const (
+	doltTestExpectedCol = 5
+	doltTestActualCol   = 6
+)
+
+// DoltTestAssertion returns the expected and actual values of the assertion of the dolt_test_run row |row|, e.g.
+// "expected_rows == 1" and "3". The actual value is empty if the assertion wasn't checked against one, e.g. because
+// the query of the test failed.
+func DoltTestAssertion(ctx *sql.Context, row sql.Row) (expected string, actual string, err error) {
+	if len(row) <= doltTestActualCol {
+		return "", "", fmt.Errorf("expected %d columns in the results of dolt_test_run --details, got %d", doltTestActualCol+1, len(row))
+	}
+	if expected, err = columnString(ctx, row[doltTestExpectedCol]); err != nil {
+		return "", "", err
+	}
+	if actual, err = columnString(ctx, row[doltTestActualCol]); err != nil {
+		return "", "", err
+	}
+	return expected, actual, nil
+}
+
+// SavedQueryAssertions returns the row and column count assertions of a saved query step, e.g. "expected_rows == 1",
+// and the counts of |rows| they are checked against. When a step asserts both counts, they are separated by "; ".
+func SavedQueryAssertions(rows []sql.Row, expectedRowsAndComparison string, expectedColumnsAndComparison string) (expected string, actual string) {
+	var expectedParts, actualParts []string
+	if strings.TrimSpace(expectedRowsAndComparison) != "" {
+		expectedParts = append(expectedParts, "expected_rows "+strings.TrimSpace(expectedRowsAndComparison))
+		actualParts = append(actualParts, fmt.Sprint(len(rows)))
+	}
+	if strings.TrimSpace(expectedColumnsAndComparison) != "" {
+		var cols int
+		if len(rows) > 0 {
+			cols = len(rows[0])
+		}
+		expectedParts = append(expectedParts, "expected_columns "+strings.TrimSpace(expectedColumnsAndComparison))
+		actualParts = append(actualParts, fmt.Sprint(cols))
+	}
+	return strings.Join(expectedParts, "; "), strings.Join(actualParts, "; ")
+}
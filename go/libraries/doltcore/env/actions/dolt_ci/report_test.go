@@ -0,0 +1,156 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() *Report {
+	r := NewReport("wf")
+	r.AddCase("job", &ReportCase{Name: "one", Status: WorkflowRunStatusPassed, Duration: 1500 * time.Millisecond})
+	r.AddCase("job", &ReportCase{
+		Name:     "two",
+		Query:    "select * from t",
+		Status:   WorkflowRunStatusFailed,
+		Duration: 250 * time.Millisecond,
+		Message:  "Assertion failed: expected_rows greater than 0, got 0",
+		Expected: "expected_rows > 0",
+		Actual:   "0",
+	})
+	r.AddCase("other", &ReportCase{Name: "three", Status: WorkflowRunStatusPassed})
+	return r
+}
+
+func TestReportAddCase(t *testing.T) {
+	r := testReport()
+	assert.Equal(t, 3, r.Tests)
+	assert.Equal(t, 1, r.Failures)
+	assert.Equal(t, 1750*time.Millisecond, r.Duration)
+	require.Len(t, r.Suites, 2)
+	assert.Equal(t, "job", r.Suites[0].Name)
+	assert.Equal(t, 2, r.Suites[0].Tests)
+	assert.Equal(t, 1, r.Suites[0].Failures)
+	assert.Equal(t, "other", r.Suites[1].Name)
+	assert.Equal(t, 0, r.Suites[1].Failures)
+}
+
+func TestReportAddDuration(t *testing.T) {
+	r := testReport()
+	r.AddDuration("other", 2*time.Second)
+	assert.Equal(t, 3750*time.Millisecond, r.Duration)
+	assert.Equal(t, 1750*time.Millisecond, r.Suites[0].Duration)
+	assert.Equal(t, 2*time.Second, r.Suites[1].Duration)
+}
+
+func TestReportWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, testReport().Write(&buf, ReportFormatJUnit))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="wf" tests="3" failures="1" time="1.750">
+  <testsuite name="job" tests="2" failures="1" time="1.750">
+    <testcase name="one" classname="job" time="1.500"></testcase>
+    <testcase name="two" classname="job" time="0.250">
+      <properties>
+        <property name="query" value="select * from t"></property>
+        <property name="expected" value="expected_rows &gt; 0"></property>
+        <property name="actual" value="0"></property>
+      </properties>
+      <failure message="Assertion failed: expected_rows greater than 0, got 0" type="AssertionFailure"><![CDATA[Assertion failed: expected_rows greater than 0, got 0
+query: select * from t
+expected: expected_rows > 0
+actual: 0]]></failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="other" tests="1" failures="0" time="0.000">
+    <testcase name="three" classname="other" time="0.000"></testcase>
+  </testsuite>
+</testsuites>
+`, buf.String())
+}
+
+func TestReportWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, testReport().Write(&buf, ReportFormatJSON))
+	assert.Contains(t, buf.String(), `"expected": "expected_rows > 0"`)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "wf", decoded["name"])
+	assert.Equal(t, float64(3), decoded["tests"])
+	assert.Equal(t, float64(1750), decoded["duration_ms"])
+	suites := decoded["suites"].([]interface{})
+	require.Len(t, suites, 2)
+	cases := suites[0].(map[string]interface{})["cases"].([]interface{})
+	require.Len(t, cases, 2)
+	assert.Equal(t, map[string]interface{}{
+		"name":        "two",
+		"query":       "select * from t",
+		"status":      "failed",
+		"duration_ms": float64(250),
+		"message":     "Assertion failed: expected_rows greater than 0, got 0",
+		"expected":    "expected_rows > 0",
+		"actual":      "0",
+	}, cases[1])
+
+	var empty bytes.Buffer
+	require.NoError(t, NewReport("empty").Write(&empty, ReportFormatJSON))
+	assert.Contains(t, empty.String(), `"suites": []`)
+}
+
+func TestReportFormat(t *testing.T) {
+	assert.NoError(t, ValidateReportFormat(ReportFormatJUnit))
+	assert.NoError(t, ValidateReportFormat(ReportFormatJSON))
+	assert.Error(t, ValidateReportFormat("xml"))
+	assert.Error(t, testReport().Write(&bytes.Buffer{}, "xml"))
+}
+
+func TestDoltTestAssertion(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	expected, actual, err := DoltTestAssertion(ctx, sql.Row{"t", "", "SELECT 1", "FAIL", "Assertion failed: expected_rows equal to 2, got 1", "expected_rows == 2", "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "expected_rows == 2", expected)
+	assert.Equal(t, "1", actual)
+
+	expected, actual, err = DoltTestAssertion(ctx, sql.Row{"t", "", "SELECT * FROM missing", "FAIL", "Query error: table not found: missing", "expected_rows == 2", nil})
+	require.NoError(t, err)
+	assert.Equal(t, "expected_rows == 2", expected)
+	assert.Equal(t, "", actual)
+
+	_, _, err = DoltTestAssertion(ctx, sql.Row{"t", "", "SELECT 1", "PASS", ""})
+	assert.Error(t, err)
+}
+
+func TestSavedQueryAssertions(t *testing.T) {
+	rows := []sql.Row{{1, 2}, {3, 4}}
+	expected, actual := SavedQueryAssertions(rows, "== 1", "")
+	assert.Equal(t, "expected_rows == 1", expected)
+	assert.Equal(t, "2", actual)
+
+	expected, actual = SavedQueryAssertions(rows, "> 0", "== 2")
+	assert.Equal(t, "expected_rows > 0; expected_columns == 2", expected)
+	assert.Equal(t, "2; 2", actual)
+
+	expected, actual = SavedQueryAssertions(nil, "", "")
+	assert.Equal(t, "", expected)
+	assert.Equal(t, "", actual)
+}
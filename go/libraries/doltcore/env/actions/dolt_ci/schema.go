@@ -203,7 +203,13 @@ func createWorkflowsTableQuery() string {
 }
 
 func createWorkflowEventsTableQuery() string {
-	return fmt.Sprintf("create table %s (`%s` varchar(36) primary key, `%s` int not null, `%s` varchar(2048) collate utf8mb4_0900_ai_ci not null, foreign key (`%s`) references %s (`%s`) on delete cascade);", doltdb.WorkflowEventsTableName, doltdb.WorkflowEventsIdPkColName, doltdb.WorkflowEventsEventTypeColName, doltdb.WorkflowEventsWorkflowNameFkColName, doltdb.WorkflowEventsWorkflowNameFkColName, doltdb.WorkflowsTableName, doltdb.WorkflowsNameColName)
+	return fmt.Sprintf("create table %s (`%s` varchar(36) primary key, `%s` int not null, `%s` varchar(2048) collate utf8mb4_0900_ai_ci not null, %s, foreign key (`%s`) references %s (`%s`) on delete cascade);", doltdb.WorkflowEventsTableName, doltdb.WorkflowEventsIdPkColName, doltdb.WorkflowEventsEventTypeColName, doltdb.WorkflowEventsWorkflowNameFkColName, workflowEventsCronColumnDefinition(), doltdb.WorkflowEventsWorkflowNameFkColName, doltdb.WorkflowsTableName, doltdb.WorkflowsNameColName)
+}
+
+// workflowEventsCronColumnDefinition is the definition of the cron column of the workflow events table, which is added
+// to the tables of databases which initialized dolt ci before schedule events were supported when a schedule is stored.
+func workflowEventsCronColumnDefinition() string {
+	return fmt.Sprintf("`%s` varchar(1024) collate utf8mb4_0900_ai_ci", doltdb.WorkflowEventsCronColName)
 }
 
 func createWorkflowEventTriggersTableQuery() string {
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 
@@ -208,10 +209,18 @@ type PullRequest struct {
 
 type WorkflowDispatch struct{}
 
+// Schedule is a cron expression on which a workflow runs against its branches, or against the default branch of the
+// database if it has none.
+type Schedule struct {
+	Cron     yaml.Node   `yaml:"cron"`
+	Branches []yaml.Node `yaml:"branches,omitempty"`
+}
+
 type On struct {
 	Push             *Push             `yaml:"push,omitempty"`
 	PullRequest      *PullRequest      `yaml:"pull_request,omitempty"`
 	WorkflowDispatch *WorkflowDispatch `yaml:"workflow_dispatch,omitempty"`
+	Schedule         []Schedule        `yaml:"schedule,omitempty"`
 }
 
 type WorkflowConfig struct {
@@ -220,6 +229,63 @@ type WorkflowConfig struct {
 	Jobs []Job     `yaml:"jobs"`
 }
 
+// TriggeredByPullRequest returns whether |activity| on a pull request into |targetBranch| triggers the pull_request
+// event of the workflow. A pull_request event without branches is triggered by pull requests into any branch, and one
+// without activities is triggered by every activity.
+func (wc *WorkflowConfig) TriggeredByPullRequest(targetBranch, activity string) bool {
+	pr := wc.On.PullRequest
+	if pr == nil {
+		return false
+	}
+	if len(pr.Branches) > 0 && !containsNodeValue(pr.Branches, targetBranch, false) {
+		return false
+	}
+	return len(pr.Activities) == 0 || containsNodeValue(pr.Activities, activity, true)
+}
+
+// TriggeredByPush returns whether an update of |branch| triggers the push event of the workflow. A push event without
+// branches is triggered by updates of any branch.
+func (wc *WorkflowConfig) TriggeredByPush(branch string) bool {
+	push := wc.On.Push
+	if push == nil {
+		return false
+	}
+	return len(push.Branches) == 0 || containsNodeValue(push.Branches, branch, false)
+}
+
+// ScheduledBranches returns the branches the workflow's schedules run against in the minute of |t|, in the order they
+// are listed, using |defaultBranch| for schedules without branches. Schedules with invalid cron expressions never run.
+func (wc *WorkflowConfig) ScheduledBranches(t time.Time, defaultBranch string) []string {
+	var branches []string
+	seen := make(map[string]bool)
+	for _, sched := range wc.On.Schedule {
+		cs, err := ParseCronSchedule(sched.Cron.Value)
+		if err != nil || !cs.Matches(t) {
+			continue
+		}
+		names := []string{defaultBranch}
+		if len(sched.Branches) > 0 {
+			names = nodesToValues(sched.Branches)
+		}
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				branches = append(branches, name)
+			}
+		}
+	}
+	return branches
+}
+
+func containsNodeValue(nodes []yaml.Node, value string, caseInsensitive bool) bool {
+	for _, n := range nodes {
+		if n.Value == value || (caseInsensitive && strings.EqualFold(n.Value, value)) {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseWorkflowConfig(r io.Reader) (workflow *WorkflowConfig, err error) {
 	workflow = &WorkflowConfig{}
 
@@ -249,7 +315,7 @@ func WorkflowConfigToYaml(workflow *WorkflowConfig) (r io.Reader, err error) {
 }
 
 func ValidateWorkflowConfig(workflow *WorkflowConfig) error {
-	if workflow.On.WorkflowDispatch == nil && workflow.On.Push == nil && workflow.On.PullRequest == nil {
+	if workflow.On.WorkflowDispatch == nil && workflow.On.Push == nil && workflow.On.PullRequest == nil && len(workflow.On.Schedule) == 0 {
 		return fmt.Errorf("invalid config: no event triggers defined for workflow")
 	}
 
@@ -296,6 +362,28 @@ func ValidateWorkflowConfig(workflow *WorkflowConfig) error {
 		}
 	}
 
+	crons := make(map[string]bool)
+	for _, sched := range workflow.On.Schedule {
+		if _, err := ParseCronSchedule(sched.Cron.Value); err != nil {
+			return fmt.Errorf("invalid config: %w", err)
+		}
+		if crons[sched.Cron.Value] {
+			return fmt.Errorf("invalid config: on schedule cron duplicated: %s", sched.Cron.Value)
+		}
+		crons[sched.Cron.Value] = true
+
+		branches := make(map[string]bool)
+		for _, branch := range sched.Branches {
+			if branches[branch.Value] {
+				return fmt.Errorf("invalid config: on schedule branch duplicated: %s", branch.Value)
+			}
+			if !ref.IsValidBranchName(branch.Value) {
+				return fmt.Errorf("invalid branch name: %s", branch.Value)
+			}
+			branches[branch.Value] = true
+		}
+	}
+
 	jobs := make(map[string]bool)
 	steps := make(map[string]bool)
 
@@ -17,6 +17,7 @@ package dolt_ci
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -195,3 +196,91 @@ jobs:
 	err = ValidateWorkflowConfig(wf)
 	require.NoError(t, err)
 }
+
+func TestWorkflowTriggeredByPullRequest(t *testing.T) {
+	yml := `name: test workflow
+on:
+  pull_request:
+    activities:
+      - opened
+      - synchronized
+    branches:
+      - main
+jobs:
+  - name: my workflow job
+    steps:
+      - name: my workflow step
+        saved_query_name: sq 1
+`
+	wf, err := ParseWorkflowConfig(strings.NewReader(yml))
+	require.NoError(t, err)
+	require.True(t, wf.TriggeredByPullRequest("main", "opened"))
+	require.True(t, wf.TriggeredByPullRequest("main", "Synchronized"))
+	require.False(t, wf.TriggeredByPullRequest("main", "closed"))
+	require.False(t, wf.TriggeredByPullRequest("alt", "opened"))
+
+	wf.On.PullRequest.Branches = nil
+	wf.On.PullRequest.Activities = nil
+	require.True(t, wf.TriggeredByPullRequest("alt", "closed"))
+
+	wf.On.PullRequest = nil
+	require.False(t, wf.TriggeredByPullRequest("main", "opened"))
+}
+
+func TestWorkflowTriggeredByPush(t *testing.T) {
+	yml := `name: test workflow
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: my workflow job
+    steps:
+      - name: my workflow step
+        saved_query_name: sq 1
+`
+	wf, err := ParseWorkflowConfig(strings.NewReader(yml))
+	require.NoError(t, err)
+	require.True(t, wf.TriggeredByPush("main"))
+	require.False(t, wf.TriggeredByPush("alt"))
+	require.False(t, wf.TriggeredByPullRequest("main", "opened"))
+
+	wf.On.Push.Branches = nil
+	require.True(t, wf.TriggeredByPush("alt"))
+
+	wf.On.Push = nil
+	require.False(t, wf.TriggeredByPush("main"))
+}
+
+func TestWorkflowScheduledBranches(t *testing.T) {
+	yml := `name: test workflow
+on:
+  schedule:
+    - cron: "0 2 * * *"
+    - cron: "0 2 * * mon"
+      branches:
+        - alt
+        - main
+jobs:
+  - name: my workflow job
+    steps:
+      - name: my workflow step
+        saved_query_name: sq 1
+`
+	wf, err := ParseWorkflowConfig(strings.NewReader(yml))
+	require.NoError(t, err)
+	require.NoError(t, ValidateWorkflowConfig(wf))
+	require.False(t, wf.TriggeredByPush("main"))
+
+	monday := time.Date(2026, time.October, 19, 2, 0, 30, 0, time.UTC)
+	require.Equal(t, []string{"main", "alt"}, wf.ScheduledBranches(monday, "main"))
+	require.Equal(t, []string{"main"}, wf.ScheduledBranches(monday.AddDate(0, 0, 1), "main"))
+	require.Empty(t, wf.ScheduledBranches(monday.Add(time.Minute), "main"))
+
+	wf.On.Schedule = append(wf.On.Schedule, Schedule{Cron: newScalarDoubleQuotedYamlNode("0 2 * *")})
+	require.Error(t, ValidateWorkflowConfig(wf))
+	wf.On.Schedule[2].Cron = newScalarDoubleQuotedYamlNode("0 2 * * *")
+	err = ValidateWorkflowConfig(wf)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "on schedule cron duplicated")
+}
@@ -27,6 +27,7 @@ const (
 	WorkflowEventTypePush
 	WorkflowEventTypePullRequest
 	WorkflowEventTypeWorkflowDispatch
+	WorkflowEventTypeSchedule
 )
 
 type WorkflowEventId string
@@ -35,6 +36,8 @@ type WorkflowEvent struct {
 	Id             *WorkflowEventId  `db:"id"`
 	WorkflowNameFK *WorkflowName     `db:"workflow_name_fk"`
 	EventType      WorkflowEventType `db:"event_type"`
+	// Cron is the cron expression of a schedule event, and is empty for other events
+	Cron string `db:"cron"`
 }
 
 // ToWorkflowEventType is used to convert an int to a valid WorkflowEventType
@@ -46,6 +49,8 @@ func ToWorkflowEventType(t int) (WorkflowEventType, error) {
 		return WorkflowEventTypePullRequest, nil
 	case int(WorkflowEventTypeWorkflowDispatch):
 		return WorkflowEventTypeWorkflowDispatch, nil
+	case int(WorkflowEventTypeSchedule):
+		return WorkflowEventTypeSchedule, nil
 	default:
 		return WorkflowEventTypeUnspecified, ErrUnknownWorkflowEventType
 	}
@@ -61,6 +66,8 @@ func WorkflowEventTypeToString(t WorkflowEventType) (string, error) {
 		return "pull request", nil
 	case WorkflowEventTypeWorkflowDispatch:
 		return "workflow dispatch", nil
+	case WorkflowEventTypeSchedule:
+		return "schedule", nil
 	default:
 		return "", ErrUnknownWorkflowEventType
 	}
@@ -103,6 +103,11 @@ func (d *doltWorkflowManager) selectAllFromWorkflowEventsTableByWorkflowNameWher
 	return mustInterpolate(tmpl, workflowName)
 }
 
+func (d *doltWorkflowManager) selectAllFromWorkflowEventsTableByWorkflowNameWhereEventTypeIsScheduleQuery(workflowName string) string {
+	tmpl := fmt.Sprintf("select * from %s where `%s` = ? and `%s` = %d;", doltdb.WorkflowEventsTableName, doltdb.WorkflowEventsWorkflowNameFkColName, doltdb.WorkflowEventsEventTypeColName, WorkflowEventTypeSchedule)
+	return mustInterpolate(tmpl, workflowName)
+}
+
 func (d *doltWorkflowManager) selectAllFromWorkflowJobsTableByWorkflowNameQuery(workflowName string) string {
 	tmpl := fmt.Sprintf("select * from %s where `%s` = ?;", doltdb.WorkflowJobsTableName, doltdb.WorkflowJobsWorkflowNameFkColName)
 	return mustInterpolate(tmpl, workflowName)
@@ -156,6 +161,12 @@ func (d *doltWorkflowManager) insertIntoWorkflowEventsTableQuery(workflowName st
 	return eventID, mustInterpolate(tmpl, eventID, workflowName, eventType)
 }
 
+func (d *doltWorkflowManager) insertIntoWorkflowScheduleEventsTableQuery(workflowName, cron string) (string, string) {
+	eventID := uuid.NewString()
+	tmpl := fmt.Sprintf("insert into %s (`%s`, `%s`, `%s`, `%s`) values (?, ?, ?, ?);", doltdb.WorkflowEventsTableName, doltdb.WorkflowEventsIdPkColName, doltdb.WorkflowEventsWorkflowNameFkColName, doltdb.WorkflowEventsEventTypeColName, doltdb.WorkflowEventsCronColName)
+	return eventID, mustInterpolate(tmpl, eventID, workflowName, int(WorkflowEventTypeSchedule), cron)
+}
+
 func (d *doltWorkflowManager) insertIntoWorkflowEventTriggersTableQuery(eventID string, triggerType int) (string, string) {
 	triggerID := uuid.NewString()
 	tmpl := fmt.Sprintf("insert into %s (`%s`, `%s`, `%s`) values (?, ?, ?);", doltdb.WorkflowEventTriggersTableName, doltdb.WorkflowEventTriggersIdPkColName, doltdb.WorkflowEventTriggersWorkflowEventsIdFkColName, doltdb.WorkflowEventTriggersEventTriggerTypeColName)
@@ -341,10 +352,16 @@ func (d *doltWorkflowManager) newWorkflowEvent(cvs columnValues) (*WorkflowEvent
 	we := &WorkflowEvent{}
 
 	for _, cv := range cvs {
+		// null columns, like the cron of events other than schedules, have no value
+		if cv == nil {
+			continue
+		}
 		switch cv.ColumnName {
 		case doltdb.WorkflowEventsIdPkColName:
 			id := WorkflowEventId(cv.Value)
 			we.Id = &id
+		case doltdb.WorkflowEventsCronColName:
+			we.Cron = cv.Value
 		case doltdb.WorkflowEventsEventTypeColName:
 			i, err := strconv.Atoi(cv.Value)
 			if err != nil {
@@ -859,6 +876,11 @@ func (d *doltWorkflowManager) listWorkflows(ctx *sql.Context) ([]*Workflow, erro
 	return d.retrieveWorkflows(ctx, query)
 }
 
+func (d *doltWorkflowManager) listWorkflowEventsByWorkflowNameWhereEventTypeIsSchedule(ctx *sql.Context, workflowName WorkflowName) ([]*WorkflowEvent, error) {
+	query := d.selectAllFromWorkflowEventsTableByWorkflowNameWhereEventTypeIsScheduleQuery(string(workflowName))
+	return d.retrieveWorkflowEvents(ctx, query)
+}
+
 func (d *doltWorkflowManager) listWorkflowEventsByWorkflowNameWhereEventTypeIsPush(ctx *sql.Context, workflowName WorkflowName) ([]*WorkflowEvent, error) {
 	query := d.selectAllFromWorkflowEventsTableByWorkflowNameWhereEventTypeIsPushQuery(string(workflowName))
 	return d.retrieveWorkflowEvents(ctx, query)
@@ -1074,6 +1096,10 @@ func (d *doltWorkflowManager) updateExistingWorkflow(ctx *sql.Context, config *W
 		}
 	}
 
+	if err := d.updateWorkflowSchedules(ctx, WorkflowName(config.Name.Value), config.On.Schedule); err != nil {
+		return err
+	}
+
 	// handle on push
 	if config.On.Push != nil {
 		if len(config.On.Push.Branches) == 0 {
@@ -1819,6 +1845,127 @@ func (d *doltWorkflowManager) writeWorkflowEventRow(ctx *sql.Context, workflowNa
 	return WorkflowEventId(eventID), nil
 }
 
+func (d *doltWorkflowManager) writeWorkflowScheduleEventRow(ctx *sql.Context, workflowName WorkflowName, cron string) (WorkflowEventId, error) {
+	eventID, query := d.insertIntoWorkflowScheduleEventsTableQuery(string(workflowName), cron)
+	err := d.sqlWriteQuery(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	return WorkflowEventId(eventID), nil
+}
+
+// writeWorkflowSchedules writes a schedule event for each of |schedules|, with a branches trigger for the branches it
+// runs against, if any.
+func (d *doltWorkflowManager) writeWorkflowSchedules(ctx *sql.Context, workflowName WorkflowName, schedules []Schedule) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	if err := d.ensureWorkflowEventsCronColumn(ctx); err != nil {
+		return err
+	}
+	for _, sched := range schedules {
+		eventID, err := d.writeWorkflowScheduleEventRow(ctx, workflowName, sched.Cron.Value)
+		if err != nil {
+			return err
+		}
+		if len(sched.Branches) == 0 {
+			continue
+		}
+		triggerID, err := d.writeWorkflowEventTriggerRow(ctx, eventID, WorkflowEventTriggerTypeBranches)
+		if err != nil {
+			return err
+		}
+		for _, branch := range sched.Branches {
+			if _, err = d.writeWorkflowEventTriggerBranchesRow(ctx, triggerID, branch.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateWorkflowSchedules replaces the schedule events of a workflow which aren't in |schedules|, leaving those with the
+// same cron expression and branches as one of |schedules| unchanged.
+func (d *doltWorkflowManager) updateWorkflowSchedules(ctx *sql.Context, workflowName WorkflowName, schedules []Schedule) error {
+	configSchedules := make(map[string]Schedule)
+	for _, sched := range schedules {
+		configSchedules[scheduleKey(sched.Cron.Value, nodesToValues(sched.Branches))] = sched
+	}
+
+	var events []*WorkflowEvent
+	hasCron, err := d.hasWorkflowEventsCronColumn(ctx)
+	if err != nil {
+		return err
+	}
+	if hasCron {
+		events, err = d.listWorkflowEventsByWorkflowNameWhereEventTypeIsSchedule(ctx, workflowName)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, event := range events {
+		var branches []string
+		triggers, err := d.listWorkflowEventTriggersByEventIdWhereEventTriggerTypeIsBranches(ctx, *event.Id)
+		if err != nil {
+			return err
+		}
+		for _, trigger := range triggers {
+			brns, err := d.listWorkflowEventTriggerBranchesByEventTriggerId(ctx, *trigger.Id)
+			if err != nil {
+				return err
+			}
+			for _, brn := range brns {
+				branches = append(branches, brn.Branch)
+			}
+		}
+
+		key := scheduleKey(event.Cron, branches)
+		if _, ok := configSchedules[key]; ok {
+			delete(configSchedules, key)
+			continue
+		}
+		if err = d.sqlWriteQuery(ctx, d.deleteFromWorkflowEventsTableByWorkflowEventIdQuery(string(*event.Id))); err != nil {
+			return err
+		}
+	}
+
+	var added []Schedule
+	for _, sched := range schedules {
+		if _, ok := configSchedules[scheduleKey(sched.Cron.Value, nodesToValues(sched.Branches))]; ok {
+			added = append(added, sched)
+		}
+	}
+	return d.writeWorkflowSchedules(ctx, workflowName, added)
+}
+
+// scheduleKey identifies a schedule by its cron expression and the set of its branches.
+func scheduleKey(cron string, branches []string) string {
+	sorted := append([]string(nil), branches...)
+	sort.Strings(sorted)
+	return cron + "\x00" + strings.Join(sorted, "\x00")
+}
+
+func (d *doltWorkflowManager) hasWorkflowEventsCronColumn(ctx *sql.Context) (bool, error) {
+	found := false
+	query := fmt.Sprintf("show columns from %s like '%s';", doltdb.WorkflowEventsTableName, doltdb.WorkflowEventsCronColName)
+	err := d.sqlReadQuery(ctx, query, func(ctx *sql.Context, cvs columnValues) error {
+		found = true
+		return nil
+	})
+	return found, err
+}
+
+// ensureWorkflowEventsCronColumn adds the cron column to the workflow events table of databases which initialized dolt
+// ci before schedule events were supported.
+func (d *doltWorkflowManager) ensureWorkflowEventsCronColumn(ctx *sql.Context) error {
+	found, err := d.hasWorkflowEventsCronColumn(ctx)
+	if err != nil || found {
+		return err
+	}
+	return d.sqlWriteQuery(ctx, fmt.Sprintf("alter table %s add column %s;", doltdb.WorkflowEventsTableName, workflowEventsCronColumnDefinition()))
+}
+
 func (d *doltWorkflowManager) writeWorkflowEventTriggerRow(ctx *sql.Context, eventID WorkflowEventId, triggerType WorkflowEventTriggerType) (WorkflowEventTriggerId, error) {
 	triggerID, query := d.insertIntoWorkflowEventTriggersTableQuery(string(eventID), int(triggerType))
 	err := d.sqlWriteQuery(ctx, query)
@@ -1967,6 +2114,11 @@ func (d *doltWorkflowManager) createWorkflow(ctx *sql.Context, config *WorkflowC
 		}
 	}
 
+	// handle on schedule
+	if err = d.writeWorkflowSchedules(ctx, workflowName, config.On.Schedule); err != nil {
+		return err
+	}
+
 	// insert into triggers
 	// handle push
 	var pushBranchesTriggerEventID WorkflowEventTriggerId
@@ -2198,9 +2350,20 @@ func (d *doltWorkflowManager) getWorkflowConfig(ctx *sql.Context, workflowName s
 			}
 		} else if event.EventType == WorkflowEventTypeWorkflowDispatch {
 			on.WorkflowDispatch = &WorkflowDispatch{}
+		} else if event.EventType == WorkflowEventTypeSchedule {
+			sched := Schedule{Cron: newScalarDoubleQuotedYamlNode(event.Cron)}
+			if len(branches) > 0 {
+				sched.Branches = branches
+			}
+			on.Schedule = append(on.Schedule, sched)
 		}
 	}
 
+	// events aren't stored in order, so schedules are ordered by their cron expressions
+	sort.Slice(on.Schedule, func(i, j int) bool {
+		return on.Schedule[i].Cron.Value < on.Schedule[j].Cron.Value
+	})
+
 	config.On = on
 
 	jobs := make([]Job, 0)
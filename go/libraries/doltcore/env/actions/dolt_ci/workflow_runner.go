@@ -0,0 +1,301 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// StepResult is the result of running a step of a workflow job.
+type StepResult struct {
+	Job      string
+	Step     string
+	Passed   bool
+	Duration time.Duration
+	// Output describes why the step failed, and is empty for steps which passed
+	Output string
+}
+
+// RunWorkflow runs the steps of every job of |config| with |queryFunc|, against the current database of |ctx|, and
+// returns their results. A failing step doesn't stop the steps after it from running. An error is only returned if the
+// saved queries of the database can't be read.
+func RunWorkflow(ctx *sql.Context, queryFunc queryFunc, config *WorkflowConfig) ([]StepResult, error) {
+	savedQueries, err := getSavedQueries(ctx, queryFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []StepResult
+	for _, job := range config.Jobs {
+		for _, step := range job.Steps {
+			start := time.Now()
+			var err error
+			switch s := step.(type) {
+			case *SavedQueryStep:
+				err = runSavedQueryStep(ctx, queryFunc, s, savedQueries)
+			case *DoltTestStep:
+				err = runDoltTestStep(ctx, queryFunc, s)
+			default:
+				err = fmt.Errorf("unsupported step type %T", step)
+			}
+			res := StepResult{Job: job.Name.Value, Step: step.GetName(), Passed: err == nil, Duration: time.Since(start)}
+			if err != nil {
+				res.Output = err.Error()
+			}
+			results = append(results, res)
+		}
+	}
+	return results, nil
+}
+
+// getSavedQueries returns the queries of dolt_query_catalog by name, or nothing if the database has no saved queries.
+func getSavedQueries(ctx *sql.Context, queryFunc queryFunc) (map[string]string, error) {
+	savedQueries := make(map[string]string)
+	rows, err := queryRows(ctx, queryFunc, fmt.Sprintf("SELECT name, query FROM %s", doltdb.DoltQueryCatalogTableName))
+	if sql.ErrTableNotFound.Is(err) {
+		return savedQueries, nil
+	} else if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		name, err := columnString(ctx, row[0])
+		if err != nil {
+			return nil, err
+		}
+		query, err := columnString(ctx, row[1])
+		if err != nil {
+			return nil, err
+		}
+		savedQueries[name] = query
+	}
+	return savedQueries, nil
+}
+
+func runSavedQueryStep(ctx *sql.Context, queryFunc queryFunc, step *SavedQueryStep, savedQueries map[string]string) error {
+	query, ok := savedQueries[step.SavedQueryName.Value]
+	if !ok || query == "" {
+		return fmt.Errorf("Could not find saved query: %s", step.SavedQueryName.Value)
+	}
+	rows, err := queryRows(ctx, queryFunc, query)
+	if err != nil {
+		return err
+	}
+	return AssertSavedQueryResults(rows, step.ExpectedRows.Value, step.ExpectedColumns.Value)
+}
+
+// AssertSavedQueryResults returns an error describing the expected row and column counts of a saved query step which
+// |rows| don't satisfy, if any.
+func AssertSavedQueryResults(rows []sql.Row, expectedRowsAndComparison string, expectedColumnsAndComparison string) error {
+	var colCount int64
+	var errs []string
+	rowCount := int64(len(rows))
+	if rowCount > 0 {
+		colCount = int64(len(rows[0]))
+	}
+
+	colCompType, expectedCols, err := ParseSavedQueryExpectedResultString(expectedColumnsAndComparison)
+	if colCompType != WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified {
+		err = ValidateQueryExpectedRowOrColumnCount(colCount, expectedCols, colCompType, "column")
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("Assertion failed: %s", err.Error()))
+		}
+	}
+	rowCompType, expectedRows, err := ParseSavedQueryExpectedResultString(expectedRowsAndComparison)
+	if rowCompType != WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified {
+		err = ValidateQueryExpectedRowOrColumnCount(rowCount, expectedRows, rowCompType, "row")
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("Assertion failed: %s", err.Error()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// runDoltTestStep runs the dolt tests selected by |step| and returns an error listing the ones which failed.
+func runDoltTestStep(ctx *sql.Context, queryFunc queryFunc, step *DoltTestStep) error {
+	rows, err := DoltTestStepRows(ctx, queryFunc, step)
+	if err != nil {
+		return err
+	}
+	var failures []string
+	for _, row := range rows {
+		testName, err := columnString(ctx, row[0])
+		if err != nil {
+			return err
+		}
+		status, err := columnString(ctx, row[3])
+		if err != nil {
+			return err
+		}
+		message, err := columnString(ctx, row[4])
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(status, "PASS") {
+			if message == "" {
+				message = "failed"
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", testName, message))
+		}
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// DoltTestStepRows returns the rows of dolt_test_run for the tests selected by |step|, with the columns of its --details
+// option. A step without tests or groups, or with a * wildcard for both, selects every test. A wildcard for only one of
+// them selects every test or group named by the other, and naming both tests and groups selects the named tests, which
+// must be in each named group.
+func DoltTestStepRows(ctx *sql.Context, queryFunc queryFunc, step *DoltTestStep) ([]sql.Row, error) {
+	testsProvided := len(step.Tests) > 0
+	groupsProvided := len(step.TestGroups) > 0
+	testsWildcard := testsProvided && hasWildcard(step.Tests)
+	groupsWildcard := groupsProvided && hasWildcard(step.TestGroups)
+
+	switch {
+	case !testsProvided && !groupsProvided:
+		return queryRows(ctx, queryFunc, "SELECT * FROM dolt_test_run('--details')")
+
+	case testsProvided && !groupsProvided:
+		if testsWildcard {
+			return queryRows(ctx, queryFunc, "SELECT * FROM dolt_test_run('--details')")
+		}
+		return collectRowsForSelectors(ctx, queryFunc, "test", nodesToValues(step.Tests))
+
+	case groupsProvided && !testsProvided:
+		if groupsWildcard {
+			return queryRows(ctx, queryFunc, "SELECT * FROM dolt_test_run('--details')")
+		}
+		return collectRowsForSelectors(ctx, queryFunc, "group", nodesToValues(step.TestGroups))
+
+	default: // both provided
+		if testsWildcard && !groupsWildcard {
+			// All tests in specified groups
+			return collectRowsForSelectors(ctx, queryFunc, "group", nodesToValues(step.TestGroups))
+		}
+		if groupsWildcard && !testsWildcard {
+			// Only specified test names across all groups
+			return collectRowsForSelectors(ctx, queryFunc, "test", nodesToValues(step.Tests))
+		}
+		// Neither wildcard: intersection
+		return collectIntersectionRows(ctx, queryFunc, nodesToValues(step.Tests), nodesToValues(step.TestGroups))
+	}
+}
+
+func hasWildcard(nodes []yaml.Node) bool {
+	return len(nodes) == 1 && strings.TrimSpace(nodes[0].Value) == "*"
+}
+
+func nodesToValues(nodes []yaml.Node) []string {
+	var vals []string
+	for _, n := range nodes {
+		vals = append(vals, n.Value)
+	}
+	return vals
+}
+
+// collectRowsForSelectors fetches rows for each selector using dolt_test_run('<selector>').
+// kind should be "test" or "group" to produce specific error messages if an empty result is somehow returned without error.
+func collectRowsForSelectors(ctx *sql.Context, queryFunc queryFunc, kind string, selectors []string) ([]sql.Row, error) {
+	var allRows []sql.Row
+	for _, sel := range selectors {
+		rows, err := fetchDoltTestRunRows(ctx, queryFunc, sel)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			// dolt_test_run should return an error in this scenario; this is a defensive fallback
+			return nil, fmt.Errorf("%s '%s' not found", kind, sel)
+		}
+		allRows = append(allRows, rows...)
+	}
+	return allRows, nil
+}
+
+// collectIntersectionRows returns only the rows for the specified tests within each specified group.
+// It also verifies that each named test exists within every specified group.
+func collectIntersectionRows(ctx *sql.Context, queryFunc queryFunc, testNames, groupNames []string) ([]sql.Row, error) {
+	var allRows []sql.Row
+	for _, group := range groupNames {
+		rows, err := fetchDoltTestRunRows(ctx, queryFunc, group)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, fmt.Errorf("group '%s' not found", group)
+		}
+		names := make([]string, len(rows))
+		groupTests := make(map[string]bool)
+		for i, r := range rows {
+			if names[i], err = columnString(ctx, r[0]); err != nil {
+				return nil, err
+			}
+			groupTests[names[i]] = true
+		}
+		// verify requested tests exist in this group
+		for _, t := range testNames {
+			if !groupTests[t] {
+				return nil, fmt.Errorf("test '%s' not found in group '%s'", t, group)
+			}
+		}
+		// filter rows to only requested tests
+		for i, r := range rows {
+			for _, t := range testNames {
+				if names[i] == t {
+					allRows = append(allRows, r)
+					break
+				}
+			}
+		}
+	}
+	return allRows, nil
+}
+
+// fetchDoltTestRunRows runs dolt_test_run for the provided selector (test or group value)
+func fetchDoltTestRunRows(ctx *sql.Context, queryFunc queryFunc, selector string) ([]sql.Row, error) {
+	q := fmt.Sprintf("SELECT * FROM dolt_test_run('--details', '%s')", strings.ReplaceAll(selector, "'", "''"))
+	return queryRows(ctx, queryFunc, q)
+}
+
+func queryRows(ctx *sql.Context, queryFunc queryFunc, query string) ([]sql.Row, error) {
+	_, iter, _, err := queryFunc(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+// columnString returns the string value of a column of a row returned by a queryFunc.
+func columnString(ctx *sql.Context, v interface{}) (string, error) {
+	v, err := sql.UnwrapAny(ctx, v)
+	if err != nil {
+		return "", err
+	}
+	return cli.QueryValueAsString(v)
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// workflowRunsKey is the key of the tuple that workflow runs are stored in.
+const workflowRunsKey = "ci_runs"
+
+// maxWorkflowRuns is the number of workflow runs kept for a database. The oldest runs are removed as new ones are
+// recorded.
+const maxWorkflowRuns = 1000
+
+// maxWorkflowRunOutputLength is the number of bytes of the output of a step, or the error of a run, that are kept when
+// a run is recorded. Longer outputs are truncated, so that the size of the stored runs stays bounded.
+const maxWorkflowRunOutputLength = 4096
+
+const truncatedOutputSuffix = "... (truncated)"
+
+const (
+	// WorkflowRunStatusPassed is the status of a workflow run whose steps all passed
+	WorkflowRunStatusPassed = "passed"
+	// WorkflowRunStatusFailed is the status of a workflow run with a failed step
+	WorkflowRunStatusFailed = "failed"
+	// WorkflowRunStatusError is the status of a workflow run which couldn't run its steps
+	WorkflowRunStatusError = "error"
+)
+
+const (
+	// WorkflowRunEventPush is the event of a workflow run triggered by a commit to a branch
+	WorkflowRunEventPush = "push"
+	// WorkflowRunEventMerge is the event of a workflow run triggered by a merge commit to a branch, which triggers the
+	// push event of a workflow
+	WorkflowRunEventMerge = "merge"
+	// WorkflowRunEventSchedule is the event of a workflow run on a branch triggered by one of its schedules
+	WorkflowRunEventSchedule = "schedule"
+	// WorkflowRunEventPullRequest is the event of a workflow run on the source commit of a pull request triggered by
+	// the pull request's activity
+	WorkflowRunEventPullRequest = "pull_request"
+)
+
+// WorkflowRun is a run of the jobs of a workflow on a commit of a branch.
+type WorkflowRun struct {
+	ID         int64     `json:"id"`
+	Workflow   string    `json:"workflow"`
+	Event      string    `json:"event"`
+	Branch     string    `json:"branch"`
+	Commit     string    `json:"commit"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	// Steps are the results of the steps of the workflow, in the order they ran
+	Steps []WorkflowStepResult `json:"steps,omitempty"`
+}
+
+// WorkflowStepResult is the result of a step of a WorkflowRun.
+type WorkflowStepResult struct {
+	Job        string `json:"job"`
+	Step       string `json:"step"`
+	Status     string `json:"status"`
+	DurationMs int64  `json:"duration_ms"`
+	Output     string `json:"output,omitempty"`
+}
+
+// WorkflowRuns are the most recent workflow runs of a database. Like pull requests, they aren't versioned, and are
+// local to a database.
+type WorkflowRuns struct {
+	LastID int64          `json:"last_id"`
+	Runs   []*WorkflowRun `json:"runs,omitempty"`
+}
+
+// LoadWorkflowRuns returns the workflow runs of |ddb|.
+func LoadWorkflowRuns(ctx context.Context, ddb *doltdb.DoltDB) (*WorkflowRuns, error) {
+	b, _, err := ddb.GetTuple(ctx, workflowRunsKey)
+	if err != nil {
+		return nil, err
+	}
+	return decodeWorkflowRuns(b)
+}
+
+// UpdateWorkflowRuns applies |edit| to the workflow runs of |ddb| and saves them, unless |edit| returns an error. Runs
+// saved by another runner in the meantime, e.g. by the scheduler while branch updates are run, aren't lost: |edit| is
+// applied again to the runs it saved, so it must only depend on the WorkflowRuns it's given.
+func UpdateWorkflowRuns(ctx context.Context, ddb *doltdb.DoltDB, edit func(wr *WorkflowRuns) error) error {
+	return ddb.UpdateTuple(ctx, workflowRunsKey, func(b []byte) ([]byte, bool, error) {
+		wr, err := decodeWorkflowRuns(b)
+		if err != nil {
+			return nil, false, err
+		}
+		if err = edit(wr); err != nil {
+			return nil, false, err
+		}
+		b, err = json.Marshal(wr)
+		if err != nil {
+			return nil, false, err
+		}
+		return b, true, nil
+	})
+}
+
+func decodeWorkflowRuns(b []byte) (*WorkflowRuns, error) {
+	wr := &WorkflowRuns{}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, wr); err != nil {
+			return nil, fmt.Errorf("unable to read workflow runs: %w", err)
+		}
+	}
+	return wr, nil
+}
+
+// Add assigns |run| the next id and records it, removing the oldest runs beyond maxWorkflowRuns. The outputs of its
+// steps and its error are truncated to maxWorkflowRunOutputLength.
+func (wr *WorkflowRuns) Add(run *WorkflowRun) {
+	wr.LastID++
+	run.ID = wr.LastID
+	run.Error = truncateWorkflowRunOutput(run.Error)
+	for i := range run.Steps {
+		run.Steps[i].Output = truncateWorkflowRunOutput(run.Steps[i].Output)
+	}
+	wr.Runs = append(wr.Runs, run)
+	if len(wr.Runs) > maxWorkflowRuns {
+		wr.Runs = wr.Runs[len(wr.Runs)-maxWorkflowRuns:]
+	}
+}
+
+// truncateWorkflowRunOutput returns |output| cut to maxWorkflowRunOutputLength bytes, without splitting a character.
+func truncateWorkflowRunOutput(output string) string {
+	if len(output) <= maxWorkflowRunOutputLength {
+		return output
+	}
+	end := maxWorkflowRunOutputLength - len(truncatedOutputSuffix)
+	for end > 0 && !utf8.RuneStart(output[end]) {
+		end--
+	}
+	return output[:end] + truncatedOutputSuffix
+}
+
+// NewWorkflowRun returns the run of the workflow |workflow| on |commit| of |branch| for |event|, with the results of its
+// steps, which started at |start|.
+func NewWorkflowRun(workflow, event, branch, commit string, start time.Time, results []StepResult) *WorkflowRun {
+	run := &WorkflowRun{
+		Workflow:   workflow,
+		Event:      event,
+		Branch:     branch,
+		Commit:     commit,
+		Status:     WorkflowRunStatusPassed,
+		StartedAt:  start,
+		FinishedAt: time.Now(),
+	}
+	for _, res := range results {
+		sr := WorkflowStepResult{
+			Job:        res.Job,
+			Step:       res.Step,
+			Status:     WorkflowRunStatusPassed,
+			DurationMs: res.Duration.Milliseconds(),
+			Output:     res.Output,
+		}
+		if !res.Passed {
+			sr.Status = WorkflowRunStatusFailed
+			run.Status = WorkflowRunStatusFailed
+		}
+		run.Steps = append(run.Steps, sr)
+	}
+	return run
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestNewWorkflowRun(t *testing.T) {
+	start := time.Now()
+	run := NewWorkflowRun("wf", WorkflowRunEventPush, "main", "abc", start, []StepResult{
+		{Job: "job", Step: "one", Passed: true, Duration: 2 * time.Millisecond},
+		{Job: "job", Step: "two", Passed: false, Duration: time.Millisecond, Output: "Assertion failed"},
+	})
+	assert.Equal(t, WorkflowRunStatusFailed, run.Status)
+	assert.Equal(t, start, run.StartedAt)
+	assert.False(t, run.FinishedAt.Before(start))
+	assert.Equal(t, []WorkflowStepResult{
+		{Job: "job", Step: "one", Status: WorkflowRunStatusPassed, DurationMs: 2},
+		{Job: "job", Step: "two", Status: WorkflowRunStatusFailed, DurationMs: 1, Output: "Assertion failed"},
+	}, run.Steps)
+
+	run = NewWorkflowRun("wf", WorkflowRunEventMerge, "main", "abc", start, nil)
+	assert.Equal(t, WorkflowRunStatusPassed, run.Status)
+}
+
+func TestWorkflowRunsAdd(t *testing.T) {
+	wr := &WorkflowRuns{}
+	for i := 0; i < maxWorkflowRuns+5; i++ {
+		wr.Add(&WorkflowRun{Workflow: "wf"})
+	}
+	require.Len(t, wr.Runs, maxWorkflowRuns)
+	assert.Equal(t, int64(maxWorkflowRuns+5), wr.LastID)
+	assert.Equal(t, int64(6), wr.Runs[0].ID)
+	assert.Equal(t, int64(maxWorkflowRuns+5), wr.Runs[len(wr.Runs)-1].ID)
+}
+
+func TestWorkflowRunsAddTruncatesOutput(t *testing.T) {
+	wr := &WorkflowRuns{}
+	long := strings.Repeat("é", maxWorkflowRunOutputLength)
+	run := &WorkflowRun{Workflow: "wf", Error: long, Steps: []WorkflowStepResult{{Step: "one", Output: long}, {Step: "two", Output: "short"}}}
+	wr.Add(run)
+
+	assert.LessOrEqual(t, len(run.Error), maxWorkflowRunOutputLength)
+	assert.True(t, utf8.ValidString(run.Error))
+	assert.True(t, strings.HasSuffix(run.Error, truncatedOutputSuffix))
+	assert.Equal(t, run.Error, run.Steps[0].Output)
+	assert.Equal(t, "short", run.Steps[1].Output)
+}
+
+func TestConcurrentUpdateWorkflowRuns(t *testing.T) {
+	ctx := context.Background()
+	ddb, err := doltdb.LoadDoltDB(ctx, types.Format_Default, doltdb.InMemDoltDB, filesys.LocalFS)
+	require.NoError(t, err)
+	require.NoError(t, ddb.WriteEmptyRepo(ctx, "main", "Bill Billerson", "bigbillieb@fake.horse"))
+
+	const n = 8
+	runs := make([]*WorkflowRun, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			runs[i] = &WorkflowRun{Workflow: "wf"}
+			assert.NoError(t, UpdateWorkflowRuns(ctx, ddb, func(wr *WorkflowRuns) error {
+				wr.Add(runs[i])
+				return nil
+			}))
+		}(i)
+	}
+	wg.Wait()
+
+	ids := make([]int64, n)
+	for i, run := range runs {
+		ids[i] = run.ID
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for i, id := range ids {
+		assert.Equal(t, int64(i+1), id)
+	}
+	wr, err := LoadWorkflowRuns(ctx, ddb)
+	require.NoError(t, err)
+	assert.Equal(t, int64(n), wr.LastID)
+	assert.Len(t, wr.Runs, n)
+}
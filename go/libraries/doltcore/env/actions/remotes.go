@@ -158,6 +158,8 @@ func push[C doltdb.Context](ctx C, rsr env.RepoStateReader[C], tmpDir string, sr
 		}
 	case ref.TagRefType:
 		return pushTagToRemote(ctx, tmpDir, opts.SrcRef, opts.DestRef, src, dest, statsCh)
+	case ref.NotesRefType:
+		return PushNotes(ctx, tmpDir, src, dest, statsCh)
 	default:
 		return fmt.Errorf("%w: %s of type %s", ErrCannotPushRef, opts.SrcRef.String(), opts.SrcRef.GetType())
 	}
@@ -207,6 +209,30 @@ func PushTag(ctx context.Context, tempTableDir string, destRef ref.TagRef, srcDB
 	return destDB.SetHead(ctx, destRef, addr)
 }
 
+// PushNotes pushes the notes in a local source database to a remote destination database, merging them into the
+// notes already there. See doltdb.MergeNotes for how conflicting notes are resolved.
+func PushNotes(ctx context.Context, tempTableDir string, srcDB, destDB *doltdb.DoltDB, statsCh chan pull.Stats) error {
+	addr, ok, err := srcDB.GetNotesAddr(ctx)
+	if err != nil {
+		return err
+	} else if !ok {
+		return doltdb.ErrUpToDate
+	}
+	destAddr, ok, err := destDB.GetNotesAddr(ctx)
+	if err != nil {
+		return err
+	} else if ok && destAddr == addr {
+		return doltdb.ErrUpToDate
+	}
+
+	err = destDB.PullChunks(ctx, tempTableDir, srcDB, []hash.Hash{addr}, statsCh, nil)
+	if err != nil && err != pull.ErrDBUpToDate {
+		return err
+	}
+
+	return destDB.MergeNotes(ctx, addr)
+}
+
 func deleteRemoteBranch(ctx context.Context, toDelete, remoteRef ref.DoltRef, localDB, remoteDB *doltdb.DoltDB, remote env.Remote, force bool) error {
 	err := DeleteRemoteBranch(ctx, toDelete.(ref.BranchRef), remoteRef.(ref.RemoteRef), localDB, remoteDB, force)
 
@@ -392,6 +418,22 @@ func FetchFollowTags(ctx context.Context, tempTableDir string, srcDB, destDB *do
 	return nil
 }
 
+// FetchNotes fetches the notes in the source DB and merges them into the notes in the destination DB. See
+// doltdb.MergeNotes for how conflicting notes are resolved.
+func FetchNotes(ctx context.Context, tempTableDir string, srcDB, destDB *doltdb.DoltDB, statsCh chan pull.Stats) error {
+	addr, ok, err := srcDB.GetNotesAddr(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	err = destDB.PullChunks(ctx, tempTableDir, srcDB, []hash.Hash{addr}, statsCh, nil)
+	if err != nil && err != pull.ErrDBUpToDate {
+		return err
+	}
+
+	return destDB.MergeNotes(ctx, addr)
+}
+
 // FetchRemoteBranch fetches and returns the |Commit| corresponding to the remote ref given. Returns an error if the
 // remote reference doesn't exist or can't be fetched. Blocks until the fetch is complete.
 func FetchRemoteBranch(
@@ -653,6 +695,11 @@ func fetchRefSpecsWithDepth[C doltdb.Context](
 		}
 	}
 
+	err = FetchNotes(ctx, tmpDir, srcDB, dbData.Ddb, statsCh)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -247,7 +247,13 @@ func LoadDoltDB(ctx context.Context, dEnv *DoltEnv) {
 				params[k] = v
 			}
 		}
-		ddb, dbLoadErr := doltdb.LoadDoltDBWithParams(ctx, types.Format_Default, dEnv.urlStr, dEnv.FS, params)
+		// A worktree loads the chunk store of the repository it is linked to
+		dataFS, err := dEnv.dataFS()
+		if err != nil {
+			dEnv.DBLoadError = err
+			return
+		}
+		ddb, dbLoadErr := doltdb.LoadDoltDBWithParams(ctx, types.Format_Default, dEnv.urlStr, dataFS, params)
 		dEnv.doltDB = ddb
 		dEnv.DBLoadError = dbLoadErr
 
@@ -395,7 +401,11 @@ func (dEnv *DoltEnv) HasDoltDataDir() bool {
 	if dEnv == nil {
 		return false
 	}
-	exists, isDir := dEnv.FS.Exists(dbfactory.DoltDataDir)
+	fs, err := dEnv.dataFS()
+	if err != nil {
+		return false
+	}
+	exists, isDir := fs.Exists(dbfactory.DoltDataDir)
 	return exists && isDir
 }
 
@@ -26,6 +26,7 @@ import (
 
 	goerrors "gopkg.in/src-d/go-errors.v1"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
@@ -43,6 +44,7 @@ var ErrBranchDoesNotMatchUpstream = errors.New("the upstream branch of your curr
 var ErrFailedToReadDb = errors.New("failed to read from the db")
 var ErrUnknownBranch = errors.New("unknown branch")
 var ErrCannotSetUpstreamForTag = errors.New("cannot set upstream for tag")
+var ErrCannotSetUpstreamForNotes = errors.New("cannot set upstream for notes")
 var ErrCannotPushRef = errors.New("cannot push ref")
 var ErrNoRefSpecForRemote = errors.New("no refspec for remote")
 var ErrInvalidFetchSpec = errors.New("invalid fetch spec")
@@ -372,6 +374,12 @@ func getPushTargetFromRefSpec(refSpec ref.RefSpec, currentBranch ref.DoltRef, re
 		if setUpstream {
 			err = ErrCannotSetUpstreamForTag
 		}
+	case ref.NotesRefType:
+		if setUpstream {
+			err = ErrCannotSetUpstreamForNotes
+		} else if !ref.Equals(src, doltdb.DefaultNotesRef) || !ref.Equals(dest, doltdb.DefaultNotesRef) {
+			err = fmt.Errorf("%w: only '%s' can be pushed", ErrCannotPushRef, doltdb.DefaultNotesRef.String())
+		}
 	default:
 		err = fmt.Errorf("%w: '%s' of type '%s'", ErrCannotPushRef, src.String(), src.GetType())
 	}
@@ -697,12 +705,25 @@ func GetAbsRemoteUrl(fs filesys2.Filesys, cfg config.ReadableConfig, urlArg stri
 		urlArg = normalized
 	}
 
+	// A path to a bundle file is a bundle remote
+	if !strings.Contains(urlArg, "://") && strings.HasSuffix(urlArg, bundle.FileExt) {
+		urlArg = dbfactory.BundleScheme + "://" + urlArg
+	}
+
 	u, err := earl.Parse(urlArg)
 	if err != nil {
 		return "", "", err
 	}
 
 	if u.Scheme != "" && fs != nil {
+		if u.Scheme == dbfactory.BundleScheme {
+			absUrl, err := getAbsBundleUrl(u, fs)
+			if err != nil {
+				return "", "", err
+			}
+			return u.Scheme, absUrl, nil
+		}
+
 		if u.Scheme == dbfactory.FileScheme || u.Scheme == dbfactory.LocalBSScheme {
 			absUrl, err := getAbsFileRemoteUrl(u, fs)
 
@@ -762,6 +783,23 @@ func getAbsFileRemoteUrl(u *url.URL, fs filesys2.Filesys) (string, error) {
 	return scheme + "://" + urlStr, nil
 }
 
+// getAbsBundleUrl returns the bundle url for the absolute path of the bundle file named by |u|, which must exist.
+func getAbsBundleUrl(u *url.URL, fs filesys2.Filesys) (string, error) {
+	urlStr, err := fs.Abs(filepath.Clean(u.Host + u.Path))
+	if err != nil {
+		return "", err
+	}
+
+	exists, isDir := fs.Exists(urlStr)
+	if !exists {
+		return "", fmt.Errorf("bundle file '%s' does not exist", urlStr)
+	} else if isDir {
+		return "", filesys2.ErrIsDir
+	}
+
+	return dbfactory.BundleScheme + "://" + filepath.ToSlash(urlStr), nil
+}
+
 // GetDefaultBranch returns the default branch from among the branches given, returning
 // the configs default config branch first, then init branch main, then the old init branch master,
 // and finally the first lexicographical branch if none of the others are found
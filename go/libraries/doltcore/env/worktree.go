@@ -0,0 +1,552 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dolthub/fslock"
+	goerrors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+)
+
+// A worktree is an additional directory that shares the chunk store of an existing repository, but has its own repo
+// state file, and so its own checked out branch. Since working sets are stored in the database per branch, each
+// worktree also gets its own working set, as long as no two worktrees check out the same branch.
+//
+// The .dolt directory of a worktree has no noms directory of its own. Instead, it holds a link file that records the
+// root of the main repository, which is where the chunk store is loaded from. The main repository keeps a list of
+// its worktrees, so they can be listed, and so a branch can't be checked out in more than one of them at once.
+const (
+	worktreeLinkFile  = "worktree.json"
+	worktreesFile     = "worktrees.json"
+	worktreesLockFile = "worktrees.lock"
+)
+
+// worktreesLockTimeout is how long to wait for another process to finish changing the list of worktrees.
+const worktreesLockTimeout = 10 * time.Second
+
+var ErrWorktreeNotFound = goerrors.NewKind("'%s' is not a working tree")
+var ErrWorktreePathExists = goerrors.NewKind("'%s' already exists")
+var ErrBranchCheckedOutInWorktree = goerrors.NewKind("'%s' is already checked out at '%s'")
+var ErrWorktreeHasChanges = goerrors.NewKind("'%s' contains modified or untracked files, use --force to delete it")
+var ErrCannotRemoveMainWorktree = errors.New("the main working tree cannot be removed")
+var ErrCannotRemoveCurrentWorktree = errors.New("the current working tree cannot be removed")
+var ErrWorktreesLocked = errors.New("timed out waiting for another process to finish changing the working trees of this repository")
+
+// Worktree is a linked working tree of a repository.
+type Worktree struct {
+	// Name is the unique name of this worktree, derived from the base name of its directory.
+	Name string `json:"name"`
+	// Path is the absolute path to the root directory of this worktree.
+	Path string `json:"path"`
+}
+
+// worktreeLink is the contents of the link file in the .dolt directory of a worktree.
+type worktreeLink struct {
+	Name     string `json:"name"`
+	MainRoot string `json:"main"`
+}
+
+// WorktreeStatus describes the state of a working tree, as reported by `dolt worktree list`.
+type WorktreeStatus struct {
+	Worktree
+	// Main is true for the working tree of the main repository.
+	Main bool
+	// Head is the branch checked out in this working tree, if it could be determined.
+	Head ref.DoltRef
+	// Prunable is true if the directory of this working tree no longer exists.
+	Prunable bool
+}
+
+// IsWorktree returns whether this environment is a linked worktree of another repository.
+func (dEnv *DoltEnv) IsWorktree() bool {
+	link, err := readWorktreeLink(dEnv.FS)
+	return err == nil && link != nil
+}
+
+func readWorktreeLink(fs filesys.ReadableFS) (*worktreeLink, error) {
+	path := filepath.Join(dbfactory.DoltDir, worktreeLinkFile)
+	if exists, _ := fs.Exists(path); !exists {
+		return nil, nil
+	}
+
+	var link worktreeLink
+	err := filesys.UnmarshalJSONFile(fs, path, &link)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// dataFS returns a filesystem whose working directory is the root of the repository that owns the chunk store of this
+// environment. For a worktree, this is the root of the main repository, otherwise it is this environment's filesystem.
+func (dEnv *DoltEnv) dataFS() (filesys.Filesys, error) {
+	return worktreeDataFS(dEnv.FS)
+}
+
+// worktreeDataFS returns a filesystem whose working directory is the root of the repository that owns the chunk store
+// of the repository at the working directory of |fs|.
+func worktreeDataFS(fs filesys.Filesys) (filesys.Filesys, error) {
+	link, err := readWorktreeLink(fs)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return fs, nil
+	}
+	return fs.WithWorkingDir(link.MainRoot)
+}
+
+// MainWorktreeRoot returns the absolute path to the root directory of the main repository of this environment.
+func (dEnv *DoltEnv) MainWorktreeRoot() (string, error) {
+	fs, err := dEnv.dataFS()
+	if err != nil {
+		return "", err
+	}
+	return fs.Abs(".")
+}
+
+// Worktrees returns the linked worktrees of the repository this environment belongs to, not including the main
+// working tree.
+func (dEnv *DoltEnv) Worktrees() ([]Worktree, error) {
+	fs, err := dEnv.dataFS()
+	if err != nil {
+		return nil, err
+	}
+	return loadWorktrees(fs)
+}
+
+func loadWorktrees(fs filesys.ReadableFS) ([]Worktree, error) {
+	path := filepath.Join(dbfactory.DoltDir, worktreesFile)
+	if exists, _ := fs.Exists(path); !exists {
+		return nil, nil
+	}
+
+	var worktrees []Worktree
+	err := filesys.UnmarshalJSONFile(fs, path, &worktrees)
+	if err != nil {
+		return nil, err
+	}
+	return worktrees, nil
+}
+
+func saveWorktrees(fs filesys.WritableFS, worktrees []Worktree) error {
+	path := filepath.Join(dbfactory.DoltDir, worktreesFile)
+	if len(worktrees) == 0 {
+		return fs.DeleteFile(path)
+	}
+
+	data, err := json.MarshalIndent(worktrees, "", "  ")
+	if err != nil {
+		return err
+	}
+	// WriteFile replaces the file atomically, so readers that don't take the lock never see a partially written file
+	return fs.WriteFile(path, data, os.ModePerm)
+}
+
+// lockWorktrees takes the lock on the list of worktrees of the repository at the working directory of |fs|, which
+// must be held while it's read, modified and written back, waiting for any other process holding it. It returns a
+// function that releases the lock.
+func lockWorktrees(fs filesys.Filesys) (func() error, error) {
+	path, err := fs.Abs(filepath.Join(dbfactory.DoltDir, worktreesLockFile))
+	if err != nil {
+		return nil, err
+	}
+	lck := filesys.CreateFilesysLock(fs, path)
+	deadline := time.Now().Add(worktreesLockTimeout)
+	for {
+		ok, err := lck.TryLock()
+		if err != nil && !errors.Is(err, fslock.ErrLocked) {
+			return nil, err
+		} else if err == nil && ok {
+			return lck.Unlock, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, ErrWorktreesLocked
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// WorktreeStatuses returns the status of the main working tree, followed by the status of each linked worktree.
+func (dEnv *DoltEnv) WorktreeStatuses() ([]WorktreeStatus, error) {
+	return worktreeStatuses(dEnv.FS)
+}
+
+func worktreeStatuses(repoFS filesys.Filesys) ([]WorktreeStatus, error) {
+	fs, err := worktreeDataFS(repoFS)
+	if err != nil {
+		return nil, err
+	}
+	mainRoot, err := fs.Abs(".")
+	if err != nil {
+		return nil, err
+	}
+	worktrees, err := loadWorktrees(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]WorktreeStatus, 0, len(worktrees)+1)
+	main := WorktreeStatus{Worktree: Worktree{Path: mainRoot}, Main: true}
+	if rs, err := LoadRepoState(fs); err == nil {
+		main.Head = rs.CWBHeadRef()
+	}
+	statuses = append(statuses, main)
+
+	for _, wt := range worktrees {
+		status := WorktreeStatus{Worktree: wt}
+		head, err := worktreeHead(fs, wt)
+		if err != nil {
+			status.Prunable = true
+		} else {
+			status.Head = head
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// worktreeHead returns the branch checked out in |wt|, or an error if |wt| no longer exists or is no longer linked to
+// the repository at |mainFS|.
+func worktreeHead(mainFS filesys.Filesys, wt Worktree) (ref.DoltRef, error) {
+	if exists, isDir := mainFS.Exists(wt.Path); !exists || !isDir {
+		return nil, ErrWorktreeNotFound.New(wt.Path)
+	}
+	wtFS, err := mainFS.WithWorkingDir(wt.Path)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := LoadRepoState(wtFS)
+	if err != nil {
+		return nil, err
+	}
+	return rs.CWBHeadRef(), nil
+}
+
+// WorktreeForBranch returns the root directory of the working tree, other than this one, that has |branch| checked
+// out. If no other working tree has |branch| checked out, false is returned.
+func (dEnv *DoltEnv) WorktreeForBranch(branch string) (string, bool, error) {
+	return WorktreeForBranch(dEnv.FS, branch)
+}
+
+// WorktreeForBranch returns the root directory of the working tree that has |branch| checked out, other than the one
+// at the working directory of |fs|. If no other working tree has |branch| checked out, false is returned.
+func WorktreeForBranch(fs filesys.Filesys, branch string) (string, bool, error) {
+	currentRoot, err := fs.Abs(".")
+	if err != nil {
+		return "", false, err
+	}
+	statuses, err := worktreeStatuses(fs)
+	if err != nil {
+		return "", false, err
+	}
+	if len(statuses) == 1 {
+		// No linked worktrees, so nothing else can have the branch checked out
+		return "", false, nil
+	}
+
+	branchRef := ref.NewBranchRef(branch)
+	for _, status := range statuses {
+		if status.Path == currentRoot || status.Head == nil {
+			continue
+		}
+		if ref.Equals(status.Head, branchRef) {
+			return status.Path, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// CheckBranchNotInOtherWorktree returns ErrBranchCheckedOutInWorktree if |branch| is checked out in a working tree
+// other than the one at the working directory of |fs|.
+func CheckBranchNotInOtherWorktree(fs filesys.Filesys, branch string) error {
+	path, ok, err := WorktreeForBranch(fs, branch)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return ErrBranchCheckedOutInWorktree.New(branch, path)
+	}
+	return nil
+}
+
+// AddWorktree creates a new worktree at |path| with |branch| checked out. |branch| must already exist, and must not be
+// checked out in any other working tree, including this one.
+func (dEnv *DoltEnv) AddWorktree(ctx context.Context, path, branch string) (Worktree, error) {
+	mainFS, err := dEnv.dataFS()
+	if err != nil {
+		return Worktree{}, err
+	}
+	mainRoot, err := mainFS.Abs(".")
+	if err != nil {
+		return Worktree{}, err
+	}
+	absPath, err := dEnv.FS.Abs(path)
+	if err != nil {
+		return Worktree{}, err
+	}
+
+	if exists, isDir := dEnv.FS.Exists(absPath); exists {
+		if !isDir || !isEmptyDir(dEnv.FS, absPath) {
+			return Worktree{}, ErrWorktreePathExists.New(path)
+		}
+	}
+
+	_, ok, err := dEnv.DoltDB(ctx).HasBranch(ctx, branch)
+	if err != nil {
+		return Worktree{}, err
+	} else if !ok {
+		return Worktree{}, fmt.Errorf("invalid reference: %s", branch)
+	}
+
+	if dEnv.RepoState != nil && ref.Equals(dEnv.RepoState.CWBHeadRef(), ref.NewBranchRef(branch)) {
+		currentRoot, err := dEnv.FS.Abs(".")
+		if err != nil {
+			return Worktree{}, err
+		}
+		return Worktree{}, ErrBranchCheckedOutInWorktree.New(branch, currentRoot)
+	}
+	// the lock is held from checking where the branch is checked out until the new worktree is saved
+	unlock, err := lockWorktrees(mainFS)
+	if err != nil {
+		return Worktree{}, err
+	}
+	defer unlock()
+	if wtPath, ok, err := dEnv.WorktreeForBranch(branch); err != nil {
+		return Worktree{}, err
+	} else if ok {
+		return Worktree{}, ErrBranchCheckedOutInWorktree.New(branch, wtPath)
+	}
+
+	worktrees, err := loadWorktrees(mainFS)
+	if err != nil {
+		return Worktree{}, err
+	}
+	wt := Worktree{Name: uniqueWorktreeName(worktrees, filepath.Base(absPath)), Path: absPath}
+
+	err = dEnv.FS.MkDirs(filepath.Join(absPath, dbfactory.DoltDir))
+	if err != nil {
+		return Worktree{}, err
+	}
+	wtFS, err := dEnv.FS.WithWorkingDir(absPath)
+	if err != nil {
+		return Worktree{}, err
+	}
+
+	data, err := json.MarshalIndent(worktreeLink{Name: wt.Name, MainRoot: mainRoot}, "", "  ")
+	if err != nil {
+		return Worktree{}, err
+	}
+	err = wtFS.WriteFile(filepath.Join(dbfactory.DoltDir, worktreeLinkFile), data, os.ModePerm)
+	if err != nil {
+		return Worktree{}, err
+	}
+
+	// Remotes and backups are copied from the main repository, so that the new worktree can push and pull
+	rs, err := LoadRepoState(mainFS)
+	if err != nil {
+		return Worktree{}, err
+	}
+	rs.Head = ref.MarshalableRef{Ref: ref.NewBranchRef(branch)}
+	err = rs.Save(wtFS)
+	if err != nil {
+		return Worktree{}, err
+	}
+
+	err = saveWorktrees(mainFS, append(worktrees, wt))
+	if err != nil {
+		return Worktree{}, err
+	}
+	return wt, nil
+}
+
+// RemoveWorktree deletes the worktree identified by |nameOrPath|, along with its directory. Unless |force| is true,
+// the worktree is not removed if its working set has any changes, since they would become the working set of its
+// branch in every other working tree.
+func (dEnv *DoltEnv) RemoveWorktree(ctx context.Context, nameOrPath string, force bool) (Worktree, error) {
+	mainFS, err := dEnv.dataFS()
+	if err != nil {
+		return Worktree{}, err
+	}
+	mainRoot, err := mainFS.Abs(".")
+	if err != nil {
+		return Worktree{}, err
+	}
+	currentRoot, err := dEnv.FS.Abs(".")
+	if err != nil {
+		return Worktree{}, err
+	}
+	absPath, err := dEnv.FS.Abs(nameOrPath)
+	if err != nil {
+		return Worktree{}, err
+	}
+	if absPath == mainRoot {
+		return Worktree{}, ErrCannotRemoveMainWorktree
+	}
+
+	unlock, err := lockWorktrees(mainFS)
+	if err != nil {
+		return Worktree{}, err
+	}
+	defer unlock()
+
+	worktrees, err := loadWorktrees(mainFS)
+	if err != nil {
+		return Worktree{}, err
+	}
+	idx := -1
+	for i, wt := range worktrees {
+		if wt.Path == absPath || wt.Name == nameOrPath {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return Worktree{}, ErrWorktreeNotFound.New(nameOrPath)
+	}
+	wt := worktrees[idx]
+	if wt.Path == currentRoot {
+		return Worktree{}, ErrCannotRemoveCurrentWorktree
+	}
+
+	if exists, _ := dEnv.FS.Exists(wt.Path); exists {
+		if !force {
+			head, err := worktreeHead(mainFS, wt)
+			if err == nil {
+				dirty, err := dEnv.branchHasChanges(ctx, head)
+				if err != nil {
+					return Worktree{}, err
+				}
+				if dirty {
+					return Worktree{}, ErrWorktreeHasChanges.New(nameOrPath)
+				}
+			}
+		}
+
+		err = dEnv.FS.Delete(wt.Path, true)
+		if err != nil {
+			return Worktree{}, err
+		}
+	}
+
+	err = saveWorktrees(mainFS, append(worktrees[:idx:idx], worktrees[idx+1:]...))
+	if err != nil {
+		return Worktree{}, err
+	}
+	return wt, nil
+}
+
+// PruneWorktrees forgets any worktrees whose directories no longer exist, and returns the worktrees that were pruned.
+func (dEnv *DoltEnv) PruneWorktrees() ([]Worktree, error) {
+	mainFS, err := dEnv.dataFS()
+	if err != nil {
+		return nil, err
+	}
+	unlock, err := lockWorktrees(mainFS)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	worktrees, err := loadWorktrees(mainFS)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept, pruned []Worktree
+	for _, wt := range worktrees {
+		if _, err := worktreeHead(mainFS, wt); err != nil {
+			pruned = append(pruned, wt)
+		} else {
+			kept = append(kept, wt)
+		}
+	}
+	if len(pruned) == 0 {
+		return nil, nil
+	}
+
+	return pruned, saveWorktrees(mainFS, kept)
+}
+
+// branchHasChanges returns whether the working set of |head| has any changes that haven't been committed.
+func (dEnv *DoltEnv) branchHasChanges(ctx context.Context, head ref.DoltRef) (bool, error) {
+	ddb := dEnv.DoltDB(ctx)
+	wsRef, err := ref.WorkingSetRefForHead(head)
+	if err != nil {
+		return false, err
+	}
+	ws, err := ddb.ResolveWorkingSet(ctx, wsRef)
+	if err != nil {
+		return false, err
+	}
+	cm, err := ddb.ResolveCommitRef(ctx, head)
+	if err != nil {
+		return false, err
+	}
+	headRoot, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	headHash, err := headRoot.HashOf()
+	if err != nil {
+		return false, err
+	}
+	workingHash, err := ws.WorkingRoot().HashOf()
+	if err != nil {
+		return false, err
+	}
+	stagedHash, err := ws.StagedRoot().HashOf()
+	if err != nil {
+		return false, err
+	}
+	return workingHash != headHash || stagedHash != headHash || ws.MergeActive(), nil
+}
+
+func uniqueWorktreeName(worktrees []Worktree, base string) string {
+	name := base
+	for i := 1; ; i++ {
+		taken := false
+		for _, wt := range worktrees {
+			if wt.Name == name {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+}
+
+func isEmptyDir(fs filesys.Filesys, path string) bool {
+	empty := true
+	_ = fs.Iter(path, false, func(string, int64, bool) bool {
+		empty = false
+		return true
+	})
+	return empty
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestWorktrees(t *testing.T) {
+	ctx := context.Background()
+	dEnv, fs := createTestEnv(false, false)
+	err := dEnv.InitRepo(ctx, types.Format_Default, "aoeu aoeu", "aoeu@aoeu.org", DefaultInitBranch)
+	require.NoError(t, err)
+	defer dEnv.Close()
+
+	headCommit, err := dEnv.HeadCommit(ctx)
+	require.NoError(t, err)
+	err = dEnv.DoltDB(ctx).NewBranchAtCommit(ctx, ref.NewBranchRef("feature"), headCommit, nil)
+	require.NoError(t, err)
+
+	wtPath := filepath.Join(filepath.Dir(workingDir), "feature")
+
+	t.Run("add", func(t *testing.T) {
+		_, err := dEnv.AddWorktree(ctx, wtPath, DefaultInitBranch)
+		assert.True(t, ErrBranchCheckedOutInWorktree.Is(err))
+
+		_, err = dEnv.AddWorktree(ctx, wtPath, "missing")
+		assert.Error(t, err)
+
+		wt, err := dEnv.AddWorktree(ctx, wtPath, "feature")
+		require.NoError(t, err)
+		assert.Equal(t, Worktree{Name: "feature", Path: wtPath}, wt)
+
+		_, err = dEnv.AddWorktree(ctx, wtPath, "feature")
+		assert.True(t, ErrWorktreePathExists.Is(err))
+	})
+
+	t.Run("load worktree", func(t *testing.T) {
+		wtFS, err := fs.WithWorkingDir(wtPath)
+		require.NoError(t, err)
+		wtEnv := LoadWithoutDB(ctx, testHomeDirFunc, wtFS, doltdb.InMemDoltDB, "test")
+		require.NoError(t, wtEnv.RSLoadErr)
+
+		assert.True(t, wtEnv.IsWorktree())
+		assert.False(t, dEnv.IsWorktree())
+		assert.True(t, wtEnv.HasDoltDataDir())
+		assert.Equal(t, ref.NewBranchRef("feature"), wtEnv.RepoState.CWBHeadRef())
+
+		mainRoot, err := wtEnv.MainWorktreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, workingDir, mainRoot)
+
+		path, ok, err := wtEnv.WorktreeForBranch(DefaultInitBranch)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, workingDir, path)
+
+		path, ok, err = dEnv.WorktreeForBranch("feature")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, wtPath, path)
+
+		_, ok, err = wtEnv.WorktreeForBranch("feature")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		err = CheckBranchNotInOtherWorktree(fs, "feature")
+		assert.True(t, ErrBranchCheckedOutInWorktree.Is(err))
+		assert.NoError(t, CheckBranchNotInOtherWorktree(wtFS, "feature"))
+		assert.NoError(t, CheckBranchNotInOtherWorktree(fs, DefaultInitBranch))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		statuses, err := dEnv.WorktreeStatuses()
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.True(t, statuses[0].Main)
+		assert.Equal(t, ref.NewBranchRef(DefaultInitBranch), statuses[0].Head)
+		assert.Equal(t, wtPath, statuses[1].Path)
+		assert.Equal(t, ref.NewBranchRef("feature"), statuses[1].Head)
+		assert.False(t, statuses[1].Prunable)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		_, err := dEnv.RemoveWorktree(ctx, workingDir, false)
+		assert.Equal(t, ErrCannotRemoveMainWorktree, err)
+
+		_, err = dEnv.RemoveWorktree(ctx, "missing", false)
+		assert.True(t, ErrWorktreeNotFound.Is(err))
+
+		wt, err := dEnv.RemoveWorktree(ctx, "feature", false)
+		require.NoError(t, err)
+		assert.Equal(t, wtPath, wt.Path)
+
+		exists, _ := fs.Exists(wtPath)
+		assert.False(t, exists)
+		worktrees, err := dEnv.Worktrees()
+		require.NoError(t, err)
+		assert.Empty(t, worktrees)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		_, err := dEnv.AddWorktree(ctx, wtPath, "feature")
+		require.NoError(t, err)
+		require.NoError(t, fs.Delete(wtPath, true))
+
+		statuses, err := dEnv.WorktreeStatuses()
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.True(t, statuses[1].Prunable)
+
+		pruned, err := dEnv.PruneWorktrees()
+		require.NoError(t, err)
+		assert.Equal(t, []Worktree{{Name: "feature", Path: wtPath}}, pruned)
+
+		worktrees, err := dEnv.Worktrees()
+		require.NoError(t, err)
+		assert.Empty(t, worktrees)
+	})
+}
+
+func TestLockWorktrees(t *testing.T) {
+	fs, err := filesys.LocalFS.WithWorkingDir(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, fs.MkDirs(dbfactory.DoltDir))
+
+	unlock, err := lockWorktrees(fs)
+	require.NoError(t, err)
+
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		close(released)
+		assert.NoError(t, unlock())
+	}()
+
+	// the second lock waits for the first to be released
+	unlock, err = lockWorktrees(fs)
+	require.NoError(t, err)
+	select {
+	case <-released:
+	default:
+		t.Fatal("lock was taken while it was held")
+	}
+	require.NoError(t, unlock())
+}
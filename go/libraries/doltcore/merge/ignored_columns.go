@@ -0,0 +1,42 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// setIgnoredColumns records the columns of the merged schema which are ignored by the dolt_ignore_columns table.
+// When both sides of the merge change an ignored column, our value is kept instead of reporting a conflict.
+func (m *valueMerger) setIgnoredColumns(ignored doltdb.IgnoredColumns) {
+	if len(ignored) == 0 || m.keyless {
+		return
+	}
+
+	m.ignored = make([]bool, m.numCols)
+	i := 0
+	for _, col := range m.resultSchema.GetNonPKCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		m.ignored[i] = ignored.Contains(col.Name)
+		i++
+	}
+}
+
+// isIgnored returns whether the column |i| of the merged schema, by stored index, is ignored.
+func (m *valueMerger) isIgnored(i int) bool {
+	return m.ignored != nil && m.ignored[i]
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// columnMergePolicy is a merge policy from the dolt_merge_policies table, resolved against the merged schema of the
+// table it applies to.
+type columnMergePolicy struct {
+	policy string
+	// tsIdx is the index of the timestamp column compared by a latest_timestamp policy
+	tsIdx int
+	// expr is the resolved expression of an expression policy
+	expr sql.Expression
+}
+
+// setPolicies resolves |policies| against the merged schema and records them for use when both sides of the merge
+// changed the same column. A policy for a specific column takes precedence over a policy for every column of the
+// table. Policies for columns that aren't in the merged schema are ignored.
+func (m *valueMerger) setPolicies(ctx *sql.Context, tblName string, policies []doltdb.MergePolicy) error {
+	if len(policies) == 0 || m.keyless {
+		return nil
+	}
+
+	idxByName := make(map[string]int, m.numCols)
+	i := 0
+	for _, col := range m.resultSchema.GetNonPKCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		idxByName[strings.ToLower(col.Name)] = i
+		i++
+	}
+
+	m.policies = make([]*columnMergePolicy, m.numCols)
+	var wildcard *doltdb.MergePolicy
+	for _, p := range policies {
+		if p.ColumnName == doltdb.MergePoliciesAllColumns {
+			p := p
+			wildcard = &p
+			continue
+		}
+		idx, ok := idxByName[strings.ToLower(p.ColumnName)]
+		if !ok {
+			continue
+		}
+		cp, err := m.resolvePolicy(ctx, tblName, idx, p, idxByName)
+		if err != nil {
+			return err
+		}
+		m.policies[idx] = cp
+	}
+
+	if wildcard != nil {
+		for idx := range m.policies {
+			if m.policies[idx] != nil || m.resultSchema.GetNonPKCols().GetByStoredIndex(idx).Generated != "" {
+				continue
+			}
+			cp, err := m.resolvePolicy(ctx, tblName, idx, *wildcard, idxByName)
+			if err != nil {
+				return err
+			}
+			m.policies[idx] = cp
+		}
+	}
+
+	return nil
+}
+
+// resolvePolicy resolves the merge policy |p| for the column |idx| of the merged schema.
+func (m *valueMerger) resolvePolicy(ctx *sql.Context, tblName string, idx int, p doltdb.MergePolicy, idxByName map[string]int) (*columnMergePolicy, error) {
+	col := m.resultSchema.GetNonPKCols().GetByStoredIndex(idx)
+	sqlType := col.TypeInfo.ToSqlType()
+	cp := &columnMergePolicy{policy: strings.ToLower(p.Policy), tsIdx: idx}
+
+	switch cp.policy {
+	case doltdb.MergePolicyPreferOurs, doltdb.MergePolicyPreferTheirs, doltdb.MergePolicyMax, doltdb.MergePolicyMin:
+	case doltdb.MergePolicySumDeltas:
+		if !types.IsNumber(sqlType) {
+			return nil, fmt.Errorf("merge policy %s for %s.%s requires a numeric column", cp.policy, tblName, col.Name)
+		}
+	case doltdb.MergePolicyLatestTimestamp:
+		if p.Expression != "" {
+			tsIdx, ok := idxByName[strings.ToLower(strings.Trim(p.Expression, "`"))]
+			if !ok {
+				return nil, fmt.Errorf("merge policy %s for %s.%s refers to unknown column %s", cp.policy, tblName, col.Name, p.Expression)
+			}
+			cp.tsIdx = tsIdx
+		}
+	case doltdb.MergePolicyExpression:
+		expr, err := expranalysis.ResolveMergePolicyExpression(ctx, col, p.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve merge policy expression for %s.%s: %w", tblName, col.Name, err)
+		}
+		cp.expr = expr
+	default:
+		return nil, fmt.Errorf("unknown merge policy %s for %s.%s", p.Policy, tblName, col.Name)
+	}
+
+	return cp, nil
+}
+
+// resolveWithPolicy returns the merged value of column |i| when both sides of the merge changed it to different
+// values, according to the column's merge policy. If the column has no merge policy but is ignored by the
+// dolt_ignore_columns table, our value is kept. Otherwise, or if the policy can't choose a value, the column is reported
+// as a conflict. |i| is the index of the column among the stored columns of the merged schema.
+func (m *valueMerger) resolveWithPolicy(ctx *sql.Context, i int, leftVal, rightVal, baseVal interface{}, left, right val.Tuple) (interface{}, bool, error) {
+	if m.policies == nil || m.policies[i] == nil {
+		if m.isIgnored(i) {
+			return leftVal, false, nil
+		}
+		return nil, true, nil
+	}
+	p := m.policies[i]
+	sqlType := m.resultSchema.GetNonPKCols().GetByStoredIndex(i).TypeInfo.ToSqlType()
+
+	switch p.policy {
+	case doltdb.MergePolicyPreferOurs:
+		return leftVal, false, nil
+	case doltdb.MergePolicyPreferTheirs:
+		return rightVal, false, nil
+	case doltdb.MergePolicyMax, doltdb.MergePolicyMin:
+		if leftVal == nil {
+			return rightVal, false, nil
+		} else if rightVal == nil {
+			return leftVal, false, nil
+		}
+		cmp, err := sqlType.Compare(ctx, leftVal, rightVal)
+		if err != nil {
+			return nil, true, err
+		}
+		if (cmp > 0) == (p.policy == doltdb.MergePolicyMax) {
+			return leftVal, false, nil
+		}
+		return rightVal, false, nil
+	case doltdb.MergePolicySumDeltas:
+		return sumDeltas(ctx, sqlType, leftVal, rightVal, baseVal)
+	case doltdb.MergePolicyLatestTimestamp:
+		return m.latestTimestamp(ctx, p.tsIdx, leftVal, rightVal, left, right)
+	case doltdb.MergePolicyExpression:
+		// an expression that fails for these values, or whose result doesn't fit the column, leaves a conflict
+		merged, err := p.expr.Eval(ctx, sql.Row{leftVal, rightVal, baseVal, nil})
+		if err != nil {
+			return nil, true, nil
+		}
+		merged, inRange, err := sqlType.Convert(ctx, merged)
+		if err != nil || inRange != sql.InRange {
+			return nil, true, nil
+		}
+		return merged, false, nil
+	default:
+		return nil, true, nil
+	}
+}
+
+// sumDeltas applies the changes made on each side of the merge to the base value, so that concurrent increments
+// and decrements of a counter are all kept. A missing base value counts as zero. NULL values on either side, or a
+// result that's out of range for the column, can't be resolved.
+func sumDeltas(ctx *sql.Context, sqlType sql.Type, leftVal, rightVal, baseVal interface{}) (interface{}, bool, error) {
+	if leftVal == nil || rightVal == nil {
+		return nil, true, nil
+	}
+	var sum decimal.Decimal
+	for i, v := range []interface{}{leftVal, rightVal, baseVal} {
+		if v == nil {
+			continue
+		}
+		d, _, err := types.InternalDecimalType.Convert(ctx, v)
+		if err != nil {
+			return nil, true, err
+		}
+		if i == 2 {
+			sum = sum.Sub(d.(decimal.Decimal))
+		} else {
+			sum = sum.Add(d.(decimal.Decimal))
+		}
+	}
+	merged, inRange, err := sqlType.Convert(ctx, sum)
+	if err != nil || inRange != sql.InRange {
+		return nil, true, nil
+	}
+	return merged, false, nil
+}
+
+// latestTimestamp chooses the value from the side of the merge whose timestamp column |tsIdx| is later. A NULL
+// timestamp is never the latest, and equal timestamps can't be resolved.
+func (m *valueMerger) latestTimestamp(ctx *sql.Context, tsIdx int, leftVal, rightVal interface{}, left, right val.Tuple) (interface{}, bool, error) {
+	tsType := m.resultSchema.GetNonPKCols().GetByStoredIndex(tsIdx).TypeInfo.ToSqlType()
+
+	var leftTs, rightTs interface{}
+	var err error
+	if _, idx, ok := getColumn(&left, &m.leftMapping, tsIdx); ok {
+		if leftTs, err = convert(ctx, m.leftVD, tsType, idx, left, m.ns); err != nil {
+			return nil, true, err
+		}
+	}
+	if _, idx, ok := getColumn(&right, &m.rightMapping, tsIdx); ok {
+		if rightTs, err = convert(ctx, m.rightVD, tsType, idx, right, m.ns); err != nil {
+			return nil, true, err
+		}
+	}
+
+	switch {
+	case leftTs == nil && rightTs == nil:
+		return nil, true, nil
+	case leftTs == nil:
+		return rightVal, false, nil
+	case rightTs == nil:
+		return leftVal, false, nil
+	}
+	cmp, err := tsType.Compare(ctx, leftTs, rightTs)
+	if err != nil {
+		return nil, true, err
+	}
+	if cmp > 0 {
+		return leftVal, false, nil
+	} else if cmp < 0 {
+		return rightVal, false, nil
+	}
+	return nil, true, nil
+}
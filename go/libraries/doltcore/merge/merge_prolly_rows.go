@@ -78,7 +78,10 @@ func mergeProllyTable(
 	if err != nil {
 		return nil, nil, err
 	}
-	valueMerger := tm.GetNewValueMerger(ctx, mergedSch, leftRows)
+	valueMerger, err := tm.GetNewValueMerger(ctx, mergedSch, leftRows)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	if !valueMerger.leftMapping.IsIdentityMapping() {
 		mergeInfo.LeftNeedsRewrite = true
@@ -1405,7 +1408,9 @@ func resolveDefaults(ctx *sql.Context, tableName string, mergedSchema schema.Sch
 			return false, nil
 		}
 
-		if col.Default != "" || col.Generated != "" || col.OnUpdate != "" {
+		// ON UPDATE expressions aren't applied when rows are merged, so a column with only an ON UPDATE expression has
+		// nothing to resolve
+		if col.Default != "" || col.Generated != "" {
 			expr, err := expranalysis.ResolveDefaultExpression(ctx, tableName, mergedSchema, col)
 			if err != nil {
 				return true, err
@@ -1826,6 +1831,10 @@ type valueMerger struct {
 	keyless                                bool
 	ns                                     tree.NodeStore
 	valueBuilder                           *val.TupleBuilder
+	// policies are the merge policies of the merged table's non-PK columns, by stored index
+	policies []*columnMergePolicy
+	// ignored records which of the merged table's non-PK columns are ignored by dolt_ignore_columns, by stored index
+	ignored []bool
 }
 
 func NewValueMerger(ctx context.Context, merged, leftSch, rightSch, baseSch schema.Schema, syncPool pool.BuffPool, ns tree.NodeStore) *valueMerger {
@@ -2070,10 +2079,10 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 	leftCol, leftColIdx, leftColExists := getColumn(&left, &m.leftMapping, i)
 	rightCol, rightColIdx, rightColExists := getColumn(&right, &m.rightMapping, i)
 	resultType := m.resultVD.Types[i]
-	resultColumn := m.resultSchema.GetNonPKCols().GetByIndex(i)
+	resultColumn := m.resultSchema.GetNonPKCols().GetByStoredIndex(i)
 	generatedColumn := resultColumn.Generated != ""
 
-	sqlType := m.resultSchema.GetNonPKCols().GetByIndex(i).TypeInfo.ToSqlType()
+	sqlType := resultColumn.TypeInfo.ToSqlType()
 
 	// We previously asserted that left and right are not nil.
 	// But base can be nil in the event of convergent inserts.
@@ -2123,8 +2132,8 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 			return leftVal, false, err
 		}
 
-		// conflicting inserts
-		return nil, true, nil
+		// conflicting inserts, unless the column has a merge policy or is ignored
+		return m.resolveWithPolicy(ctx, i, leftVal, rightVal, nil, left, right)
 	}
 
 	// If left and right both contain byte-level changes to an existing column,
@@ -2203,6 +2212,10 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 		if generatedColumn {
 			return leftVal, false, nil
 		}
+		// a merge policy for the column, or ignoring it, takes precedence over merging JSON
+		if (m.policies != nil && m.policies[i] != nil) || m.isIgnored(i) {
+			return m.resolveWithPolicy(ctx, i, leftVal, rightVal, baseVal, left, right)
+		}
 		// concurrent modification
 		// if the result type is JSON, we can attempt to merge the JSON changes.
 		dontMergeJsonVar, err := ctx.Session.GetSessionVariable(ctx, "dolt_dont_merge_json")
@@ -2223,6 +2236,20 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 			}
 			return m.mergeJSONAddr(ctx, baseCol, leftCol, rightCol)
 		}
+		// if the result type is TEXT, and line merging is enabled, we can attempt to merge the lines that changed.
+		if types.IsTextBlob(sqlType) && !types.IsBinaryType(sqlType) {
+			mergeTextVar, err := ctx.Session.GetSessionVariable(ctx, "dolt_merge_text_lines")
+			if err != nil {
+				return nil, true, err
+			}
+			allowTextMerge, err := sql.ConvertToBool(ctx, mergeTextVar)
+			if err != nil {
+				return nil, true, err
+			}
+			if allowTextMerge {
+				return mergeText(ctx, sqlType, baseVal, leftVal, rightVal)
+			}
+		}
 		// otherwise, this is a conflict.
 		return nil, true, nil
 	case leftModified:
@@ -2282,6 +2309,36 @@ func (m *valueMerger) mergeJSONAdaptive(ctx context.Context, baseField []byte, l
 	return MergeJSON(ctx, m.ns, baseJson, leftJson, rightJson)
 }
 
+// mergeText performs a line-based three-way merge of TEXT values. NULL values are a conflict, and merged values which
+// can't be converted to the column's type are an error.
+func mergeText(ctx *sql.Context, sqlType sql.Type, baseVal, leftVal, rightVal interface{}) (result interface{}, conflict bool, err error) {
+	if baseVal == nil || leftVal == nil || rightVal == nil {
+		return nil, true, nil
+	}
+	base, err := types.ConvertToString(ctx, baseVal, types.LongText, nil)
+	if err != nil {
+		return nil, true, err
+	}
+	left, err := types.ConvertToString(ctx, leftVal, types.LongText, nil)
+	if err != nil {
+		return nil, true, err
+	}
+	right, err := types.ConvertToString(ctx, rightVal, types.LongText, nil)
+	if err != nil {
+		return nil, true, err
+	}
+
+	merged, conflict := MergeTextLines(base, left, right)
+	if conflict {
+		return nil, true, nil
+	}
+	result, _, err = sqlType.Convert(ctx, merged)
+	if err != nil {
+		return nil, true, err
+	}
+	return result, false, nil
+}
+
 // MergeJSON performs a three-way merge of JSON documents. Non-overlapping
 // field changes are merged automatically; overlapping changes produce a
 // conflict (conflict=true). All three inputs must be JSON objects for
@@ -17,6 +17,7 @@ package merge
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
@@ -81,10 +82,26 @@ type TableMerger struct {
 	// exception is for the dolt_verify_constraints() stored procedure, which allows callers to
 	// only record constraint violations for a specified subset of tables.
 	recordViolations bool
+
+	// policies are the merge policies from the dolt_merge_policies table that apply to this table
+	policies []doltdb.MergePolicy
+	// ignoredColumnPatterns are the patterns from the dolt_ignore_columns table
+	ignoredColumnPatterns doltdb.IgnoredColumnPatterns
 }
 
-func (tm TableMerger) GetNewValueMerger(ctx context.Context, mergeSch schema.Schema, leftRows prolly.Map) *valueMerger {
-	return NewValueMerger(ctx, mergeSch, tm.leftSch, tm.rightSch, tm.ancSch, leftRows.Pool(), leftRows.NodeStore())
+// GetNewValueMerger returns a valueMerger for the rows of this table, which resolves conflicting changes to a cell
+// using the table's merge policies and ignored columns.
+func (tm TableMerger) GetNewValueMerger(ctx *sql.Context, mergeSch schema.Schema, leftRows prolly.Map) (*valueMerger, error) {
+	vm := NewValueMerger(ctx, mergeSch, tm.leftSch, tm.rightSch, tm.ancSch, leftRows.Pool(), leftRows.NodeStore())
+	if err := vm.setPolicies(ctx, tm.name.Name, tm.policies); err != nil {
+		return nil, err
+	}
+	ignored, err := tm.ignoredColumnPatterns.IgnoredColumns(tm.name.Name, mergeSch)
+	if err != nil {
+		return nil, err
+	}
+	vm.setIgnoredColumns(ignored)
+	return vm, nil
 }
 
 func rowsFromTable(ctx context.Context, tbl *doltdb.Table) (prolly.Map, error) {
@@ -160,6 +177,11 @@ type RootMerger struct {
 
 	vrw types.ValueReadWriter
 	ns  tree.NodeStore
+
+	// policies caches the merge policies on our side of the merge, by database schema name
+	policies map[string][]doltdb.MergePolicy
+	// ignoredColumnPatterns caches the dolt_ignore_columns patterns on our side of the merge, by database schema name
+	ignoredColumnPatterns map[string]doltdb.IgnoredColumnPatterns
 }
 
 // NewMerger creates a new merger utility object.
@@ -363,9 +385,59 @@ func (rm *RootMerger) MakeTableMerger(ctx context.Context, tblName doltdb.TableN
 		return nil, errors.New("Attempting to merge fundamentally different objects, which has not yet been implemented\n" +
 			"Please contact us and share how you ran into this error to better help our development efforts.")
 	}
+
+	if tm.HasTable() {
+		if tm.policies, err = rm.mergePoliciesForTable(ctx, tblName); err != nil {
+			return nil, err
+		}
+		if tm.ignoredColumnPatterns, err = rm.ignoredColumnPatternsForSchema(ctx, tblName.Schema); err != nil {
+			return nil, err
+		}
+	}
 	return &tm, nil
 }
 
+// mergePoliciesForTable returns the merge policies for |tblName| on our side of the merge.
+func (rm *RootMerger) mergePoliciesForTable(ctx context.Context, tblName doltdb.TableName) ([]doltdb.MergePolicy, error) {
+	policies, ok := rm.policies[tblName.Schema]
+	if !ok {
+		var err error
+		policies, err = doltdb.GetMergePolicies(ctx, rm.left, tblName.Schema)
+		if err != nil {
+			return nil, err
+		}
+		if rm.policies == nil {
+			rm.policies = make(map[string][]doltdb.MergePolicy)
+		}
+		rm.policies[tblName.Schema] = policies
+	}
+
+	var tblPolicies []doltdb.MergePolicy
+	for _, p := range policies {
+		if strings.EqualFold(p.TableName, tblName.Name) {
+			tblPolicies = append(tblPolicies, p)
+		}
+	}
+	return tblPolicies, nil
+}
+
+// ignoredColumnPatternsForSchema returns the dolt_ignore_columns patterns for the database schema |schemaName| on our side of
+// the merge.
+func (rm *RootMerger) ignoredColumnPatternsForSchema(ctx context.Context, schemaName string) (doltdb.IgnoredColumnPatterns, error) {
+	if patterns, ok := rm.ignoredColumnPatterns[schemaName]; ok {
+		return patterns, nil
+	}
+	patterns, err := doltdb.GetIgnoredColumnPatterns(ctx, rm.left, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	if rm.ignoredColumnPatterns == nil {
+		rm.ignoredColumnPatterns = make(map[string]doltdb.IgnoredColumnPatterns)
+	}
+	rm.ignoredColumnPatterns[schemaName] = patterns
+	return patterns, nil
+}
+
 func (rm *RootMerger) MaybeShortCircuit(ctx context.Context, tm *TableMerger, opts MergeOpts) (*doltdb.Table, doltdb.RootObject, *MergeStats, error) {
 	// If we need to re-verify all constraints as part of this merge, then we can't short
 	// circuit considering any tables, so return immediately
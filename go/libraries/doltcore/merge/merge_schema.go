@@ -105,18 +105,75 @@ func (sc SchemaConflict) messages() (mm []string) {
 type ColConflict struct {
 	Kind         conflictKind
 	Ours, Theirs schema.Column
+	// Ancestor is the column in the common ancestor, if the column was modified on both sides of the merge.
+	Ancestor *schema.Column
 }
 
 func (c ColConflict) String() string {
 	switch c.Kind {
 	case NameCollision:
-		return fmt.Sprintf("incompatible column types for column '%s': %s and %s", c.Ours.Name, c.Ours.TypeInfo, c.Theirs.TypeInfo)
+		switch {
+		case c.Theirs.TypeInfo == nil:
+			return fmt.Sprintf("column %s was modified on our branch and deleted on their branch", c.Ours.Name)
+		case c.Ours.TypeInfo == nil:
+			return fmt.Sprintf("column %s was deleted on our branch and modified on their branch", c.Theirs.Name)
+		}
+		return fmt.Sprintf("incompatible column types for column '%s': %s and %s", c.Ours.Name, columnTypeString(c.Ours), columnTypeString(c.Theirs))
 	case TagCollision:
-		return fmt.Sprintf("different column definitions for our column %s and their column %s", c.Ours.Name, c.Theirs.Name)
+		msg := fmt.Sprintf("different column definitions for our column %s and their column %s", c.Ours.Name, c.Theirs.Name)
+		if c.Ancestor != nil {
+			if changes := describeDivergentColumnChanges(*c.Ancestor, c.Ours, c.Theirs); len(changes) > 0 {
+				msg += ": " + strings.Join(changes, "; ")
+			}
+		}
+		return msg
 	}
 	return ""
 }
 
+// describeDivergentColumnChanges returns a description of each part of the column definition that was changed in
+// different ways on both sides of a merge.
+func describeDivergentColumnChanges(anc, ours, theirs schema.Column) (changes []string) {
+	if anc.Name != ours.Name && anc.Name != theirs.Name && ours.Name != theirs.Name {
+		changes = append(changes, fmt.Sprintf("renamed from %s to %s on our branch and to %s on their branch",
+			anc.Name, ours.Name, theirs.Name))
+	}
+	if !anc.TypeInfo.Equals(ours.TypeInfo) && !anc.TypeInfo.Equals(theirs.TypeInfo) && !ours.TypeInfo.Equals(theirs.TypeInfo) {
+		changes = append(changes, fmt.Sprintf("type changed from %s to %s on our branch and to %s on their branch",
+			columnTypeString(anc), columnTypeString(ours), columnTypeString(theirs)))
+	}
+	if anc.Default != ours.Default && anc.Default != theirs.Default && ours.Default != theirs.Default {
+		changes = append(changes, fmt.Sprintf("default value changed from '%s' to '%s' on our branch and to '%s' on their branch",
+			anc.Default, ours.Default, theirs.Default))
+	}
+	if !schema.ColConstraintsAreEqual(anc.Constraints, ours.Constraints) &&
+		!schema.ColConstraintsAreEqual(anc.Constraints, theirs.Constraints) &&
+		!schema.ColConstraintsAreEqual(ours.Constraints, theirs.Constraints) {
+		changes = append(changes, "constraints changed on both branches")
+	}
+	for _, field := range []struct {
+		name              string
+		anc, ours, theirs string
+	}{
+		{"generated value", anc.Generated, ours.Generated, theirs.Generated},
+		{"on update value", anc.OnUpdate, ours.OnUpdate, theirs.OnUpdate},
+	} {
+		if field.anc != field.ours && field.anc != field.theirs && field.ours != field.theirs {
+			changes = append(changes, fmt.Sprintf("%s changed from '%s' to '%s' on our branch and to '%s' on their branch",
+				field.name, field.anc, field.ours, field.theirs))
+		}
+	}
+	return changes
+}
+
+// columnTypeString returns the SQL type of |col|, e.g. "varchar(20)".
+func columnTypeString(col schema.Column) string {
+	if col.TypeInfo == nil {
+		return "<nil>"
+	}
+	return col.TypeInfo.ToSqlType().String()
+}
+
 type IdxConflict struct {
 	Kind         conflictKind
 	Ours, Theirs schema.Index
@@ -180,7 +237,8 @@ func SchemaMerge(
 		TableName: tblName,
 	}
 
-	if schema.SchemasAreEqual(ancSch, ourSch) && schema.SchemasAreEqual(ancSch, theirSch) {
+	if schema.SchemasAreEqual(ancSch, ourSch) && schema.SchemasAreEqual(ancSch, theirSch) &&
+		columnAttributesAreEqual(ancSch, ourSch) && columnAttributesAreEqual(ancSch, theirSch) {
 		// All schemas are identical, so no merge is needed.
 		return ourSch, sc, mergeInfo, diffInfo, nil
 	}
@@ -463,18 +521,45 @@ func mergeColumns(ctx *sql.Context, tblName string, format *storetypes.NomsBinFo
 		case ours != nil && theirs != nil:
 			// otherwise, we have two valid columns and we need to figure out which one to use
 			if anc != nil {
-				oursChanged := !anc.Equals(*ours)
-				theirsChanged := !anc.Equals(*theirs)
+				oursChanged := !columnDefinitionsEqual(*anc, *ours)
+				theirsChanged := !columnDefinitionsEqual(*anc, *theirs)
 				if oursChanged && theirsChanged {
 					diffInfo.LeftSchemaChange = true
 					diffInfo.RightSchemaChange = true
 					// If both columns changed in the same way, the modifications converge, so accept the column.
-					// If not, don't report a conflict, since this case is already handled in checkForColumnConflicts.
-					if ours.Equals(*theirs) {
+					if columnDefinitionsEqual(*ours, *theirs) {
 						mergedColumns = append(mergedColumns, *theirs)
-					} else {
-						diffInfo.LeftAndRightSchemasDiffer = true
+						continue
 					}
+					diffInfo.LeftAndRightSchemasDiffer = true
+					// Otherwise, try to combine the changes from both sides (e.g. a column renamed on one side
+					// and widened on the other), migrating the rows from both sides to the merged column type.
+					merged, ok := mergeColumnDefinitions(compatChecker, *anc, *ours, *theirs)
+					if !ok {
+						conflicts = append(conflicts, ColConflict{
+							Kind:     TagCollision,
+							Ours:     *ours,
+							Theirs:   *theirs,
+							Ancestor: anc,
+						})
+						continue
+					}
+					leftInfo := compatChecker.IsTypeChangeCompatible(ours.TypeInfo, merged.TypeInfo)
+					rightInfo := compatChecker.IsTypeChangeCompatible(theirs.TypeInfo, merged.TypeInfo)
+					if !leftInfo.Compatible || !rightInfo.Compatible {
+						conflicts = append(conflicts, ColConflict{
+							Kind:   NameCollision,
+							Ours:   *ours,
+							Theirs: *theirs,
+						})
+						continue
+					}
+					if leftInfo.InvalidateSecondaryIndexes || rightInfo.InvalidateSecondaryIndexes {
+						mergeInfo.InvalidateSecondaryIndexes = true
+					}
+					mergeInfo.LeftNeedsRewrite = true
+					mergeInfo.RightNeedsRewrite = true
+					mergedColumns = append(mergedColumns, merged)
 				} else if theirsChanged {
 					diffInfo.LeftAndRightSchemasDiffer = true
 					// In this case, only theirsChanged, so we need to check if moving from ours->theirs
@@ -546,6 +631,124 @@ func mergeColumns(ctx *sql.Context, tblName string, format *storetypes.NomsBinFo
 	return schema.NewColCollection(mergedColumns...), nil, mergeInfo, diffInfo, nil
 }
 
+// mergeColumnDefinitions merges the definitions of a column that was modified on both sides of a merge. Each part
+// of the definition (name, type, default value, constraints, comment, and so on) may be changed on one side only, or
+// changed in the same way on both sides. Types changed differently on both sides can be merged if both changes are
+// compatible with the ancestor type and one side's type is a widening of the other's (e.g. SMALLINT to INT on one side,
+// and SMALLINT to BIGINT on the other), in which case the wider type is used. Comments changed differently on both
+// sides never conflict; our comment is used. Returns false if the column definitions
+// can't be merged. Callers must still check that the types from both sides can be converted to the merged column's
+// type.
+func mergeColumnDefinitions(compatChecker typecompatibility.TypeCompatibilityChecker, anc, ours, theirs schema.Column) (schema.Column, bool) {
+	merged := ours
+
+	var ok bool
+	for _, field := range []struct {
+		merged            *string
+		anc, ours, theirs string
+	}{
+		{&merged.Name, anc.Name, ours.Name, theirs.Name},
+		{&merged.Default, anc.Default, ours.Default, theirs.Default},
+		{&merged.Generated, anc.Generated, ours.Generated, theirs.Generated},
+		{&merged.OnUpdate, anc.OnUpdate, ours.OnUpdate, theirs.OnUpdate},
+	} {
+		if *field.merged, ok = mergeColumnField(field.anc, field.ours, field.theirs); !ok {
+			return schema.Column{}, false
+		}
+	}
+	// comments don't affect any data, so rather than conflicting, ours is kept if they were changed on both sides
+	merged.Comment, _ = mergeColumnField(anc.Comment, ours.Comment, theirs.Comment)
+	for _, field := range []struct {
+		merged            *bool
+		anc, ours, theirs bool
+	}{
+		{&merged.IsPartOfPK, anc.IsPartOfPK, ours.IsPartOfPK, theirs.IsPartOfPK},
+		{&merged.Virtual, anc.Virtual, ours.Virtual, theirs.Virtual},
+		{&merged.AutoIncrement, anc.AutoIncrement, ours.AutoIncrement, theirs.AutoIncrement},
+		{&merged.Hidden, anc.Hidden, ours.Hidden, theirs.Hidden},
+		{&merged.SystemHidden, anc.SystemHidden, ours.SystemHidden, theirs.SystemHidden},
+	} {
+		if *field.merged, ok = mergeColumnField(field.anc, field.ours, field.theirs); !ok {
+			return schema.Column{}, false
+		}
+	}
+
+	switch {
+	case schema.ColConstraintsAreEqual(theirs.Constraints, anc.Constraints):
+	case schema.ColConstraintsAreEqual(ours.Constraints, anc.Constraints):
+		merged.Constraints = theirs.Constraints
+	default:
+		return schema.Column{}, false
+	}
+
+	switch {
+	case theirs.TypeInfo.Equals(anc.TypeInfo) || theirs.TypeInfo.Equals(ours.TypeInfo):
+	case ours.TypeInfo.Equals(anc.TypeInfo):
+		merged.TypeInfo, merged.Kind = theirs.TypeInfo, theirs.Kind
+	default:
+		if !compatChecker.IsTypeChangeCompatible(anc.TypeInfo, ours.TypeInfo).Compatible ||
+			!compatChecker.IsTypeChangeCompatible(anc.TypeInfo, theirs.TypeInfo).Compatible {
+			return schema.Column{}, false
+		}
+		if compatChecker.IsTypeChangeCompatible(ours.TypeInfo, theirs.TypeInfo).Compatible {
+			merged.TypeInfo, merged.Kind = theirs.TypeInfo, theirs.Kind
+		} else if !compatChecker.IsTypeChangeCompatible(theirs.TypeInfo, ours.TypeInfo).Compatible {
+			return schema.Column{}, false
+		}
+	}
+
+	return merged, true
+}
+
+// mergeColumnField merges a part of a column definition from both sides of a merge, taking the side that changed it
+// from the ancestor |anc|. Returns false if both sides changed it in different ways.
+func mergeColumnField[T comparable](anc, ours, theirs T) (T, bool) {
+	switch {
+	case theirs == anc || theirs == ours:
+		return ours, true
+	case ours == anc:
+		return theirs, true
+	default:
+		return ours, false
+	}
+}
+
+// columnDefinitionsEqual returns whether |a| and |b| have the same definition. Unlike schema.Column.Equals, every part
+// of the definition is compared, so that changes to e.g. the comment of a column are merged too.
+func columnDefinitionsEqual(a, b schema.Column) bool {
+	return a.Equals(b) && columnAttributesEqual(a, b)
+}
+
+// columnAttributesAreEqual returns whether the columns of |a| and |b| have the same attributes, which
+// schema.SchemasAreEqual doesn't compare. It's only meaningful for schemas with the same columns.
+func columnAttributesAreEqual(a, b schema.Schema) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aCols, bCols := a.GetAllCols().GetColumns(), b.GetAllCols().GetColumns()
+	if len(aCols) != len(bCols) {
+		return false
+	}
+	for i := range aCols {
+		if !columnAttributesEqual(aCols[i], bCols[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// columnAttributesEqual returns whether |a| and |b| have the same generated value, on update value, comment, and
+// other attributes which schema.Column.Equals doesn't compare.
+func columnAttributesEqual(a, b schema.Column) bool {
+	return a.Generated == b.Generated &&
+		a.OnUpdate == b.OnUpdate &&
+		a.Virtual == b.Virtual &&
+		a.AutoIncrement == b.AutoIncrement &&
+		a.Comment == b.Comment &&
+		a.Hidden == b.Hidden &&
+		a.SystemHidden == b.SystemHidden
+}
+
 // checkForColumnConflicts iterates over |mergedColumns|, checks for duplicate column names or column tags, and returns
 // a slice of ColConflicts for any conflicts found.
 func checkForColumnConflicts(mergedColumns []schema.Column) []ColConflict {
@@ -607,14 +810,8 @@ func checkSchemaConflicts(columnMappings columnMappings) ([]ColConflict, error)
 				}
 			case theirs != nil && anc != nil:
 				// Column exists on their side and in ancestor
-				// If the column differs from the ancestor on both sides, then we have a conflict
-				if !anc.Equals(*ours) && !anc.Equals(*theirs) {
-					conflicts = append(conflicts, ColConflict{
-						Kind:   TagCollision,
-						Ours:   *ours,
-						Theirs: *theirs,
-					})
-				}
+				// If the column differs from the ancestor on both sides, the changes are merged (or reported as
+				// a conflict) in mergeColumns
 			case theirs != nil && anc == nil:
 				// Column exists on both sides, but not in ancestor
 				// col added on our branch and their branch with different def
@@ -0,0 +1,198 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"strings"
+)
+
+// MergeTextLines performs a line-based three-way merge of text values, in the style of diff3. Lines changed on only
+// one side are merged automatically. Changes to the same or adjacent lines on both sides are a conflict
+// (conflict=true), unless both sides made the same change.
+func MergeTextLines(base, left, right string) (merged string, conflict bool) {
+	baseLines, leftLines, rightLines := splitLines(base), splitLines(left), splitLines(right)
+	b, l, r := internLines(baseLines, leftLines, rightLines)
+	leftMatches := matchLines(b, l)
+	rightMatches := matchLines(b, r)
+
+	var sb strings.Builder
+	i, li, ri := 0, 0, 0
+	for i < len(b) || li < len(l) || ri < len(r) {
+		// find the next base line which is unchanged on both sides
+		j := i
+		for j < len(b) && (leftMatches[j] < 0 || rightMatches[j] < 0) {
+			j++
+		}
+		nextL, nextR := len(l), len(r)
+		if j < len(b) {
+			nextL, nextR = leftMatches[j], rightMatches[j]
+		}
+
+		if j == i && nextL == li && nextR == ri {
+			// a stable line
+			sb.WriteString(baseLines[i])
+			i, li, ri = i+1, li+1, ri+1
+			continue
+		}
+
+		baseChunk, leftChunk, rightChunk := b[i:j], l[li:nextL], r[ri:nextR]
+		switch {
+		case linesEqual(leftChunk, baseChunk):
+			writeLines(&sb, rightLines[ri:nextR])
+		case linesEqual(rightChunk, baseChunk), linesEqual(leftChunk, rightChunk):
+			writeLines(&sb, leftLines[li:nextL])
+		default:
+			return "", true
+		}
+		i, li, ri = j, nextL, nextR
+	}
+
+	return sb.String(), false
+}
+
+// splitLines splits |s| into lines, each of which keeps its line terminator.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// internLines replaces each distinct line of |base|, |left| and |right| with an integer, so that lines can be compared
+// cheaply.
+func internLines(base, left, right []string) (b, l, r []int) {
+	ids := make(map[string]int)
+	intern := func(lines []string) []int {
+		res := make([]int, len(lines))
+		for i, line := range lines {
+			id, ok := ids[line]
+			if !ok {
+				id = len(ids)
+				ids[line] = id
+			}
+			res[i] = id
+		}
+		return res
+	}
+	return intern(base), intern(left), intern(right)
+}
+
+func linesEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(sb *strings.Builder, lines []string) {
+	for _, line := range lines {
+		sb.WriteString(line)
+	}
+}
+
+// matchLines returns, for each line of |a|, the index of the line of |b| it's matched with in a longest common
+// subsequence of |a| and |b|, or -1 if it isn't matched. Matched indexes are strictly increasing.
+func matchLines(a, b []int) []int {
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	// match the common prefix and suffix directly, and only diff what's between them
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		matches[prefix] = prefix
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		matches[len(a)-1-suffix] = len(b) - 1 - suffix
+		suffix++
+	}
+
+	myersMatches(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], func(x, y int) {
+		matches[prefix+x] = prefix + y
+	})
+	return matches
+}
+
+// myersMatches finds a shortest edit script between |a| and |b| using Myers' algorithm, and calls |match| for each
+// pair of lines which the script leaves unchanged.
+func myersMatches(a, b []int, match func(x, y int)) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return
+	}
+
+	max := n + m
+	off := max + 1
+	v := make([]int, 2*max+3)
+	// trace[d] holds the furthest reaching paths for the diagonals -d-1 through d+1 before step d
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v[off-d-1:off+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				backtrackMatches(trace, n, m, match)
+				return
+			}
+		}
+	}
+}
+
+// backtrackMatches walks the paths recorded in |trace| back from the end of both sequences, calling |match| for each
+// diagonal move.
+func backtrackMatches(trace [][]int, x, y int, match func(x, y int)) {
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY && x > 0 && y > 0 {
+			x, y = x-1, y-1
+			match(x, y)
+		}
+		if d > 0 {
+			x, y = prevX, prevY
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type textMergeTest struct {
+	name             string
+	base             string
+	left             string
+	right            string
+	expected         string
+	expectedConflict bool
+}
+
+var textMergeTests = []textMergeTest{
+	{
+		name:     "changes to different lines",
+		base:     "one\ntwo\nthree\nfour\nfive\n",
+		left:     "ONE\ntwo\nthree\nfour\nfive\n",
+		right:    "one\ntwo\nthree\nfour\nFIVE\n",
+		expected: "ONE\ntwo\nthree\nfour\nFIVE\n",
+	},
+	{
+		name:     "insertions and deletions",
+		base:     "one\ntwo\nthree\nfour\nfive\n",
+		left:     "zero\none\ntwo\nthree\nfour\nfive\n",
+		right:    "one\ntwo\nthree\nfive\nsix\n",
+		expected: "zero\none\ntwo\nthree\nfive\nsix\n",
+	},
+	{
+		name:     "identical changes",
+		base:     "one\ntwo\nthree\n",
+		left:     "one\n2\nthree\n",
+		right:    "one\n2\nthree\n",
+		expected: "one\n2\nthree\n",
+	},
+	{
+		name:     "only one side changed",
+		base:     "one\ntwo\nthree\n",
+		left:     "one\ntwo\nthree\n",
+		right:    "one\ntwo\n3\nfour",
+		expected: "one\ntwo\n3\nfour",
+	},
+	{
+		name:     "missing trailing newlines",
+		base:     "one\ntwo\nthree",
+		left:     "1\ntwo\nthree",
+		right:    "one\ntwo\nthree\nfour",
+		expected: "1\ntwo\nthree\nfour",
+	},
+	{
+		name:     "empty base",
+		base:     "",
+		left:     "one\n",
+		right:    "",
+		expected: "one\n",
+	},
+	{
+		name:             "changes to the same line",
+		base:             "one\ntwo\nthree\n",
+		left:             "one\n2\nthree\n",
+		right:            "one\nTWO\nthree\n",
+		expectedConflict: true,
+	},
+	{
+		name:             "changes to adjacent lines",
+		base:             "one\ntwo\nthree\n",
+		left:             "one\n2\nthree\n",
+		right:            "one\ntwo\n3\n",
+		expectedConflict: true,
+	},
+	{
+		name:             "insertions at the same place",
+		base:             "one\nthree\n",
+		left:             "one\ntwo\nthree\n",
+		right:            "one\n2\nthree\n",
+		expectedConflict: true,
+	},
+	{
+		name:             "deleting a changed line",
+		base:             "one\ntwo\nthree\n",
+		left:             "one\nthree\n",
+		right:            "one\nTWO\nthree\n",
+		expectedConflict: true,
+	},
+	{
+		name:     "long text",
+		base:     strings.Repeat("line\n", 500) + "end\n",
+		left:     "start\n" + strings.Repeat("line\n", 500) + "end\n",
+		right:    strings.Repeat("line\n", 250) + "middle\n" + strings.Repeat("line\n", 250) + "end\n",
+		expected: "start\n" + strings.Repeat("line\n", 250) + "middle\n" + strings.Repeat("line\n", 250) + "end\n",
+	},
+}
+
+func TestMergeTextLines(t *testing.T) {
+	for _, test := range textMergeTests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, conflict := MergeTextLines(test.base, test.left, test.right)
+			assert.Equal(t, test.expectedConflict, conflict)
+			if !test.expectedConflict {
+				assert.Equal(t, test.expected, merged)
+			}
+
+			// the merge is symmetric
+			merged, conflict = MergeTextLines(test.base, test.right, test.left)
+			assert.Equal(t, test.expectedConflict, conflict)
+			if !test.expectedConflict {
+				assert.Equal(t, test.expected, merged)
+			}
+		})
+	}
+}
+
+func TestMergeText(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	base := "one\ntwo\nthree\n"
+	left := strings.Repeat("a", 200) + "\ntwo\nthree\n"
+	right := "one\ntwo\n" + strings.Repeat("b", 200) + "\n"
+
+	merged, conflict, err := mergeText(ctx, types.Text, base, left, right)
+	require.NoError(t, err)
+	assert.False(t, conflict)
+	assert.Equal(t, strings.Repeat("a", 200)+"\ntwo\n"+strings.Repeat("b", 200)+"\n", merged)
+
+	// the merged value is too long for the column
+	_, _, err = mergeText(ctx, types.TinyText, base, left, right)
+	assert.Error(t, err)
+
+	_, conflict, err = mergeText(ctx, types.Text, nil, left, right)
+	require.NoError(t, err)
+	assert.True(t, conflict)
+}
+
+func TestMatchLines(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6}
+	b := []int{0, 2, 3, 7, 5, 6, 8}
+	assert.Equal(t, []int{-1, 1, 2, -1, 4, 5}, matchLines(a, b))
+	assert.Equal(t, []int{-1, -1}, matchLines([]int{1, 2}, []int{3}))
+	assert.Equal(t, []int{0, 1}, matchLines([]int{1, 2}, []int{1, 2}))
+}
@@ -15,16 +15,21 @@
 package merge
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/pool"
 	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/val"
@@ -80,6 +85,109 @@ func (m UniqCVMeta) PrettyPrint() string {
 	return jsonStr
 }
 
+// UniqueKeyChecker finds the rows of a table which violate its unique keys, for changes made to a table outside a
+// merge, such as replaying recorded conflict resolutions.
+type UniqueKeyChecker struct {
+	rows    prolly.Map
+	indexes []uniqueKeyIndex
+}
+
+// uniqueKeyIndex is a unique index checked by a UniqueKeyChecker. Unlike a merge, which checks each row before adding
+// it to the index, the index already includes the rows being checked, so it's searched by prefix for every row with
+// the same unique key.
+type uniqueKeyIndex struct {
+	uniqIndex
+	index prolly.Map
+}
+
+// NewUniqueKeyChecker returns a UniqueKeyChecker for the table with schema |sch|, whose primary rows are |rows| and
+// whose secondary indexes are |idxSet|. The rows and indexes must already include the changes being checked.
+func NewUniqueKeyChecker(ctx *sql.Context, sch schema.Schema, tableName string, rows prolly.Map, idxSet durable.IndexSet) (UniqueKeyChecker, error) {
+	uc := UniqueKeyChecker{rows: rows}
+	for _, def := range sch.Indexes().AllIndexes() {
+		if !def.IsUnique() {
+			continue
+		}
+		idx, err := idxSet.GetIndex(ctx, sch, nil, def.Name())
+		if err != nil {
+			return UniqueKeyChecker{}, err
+		}
+		secondary, err := durable.ProllyMapFromIndex(idx)
+		if err != nil {
+			return UniqueKeyChecker{}, err
+		}
+		u, err := newUniqIndex(ctx, sch, tableName, def, rows, secondary)
+		if err != nil {
+			return UniqueKeyChecker{}, err
+		}
+		uc.indexes = append(uc.indexes, uniqueKeyIndex{uniqIndex: u, index: secondary})
+	}
+	return uc, nil
+}
+
+// ReplaceViolations records a unique key violation artifact in |edt| for the row |key|, |value| and every row it
+// collides with in a unique key, attributed to the root-ish |srcHash|. It returns the number of artifacts recorded.
+func (uc UniqueKeyChecker) ReplaceViolations(ctx *sql.Context, edt *prolly.ArtifactsEditor, srcHash hash.Hash, key, value val.Tuple) (int, error) {
+	violations := 0
+	for _, idx := range uc.indexes {
+		vinfo, err := json.Marshal(idx.meta)
+		if err != nil {
+			return 0, err
+		}
+		replace := func(k, v val.Tuple) error {
+			violations++
+			cvm := prolly.ConstraintViolationMeta{VInfo: vinfo, Value: v}
+			return edt.ReplaceConstraintViolation(ctx, k, srcHash, prolly.ArtifactTypeUniqueKeyViol, cvm)
+		}
+
+		indexKey, err := idx.secondaryBld.SecondaryKeyFromRow(ctx, key, value)
+		if err != nil {
+			return 0, err
+		}
+		if idx.prefixDesc.HasNulls(indexKey) {
+			continue // NULLs cannot cause unique violations
+		}
+		iter, err := idx.index.IterRange(ctx, prolly.PrefixRange(ctx, indexKey, idx.prefixDesc))
+		if err != nil {
+			return 0, err
+		}
+
+		collisionDetected := false
+		for {
+			collision, _, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return 0, err
+			}
+			clusteredKey, err := idx.clusteredBld.ClusteredKeyFromIndexKey(ctx, collision)
+			if err != nil {
+				return 0, err
+			}
+			if bytes.Equal(key, clusteredKey) {
+				continue // collided with ourselves
+			}
+			err = uc.rows.Get(ctx, clusteredKey, func(k, v val.Tuple) error {
+				if k == nil {
+					s := idx.clusteredKeyDesc.Format(ctx, clusteredKey)
+					return errors.New("failed to find key: " + s)
+				}
+				collisionDetected = true
+				return replace(k, v)
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+		if collisionDetected {
+			if err = replace(key, value); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return violations, nil
+}
+
 func replaceUniqueKeyViolation(ctx context.Context, edt *prolly.ArtifactsEditor, m prolly.Map, k val.Tuple, theirRootIsh doltdb.Rootish, vInfo []byte) error {
 	var value val.Tuple
 	err := m.Get(ctx, k, func(_, v val.Tuple) error {
@@ -0,0 +1,143 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+)
+
+// ErrAmUncommittedChanges is returned when patches are committed with Am without a clean working set.
+var ErrAmUncommittedChanges = errors.New("cannot apply patches with uncommitted changes")
+
+// AmOptions specifies optional parameters for how Am commits patches.
+type AmOptions struct {
+	// SkipVerification controls whether test validation should be skipped before creating commits.
+	SkipVerification bool
+}
+
+// Am applies each of |patches| in order, committing each one with the author, date and message recorded in the
+// patch. The committer is taken from the session. Am stops at the first patch whose three-way merge has conflicts or
+// constraint violations, and returns its merge result. Unlike Apply, that merge is recorded as a cherry-pick of the
+// patch's commit, since `dolt cherry-pick --continue` then commits the resolved patch with the patch's author and
+// message and a single parent, just as if it had applied cleanly. Committing a merge would instead make a merge commit
+// with the dangling commit of the patch as its second parent. The hashes of the commits made are returned in order.
+func Am(ctx *sql.Context, patches []*Patch, options AmOptions) ([]string, *merge.Result, error) {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	roots, ok := doltSession.GetRoots(ctx, dbName)
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to get roots for current session")
+	}
+	clean, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !clean {
+		return nil, nil, ErrAmUncommittedChanges
+	}
+
+	var commits []string
+	for _, p := range patches {
+		if !p.HasHeader() {
+			return commits, nil, fmt.Errorf("cannot commit a patch without a header, use dolt_apply instead")
+		}
+
+		result, err := apply(ctx, p, true)
+		if err != nil {
+			return commits, nil, err
+		}
+		if result != nil && result.HasMergeArtifacts() {
+			return commits, result, stageCleanTables(ctx, result.Stats)
+		}
+
+		roots, ok = doltSession.GetRoots(ctx, dbName)
+		if !ok {
+			return commits, nil, fmt.Errorf("failed to get roots for current session")
+		}
+		roots, err = actions.StageAllTables(ctx, roots, true)
+		if err != nil {
+			return commits, nil, err
+		}
+		if err = doltSession.SetRoots(ctx, dbName, roots); err != nil {
+			return commits, nil, err
+		}
+
+		props, _, err := dsess.NewCommitStagedProps(ctx, p.Message)
+		if err != nil {
+			return commits, nil, err
+		}
+		props.Author.Name = p.Author
+		props.Author.Email = p.Email
+		props.Author.Date = datas.CommitDateAt(p.Date)
+		props.SkipVerification = options.SkipVerification
+
+		pendingCommit, err := doltSession.NewPendingCommit(ctx, dbName, roots, props)
+		if err != nil {
+			return commits, nil, err
+		}
+		if pendingCommit == nil {
+			return commits, nil, fmt.Errorf("patch '%s' is empty", p.Subject())
+		}
+		commit, err := doltSession.DoltCommit(ctx, dbName, doltSession.GetTransaction(), pendingCommit)
+		if err != nil {
+			return commits, nil, err
+		}
+		h, err := commit.HashOf()
+		if err != nil {
+			return commits, nil, err
+		}
+		commits = append(commits, h.String())
+	}
+	return commits, nil, nil
+}
+
+// stageCleanTables stages the tables from |mergeStats| that don't have any merge artifacts, like cherry-pick does
+// when it stops on conflicts.
+func stageCleanTables(ctx *sql.Context, mergeStats map[doltdb.TableName]*merge.MergeStats) error {
+	var tables []doltdb.TableName
+	for tableName, stats := range mergeStats {
+		if stats.HasArtifacts() {
+			continue
+		}
+		if stats.Operation == merge.TableRemoved {
+			tables = append([]doltdb.TableName{tableName}, tables...)
+		} else {
+			tables = append(tables, tableName)
+		}
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+	roots, ok := doltSession.GetRoots(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("unable to get roots for database '%s' from session", dbName)
+	}
+	roots, err := actions.StageTables(ctx, roots, tables, true)
+	if err != nil {
+		return err
+	}
+	return doltSession.SetRoots(ctx, dbName, roots)
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// ErrPatchDoesNotApply is returned when a patch's statements can't be run against the working set and the patch
+// can't be merged instead, because its parent commit isn't in the database.
+var ErrPatchDoesNotApply = errors.New("patch does not apply")
+
+// ErrMergeActive is returned when a patch is applied while a merge, cherry-pick or earlier patch is unresolved.
+var ErrMergeActive = errors.New("cannot apply a patch while a merge is in progress, resolve or abort it first")
+
+// Apply applies |p| to the working set of the current database. The patch's statements are first run directly
+// against the working set. If a statement fails or changes no rows, or if a table the patch touches has changed since
+// the patch's parent commit, the statements are instead run against the parent commit and the result is merged into
+// the working set with a three-way merge. Conflicts and constraint violations from that merge are recorded the same
+// way dolt merge records them, merging a dangling commit of the patch, and the merge result is returned. When the patch
+// applies directly, the returned result is nil.
+func Apply(ctx *sql.Context, p *Patch) (*merge.Result, error) {
+	return apply(ctx, p, false)
+}
+
+// apply applies |p| like Apply does. If |asCherryPick| is true, a three-way merge with conflicts or constraint
+// violations is recorded as a cherry-pick of the patch's commit rather than a merge of it.
+func apply(ctx *sql.Context, p *Patch, asCherryPick bool) (*merge.Result, error) {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	ddb, ok := doltSession.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("failed to get doltDB")
+	}
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if ws.MergeActive() {
+		return nil, ErrMergeActive
+	}
+	working := ws.WorkingRoot()
+
+	engine := gms.NewDefault(doltSession.Provider())
+	applied, applyErr := runStatements(ctx, engine, ws, working, p.Statements)
+
+	parent, err := resolveParent(ctx, ddb, p)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		if applyErr != nil {
+			return nil, fmt.Errorf("%w: %s", ErrPatchDoesNotApply, applyErr.Error())
+		}
+		return nil, doltSession.SetWorkingRoot(ctx, dbName, applied)
+	}
+
+	parentRoot, err := parent.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if applyErr == nil {
+		diverged, err := touchedTablesDiverged(ctx, working, applied, parentRoot)
+		if err != nil {
+			return nil, err
+		}
+		if !diverged {
+			return nil, doltSession.SetWorkingRoot(ctx, dbName, applied)
+		}
+	}
+
+	theirsRoot, err := runStatements(ctx, engine, ws, parentRoot, p.Statements)
+	if err != nil {
+		return nil, fmt.Errorf("%w: it does not apply to its parent commit %s either: %s", ErrPatchDoesNotApply, p.ParentHash, err.Error())
+	}
+	theirs, err := commitPatchRoot(ctx, ddb, p, theirsRoot, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	tableResolver, err := dsess.GetTableResolver(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	dbState, ok, err := doltSession.LookupDbState(ctx, dbName)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	result, err := merge.MergeRoots(ctx, tableResolver, working, theirsRoot, parentRoot, theirs, parent, dbState.EditOpts(), merge.MergeOpts{IsCherryPick: asCherryPick})
+	if err != nil {
+		return nil, err
+	}
+	for _, schConflict := range result.SchemaConflicts {
+		if schConflict.ModifyDeleteConflict {
+			return nil, schConflict
+		}
+	}
+
+	if !result.HasMergeArtifacts() {
+		return result, doltSession.SetWorkingRoot(ctx, dbName, result.Root)
+	}
+
+	// Record the merge like dolt merge does, so the conflicts can be resolved and committed, or the whole patch
+	// undone with `dolt merge --abort`.
+	headCommit, err := doltSession.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	theirsHash, err := theirs.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	var newWs *doltdb.WorkingSet
+	if asCherryPick {
+		newWs = ws.StartCherryPick(headCommit, theirs, theirsHash.String())
+	} else {
+		newWs = ws.StartMerge(headCommit, theirs, theirsHash.String())
+		newWs = newWs.WithUnmergableTables(merge.SchemaConflictTableNames(result.SchemaConflicts))
+	}
+	return result, doltSession.SetWorkingSet(ctx, dbName, newWs.WithWorkingRoot(result.Root))
+}
+
+// runStatements runs |stmts| against |root| and returns the resulting root. The session's working set is restored to
+// |ws| afterward, whether the statements succeed or not. UPDATE and DELETE statements that change no rows are treated
+// as failures, since the rows they were generated for are missing or already changed.
+func runStatements(ctx *sql.Context, engine *gms.Engine, ws *doltdb.WorkingSet, root doltdb.RootValue, stmts []string) (res doltdb.RootValue, err error) {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	if err = doltSession.SetWorkingSet(ctx, dbName, ws.WithWorkingRoot(root)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if restoreErr := doltSession.SetWorkingSet(ctx, dbName, ws); err == nil {
+			err = restoreErr
+		}
+	}()
+
+	// Statements are run as part of the current transaction, so they must not commit it.
+	_, err = sql.RunInterpreted(ctx, func(ctx *sql.Context) (struct{}, error) {
+		for _, stmt := range stmts {
+			_, iter, _, err := engine.Query(ctx, stmt)
+			if err != nil {
+				return struct{}{}, err
+			}
+			rows, err := sql.RowIterToRows(ctx, iter)
+			if err != nil {
+				return struct{}{}, err
+			}
+			if changesRows(stmt) && len(rows) == 1 && types.IsOkResult(rows[0]) && types.GetOkResult(rows[0]).RowsAffected == 0 {
+				return struct{}{}, fmt.Errorf("statement changed no rows: %s", stmt)
+			}
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newWs, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	return newWs.WorkingRoot(), nil
+}
+
+func changesRows(stmt string) bool {
+	verb, _, _ := strings.Cut(strings.TrimSpace(stmt), " ")
+	return strings.EqualFold(verb, "update") || strings.EqualFold(verb, "delete")
+}
+
+// resolveParent returns the parent commit named in |p|'s header, or nil if the patch has no header or the commit
+// isn't in |ddb|.
+func resolveParent(ctx *sql.Context, ddb *doltdb.DoltDB, p *Patch) (*doltdb.Commit, error) {
+	h, ok := hash.MaybeParse(p.ParentHash)
+	if !ok {
+		return nil, nil
+	}
+	optCmt, err := ddb.ReadCommit(ctx, h)
+	if errors.Is(err, datas.ErrCommitNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, nil
+	}
+	return cm, nil
+}
+
+// touchedTablesDiverged returns whether any table that differs between |working| and |applied| also differs between
+// |working| and |parentRoot|, in which case the patch's statements may have applied to rows that no longer match the
+// ones they were generated from.
+func touchedTablesDiverged(ctx *sql.Context, working, applied, parentRoot doltdb.RootValue) (bool, error) {
+	workingHashes, err := doltdb.MapTableHashes(ctx, working)
+	if err != nil {
+		return false, err
+	}
+	appliedHashes, err := doltdb.MapTableHashes(ctx, applied)
+	if err != nil {
+		return false, err
+	}
+	parentHashes, err := doltdb.MapTableHashes(ctx, parentRoot)
+	if err != nil {
+		return false, err
+	}
+
+	touched := make(map[doltdb.TableName]struct{})
+	for name, h := range appliedHashes {
+		if workingHashes[name] != h {
+			touched[name] = struct{}{}
+		}
+	}
+	for name := range workingHashes {
+		if _, ok := appliedHashes[name]; !ok {
+			touched[name] = struct{}{}
+		}
+	}
+	for name := range touched {
+		if workingHashes[name] != parentHashes[name] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// commitPatchRoot writes |root| as a dangling commit on top of |parent|, using the metadata from |p|, so that it can
+// be merged and recorded in a working set's merge state.
+func commitPatchRoot(ctx *sql.Context, ddb *doltdb.DoltDB, p *Patch, root doltdb.RootValue, parent *doltdb.Commit) (*doltdb.Commit, error) {
+	_, valHash, err := ddb.WriteRootValue(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	author := datas.CommitIdent{Name: p.Author, Email: p.Email, Date: datas.CommitDateAt(p.Date)}
+	meta, err := datas.NewCommitMetaWithAuthorCommitter(author, author, p.Message)
+	if err != nil {
+		return nil, err
+	}
+	return ddb.CommitDanglingWithParentCommits(ctx, valHash, []*doltdb.Commit{parent}, meta)
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+const (
+	fromHeader      = "# From: "
+	parentHeader    = "# Parent: "
+	authorHeader    = "# Author: "
+	dateHeader      = "# Date: "
+	headerSeparator = "# ---"
+	headerComment   = "#"
+	messageIndent   = "    "
+)
+
+// Patch is a single commit serialized as the SQL statements that recreate its changes, along with the metadata of
+// the commit. Patches are written by `dolt format-patch` and read by `dolt apply` and `dolt am`. The metadata is kept
+// in a SQL comment header, so a patch file can also be run directly with `dolt sql`.
+type Patch struct {
+	// CommitHash is the hash of the commit the patch was made from. It is empty for patches without a header, such
+	// as the output of `dolt diff -r sql`.
+	CommitHash string
+	// ParentHash is the hash of the commit the statements were generated against. When the statements don't apply
+	// cleanly, the patch is replayed on this commit and merged instead.
+	ParentHash string
+	Author     string
+	Email      string
+	Date       time.Time
+	Message    string
+	Statements []string
+}
+
+// HasHeader returns whether |p| carries commit metadata.
+func (p *Patch) HasHeader() bool {
+	return p.CommitHash != ""
+}
+
+// Subject returns the first line of the patch's commit message.
+func (p *Patch) Subject() string {
+	subject, _, _ := strings.Cut(strings.TrimSpace(p.Message), "\n")
+	return strings.TrimSpace(subject)
+}
+
+// String returns the patch in the format read by Parse.
+func (p *Patch) String() string {
+	sb := strings.Builder{}
+	if p.HasHeader() {
+		sb.WriteString(fromHeader + p.CommitHash + "\n")
+		if p.ParentHash != "" {
+			sb.WriteString(parentHeader + p.ParentHash + "\n")
+		}
+		sb.WriteString(fmt.Sprintf("%s%s <%s>\n", authorHeader, p.Author, p.Email))
+		sb.WriteString(dateHeader + p.Date.UTC().Format(time.RFC3339Nano) + "\n")
+		sb.WriteString(headerComment + "\n")
+		for _, line := range strings.Split(strings.TrimSpace(p.Message), "\n") {
+			sb.WriteString(strings.TrimRight(headerComment+" "+messageIndent+line, " ") + "\n")
+		}
+		sb.WriteString(headerComment + "\n")
+		sb.WriteString(headerSeparator + "\n")
+	}
+	for _, stmt := range p.Statements {
+		sb.WriteString(stmt)
+		if !strings.HasSuffix(stmt, ";") {
+			sb.WriteString(";")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// FileName returns the name `dolt format-patch` gives the |n|th patch of a series, made from its number and subject.
+func (p *Patch) FileName(n int) string {
+	slug := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(p.Subject()), "-"), "-")
+	if len(slug) > 52 {
+		slug = strings.TrimRight(slug[:52], "-")
+	}
+	if slug == "" {
+		return fmt.Sprintf("%04d.sql", n)
+	}
+	return fmt.Sprintf("%04d-%s.sql", n, slug)
+}
+
+// Parse reads the patches in |text|. Any number of patches written by Patch.String may be concatenated, each one
+// starting at its From line. SQL that comes before the first header, or input without any header at all, is read as
+// a single patch without metadata.
+func Parse(text string) ([]*Patch, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	var patches []*Patch
+	var current *Patch
+	var body []string
+	finish := func() error {
+		stmts, err := splitStatements(strings.Join(body, "\n"))
+		if err != nil {
+			return err
+		}
+		body = nil
+		if current == nil && len(stmts) == 0 {
+			return nil
+		} else if current == nil {
+			current = &Patch{}
+		}
+		current.Statements = stmts
+		patches = append(patches, current)
+		return nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		if !strings.HasPrefix(lines[i], fromHeader) {
+			body = append(body, lines[i])
+			continue
+		}
+		if err := finish(); err != nil {
+			return nil, err
+		}
+		var err error
+		current, i, err = parseHeader(lines, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return patches, nil
+}
+
+// parseHeader parses the header that starts at |lines[start]| and returns the patch it describes, along with the
+// index of the header's last line.
+func parseHeader(lines []string, start int) (*Patch, int, error) {
+	p := &Patch{CommitHash: strings.TrimSpace(strings.TrimPrefix(lines[start], fromHeader))}
+	var message []string
+	inMessage := false
+	for i := start + 1; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		switch {
+		case line == headerSeparator:
+			p.Message = strings.TrimSpace(strings.Join(message, "\n"))
+			if p.Author == "" || p.Message == "" {
+				return nil, 0, fmt.Errorf("invalid patch header for %s: missing author or message", p.CommitHash)
+			}
+			return p, i, nil
+		case inMessage:
+			if !strings.HasPrefix(line, headerComment) {
+				return nil, 0, fmt.Errorf("invalid patch header for %s: unterminated message", p.CommitHash)
+			}
+			line = strings.TrimPrefix(strings.TrimPrefix(line, headerComment), " ")
+			message = append(message, strings.TrimPrefix(line, messageIndent))
+		case strings.HasPrefix(line, parentHeader):
+			p.ParentHash = strings.TrimSpace(strings.TrimPrefix(line, parentHeader))
+		case strings.HasPrefix(line, authorHeader):
+			author := strings.TrimSpace(strings.TrimPrefix(line, authorHeader))
+			openIdx, closeIdx := strings.LastIndex(author, "<"), strings.LastIndex(author, ">")
+			if openIdx < 0 || closeIdx < openIdx {
+				return nil, 0, fmt.Errorf("invalid patch header for %s: author must be in the format 'Name <email>'", p.CommitHash)
+			}
+			p.Author = strings.TrimSpace(author[:openIdx])
+			p.Email = strings.TrimSpace(author[openIdx+1 : closeIdx])
+		case strings.HasPrefix(line, dateHeader):
+			date, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(strings.TrimPrefix(line, dateHeader)))
+			if err != nil {
+				return nil, 0, fmt.Errorf("invalid patch header for %s: %w", p.CommitHash, err)
+			}
+			p.Date = date
+		case line == headerComment:
+			inMessage = true
+		default:
+			return nil, 0, fmt.Errorf("invalid patch header for %s: unexpected line '%s'", p.CommitHash, line)
+		}
+	}
+	return nil, 0, fmt.Errorf("invalid patch header for %s: missing '%s' separator", p.CommitHash, headerSeparator)
+}
+
+// splitStatements splits |body| into its SQL statements, dropping comment lines that come before a statement.
+func splitStatements(body string) ([]string, error) {
+	pieces, err := sqlparser.SplitStatementToPieces(body)
+	if err != nil {
+		return nil, err
+	}
+	var stmts []string
+	for _, piece := range pieces {
+		if stmt := trimLeadingComments(piece); stmt != "" {
+			stmts = append(stmts, stmt+";")
+		}
+	}
+	return stmts, nil
+}
+
+func trimLeadingComments(piece string) string {
+	lines := strings.Split(strings.TrimSpace(piece), "\n")
+	for len(lines) > 0 {
+		line := strings.TrimSpace(lines[0])
+		if line != "" && !strings.HasPrefix(line, "--") && !strings.HasPrefix(line, "#") {
+			break
+		}
+		lines = lines[1:]
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
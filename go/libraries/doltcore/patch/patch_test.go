@@ -0,0 +1,88 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchRoundTrip(t *testing.T) {
+	first := &Patch{
+		CommitHash: "8n1ffbclr4tdfh5jpb4n8lo2iafe1cmi",
+		ParentHash: "cpr0ce6lbjb3rkp2n9jltofpd9ohb0mq",
+		Author:     "John Doe",
+		Email:      "john@doe.com",
+		Date:       time.Date(2026, 3, 14, 15, 9, 26, 535000000, time.UTC),
+		Message:    "add rows\n\nThe second paragraph\n  is indented.",
+		Statements: []string{
+			"INSERT INTO `t` (`pk`,`v`) VALUES (1,'a;b');",
+			"CREATE TABLE `u` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n);",
+		},
+	}
+	second := &Patch{
+		CommitHash: "2c3dkb0tt5k25kl76vn9svgi2amr9bl7",
+		ParentHash: first.CommitHash,
+		Author:     "Jane Doe",
+		Email:      "jane@doe.com",
+		Date:       time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		Message:    "delete a row",
+		Statements: []string{"DELETE FROM `t` WHERE `pk`=1;"},
+	}
+
+	patches, err := Parse(first.String())
+	require.NoError(t, err)
+	require.Len(t, patches, 1)
+	assert.Equal(t, first, patches[0])
+
+	patches, err = Parse(first.String() + second.String())
+	require.NoError(t, err)
+	require.Len(t, patches, 2)
+	assert.Equal(t, first, patches[0])
+	assert.Equal(t, second, patches[1])
+}
+
+func TestParseWithoutHeader(t *testing.T) {
+	patches, err := Parse("-- generated by dolt diff\nUPDATE `t` SET `v`='x' WHERE `pk`=1;\n\nDELETE FROM `t` WHERE `pk`=2;\n")
+	require.NoError(t, err)
+	require.Len(t, patches, 1)
+	assert.False(t, patches[0].HasHeader())
+	assert.Equal(t, []string{"UPDATE `t` SET `v`='x' WHERE `pk`=1;", "DELETE FROM `t` WHERE `pk`=2;"}, patches[0].Statements)
+
+	patches, err = Parse("\n-- nothing here\n")
+	require.NoError(t, err)
+	assert.Empty(t, patches)
+}
+
+func TestParseInvalidHeader(t *testing.T) {
+	_, err := Parse("# From: abc\n# Author: nobody\n#\n#     msg\n#\n# ---\n")
+	assert.ErrorContains(t, err, "author must be in the format 'Name <email>'")
+
+	_, err = Parse("# From: abc\n# Author: A <a@b.c>\n#\n#     msg\nINSERT INTO t VALUES (1);\n")
+	assert.ErrorContains(t, err, "unterminated message")
+
+	_, err = Parse("# From: abc\n# Author: A <a@b.c>\n# ---\n")
+	assert.ErrorContains(t, err, "missing author or message")
+}
+
+func TestFileName(t *testing.T) {
+	p := &Patch{Message: "Add the `users` table, and seed it!\n\nbody"}
+	assert.Equal(t, "0001-add-the-users-table-and-seed-it.sql", p.FileName(1))
+	p.Message = "!!!"
+	assert.Equal(t, "0012.sql", p.FileName(12))
+}
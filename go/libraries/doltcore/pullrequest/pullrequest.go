@@ -0,0 +1,236 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pullrequest stores the pull requests of a database. A pull request proposes merging a source branch into a
+// target branch, and collects comments and approvals from reviewers until it's merged. Pull requests aren't versioned:
+// they're stored alongside the branches of a database rather than in one of them, so every branch sees the same pull
+// requests. Like recorded conflict resolutions, they're local to a database and aren't pushed or cloned.
+package pullrequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// storeKey is the key of the tuple that pull requests are stored in.
+const storeKey = "pull_requests"
+
+const (
+	// StatusOpen is the status of a pull request which hasn't been merged
+	StatusOpen = "open"
+	// StatusMerged is the status of a pull request whose source branch was merged into its target branch
+	StatusMerged = "merged"
+)
+
+const (
+	// ReviewComment is the kind of review which only comments on a pull request
+	ReviewComment = "comment"
+	// ReviewApproval is the kind of review which approves the source commit of a pull request
+	ReviewApproval = "approval"
+)
+
+const (
+	// ActivityOpened is the activity of a pull request being opened
+	ActivityOpened = "opened"
+	// ActivitySynchronized is the activity of the source branch of an open pull request moving to a new commit
+	ActivitySynchronized = "synchronized"
+	// ActivityClosed is the activity of a pull request being closed by merging it
+	ActivityClosed = "closed"
+)
+
+// ErrPullRequestNotFound is returned when a pull request id doesn't exist.
+var ErrPullRequestNotFound = fmt.Errorf("pull request not found")
+
+// PullRequest is a proposal to merge a source branch into a target branch.
+type PullRequest struct {
+	ID           int64  `json:"id"`
+	Title        string `json:"title"`
+	Description  string `json:"description,omitempty"`
+	Author       string `json:"author"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	// SourceCommit is the commit of the source branch when the pull request was last opened, reviewed or merged.
+	// Approvals only count towards merging the pull request while they approve this commit.
+	SourceCommit string `json:"source_commit"`
+	// Reviewers are the users asked to review the pull request
+	Reviewers []string `json:"reviewers,omitempty"`
+	Status    string   `json:"status"`
+	// MergeCommit is the commit the pull request was merged with, once it's merged
+	MergeCommit string    `json:"merge_commit,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Review is a comment on, or an approval of, a pull request.
+type Review struct {
+	ID            int64  `json:"id"`
+	PullRequestID int64  `json:"pull_request_id"`
+	Reviewer      string `json:"reviewer"`
+	Kind          string `json:"kind"`
+	Body          string `json:"body,omitempty"`
+	// Commit is the source commit of the pull request when it was reviewed
+	Commit    string    `json:"commit"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Event is an activity of a pull request, with the dolt_ci workflows whose pull request events it triggered.
+type Event struct {
+	ID            int64     `json:"id"`
+	PullRequestID int64     `json:"pull_request_id"`
+	Activity      string    `json:"activity"`
+	Commit        string    `json:"commit"`
+	Workflows     []string  `json:"workflows,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Store is the set of pull requests of a database, with their reviews and events.
+type Store struct {
+	// LastID is the id of the last pull request opened. Reviews and events are never removed, so they're numbered by
+	// their position instead.
+	LastID       int64          `json:"last_id"`
+	PullRequests []*PullRequest `json:"pull_requests,omitempty"`
+	Reviews      []Review       `json:"reviews,omitempty"`
+	Events       []Event        `json:"events,omitempty"`
+}
+
+// Load returns the pull requests of |ddb|.
+func Load(ctx context.Context, ddb *doltdb.DoltDB) (*Store, error) {
+	b, _, err := ddb.GetTuple(ctx, storeKey)
+	if err != nil {
+		return nil, err
+	}
+	return decode(b)
+}
+
+// Update applies |edit| to the pull requests of |ddb| and saves them, unless |edit| returns an error. Pull requests
+// saved by another session in the meantime aren't lost: |edit| is applied again to the pull requests it saved, so it
+// must only depend on the Store it's given.
+func Update(ctx context.Context, ddb *doltdb.DoltDB, edit func(s *Store) error) error {
+	return ddb.UpdateTuple(ctx, storeKey, func(b []byte) ([]byte, bool, error) {
+		s, err := decode(b)
+		if err != nil {
+			return nil, false, err
+		}
+		if err = edit(s); err != nil {
+			return nil, false, err
+		}
+		b, err = json.Marshal(s)
+		if err != nil {
+			return nil, false, err
+		}
+		return b, true, nil
+	})
+}
+
+func decode(b []byte) (*Store, error) {
+	s := &Store{}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, s); err != nil {
+			return nil, fmt.Errorf("unable to read pull requests: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// Get returns the pull request with the id |id|.
+func (s *Store) Get(id int64) (*PullRequest, error) {
+	for _, pr := range s.PullRequests {
+		if pr.ID == id {
+			return pr, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d", ErrPullRequestNotFound, id)
+}
+
+// FindOpen returns the open pull request from |source| into |target|, if there is one.
+func (s *Store) FindOpen(source, target string) (*PullRequest, bool) {
+	for _, pr := range s.PullRequests {
+		if pr.Status == StatusOpen && pr.SourceBranch == source && pr.TargetBranch == target {
+			return pr, true
+		}
+	}
+	return nil, false
+}
+
+// Open adds an open pull request of the commit |sourceCommit| of |source| into |target|.
+func (s *Store) Open(title, description, author, source, target, sourceCommit string, reviewers []string, now time.Time) *PullRequest {
+	pr := &PullRequest{
+		ID:           s.LastID + 1,
+		Title:        title,
+		Description:  description,
+		Author:       author,
+		SourceBranch: source,
+		TargetBranch: target,
+		SourceCommit: sourceCommit,
+		Reviewers:    reviewers,
+		Status:       StatusOpen,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	s.LastID = pr.ID
+	s.PullRequests = append(s.PullRequests, pr)
+	return pr
+}
+
+// AddReview adds a review of the current source commit of |pr| by |reviewer|.
+func (s *Store) AddReview(pr *PullRequest, reviewer, kind, body string, now time.Time) Review {
+	r := Review{
+		ID:            int64(len(s.Reviews) + 1),
+		PullRequestID: pr.ID,
+		Reviewer:      reviewer,
+		Kind:          kind,
+		Body:          body,
+		Commit:        pr.SourceCommit,
+		CreatedAt:     now,
+	}
+	s.Reviews = append(s.Reviews, r)
+	pr.UpdatedAt = now
+	return r
+}
+
+// AddEvent records the activity |activity| of |pr|, which triggered |workflows|.
+func (s *Store) AddEvent(pr *PullRequest, activity string, workflows []string, now time.Time) Event {
+	e := Event{
+		ID:            int64(len(s.Events) + 1),
+		PullRequestID: pr.ID,
+		Activity:      activity,
+		Commit:        pr.SourceCommit,
+		Workflows:     workflows,
+		CreatedAt:     now,
+	}
+	s.Events = append(s.Events, e)
+	return e
+}
+
+// Approvals returns the reviewers who approved the current source commit of |pr|. The author of a pull request can't
+// approve it, and approvals of earlier source commits don't count.
+func (s *Store) Approvals(pr *PullRequest) []string {
+	var approvers []string
+	seen := make(map[string]struct{})
+	for _, r := range s.Reviews {
+		if r.PullRequestID != pr.ID || r.Kind != ReviewApproval || r.Commit != pr.SourceCommit || r.Reviewer == pr.Author {
+			continue
+		}
+		if _, ok := seen[r.Reviewer]; ok {
+			continue
+		}
+		seen[r.Reviewer] = struct{}{}
+		approvers = append(approvers, r.Reviewer)
+	}
+	return approvers
+}
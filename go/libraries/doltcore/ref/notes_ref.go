@@ -0,0 +1,57 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ref
+
+import (
+	"strings"
+)
+
+// DefaultNotesName is the name of the notes ref used when none is given, i.e. refs/notes/commits
+const DefaultNotesName = "commits"
+
+type NotesRef struct {
+	notes string
+}
+
+var _ DoltRef = NotesRef{}
+
+// NewNotesRef creates a reference to a set of commit notes.
+func NewNotesRef(notesName string) NotesRef {
+	if IsRef(notesName) {
+		prefix := PrefixForType(NotesRefType)
+		if strings.HasPrefix(notesName, prefix) {
+			notesName = notesName[len(prefix):]
+		} else {
+			panic(notesName + " is a ref that is not of type " + prefix)
+		}
+	}
+
+	return NotesRef{notesName}
+}
+
+// GetType will return NotesRefType
+func (nr NotesRef) GetType() RefType {
+	return NotesRefType
+}
+
+// GetPath returns the name of the notes
+func (nr NotesRef) GetPath() string {
+	return nr.notes
+}
+
+// String returns the fully qualified reference name e.g. refs/notes/commits
+func (nr NotesRef) String() string {
+	return String(nr)
+}
@@ -106,6 +106,8 @@ func ParseRefSpecForRemote(remote, refSpecStr string) (RefSpec, error) {
 		return NewBranchToBranchRefSpec(fromRef.(BranchRef), toRef.(BranchRef))
 	} else if fromRef.GetType() == TagRefType && toRef.GetType() == TagRefType {
 		return NewTagToTagRefSpec(fromRef.(TagRef), toRef.(TagRef))
+	} else if fromRef.GetType() == NotesRefType && toRef.GetType() == NotesRefType {
+		return NewNotesToNotesRefSpec(fromRef.(NotesRef), toRef.(NotesRef))
 	}
 
 	return nil, ErrUnsupportedMapping
@@ -207,6 +209,43 @@ func (rs TagToTagRefSpec) GetRemRefToLocal() branchMapper {
 	return identityBranchMapper(rs.srcRef.GetPath())
 }
 
+type NotesToNotesRefSpec struct {
+	srcRef  DoltRef
+	destRef DoltRef
+}
+
+// NewNotesToNotesRefSpec takes a source and destination NotesRef and returns a RefSpec that maps source to dest.
+func NewNotesToNotesRefSpec(srcRef, destRef NotesRef) (RefSpec, error) {
+	return NotesToNotesRefSpec{
+		srcRef:  srcRef,
+		destRef: destRef,
+	}, nil
+}
+
+// SrcRef will always determine the DoltRef specified as the source ref regardless to the cwbRef
+func (rs NotesToNotesRefSpec) SrcRef(_ DoltRef) DoltRef {
+	return rs.srcRef
+}
+
+// DestRef returns the destination notes ref if |r| is the source notes ref, or nil otherwise.
+func (rs NotesToNotesRefSpec) DestRef(r DoltRef) DoltRef {
+	if Equals(r, rs.srcRef) {
+		return rs.destRef
+	}
+
+	return nil
+}
+
+// GetRemote returns the name of the remote being operated on.
+func (rs NotesToNotesRefSpec) GetRemote() string {
+	return ""
+}
+
+// GetRemRefToLocal returns the local notes ref.
+func (rs NotesToNotesRefSpec) GetRemRefToLocal() branchMapper {
+	return identityBranchMapper(rs.srcRef.GetPath())
+}
+
 // BranchToTrackingBranchRefSpec maps a branch to the branch that should be tracking it
 type BranchToTrackingBranchRefSpec struct {
 	localPattern  pattern
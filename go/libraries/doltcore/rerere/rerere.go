@@ -0,0 +1,729 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rerere reuses recorded resolutions of merge conflicts. When a merge, cherry-pick or rebase stops with
+// conflicting rows, the conflicts are recorded as pending. When they're resolved and committed, the committed value
+// of each row is recorded as the resolution of its (base, ours, theirs) values. Later merges that produce the same
+// conflict for the same row reuse the recorded resolution instead of stopping. Recorded resolutions are local to a
+// database and aren't pushed or cloned. At most maxEntries resolutions are kept, evicting the least recently used.
+package rerere
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// cacheKey is the key of the tuple that recorded resolutions are stored in.
+const cacheKey = "rerere"
+
+// maxEntries is the most recorded resolutions kept, and the most pending conflicts recorded for a single merge.
+const maxEntries = 10000
+
+const (
+	// StatusResolved is the status of a conflict with a recorded resolution
+	StatusResolved = "resolved"
+	// StatusPending is the status of a conflict whose resolution will be recorded when it's committed
+	StatusPending = "pending"
+)
+
+// Resolution is the recorded resolution of a conflicting row.
+type Resolution struct {
+	Table doltdb.TableName `json:"table"`
+	Key   string           `json:"key"`
+	// Value is the resolved value tuple of the row, unless the row was deleted
+	Value   []byte `json:"value,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+	// Used is the cache's clock when the resolution was last recorded or reused
+	Used uint64 `json:"used,omitempty"`
+}
+
+// Pending is a conflicting row left by a merge on a branch, whose resolution will be recorded when the merge is
+// committed.
+type Pending struct {
+	ID         string           `json:"id"`
+	Branch     string           `json:"branch"`
+	Table      doltdb.TableName `json:"table"`
+	Key        string           `json:"key"`
+	KeyTuple   []byte           `json:"key_tuple"`
+	SchemaHash string           `json:"schema_hash"`
+}
+
+// Entry is a recorded resolution or a pending conflict, as listed by Entries.
+type Entry struct {
+	Table  doltdb.TableName
+	Key    string
+	Status string
+}
+
+// Update is a change to the pending conflicts of a branch made by a merge, or by aborting one. It isn't written by
+// the merge itself, but saved with Save once the transaction that made it commits, so that a merge which is rolled
+// back leaves nothing behind.
+type Update struct {
+	Branch ref.DoltRef
+	// Pending replaces the pending conflicts of Branch
+	Pending []Pending
+	// Used are the IDs of the recorded resolutions which were reused
+	Used []string
+}
+
+// Save writes the update to the recorded resolutions of |ddb|.
+func (u *Update) Save(ctx *sql.Context, ddb *doltdb.DoltDB) error {
+	return update(ctx, ddb, func(c *cache) (bool, error) {
+		c.Pending = append(c.withoutPending(func(p Pending) bool {
+			return p.Branch == u.Branch.String()
+		}), u.Pending...)
+		for _, id := range u.Used {
+			if res, ok := c.Resolutions[id]; ok {
+				c.Clock++
+				res.Used = c.Clock
+				c.Resolutions[id] = res
+			}
+		}
+		return true, nil
+	})
+}
+
+// Forget removes the pending conflicts of the tables named from the update.
+func (u *Update) Forget(tblNames []doltdb.TableName) {
+	var pending []Pending
+	for _, p := range u.Pending {
+		if !matchesAny(p.Table, tblNames) {
+			pending = append(pending, p)
+		}
+	}
+	u.Pending = pending
+}
+
+// cache is the set of recorded resolutions and pending conflicts of a database. Clock is incremented whenever a
+// resolution is recorded or reused, so that the least recently used resolutions can be evicted.
+type cache struct {
+	Resolutions map[string]Resolution `json:"resolutions,omitempty"`
+	Pending     []Pending             `json:"pending,omitempty"`
+	Clock       uint64                `json:"clock,omitempty"`
+}
+
+func load(ctx *sql.Context, ddb *doltdb.DoltDB) (*cache, error) {
+	b, _, err := ddb.GetTuple(ctx, cacheKey)
+	if err != nil {
+		return nil, err
+	}
+	return decode(b)
+}
+
+func decode(b []byte) (*cache, error) {
+	c := &cache{}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, c); err != nil {
+			return nil, fmt.Errorf("unable to read recorded resolutions: %w", err)
+		}
+	}
+	if c.Resolutions == nil {
+		c.Resolutions = make(map[string]Resolution)
+	}
+	return c, nil
+}
+
+// update applies |edit| to the cache and saves it, unless |edit| returns false. Concurrent updates by other sessions
+// aren't lost: if the cache is saved by another session first, |edit| is applied again to the cache it saved.
+func update(ctx *sql.Context, ddb *doltdb.DoltDB, edit func(c *cache) (bool, error)) error {
+	return ddb.UpdateTuple(ctx, cacheKey, func(b []byte) ([]byte, bool, error) {
+		c, err := decode(b)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok, err := edit(c); err != nil || !ok {
+			return nil, false, err
+		}
+		c.evict()
+		b, err = json.Marshal(c)
+		if err != nil {
+			return nil, false, err
+		}
+		return b, true, nil
+	})
+}
+
+// evict removes the least recently used resolutions until at most maxEntries are left.
+func (c *cache) evict() {
+	if len(c.Resolutions) <= maxEntries {
+		return
+	}
+	ids := make([]string, 0, len(c.Resolutions))
+	for id := range c.Resolutions {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return c.Resolutions[ids[i]].Used < c.Resolutions[ids[j]].Used
+	})
+	for _, id := range ids[:len(ids)-maxEntries] {
+		delete(c.Resolutions, id)
+	}
+}
+
+// withoutPending returns the pending conflicts which don't match |drop|.
+func (c *cache) withoutPending(drop func(p Pending) bool) []Pending {
+	var pending []Pending
+	for _, p := range c.Pending {
+		if !drop(p) {
+			pending = append(pending, p)
+		}
+	}
+	return pending
+}
+
+// Applied is the outcome of applying recorded resolutions to a root value.
+type Applied struct {
+	// Update records the conflicts which weren't resolved as pending conflicts of the branch
+	Update *Update
+	// Resolved is the number of conflicts resolved in each table
+	Resolved map[doltdb.TableName]int
+	// Violations is the number of unique key violations in each table found after resolving its conflicts
+	Violations map[doltdb.TableName]int
+}
+
+// Apply resolves the conflicting rows in |root| which have recorded resolutions, and returns the new root along with
+// an Update recording the rest as pending conflicts of |branch|, which replace any it had once the update is saved.
+// Unique keys are checked again for the resolved rows, since a recorded resolution can collide with rows that weren't
+// there when it was recorded, and any violations are recorded in the root like those found by a merge. Conflicts in
+// keyless tables are never resolved or recorded.
+func Apply(ctx *sql.Context, ddb *doltdb.DoltDB, branch ref.DoltRef, root doltdb.RootValue) (doltdb.RootValue, *Applied, error) {
+	c, err := load(ctx, ddb)
+	if err != nil {
+		return nil, nil, err
+	}
+	tblNames, err := doltdb.TablesWithDataConflicts(ctx, root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	applied := &Applied{
+		Update:     &Update{Branch: branch},
+		Resolved:   make(map[doltdb.TableName]int),
+		Violations: make(map[doltdb.TableName]int),
+	}
+	for _, tblName := range tblNames {
+		tbl, ok, err := root.GetTable(ctx, tblName)
+		if err != nil {
+			return nil, nil, err
+		} else if !ok {
+			continue
+		}
+		sch, err := tbl.GetSchema(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if schema.IsKeyless(sch) {
+			continue
+		}
+
+		tr, err := newTableResolver(ctx, c, branch, tblName, tbl, sch)
+		if err != nil {
+			return nil, nil, err
+		}
+		tbl, n, violations, err := tr.resolve(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, p := range tr.pending {
+			if len(applied.Update.Pending) < maxEntries {
+				applied.Update.Pending = append(applied.Update.Pending, p)
+			}
+		}
+		applied.Update.Used = append(applied.Update.Used, tr.used...)
+		if n == 0 {
+			continue
+		}
+		if root, err = root.PutTable(ctx, tblName, tbl); err != nil {
+			return nil, nil, err
+		}
+		applied.Resolved[tblName] = n
+		if violations > 0 {
+			applied.Violations[tblName] = violations
+		}
+	}
+	return root, applied, nil
+}
+
+// ApplyToMergeResult applies recorded resolutions to the conflicts in |result|, updating its root and the counts of
+// data conflicts and constraint violations of each table. It returns the Update to save once the merge is committed,
+// which is nil if the merge had no data conflicts. See Apply.
+func ApplyToMergeResult(ctx *sql.Context, ddb *doltdb.DoltDB, branch ref.DoltRef, result *merge.Result) (*Update, error) {
+	hasConflicts := false
+	for _, stats := range result.Stats {
+		if stats.HasDataConflicts() {
+			hasConflicts = true
+			break
+		}
+	}
+	if !hasConflicts {
+		return nil, nil
+	}
+
+	root, applied, err := Apply(ctx, ddb, branch, result.Root)
+	if err != nil {
+		return nil, err
+	}
+	result.Root = root
+	for tblName, n := range applied.Resolved {
+		if stats, ok := result.Stats[tblName]; ok {
+			stats.DataConflicts -= n
+			stats.ConstraintViolations += applied.Violations[tblName]
+		}
+	}
+	return applied.Update, nil
+}
+
+// RecordResolutions records the resolutions of the pending conflicts of |branch|, which were committed with the root
+// value |root|. Conflicts whose tables changed schema, or still have conflicts, are discarded, as are conflicts whose
+// resolved rows store values out-of-band.
+func RecordResolutions(ctx *sql.Context, ddb *doltdb.DoltDB, branch ref.DoltRef, root doltdb.RootValue) error {
+	return update(ctx, ddb, func(c *cache) (bool, error) {
+		isBranch := func(p Pending) bool {
+			return p.Branch == branch.String()
+		}
+		remaining := c.withoutPending(isBranch)
+		if len(remaining) == len(c.Pending) {
+			return false, nil
+		}
+
+		for _, p := range c.Pending {
+			if !isBranch(p) {
+				continue
+			}
+			res, ok, err := resolutionOf(ctx, root, p)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				c.Clock++
+				res.Used = c.Clock
+				c.Resolutions[p.ID] = res
+			}
+		}
+
+		c.Pending = remaining
+		return true, nil
+	})
+}
+
+// resolutionOf returns the committed value of the pending conflict |p| in |root|.
+func resolutionOf(ctx *sql.Context, root doltdb.RootValue, p Pending) (Resolution, bool, error) {
+	tbl, ok, err := root.GetTable(ctx, p.Table)
+	if err != nil || !ok {
+		return Resolution{}, false, err
+	}
+	schHash, err := tbl.GetSchemaHash(ctx)
+	if err != nil {
+		return Resolution{}, false, err
+	}
+	if schHash.String() != p.SchemaHash {
+		return Resolution{}, false, nil
+	}
+	if hasConflicts, err := tbl.HasConflicts(ctx); err != nil || hasConflicts {
+		return Resolution{}, false, err
+	}
+
+	rows, err := tableRows(ctx, tbl)
+	if err != nil {
+		return Resolution{}, false, err
+	}
+	_, vd := rows.Descriptors()
+	res := Resolution{Table: p.Table, Key: p.Key, Deleted: true}
+	outOfBand := false
+	err = rows.Get(ctx, val.Tuple(p.KeyTuple), func(_, v val.Tuple) error {
+		if v != nil {
+			res.Value = append([]byte(nil), v...)
+			res.Deleted = false
+			outOfBand = hasOutOfBandValues(vd, v)
+		}
+		return nil
+	})
+	if err != nil || outOfBand {
+		return Resolution{}, false, err
+	}
+	return res, true, nil
+}
+
+// hasOutOfBandValues returns whether the value tuple |v|, described by |vd|, stores any of its fields out-of-band, as
+// the address of a chunk, e.g. large TEXT, BLOB and JSON values. Recorded resolutions are stored as raw tuples, whose
+// addresses garbage collection doesn't walk, so a resolution reused after the chunks were collected would write a row
+// referring to missing chunks.
+func hasOutOfBandValues(vd *val.TupleDesc, v val.Tuple) bool {
+	for i, typ := range vd.Types {
+		field := vd.GetField(i, v)
+		if field == nil {
+			continue
+		}
+		if val.IsAddrEncoding(typ.Enc) || (val.IsAdaptiveEncoding(typ.Enc) && val.AdaptiveValue(field).IsOutOfBand()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Forget removes the recorded resolutions and pending conflicts of the tables named.
+func Forget(ctx *sql.Context, ddb *doltdb.DoltDB, tblNames []doltdb.TableName) error {
+	return update(ctx, ddb, func(c *cache) (bool, error) {
+		for id, res := range c.Resolutions {
+			if matchesAny(res.Table, tblNames) {
+				delete(c.Resolutions, id)
+			}
+		}
+		c.Pending = c.withoutPending(func(p Pending) bool {
+			return matchesAny(p.Table, tblNames)
+		})
+		return true, nil
+	})
+}
+
+// Clear removes the pending conflicts of |branch|, so that their resolutions won't be recorded.
+func Clear(ctx *sql.Context, ddb *doltdb.DoltDB, branch ref.DoltRef) error {
+	return (&Update{Branch: branch}).Save(ctx, ddb)
+}
+
+// Entries returns the recorded resolutions, and the pending conflicts of |branch|, ordered by table and key. If
+// |unsaved| isn't nil, it's an update of |branch| which hasn't been saved yet, and its pending conflicts are listed
+// instead of the saved ones.
+func Entries(ctx *sql.Context, ddb *doltdb.DoltDB, branch ref.DoltRef, unsaved *Update) ([]Entry, error) {
+	c, err := load(ctx, ddb)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, res := range c.Resolutions {
+		entries = append(entries, Entry{Table: res.Table, Key: res.Key, Status: StatusResolved})
+	}
+	pending := c.Pending
+	if unsaved != nil {
+		pending = unsaved.Pending
+	}
+	for _, p := range pending {
+		if branch != nil && p.Branch == branch.String() {
+			entries = append(entries, Entry{Table: p.Table, Key: p.Key, Status: StatusPending})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Table != entries[j].Table {
+			return entries[i].Table.Less(entries[j].Table)
+		} else if entries[i].Key != entries[j].Key {
+			return entries[i].Key < entries[j].Key
+		}
+		return entries[i].Status < entries[j].Status
+	})
+	return entries, nil
+}
+
+// matchesAny returns whether |t| is one of |tblNames|, ignoring case.
+func matchesAny(t doltdb.TableName, tblNames []doltdb.TableName) bool {
+	for _, tblName := range tblNames {
+		if t.EqualFold(tblName) {
+			return true
+		}
+	}
+	return false
+}
+
+// tableResolver resolves the conflicts of a single table using recorded resolutions.
+type tableResolver struct {
+	cache      *cache
+	branch     ref.DoltRef
+	tblName    doltdb.TableName
+	tbl        *doltdb.Table
+	sch        schema.Schema
+	schemaHash hash.Hash
+	ourRows    prolly.Map
+
+	baseHash, theirHash hash.Hash
+	baseRows, theirRows prolly.Map
+
+	pending []Pending
+	used    []string
+}
+
+func newTableResolver(ctx *sql.Context, c *cache, branch ref.DoltRef, tblName doltdb.TableName, tbl *doltdb.Table, sch schema.Schema) (*tableResolver, error) {
+	schHash, err := tbl.GetSchemaHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ourRows, err := tableRows(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+	return &tableResolver{
+		cache:      c,
+		branch:     branch,
+		tblName:    tblName,
+		tbl:        tbl,
+		sch:        sch,
+		schemaHash: schHash,
+		ourRows:    ourRows,
+	}, nil
+}
+
+// resolvedRow is a conflicting row whose recorded resolution, which didn't delete it, was replayed.
+type resolvedRow struct {
+	key, value   val.Tuple
+	theirRootIsh hash.Hash
+}
+
+// resolve applies the recorded resolutions of the table's conflicts, returning the updated table, the number of
+// conflicts resolved and the number of unique key violations recorded for the resolved rows. Conflicts without a
+// recorded resolution are collected as pending.
+func (tr *tableResolver) resolve(ctx *sql.Context) (*doltdb.Table, int, int, error) {
+	artIdx, err := tr.tbl.GetArtifacts(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	artMap := durable.ProllyMapFromArtifactIndex(artIdx)
+	iter, err := artMap.IterAllConflicts(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	artEd := artMap.Editor()
+	mutMap := tr.ourRows.Mutate()
+	idxSet, err := tr.tbl.GetIndexSet(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	mutIdxs, err := merge.GetMutableSecondaryIdxs(ctx, tr.sch, tr.sch, tr.tblName.Name, idxSet)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	resolved := 0
+	var replayed []resolvedRow
+	for {
+		ca, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, 0, 0, err
+		}
+
+		base, ours, theirs, err := tr.conflictValues(ctx, ca)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		id := tr.conflictID(ca.Key, base, ours, theirs)
+		res, ok := tr.cache.Resolutions[id]
+		if !ok {
+			key, err := formatKey(ctx, tr.sch, ca.Key, tr.tbl.NodeStore())
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			tr.pending = append(tr.pending, Pending{
+				ID:         id,
+				Branch:     tr.branch.String(),
+				Table:      tr.tblName,
+				Key:        key,
+				KeyTuple:   append([]byte(nil), ca.Key...),
+				SchemaHash: tr.schemaHash.String(),
+			})
+			continue
+		}
+		tr.used = append(tr.used, id)
+
+		if res.Deleted {
+			err = mutMap.Delete(ctx, ca.Key)
+		} else {
+			err = mutMap.Put(ctx, ca.Key, val.Tuple(res.Value))
+		}
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		for _, mutIdx := range mutIdxs {
+			if ours == nil && !res.Deleted {
+				err = mutIdx.InsertEntry(ctx, ca.Key, val.Tuple(res.Value))
+			} else if ours != nil && res.Deleted {
+				err = mutIdx.DeleteEntry(ctx, ca.Key, ours)
+			} else if ours != nil {
+				err = mutIdx.UpdateEntry(ctx, ca.Key, ours, val.Tuple(res.Value))
+			}
+			if err != nil {
+				return nil, 0, 0, err
+			}
+		}
+
+		artKey, err := artEd.BuildArtifactKey(ctx, ca.Key, ca.TheirRootIsh, prolly.ArtifactTypeConflict, nil)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if err = artEd.Delete(ctx, artKey); err != nil {
+			return nil, 0, 0, err
+		}
+		if !res.Deleted {
+			replayed = append(replayed, resolvedRow{
+				key:          append(val.Tuple(nil), ca.Key...),
+				value:        val.Tuple(res.Value),
+				theirRootIsh: ca.TheirRootIsh,
+			})
+		}
+		resolved++
+	}
+
+	if resolved == 0 {
+		return tr.tbl, 0, 0, nil
+	}
+
+	rows, err := mutMap.Map(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	tbl, err := tr.tbl.UpdateRows(ctx, durable.IndexFromProllyMap(rows))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	for _, mutIdx := range mutIdxs {
+		m, err := mutIdx.Map(ctx)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		idxSet, err = idxSet.PutIndex(ctx, mutIdx.Name, durable.IndexFromProllyMap(m))
+		if err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	if tbl, err = tbl.SetIndexSet(ctx, idxSet); err != nil {
+		return nil, 0, 0, err
+	}
+
+	uniq, err := merge.NewUniqueKeyChecker(ctx, tr.sch, tr.tblName.Name, rows, idxSet)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	violations := 0
+	for _, r := range replayed {
+		n, err := uniq.ReplaceViolations(ctx, artEd, r.theirRootIsh, r.key, r.value)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		violations += n
+	}
+
+	arts, err := artEd.Flush(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	tbl, err = tbl.SetArtifacts(ctx, durable.ArtifactIndexFromProllyMap(arts))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return tbl, resolved, violations, nil
+}
+
+// conflictValues returns the base, our and their values of the conflicting row |ca|, any of which are nil if the
+// row doesn't exist on that side.
+func (tr *tableResolver) conflictValues(ctx *sql.Context, ca prolly.ConflictArtifact) (base, ours, theirs val.Tuple, err error) {
+	if tr.baseHash != ca.Metadata.BaseRootIsh {
+		if tr.baseRows, err = tr.rowsAt(ctx, ca.Metadata.BaseRootIsh); err != nil {
+			return nil, nil, nil, err
+		}
+		tr.baseHash = ca.Metadata.BaseRootIsh
+	}
+	if tr.theirHash != ca.TheirRootIsh {
+		if tr.theirRows, err = tr.rowsAt(ctx, ca.TheirRootIsh); err != nil {
+			return nil, nil, nil, err
+		}
+		tr.theirHash = ca.TheirRootIsh
+	}
+
+	get := func(m prolly.Map) (v val.Tuple, err error) {
+		err = m.Get(ctx, ca.Key, func(_, value val.Tuple) error {
+			v = value
+			return nil
+		})
+		return v, err
+	}
+	if base, err = get(tr.baseRows); err != nil {
+		return nil, nil, nil, err
+	}
+	if ours, err = get(tr.ourRows); err != nil {
+		return nil, nil, nil, err
+	}
+	if theirs, err = get(tr.theirRows); err != nil {
+		return nil, nil, nil, err
+	}
+	return base, ours, theirs, nil
+}
+
+// rowsAt returns the rows of the table in the root value with root-ish address |h|, which are empty if the table
+// doesn't exist there.
+func (tr *tableResolver) rowsAt(ctx *sql.Context, h hash.Hash) (prolly.Map, error) {
+	root, err := doltdb.LoadRootValueFromRootIshAddr(ctx, tr.tbl.ValueReadWriter(), tr.tbl.NodeStore(), h)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	tbl, ok, err := root.GetTable(ctx, tr.tblName)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	if !ok {
+		idx, err := durable.NewEmptyPrimaryIndex(ctx, tr.tbl.ValueReadWriter(), tr.tbl.NodeStore(), tr.sch)
+		if err != nil {
+			return prolly.Map{}, err
+		}
+		return durable.ProllyMapFromIndex(idx)
+	}
+	return tableRows(ctx, tbl)
+}
+
+// conflictID identifies a conflict by the table, its schema, and the key and values of the conflicting row.
+func (tr *tableResolver) conflictID(key, base, ours, theirs val.Tuple) string {
+	var buf []byte
+	for _, b := range [][]byte{[]byte(tr.tblName.String()), tr.schemaHash[:], key, base, ours, theirs} {
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		buf = append(buf, b...)
+	}
+	return hash.Of(buf).String()
+}
+
+// formatKey returns the primary key values of |key| as a comma-separated string.
+func formatKey(ctx *sql.Context, sch schema.Schema, key val.Tuple, ns tree.NodeStore) (string, error) {
+	kd := sch.GetKeyDescriptor(ns)
+	vals := make([]string, kd.Count())
+	for i := range vals {
+		v, err := tree.GetField(ctx, kd, i, key, ns)
+		if err != nil {
+			return "", err
+		}
+		vals[i] = fmt.Sprint(v)
+	}
+	return strings.Join(vals, ","), nil
+}
+
+func tableRows(ctx *sql.Context, tbl *doltdb.Table) (prolly.Map, error) {
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	return durable.ProllyMapFromIndex(idx)
+}
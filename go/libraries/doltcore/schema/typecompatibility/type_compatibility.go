@@ -61,6 +61,8 @@ func newDoltTypeCompatibilityChecker() TypeCompatibilityChecker {
 			stringTypeChangeHandler{},
 			enumTypeChangeHandler{},
 			setTypeChangeHandler{},
+			integerTypeChangeHandler{},
+			decimalTypeChangeHandler{},
 		},
 	}
 }
@@ -277,3 +279,85 @@ func (s setTypeChangeHandler) isCompatible(fromType, toType sql.Type) (res TypeC
 	res.Compatible = true
 	return res
 }
+
+// integerTypeChangeHandler handles type change compatibility checking for changes between integer types. Widening an
+// integer type (e.g. INT to BIGINT, or SMALLINT UNSIGNED to INT) is considered compatible, since every value of the
+// old type can be represented in the new type. The DOLT storage format encodes each integer size differently, so the
+// table data and any secondary indexes need to be rewritten.
+type integerTypeChangeHandler struct{}
+
+var _ typeChangeHandler = (*integerTypeChangeHandler)(nil)
+
+// canHandle implements the typeChangeHandler interface.
+func (i integerTypeChangeHandler) canHandle(fromSqlType, toSqlType sql.Type) bool {
+	_, fromOk := integerTypeSizes[fromSqlType.Type()]
+	_, toOk := integerTypeSizes[toSqlType.Type()]
+	return fromOk && toOk
+}
+
+// isCompatible implements the typeChangeHandler interface.
+func (i integerTypeChangeHandler) isCompatible(fromSqlType, toSqlType sql.Type) (res TypeChangeInfo) {
+	fromSize, toSize := integerTypeSizes[fromSqlType.Type()], integerTypeSizes[toSqlType.Type()]
+	fromUnsigned, toUnsigned := sqltypes.IsUnsigned(fromSqlType.Type()), sqltypes.IsUnsigned(toSqlType.Type())
+
+	switch {
+	case fromUnsigned == toUnsigned:
+		res.Compatible = toSize >= fromSize
+	case fromUnsigned && !toUnsigned:
+		// a signed type needs one more bit to hold all values of an unsigned type of the same size
+		res.Compatible = toSize > fromSize
+	default:
+		// negative values can never be stored in an unsigned type
+		res.Compatible = false
+	}
+
+	if res.Compatible && fromSqlType.Type() != toSqlType.Type() {
+		res.RewriteRows = true
+		res.InvalidateSecondaryIndexes = true
+	}
+	return res
+}
+
+// integerTypeSizes maps each integer type to its size in bytes.
+var integerTypeSizes = map[query.Type]int{
+	query.Type_INT8:   1,
+	query.Type_UINT8:  1,
+	query.Type_INT16:  2,
+	query.Type_UINT16: 2,
+	query.Type_INT24:  3,
+	query.Type_UINT24: 3,
+	query.Type_INT32:  4,
+	query.Type_UINT32: 4,
+	query.Type_INT64:  8,
+	query.Type_UINT64: 8,
+}
+
+// decimalTypeChangeHandler handles type change compatibility checking for changes between decimal types. A change
+// is considered compatible when neither the number of integer digits nor the number of fractional digits shrinks,
+// e.g. DECIMAL(4,2) to DECIMAL(8,4). The DOLT storage format stores the exponent of each decimal value, so changing
+// the scale requires the table data and any secondary indexes to be rewritten.
+type decimalTypeChangeHandler struct{}
+
+var _ typeChangeHandler = (*decimalTypeChangeHandler)(nil)
+
+// canHandle implements the typeChangeHandler interface.
+func (d decimalTypeChangeHandler) canHandle(fromSqlType, toSqlType sql.Type) bool {
+	return types.IsDecimal(fromSqlType) && types.IsDecimal(toSqlType)
+}
+
+// isCompatible implements the typeChangeHandler interface.
+func (d decimalTypeChangeHandler) isCompatible(fromSqlType, toSqlType sql.Type) (res TypeChangeInfo) {
+	fromDecimalType := fromSqlType.(sql.DecimalType)
+	toDecimalType := toSqlType.(sql.DecimalType)
+
+	fromScale, toScale := fromDecimalType.Scale(), toDecimalType.Scale()
+	fromIntegerDigits := int(fromDecimalType.Precision()) - int(fromScale)
+	toIntegerDigits := int(toDecimalType.Precision()) - int(toScale)
+	res.Compatible = toScale >= fromScale && toIntegerDigits >= fromIntegerDigits
+
+	if res.Compatible && fromScale != toScale {
+		res.RewriteRows = true
+		res.InvalidateSecondaryIndexes = true
+	}
+	return res
+}
@@ -72,6 +72,12 @@ var varbinary10 = mustCreateType(gmstypes.MustCreateString(sqltypes.VarBinary, 1
 var blob = mustCreateType(gmstypes.MustCreateString(sqltypes.Blob, 65_535, sql.Collation_binary))
 var mediumBlob = mustCreateType(gmstypes.MustCreateBinary(sqltypes.Blob, 16_777_215))
 
+// Decimal type test data
+var decimal4_2 = mustCreateType(gmstypes.MustCreateDecimalType(4, 2))
+var decimal4_3 = mustCreateType(gmstypes.MustCreateDecimalType(4, 3))
+var decimal8_2 = mustCreateType(gmstypes.MustCreateDecimalType(8, 2))
+var decimal8_4 = mustCreateType(gmstypes.MustCreateDecimalType(8, 4))
+
 // ExtendedType test data
 var extendedTypeInfo = typeinfo.CreateExtendedTypeFromSqlType(extendedType{})
 
@@ -86,15 +92,63 @@ func TestDoltIsTypeChangeCompatible(t *testing.T) {
 			to:         typeinfo.Int64Type,
 			compatible: true,
 		}, {
-			name:       "int family: small to large type changes are incompatible",
-			from:       typeinfo.Int8Type,
-			to:         typeinfo.Int16Type,
-			compatible: false,
+			name:                       "int family: small to large type changes are compatible",
+			from:                       typeinfo.Int8Type,
+			to:                         typeinfo.Int16Type,
+			compatible:                 true,
+			rewrite:                    true,
+			invalidateSecondaryIndexes: true,
+		}, {
+			name:                       "int family: INT to BIGINT is compatible",
+			from:                       typeinfo.Int32Type,
+			to:                         typeinfo.Int64Type,
+			compatible:                 true,
+			rewrite:                    true,
+			invalidateSecondaryIndexes: true,
 		}, {
 			name:       "int family: large to small type changes are incompatible",
 			from:       typeinfo.Int64Type,
 			to:         typeinfo.Int16Type,
 			compatible: false,
+		}, {
+			name:                       "int family: unsigned to larger signed type changes are compatible",
+			from:                       typeinfo.Uint16Type,
+			to:                         typeinfo.Int32Type,
+			compatible:                 true,
+			rewrite:                    true,
+			invalidateSecondaryIndexes: true,
+		}, {
+			name:       "int family: unsigned to signed type of the same size is incompatible",
+			from:       typeinfo.Uint32Type,
+			to:         typeinfo.Int32Type,
+			compatible: false,
+		}, {
+			name:       "int family: signed to unsigned type changes are incompatible",
+			from:       typeinfo.Int16Type,
+			to:         typeinfo.Uint64Type,
+			compatible: false,
+		}, {
+			name:       "decimal: precision increase is compatible",
+			from:       decimal4_2,
+			to:         decimal8_2,
+			compatible: true,
+		}, {
+			name:                       "decimal: precision and scale increase is compatible",
+			from:                       decimal4_2,
+			to:                         decimal8_4,
+			compatible:                 true,
+			rewrite:                    true,
+			invalidateSecondaryIndexes: true,
+		}, {
+			name:       "decimal: scale decrease is incompatible",
+			from:       decimal8_4,
+			to:         decimal8_2,
+			compatible: false,
+		}, {
+			name:       "decimal: scale increase without precision increase is incompatible",
+			from:       decimal4_2,
+			to:         decimal4_3,
+			compatible: false,
 		}, {
 			name:       "additive enum changes are compatible",
 			from:       abcEnum,
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -64,6 +65,7 @@ const (
 	DefaultAutoGCBehaviorEnable      = true
 	DefaultDoltTransactionCommit     = false
 	DefaultBranchActivityTracking    = false
+	DefaultRunCIWorkflows            = false
 	DefaultMaxConnections            = 1000
 	DefaultMaxWaitConnections        = 50
 	DefaultMaxWaitConnectionsTimeout = 60 * time.Second
@@ -114,6 +116,29 @@ type ClusterConfig interface {
 	RemotesAPIConfig() ClusterRemotesAPIConfig
 }
 
+// ChangeDataCaptureConfig is the configuration for writing the row changes of the commits made to the server's
+// databases as change events to a file or named pipe, one JSON object per line.
+type ChangeDataCaptureConfig interface {
+	// Path is the file or named pipe the change events are written to.
+	Path() string
+	// Branches are the branches whose commits are written, or nil for all branches.
+	Branches() []string
+}
+
+// WebhookConfig is the configuration of an HTTP endpoint which is sent a JSON payload when the branches and tags of
+// the server's databases are updated.
+type WebhookConfig interface {
+	// Name identifies the webhook in its deliveries. It defaults to the URL.
+	Name() string
+	// URL is the endpoint the payloads are POSTed to.
+	URL() string
+	// Refs are the patterns of the refs, such as refs/heads/main or refs/tags/*, whose updates are delivered, or nil
+	// for all branches and tags.
+	Refs() []string
+	// Secret is the key the payloads are signed with, or empty if they aren't signed.
+	Secret() string
+}
+
 type ClusterRemotesAPIConfig interface {
 	Address() string
 	Port() int
@@ -165,6 +190,9 @@ type ServerConfig interface {
 	DoltTransactionCommit() bool
 	// BranchActivityTracking enables or disables the tracking of branch activity for the dolt_branch_activity table
 	BranchActivityTracking() bool
+	// RunCIWorkflows enables or disables running the dolt_ci workflows triggered by branch updates, pull requests and
+	// schedules, and recording their runs in dolt_ci_runs
+	RunCIWorkflows() bool
 	// DataDir is the path to a directory to use as the data dir, both to create new databases and locate existing ones.
 	DataDir() string
 	// CfgDir is the path to a directory to use to store the dolt configuration files.
@@ -243,6 +271,11 @@ type ServerConfig interface {
 	MCPDatabase() *string
 	// ClusterConfig is the configuration for clustering in this sql-server.
 	ClusterConfig() ClusterConfig
+	// ChangeDataCapture is the configuration for writing the row changes of new commits to a file, or nil if they
+	// aren't written.
+	ChangeDataCapture() ChangeDataCaptureConfig
+	// Webhooks are the HTTP endpoints which are notified of the updates of branches and tags.
+	Webhooks() []WebhookConfig
 	// EventSchedulerStatus is the configuration for enabling or disabling the event scheduler in this server.
 	EventSchedulerStatus() string
 	// ValueSet returns whether the value string provided was explicitly set in the config
@@ -269,6 +302,7 @@ func defaultServerConfigYAML() *YAMLConfig {
 			AutoCommit:             ptr(DefaultAutoCommit),
 			DoltTransactionCommit:  ptr(DefaultDoltTransactionCommit),
 			BranchActivityTracking: ptr(DefaultBranchActivityTracking),
+			RunCIWorkflows:         ptr(DefaultRunCIWorkflows),
 			AutoGCBehavior: &AutoGCBehaviorYAMLConfig{
 				Enable_:       ptr(DefaultAutoGCBehaviorEnable),
 				ArchiveLevel_: ptr(DefaultCompressionLevel),
@@ -332,9 +366,35 @@ func ValidateConfig(config ServerConfig) error {
 	if config.RequireSecureTransport() && config.TLSCert() == "" && config.TLSKey() == "" {
 		return fmt.Errorf("require_secure_transport can only be `true` when a tls_key and tls_cert are provided.")
 	}
+	if cdc := config.ChangeDataCapture(); cdc != nil && cdc.Path() == "" {
+		return fmt.Errorf("change_data_capture.path is required to capture changes")
+	}
+	if err := ValidateWebhooks(config.Webhooks()); err != nil {
+		return err
+	}
 	return ValidateClusterConfig(config.ClusterConfig())
 }
 
+// ValidateWebhooks returns an error if a webhook has no URL, or if two webhooks have the same name.
+func ValidateWebhooks(webhooks []WebhookConfig) error {
+	names := make(map[string]struct{}, len(webhooks))
+	for _, wh := range webhooks {
+		if wh.URL() == "" {
+			return fmt.Errorf("webhooks: url is required for each webhook")
+		}
+		if _, ok := names[wh.Name()]; ok {
+			return fmt.Errorf("webhooks: name %s is used by more than one webhook", wh.Name())
+		}
+		names[wh.Name()] = struct{}{}
+		for _, pattern := range wh.Refs() {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("webhooks: invalid ref pattern %s for webhook %s", pattern, wh.Name())
+			}
+		}
+	}
+	return nil
+}
+
 const (
 	HostKey                           = "host"
 	PortKey                           = "port"
@@ -348,6 +408,7 @@ const (
 	AutoCommitKey                     = "autocommit"
 	DoltTransactionCommitKey          = "dolt_transaction_commit"
 	BranchActivityTrackingKey         = "branch_activity_tracking"
+	RunCIWorkflowsKey                 = "run_ci_workflows"
 	DataDirKey                        = "data_dir"
 	CfgDirKey                         = "cfg_dir"
 	MaxConnectionsKey                 = "max_connections"
@@ -71,6 +71,8 @@ type BehaviorYAMLConfig struct {
 	AutoGCBehavior *AutoGCBehaviorYAMLConfig `yaml:"auto_gc_behavior,omitempty" minver:"1.50.0"`
 
 	BranchActivityTracking *bool `yaml:"branch_activity_tracking,omitempty" minver:"1.77.0"`
+
+	RunCIWorkflows *bool `yaml:"run_ci_workflows,omitempty" minver:"TBD"`
 }
 
 // UserYAMLConfig contains server configuration regarding the user account clients must use to connect
@@ -171,6 +173,10 @@ type YAMLConfig struct {
 	GoldenMysqlConn *string                `yaml:"golden_mysql_conn,omitempty"`
 	MetricsConfig   MetricsYAMLConfig      `yaml:"metrics,omitempty"`
 	ClusterCfg      *ClusterYAMLConfig     `yaml:"cluster,omitempty"`
+	// CDCCfg configures writing the row changes of new commits to a file
+	CDCCfg *ChangeDataCaptureYAMLConfig `yaml:"change_data_capture,omitempty" minver:"TBD"`
+	// WebhooksCfg are the HTTP endpoints which are notified of the updates of branches and tags
+	WebhooksCfg []WebhookYAMLConfig `yaml:"webhooks,omitempty" minver:"TBD"`
 }
 
 var _ ServerConfig = YAMLConfig{}
@@ -221,6 +227,7 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 			DisableClientMultiStatements: ptr(cfg.DisableClientMultiStatements()),
 			DoltTransactionCommit:        ptr(cfg.DoltTransactionCommit()),
 			BranchActivityTracking:       ptr(cfg.BranchActivityTracking()),
+			RunCIWorkflows:               ptr(cfg.RunCIWorkflows()),
 			EventSchedulerStatus:         ptr(cfg.EventSchedulerStatus()),
 			AutoGCBehavior:               autoGCBehavior,
 		},
@@ -255,6 +262,8 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 			ReadOnly_: cfg.RemotesapiReadOnly(),
 		},
 		ClusterCfg:        clusterConfigAsYAMLConfig(cfg.ClusterConfig()),
+		CDCCfg:            changeDataCaptureAsYAMLConfig(cfg.ChangeDataCapture()),
+		WebhooksCfg:       webhooksAsYAMLConfig(cfg.Webhooks()),
 		PrivilegeFile:     ptr(cfg.PrivilegeFilePath()),
 		BranchControlFile: ptr(cfg.BranchControlFilePath()),
 		SystemVars_:       systemVars,
@@ -284,6 +293,34 @@ func clusterConfigAsYAMLConfig(config ClusterConfig) *ClusterYAMLConfig {
 	}
 }
 
+func changeDataCaptureAsYAMLConfig(config ChangeDataCaptureConfig) *ChangeDataCaptureYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &ChangeDataCaptureYAMLConfig{
+		Path_:     ptr(config.Path()),
+		Branches_: config.Branches(),
+	}
+}
+
+func webhooksAsYAMLConfig(config []WebhookConfig) []WebhookYAMLConfig {
+	if len(config) == 0 {
+		return nil
+	}
+
+	webhooks := make([]WebhookYAMLConfig, len(config))
+	for i, wh := range config {
+		webhooks[i] = WebhookYAMLConfig{
+			Name_:   ptr(wh.Name()),
+			URL_:    ptr(wh.URL()),
+			Refs_:   wh.Refs(),
+			Secret_: nillableStrPtr(wh.Secret()),
+		}
+	}
+	return webhooks
+}
+
 // ServerConfigSetValuesAsYAMLConfig returns a YAMLConfig containing only values
 // that were explicitly set in the given ServerConfig.
 func ServerConfigSetValuesAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
@@ -300,6 +337,7 @@ func ServerConfigSetValuesAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 			DisableClientMultiStatements: zeroIf(ptr(cfg.DisableClientMultiStatements()), !cfg.ValueSet(DisableClientMultiStatementsKey)),
 			DoltTransactionCommit:        zeroIf(ptr(cfg.DoltTransactionCommit()), !cfg.ValueSet(DoltTransactionCommitKey)),
 			BranchActivityTracking:       zeroIf(ptr(cfg.BranchActivityTracking()), !cfg.ValueSet(BranchActivityTrackingKey)),
+			RunCIWorkflows:               zeroIf(ptr(cfg.RunCIWorkflows()), !cfg.ValueSet(RunCIWorkflowsKey)),
 			EventSchedulerStatus:         zeroIf(ptr(cfg.EventSchedulerStatus()), !cfg.ValueSet(EventSchedulerKey)),
 		},
 		ListenerConfig: ListenerYAMLConfig{
@@ -711,6 +749,15 @@ func (cfg YAMLConfig) BranchActivityTracking() bool {
 	return *cfg.BehaviorConfig.BranchActivityTracking
 }
 
+// RunCIWorkflows enables or disables running the dolt_ci workflows of the server's databases
+func (cfg YAMLConfig) RunCIWorkflows() bool {
+	if cfg.BehaviorConfig.RunCIWorkflows == nil {
+		return DefaultRunCIWorkflows
+	}
+
+	return *cfg.BehaviorConfig.RunCIWorkflows
+}
+
 // LogLevel returns the level of logging that the server will use.
 func (cfg YAMLConfig) LogLevel() LogLevel {
 	if cfg.LogLevelStr == nil {
@@ -1019,6 +1066,24 @@ func (cfg YAMLConfig) ClusterConfig() ClusterConfig {
 	return cfg.ClusterCfg
 }
 
+func (cfg YAMLConfig) ChangeDataCapture() ChangeDataCaptureConfig {
+	if cfg.CDCCfg == nil {
+		return nil
+	}
+	return cfg.CDCCfg
+}
+
+func (cfg YAMLConfig) Webhooks() []WebhookConfig {
+	if len(cfg.WebhooksCfg) == 0 {
+		return nil
+	}
+	webhooks := make([]WebhookConfig, len(cfg.WebhooksCfg))
+	for i := range cfg.WebhooksCfg {
+		webhooks[i] = cfg.WebhooksCfg[i]
+	}
+	return webhooks
+}
+
 func (cfg YAMLConfig) AutoGCBehavior() AutoGCBehavior {
 	if cfg.BehaviorConfig.AutoGCBehavior == nil {
 		return nil
@@ -1140,6 +1205,61 @@ func (cfg YAMLConfig) ValueSet(value string) bool {
 	return false
 }
 
+// ChangeDataCaptureYAMLConfig is the yaml configuration for writing the row changes of new commits to a file
+type ChangeDataCaptureYAMLConfig struct {
+	Path_     *string  `yaml:"path,omitempty"`
+	Branches_ []string `yaml:"branches,omitempty"`
+}
+
+var _ ChangeDataCaptureConfig = (*ChangeDataCaptureYAMLConfig)(nil)
+
+func (c *ChangeDataCaptureYAMLConfig) Path() string {
+	if c.Path_ == nil {
+		return ""
+	}
+	return *c.Path_
+}
+
+func (c *ChangeDataCaptureYAMLConfig) Branches() []string {
+	return c.Branches_
+}
+
+// WebhookYAMLConfig is the yaml configuration of an HTTP endpoint which is notified of the updates of branches and
+// tags
+type WebhookYAMLConfig struct {
+	Name_   *string  `yaml:"name,omitempty"`
+	URL_    *string  `yaml:"url,omitempty"`
+	Refs_   []string `yaml:"refs,omitempty"`
+	Secret_ *string  `yaml:"secret,omitempty"`
+}
+
+var _ WebhookConfig = WebhookYAMLConfig{}
+
+func (w WebhookYAMLConfig) Name() string {
+	if w.Name_ == nil || *w.Name_ == "" {
+		return w.URL()
+	}
+	return *w.Name_
+}
+
+func (w WebhookYAMLConfig) URL() string {
+	if w.URL_ == nil {
+		return ""
+	}
+	return *w.URL_
+}
+
+func (w WebhookYAMLConfig) Refs() []string {
+	return w.Refs_
+}
+
+func (w WebhookYAMLConfig) Secret() string {
+	if w.Secret_ == nil {
+		return ""
+	}
+	return *w.Secret_
+}
+
 type AutoGCBehaviorYAMLConfig struct {
 	Enable_              *bool   `yaml:"enable,omitempty" minver:"1.50.0"`
 	ArchiveLevel_        *int    `yaml:"archive_level,omitempty" minver:"1.52.1"`
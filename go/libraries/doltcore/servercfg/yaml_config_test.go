@@ -40,6 +40,7 @@ behavior:
         enable: true
         archive_level: 1
     branch_activity_tracking: false
+    run_ci_workflows: false
 
 listener:
     host: localhost
@@ -208,6 +209,63 @@ cluster:
 	require.Equal(t, "http://doltdb-1.doltdb:50051/{database}", config.ClusterConfig().StandbyRemotes()[0].RemoteURLTemplate())
 }
 
+func TestUnmarshallChangeDataCapture(t *testing.T) {
+	config, err := NewYamlConfig([]byte("log_level: info\n"))
+	require.NoError(t, err)
+	require.Nil(t, config.ChangeDataCapture())
+
+	testStr := `
+change_data_capture:
+  path: /var/run/dolt/changes.jsonl
+  branches: [main, release]
+`
+	config, err = NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	require.NotNil(t, config.ChangeDataCapture())
+	require.Equal(t, "/var/run/dolt/changes.jsonl", config.ChangeDataCapture().Path())
+	require.Equal(t, []string{"main", "release"}, config.ChangeDataCapture().Branches())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte("change_data_capture:\n  branches: [main]\n"))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+}
+
+func TestUnmarshallWebhooks(t *testing.T) {
+	testStr := `
+webhooks:
+  - name: ci
+    url: https://ci.example.com/hook
+    refs: [refs/heads/main, refs/tags/*]
+    secret: s3cret
+  - url: https://other.example.com/hook
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	webhooks := config.Webhooks()
+	require.Len(t, webhooks, 2)
+	require.Equal(t, "ci", webhooks[0].Name())
+	require.Equal(t, "https://ci.example.com/hook", webhooks[0].URL())
+	require.Equal(t, []string{"refs/heads/main", "refs/tags/*"}, webhooks[0].Refs())
+	require.Equal(t, "s3cret", webhooks[0].Secret())
+	require.Equal(t, "https://other.example.com/hook", webhooks[1].Name())
+	require.Nil(t, webhooks[1].Refs())
+	require.Equal(t, "", webhooks[1].Secret())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte("webhooks:\n  - name: ci\n"))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte("webhooks:\n  - url: http://a\n  - url: http://a\n"))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte("webhooks:\n  - url: http://a\n    refs: ['refs/heads/[']\n"))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+}
+
 func TestYamlConfigFromFileEnvInterpolation_String(t *testing.T) {
 	t.Setenv("DOLT_TEST_SQLSERVER_HOST", "127.0.0.1")
 
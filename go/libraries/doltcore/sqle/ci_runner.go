@@ -0,0 +1,390 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/doltcore/pullrequest"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	ciRunnerBufferSize = 1024
+	ciRunnerThread     = "ci_runner"
+	ciSchedulerThread  = "ci_scheduler"
+)
+
+// CIRunner runs the dolt_ci workflows of a server's databases which are triggered by commits to their branches, by the
+// activity of pull requests, or scheduled with cron expressions, and records the runs in the dolt_ci_runs and
+// dolt_ci_step_results system tables. A commit hook on each database queues the new heads of branches, the pull request
+// procedures queue their activity, and a scheduler queues every minute for each database. A background thread runs the
+// queued workflows one at a time. Updates queued while the server shuts down aren't run.
+type CIRunner struct {
+	// refHeads are the last heads seen of the branches of each database
+	*refHeads
+	ch chan ciRunnerArg
+
+	mu sync.Mutex
+	// dbs are the databases with the runner's commit hook, by name
+	dbs map[string]*doltdb.DoltDB
+}
+
+type ciRunnerArg struct {
+	dbName string
+	db     *doltdb.DoltDB
+	// branch and head are the updated branch and its new head for a push, and head is empty when the branch was deleted
+	branch string
+	head   hash.Hash
+	// scheduledAt is the minute scheduled workflows run for, and is zero for a push
+	scheduledAt time.Time
+	// pullRequest is the pull request whose activity triggers workflows, and is nil for a push
+	pullRequest *pullrequest.PullRequest
+	activity    string
+}
+
+// NewCIRunner creates a CIRunner.
+func NewCIRunner() *CIRunner {
+	r := &CIRunner{
+		ch:  make(chan ciRunnerArg, ciRunnerBufferSize),
+		dbs: make(map[string]*doltdb.DoltDB),
+	}
+	r.refHeads = newRefHeads(false, func(name string, ddb *doltdb.DoltDB) doltdb.CommitHook {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.dbs[name] = ddb
+		return &CIRunnerHook{runner: r, dbName: name}
+	})
+	return r
+}
+
+// RunBackgroundThread starts the threads which schedule and run the triggered workflows during engine initialization.
+func (r *CIRunner) RunBackgroundThread(bThreads BackgroundThreads, ctxF func(context.Context) (*sql.Context, error)) error {
+	err := bThreads.Add(ciRunnerThread, func(ctx context.Context) {
+		for {
+			select {
+			case arg := <-r.ch:
+				r.process(ctx, ctxF, arg)
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return bThreads.Add(ciSchedulerThread, func(ctx context.Context) {
+		for {
+			now := time.Now()
+			next := now.Truncate(time.Minute).Add(time.Minute)
+			timer := time.NewTimer(next.Sub(now))
+			select {
+			case <-timer.C:
+				r.schedule(next)
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	})
+}
+
+// schedule queues the minute |t| for every database, to run the workflows scheduled in it.
+func (r *CIRunner) schedule(t time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, ddb := range r.dbs {
+		select {
+		case r.ch <- ciRunnerArg{dbName: name, db: ddb, scheduledAt: t}:
+		default:
+			logrus.Warnf("dolt ci: too many queued workflow runs, not running scheduled workflows of database %s", name)
+		}
+	}
+}
+
+// queuePullRequest queues |activity| of |pr| in the database |dbName|, to run the workflows it triggers. Like commits,
+// pull requests aren't held up by workflows, so the activity is dropped if the runner has fallen too far behind.
+func (r *CIRunner) queuePullRequest(dbName string, ddb *doltdb.DoltDB, pr pullrequest.PullRequest, activity string) {
+	select {
+	case r.ch <- ciRunnerArg{dbName: dbName, db: ddb, pullRequest: &pr, activity: activity}:
+	default:
+		logrus.Warnf("dolt ci: too many queued workflow runs, not running workflows for pull request %d of database %s", pr.ID, dbName)
+	}
+}
+
+// process runs the workflows scheduled by |arg|, or triggered by the commit a branch moved to, if it moved since the
+// last head seen. New branches don't trigger workflows, since their heads were already committed to another branch.
+func (r *CIRunner) process(ctx context.Context, ctxF func(context.Context) (*sql.Context, error), arg ciRunnerArg) {
+	if arg.scheduledAt.IsZero() && arg.pullRequest == nil {
+		prev, hasPrev := r.swap(arg.dbName, ref.NewBranchRef(arg.branch), arg.head)
+		if arg.head.IsEmpty() || !hasPrev || prev == arg.head {
+			return
+		}
+	}
+
+	sqlCtx, err := ctxF(ctx)
+	if err != nil {
+		logrus.Errorf("dolt ci: could not create *sql.Context: %v", err)
+		return
+	}
+	defer sql.SessionEnd(sqlCtx.Session)
+	sql.SessionCommandBegin(sqlCtx.Session)
+	defer sql.SessionCommandEnd(sqlCtx.Session)
+
+	if !arg.scheduledAt.IsZero() {
+		if err = r.runScheduledWorkflows(sqlCtx, arg); err != nil {
+			logrus.Errorf("dolt ci: failed to run scheduled workflows of database %s: %v", arg.dbName, err)
+		}
+	} else if arg.pullRequest != nil {
+		if err = r.runPullRequestWorkflows(sqlCtx, arg); err != nil {
+			logrus.Errorf("dolt ci: failed to run workflows for pull request %d of database %s: %v", arg.pullRequest.ID, arg.dbName, err)
+		}
+	} else if err = r.runPushWorkflows(sqlCtx, arg); err != nil {
+		logrus.Errorf("dolt ci: failed to run workflows for branch %s of database %s: %v", arg.branch, arg.dbName, err)
+	}
+}
+
+// runPushWorkflows runs the workflows defined on the new head of a branch whose push event is triggered by the branch,
+// and records their runs.
+func (r *CIRunner) runPushWorkflows(ctx *sql.Context, arg ciRunnerArg) error {
+	cm, err := readCICommit(ctx, arg.db, arg.head)
+	if err != nil {
+		return err
+	}
+	event := dolt_ci.WorkflowRunEventPush
+	if cm.NumParents() > 1 {
+		event = dolt_ci.WorkflowRunEventMerge
+	}
+
+	// the workflows run against the commit rather than the branch, which may have moved on since
+	commit := arg.head.String()
+	engine := gms.NewDefault(dsess.DSessFromSess(ctx.Session).Provider())
+	workflows, err := listCIWorkflows(ctx, engine, arg.dbName, cm, commit)
+	if err != nil {
+		return err
+	}
+
+	var runs []*dolt_ci.WorkflowRun
+	for _, wf := range workflows {
+		if wf.err == nil && !wf.config.TriggeredByPush(arg.branch) {
+			continue
+		}
+		runs = append(runs, runCIWorkflow(ctx, engine, arg.dbName, wf, event, arg.branch, commit))
+	}
+	return saveCIRuns(ctx, arg.db, runs)
+}
+
+// runPullRequestWorkflows runs the workflows defined on the head of the target branch of a pull request whose
+// pull_request event is triggered by its activity, against the source commit of the pull request, and records their
+// runs on its source branch.
+func (r *CIRunner) runPullRequestWorkflows(ctx *sql.Context, arg ciRunnerArg) error {
+	pr := arg.pullRequest
+	cm, err := arg.db.ResolveCommitRef(ctx, ref.NewBranchRef(pr.TargetBranch))
+	if err != nil {
+		return err
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+
+	engine := gms.NewDefault(dsess.DSessFromSess(ctx.Session).Provider())
+	workflows, err := listCIWorkflows(ctx, engine, arg.dbName, cm, h.String())
+	if err != nil {
+		return err
+	}
+
+	var runs []*dolt_ci.WorkflowRun
+	for _, wf := range workflows {
+		// workflows which can't be read are recorded when they're pushed
+		if wf.err != nil || !wf.config.TriggeredByPullRequest(pr.TargetBranch, arg.activity) {
+			continue
+		}
+		runs = append(runs, runCIWorkflow(ctx, engine, arg.dbName, wf, dolt_ci.WorkflowRunEventPullRequest, pr.SourceBranch, pr.SourceCommit))
+	}
+	return saveCIRuns(ctx, arg.db, runs)
+}
+
+// runScheduledWorkflows runs the workflows defined on the default branch of a database which are scheduled in the
+// minute of |arg|, against the heads of the branches they are scheduled for, and records their runs.
+func (r *CIRunner) runScheduledWorkflows(ctx *sql.Context, arg ciRunnerArg) error {
+	ctx.SetCurrentDatabase(arg.dbName)
+	defaultBranch, err := dsess.DSessFromSess(ctx.Session).GetBranch(ctx)
+	if err != nil || defaultBranch == "" {
+		return err
+	}
+	cm, err := arg.db.ResolveCommitRef(ctx, ref.NewBranchRef(defaultBranch))
+	if err != nil {
+		return err
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+
+	engine := gms.NewDefault(dsess.DSessFromSess(ctx.Session).Provider())
+	workflows, err := listCIWorkflows(ctx, engine, arg.dbName, cm, h.String())
+	if err != nil {
+		return err
+	}
+
+	var runs []*dolt_ci.WorkflowRun
+	for _, wf := range workflows {
+		// workflows which can't be read are recorded when they're pushed, rather than every minute
+		if wf.err != nil {
+			continue
+		}
+		for _, branch := range wf.config.ScheduledBranches(arg.scheduledAt, defaultBranch) {
+			head, err := arg.db.ResolveCommitRef(ctx, ref.NewBranchRef(branch))
+			if err != nil {
+				run := ciErrorRun(wf.name, dolt_ci.WorkflowRunEventSchedule, branch, "", time.Now(), fmt.Errorf("could not resolve branch %s: %w", branch, err))
+				runs = append(runs, run)
+				continue
+			}
+			hh, err := head.HashOf()
+			if err != nil {
+				return err
+			}
+			runs = append(runs, runCIWorkflow(ctx, engine, arg.dbName, wf, dolt_ci.WorkflowRunEventSchedule, branch, hh.String()))
+		}
+	}
+	return saveCIRuns(ctx, arg.db, runs)
+}
+
+func readCICommit(ctx *sql.Context, ddb *doltdb.DoltDB, h hash.Hash) (*doltdb.Commit, error) {
+	optCm, err := ddb.ReadCommit(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCm.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm, nil
+}
+
+// ciWorkflow is a workflow defined on a commit, with its config, or the error reading it.
+type ciWorkflow struct {
+	name   string
+	config *dolt_ci.WorkflowConfig
+	err    error
+}
+
+// listCIWorkflows returns the workflows defined on |cm|, whose hash is |commit|, in the database |dbName|.
+func listCIWorkflows(ctx *sql.Context, engine *gms.Engine, dbName string, cm *doltdb.Commit, commit string) ([]ciWorkflow, error) {
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hasWorkflows, err := root.HasTable(ctx, doltdb.TableName{Name: doltdb.WorkflowsTableName}); err != nil || !hasWorkflows {
+		return nil, err
+	}
+
+	ctx.SetCurrentDatabase(doltdb.RevisionDbName(dbName, commit))
+	wm := dolt_ci.NewWorkflowManager("", "", engine.Query)
+	names, err := wm.ListWorkflows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	workflows := make([]ciWorkflow, len(names))
+	for i, name := range names {
+		workflows[i].name = name
+		workflows[i].config, workflows[i].err = wm.GetWorkflowConfig(ctx, name)
+	}
+	return workflows, nil
+}
+
+// runCIWorkflow runs |wf| against |commit| of |branch| in the database |dbName|, and returns its run.
+func runCIWorkflow(ctx *sql.Context, engine *gms.Engine, dbName string, wf ciWorkflow, event, branch, commit string) *dolt_ci.WorkflowRun {
+	start := time.Now()
+	if wf.err != nil {
+		return ciErrorRun(wf.name, event, branch, commit, start, wf.err)
+	}
+	ctx.SetCurrentDatabase(doltdb.RevisionDbName(dbName, commit))
+	results, err := dolt_ci.RunWorkflow(ctx, engine.Query, wf.config)
+	if err != nil {
+		return ciErrorRun(wf.name, event, branch, commit, start, err)
+	}
+	run := dolt_ci.NewWorkflowRun(wf.name, event, branch, commit, start, results)
+	if run.Status == dolt_ci.WorkflowRunStatusFailed {
+		logrus.Warnf("dolt ci: workflow %s failed on branch %s of database %s at commit %s", wf.name, branch, dbName, commit)
+	}
+	return run
+}
+
+// saveCIRuns records |runs| in |ddb|.
+func saveCIRuns(ctx *sql.Context, ddb *doltdb.DoltDB, runs []*dolt_ci.WorkflowRun) error {
+	if len(runs) == 0 {
+		return nil
+	}
+	return dolt_ci.UpdateWorkflowRuns(ctx, ddb, func(wr *dolt_ci.WorkflowRuns) error {
+		for _, run := range runs {
+			wr.Add(run)
+		}
+		return nil
+	})
+}
+
+// ciErrorRun returns the run of a workflow which couldn't run its steps because of |err|.
+func ciErrorRun(workflow, event, branch, commit string, start time.Time, err error) *dolt_ci.WorkflowRun {
+	run := dolt_ci.NewWorkflowRun(workflow, event, branch, commit, start, nil)
+	run.Status = dolt_ci.WorkflowRunStatusError
+	run.Error = err.Error()
+	return run
+}
+
+// CIRunnerHook is the CommitHook of a CIRunner for a database, which queues the new heads of its branches.
+type CIRunnerHook struct {
+	runner *CIRunner
+	dbName string
+}
+
+var _ doltdb.CommitHook = (*CIRunnerHook)(nil)
+
+// Execute implements CommitHook, queues the new head of a branch to run the workflows it triggers. Commits aren't
+// held up by workflows, so the update is dropped if the runner has fallen too far behind.
+func (h *CIRunnerHook) Execute(ctx context.Context, ds datas.Dataset, db *doltdb.DoltDB) (func(context.Context) error, error) {
+	rf, err := ref.Parse(ds.ID())
+	if err != nil || rf.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+	addr, _ := ds.MaybeHeadAddr()
+	select {
+	case h.runner.ch <- ciRunnerArg{dbName: h.dbName, branch: rf.GetPath(), db: db, head: addr}:
+	default:
+		logrus.Warnf("dolt ci: too many queued branch updates, not running workflows for branch %s of database %s", rf.GetPath(), h.dbName)
+	}
+	return nil, nil
+}
+
+func (*CIRunnerHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+func (*CIRunnerHook) ExecuteForReplicaWrite() bool {
+	return false
+}
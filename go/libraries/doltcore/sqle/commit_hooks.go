@@ -18,16 +18,19 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/sirupsen/logrus"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
@@ -185,6 +188,297 @@ func (*LogHook) ExecuteForReplicaWrite() bool {
 	return false
 }
 
+// ChangeSink writes the change events of the commits made to the branches of a server's databases to a file or named
+// pipe, as JSON lines in the style of Debezium, so that downstream services can react to committed changes. The events
+// are the same as the ones returned by dolt_changes. A commit hook on each database queues the new heads of branches,
+// and a background thread writes the events of the commits made since the last head it wrote the events of. Commits
+// aren't held up by the sink, so a branch whose update doesn't fit in the queue is queued again once it has room.
+type ChangeSink struct {
+	// refHeads are the last heads of the branches of each database whose events were written
+	*refHeads
+	path     string
+	branches map[string]struct{}
+	ch       chan changeSinkArg
+
+	mu sync.Mutex
+	// overflow are the latest updates of the branches which didn't fit in the queue, by database and branch
+	overflow map[string]changeSinkArg
+}
+
+type changeSinkArg struct {
+	dbName string
+	branch string
+	db     *doltdb.DoltDB
+	// head is empty when the branch was deleted
+	head hash.Hash
+}
+
+const (
+	changeSinkBufferSize = 2048
+	changeSinkThread     = "change_sink"
+)
+
+// NewChangeSink creates a ChangeSink which writes to |path| the change events of the commits made to |branches|, or
+// to all branches if |branches| is empty.
+func NewChangeSink(path string, branches []string) *ChangeSink {
+	s := &ChangeSink{
+		path:     path,
+		ch:       make(chan changeSinkArg, changeSinkBufferSize),
+		overflow: make(map[string]changeSinkArg),
+	}
+	s.refHeads = newRefHeads(false, func(name string, _ *doltdb.DoltDB) doltdb.CommitHook {
+		return &ChangeSinkHook{sink: s, dbName: name}
+	})
+	if len(branches) > 0 {
+		s.branches = make(map[string]struct{}, len(branches))
+		for _, b := range branches {
+			s.branches[b] = struct{}{}
+		}
+	}
+	return s
+}
+
+// RunBackgroundThread starts the thread which writes the change events during engine initialization.
+func (s *ChangeSink) RunBackgroundThread(bThreads BackgroundThreads, ctxF func(context.Context) (*sql.Context, error)) error {
+	return bThreads.Add(changeSinkThread, func(ctx context.Context) {
+		w := &changeSinkWriter{path: s.path}
+		defer w.close()
+		for {
+			select {
+			case arg := <-s.ch:
+				s.process(ctx, ctxF, w, arg)
+				s.processOverflow(ctx, ctxF, w)
+			case <-ctx.Done():
+				// write the heads that were queued before shutting down, as long as the file can be written
+				for {
+					select {
+					case arg := <-s.ch:
+						s.process(ctx, ctxF, w, arg)
+					default:
+						s.processOverflow(ctx, ctxF, w)
+						return
+					}
+				}
+			}
+		}
+	})
+}
+
+// queue queues the update of a branch without blocking. If the queue is full, or an earlier update of the branch
+// didn't fit in it, the update replaces the overflowed update of the branch, so that the updates of a branch are
+// processed in order.
+func (s *ChangeSink) queue(arg changeSinkArg) {
+	key := refHeadKey(arg.dbName, ref.NewBranchRef(arg.branch))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.overflow[key]; !ok {
+		select {
+		case s.ch <- arg:
+			return
+		default:
+		}
+	}
+	if len(s.overflow) == 0 {
+		logrus.Warnf("change capture: too many queued branch updates, coalescing updates until the queue drains")
+	}
+	s.overflow[key] = arg
+}
+
+// processOverflow writes the change events of the updates which didn't fit in the queue, once the queue is empty.
+// Updates only overflow while the queue is full, so the thread receives from it again before any more overflow.
+func (s *ChangeSink) processOverflow(ctx context.Context, ctxF func(context.Context) (*sql.Context, error), w *changeSinkWriter) {
+	if len(s.ch) > 0 {
+		return
+	}
+	s.mu.Lock()
+	overflow := s.overflow
+	s.overflow = make(map[string]changeSinkArg)
+	s.mu.Unlock()
+	for _, arg := range overflow {
+		s.process(ctx, ctxF, w, arg)
+	}
+}
+
+// process writes the change events of the commits made to a branch since the last head whose events were written. The
+// head of the branch only advances once its events are written, so that the events of a failed write are written with
+// those of the next update of the branch.
+func (s *ChangeSink) process(ctx context.Context, ctxF func(context.Context) (*sql.Context, error), w *changeSinkWriter, arg changeSinkArg) {
+	rf := ref.NewBranchRef(arg.branch)
+	prev, hasPrev := s.get(arg.dbName, rf)
+	// new branches, and branches that didn't move, have no new commits
+	if arg.head.IsEmpty() || !hasPrev || prev == arg.head {
+		s.set(arg.dbName, rf, arg.head)
+		return
+	}
+
+	// use background context to write the changes queued when the sql context is canceled
+	sqlCtx, err := ctxF(context.Background())
+	if err != nil {
+		logrus.Errorf("change capture failed: could not create *sql.Context: %v", err)
+		return
+	}
+	defer sql.SessionEnd(sqlCtx.Session)
+	sql.SessionCommandBegin(sqlCtx.Session)
+	defer sql.SessionCommandEnd(sqlCtx.Session)
+
+	events, err := changeSinkEvents(sqlCtx, arg.db, prev, arg.head)
+	if dtablefunctions.ErrNotInFirstParentHistory.Is(err) {
+		// the branch was reset or force pushed rather than committed to
+		logrus.Warnf("change capture skipped %s of database %s: %v", arg.branch, arg.dbName, err)
+		s.set(arg.dbName, rf, arg.head)
+		return
+	} else if err != nil {
+		logrus.Errorf("change capture failed for branch %s of database %s: %v", arg.branch, arg.dbName, err)
+		return
+	}
+
+	for _, e := range events {
+		line, err := gmstypes.JsonToMySqlBytes(sqlCtx, changeSinkEnvelope(arg.dbName, arg.branch, e))
+		if err != nil {
+			logrus.Errorf("change capture failed for branch %s of database %s: %v", arg.branch, arg.dbName, err)
+			return
+		}
+		if err = w.writeLine(ctx, line); err != nil {
+			logrus.Errorf("change capture failed to write to %s: %v", s.path, err)
+			return
+		}
+	}
+	s.set(arg.dbName, rf, arg.head)
+}
+
+// changeSinkEvents returns the change events of the commits made after |prev| on the first-parent history of |head|.
+func changeSinkEvents(ctx *sql.Context, ddb *doltdb.DoltDB, prev, head hash.Hash) ([]dtablefunctions.ChangeEvent, error) {
+	commits := make([]*doltdb.Commit, 2)
+	for i, h := range []hash.Hash{prev, head} {
+		optCm, err := ddb.ReadCommit(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if commits[i], ok = optCm.ToCommit(); !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+	}
+	return dtablefunctions.ChangesSince(ctx, commits[1], commits[0])
+}
+
+// changeSinkEnvelope returns the JSON object written for the change event |e| of a branch of a database.
+func changeSinkEnvelope(dbName, branch string, e dtablefunctions.ChangeEvent) gmstypes.JSONDocument {
+	var before, after interface{}
+	if e.Before != nil {
+		before = e.Before
+	}
+	if e.After != nil {
+		after = e.After
+	}
+	return gmstypes.JSONDocument{Val: map[string]interface{}{
+		"before": before,
+		"after":  after,
+		"op":     e.Op,
+		"ts_ms":  e.CommitDate.UnixMilli(),
+		"source": map[string]interface{}{
+			"db":          dbName,
+			"branch":      branch,
+			"table":       e.Table,
+			"commit_hash": e.CommitHash,
+			"committer":   e.Committer,
+			"commit_date": e.CommitDate,
+		},
+	}}
+}
+
+// changeSinkWriter writes lines to the file of a ChangeSink, which is opened when the first line is written.
+type changeSinkWriter struct {
+	path string
+	f    *os.File
+}
+
+// writeLine writes |line| to the file. If writing fails, the file is reopened and the line written again once, since
+// the reader of a named pipe may have gone away.
+func (w *changeSinkWriter) writeLine(ctx context.Context, line []byte) error {
+	line = append(line, '\n')
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if w.f == nil {
+			if w.f, err = openChangeSinkFile(ctx, w.path); err != nil {
+				return err
+			}
+		}
+		if _, err = w.f.Write(line); err == nil {
+			return nil
+		}
+		w.close()
+	}
+	return err
+}
+
+func (w *changeSinkWriter) close() {
+	if w.f != nil {
+		_ = w.f.Close()
+		w.f = nil
+	}
+}
+
+// openChangeSinkFile opens |path| for appending. Opening a named pipe blocks until it has a reader, so this returns
+// early if |ctx| is canceled while waiting for one.
+func openChangeSinkFile(ctx context.Context, path string) (*os.File, error) {
+	type result struct {
+		f   *os.File
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		ch <- result{f, err}
+	}()
+	select {
+	case r := <-ch:
+		return r.f, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-ch; r.f != nil {
+				_ = r.f.Close()
+			}
+		}()
+		return nil, context.Cause(ctx)
+	}
+}
+
+// ChangeSinkHook is the CommitHook of a ChangeSink for a database, which queues the new heads of its branches.
+type ChangeSinkHook struct {
+	sink   *ChangeSink
+	dbName string
+}
+
+var _ doltdb.CommitHook = (*ChangeSinkHook)(nil)
+
+// Execute implements CommitHook, queues the new head of a branch to have the changes of its new commits written. Commits
+// aren't held up by the sink, so this never blocks.
+func (h *ChangeSinkHook) Execute(ctx context.Context, ds datas.Dataset, db *doltdb.DoltDB) (func(context.Context) error, error) {
+	rf, err := ref.Parse(ds.ID())
+	if err != nil || rf.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+	branch := rf.GetPath()
+	if h.sink.branches != nil {
+		if _, ok := h.sink.branches[branch]; !ok {
+			return nil, nil
+		}
+	}
+
+	addr, _ := ds.MaybeHeadAddr()
+	h.sink.queue(changeSinkArg{dbName: h.dbName, branch: branch, db: db, head: addr})
+	return nil, nil
+}
+
+func (*ChangeSinkHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+func (*ChangeSinkHook) ExecuteForReplicaWrite() bool {
+	return false
+}
+
 func RunAsyncReplicationThreads(bThreads BackgroundThreads, nameSuffix string, ctxF func(context.Context) (*sql.Context, error), ch chan PushArg, tmpDir string, logger io.Writer) error {
 	mu := &sync.Mutex{}
 	var newHeads = make(map[string]PushArg, asyncPushBufferSize)
@@ -17,6 +17,7 @@ package sqle
 import (
 	"bytes"
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
 )
 
 const defaultBranch = "main"
@@ -323,6 +325,85 @@ func TestAsyncPushOnWrite(t *testing.T) {
 	})
 }
 
+func TestChangeSink(t *testing.T) {
+	ctx := context.Background()
+	ddb, err := doltdb.LoadDoltDB(ctx, types.Format_Default, doltdb.InMemDoltDB, filesys.LocalFS)
+	require.NoError(t, err)
+	require.NoError(t, ddb.WriteEmptyRepo(ctx, "main", "Bill Billerson", "bigbillieb@fake.horse"))
+
+	// the sink can't write to a directory
+	path := t.TempDir()
+	sink := NewChangeSink(path, nil)
+	hook, err := sink.install(ctx, "mydb", ddb)
+	require.NoError(t, err)
+	ctxF := func(context.Context) (*sql.Context, error) {
+		return sql.NewEmptyContext(), nil
+	}
+	w := &changeSinkWriter{path: path}
+	defer w.close()
+
+	// commit a row to main
+	head, err := ddb.ResolveCommitRef(ctx, ref.NewBranchRef("main"))
+	require.NoError(t, err)
+	oldHash, err := head.HashOf()
+	require.NoError(t, err)
+	root, err := head.GetRootValue(ctx)
+	require.NoError(t, err)
+	sch := schema.MustSchemaFromCols(schema.NewColCollection(schema.NewColumn("pk", 0, types.IntKind, true, schema.NotNullConstraint{})))
+	idx, err := durable.NewEmptyPrimaryIndex(ctx, ddb.ValueReadWriter(), ddb.NodeStore(), sch)
+	require.NoError(t, err)
+	m, err := durable.ProllyMapFromIndex(idx)
+	require.NoError(t, err)
+	kb := val.NewTupleBuilder(sch.GetKeyDescriptor(ddb.NodeStore()), ddb.NodeStore())
+	kb.PutInt64(0, 1)
+	k, err := kb.Build(ctx, ddb.NodeStore().Pool())
+	require.NoError(t, err)
+	mut := m.Mutate()
+	require.NoError(t, mut.Put(ctx, k, val.EmptyTuple))
+	m, err = mut.Map(ctx)
+	require.NoError(t, err)
+	tbl, err := createHooksTestTable(ddb.ValueReadWriter(), ddb.NodeStore(), sch, durable.IndexFromProllyMap(m))
+	require.NoError(t, err)
+	root, err = root.PutTable(ctx, doltdb.TableName{Name: "test"}, tbl)
+	require.NoError(t, err)
+	_, valHash, err := ddb.WriteRootValue(ctx, root)
+	require.NoError(t, err)
+	meta, err := datas.NewCommitMeta("Bill Billerson", "bigbillieb@fake.horse", "add test")
+	require.NoError(t, err)
+	commit, err := ddb.Commit(ctx, valHash, ref.NewBranchRef("main"), meta)
+	require.NoError(t, err)
+	newHash, err := commit.HashOf()
+	require.NoError(t, err)
+	ds, err := doltdb.ExposeDatabaseFromDoltDB(ddb).GetDataset(ctx, "refs/heads/main")
+	require.NoError(t, err)
+
+	// the hook doesn't block when the queue is full, and the update is processed once the queue drains
+	for i := 0; i < changeSinkBufferSize; i++ {
+		sink.ch <- changeSinkArg{dbName: "mydb", branch: "other"}
+	}
+	_, err = hook.Execute(ctx, ds, ddb)
+	require.NoError(t, err)
+	require.Contains(t, sink.overflow, refHeadKey("mydb", ref.NewBranchRef("main")))
+	for len(sink.ch) > 0 {
+		<-sink.ch
+	}
+
+	// the head doesn't advance when the events can't be written
+	sink.processOverflow(ctx, ctxF, w)
+	assert.Empty(t, sink.overflow)
+	prev, _ := sink.get("mydb", ref.NewBranchRef("main"))
+	assert.Equal(t, oldHash, prev)
+
+	w.path = filepath.Join(path, "changes.jsonl")
+	sink.process(ctx, ctxF, w, changeSinkArg{dbName: "mydb", branch: "main", db: ddb, head: newHash})
+	prev, _ = sink.get("mydb", ref.NewBranchRef("main"))
+	assert.Equal(t, newHash, prev)
+	data, err := os.ReadFile(w.path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, bytes.Count(data, []byte("\n")))
+	assert.Contains(t, string(data), newHash.String())
+}
+
 var _ doltdb.CommitHook = (*countingCommitHook)(nil)
 
 type countingCommitHook struct {
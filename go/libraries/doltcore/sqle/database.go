@@ -47,6 +47,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/adapters"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/globalstate"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/overrides"
@@ -470,6 +471,23 @@ func (db Database) getTableInsensitiveWithRoot(ctx *sql.Context, head *doltdb.Co
 		}
 		return dt, true, nil
 
+	case strings.HasPrefix(lwrName, doltdb.DoltCellBlameTablePrefix):
+		if head == nil {
+			var err error
+			head, err = ds.GetHeadCommit(ctx, db.RevisionQualifiedName())
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		baseTableName := tblName[len(doltdb.DoltCellBlameTablePrefix):]
+		tname := doltdb.TableName{Name: baseTableName, Schema: db.schemaName}
+		dt, err := dtables.NewCellBlameTable(ctx, db.Name(), tname, db.ddb, head)
+		if err != nil {
+			return nil, false, err
+		}
+		return dt, true, nil
+
 	case strings.HasPrefix(lwrName, doltdb.DoltCommitDiffTablePrefix):
 		baseTableName := tblName[len(doltdb.DoltCommitDiffTablePrefix):]
 		tname := doltdb.TableName{Name: baseTableName, Schema: db.schemaName}
@@ -745,6 +763,73 @@ func (db Database) getTableInsensitiveWithRoot(ctx *sql.Context, head *doltdb.Co
 		if !resolve.UseSearchPath || isDoltgresSystemTable {
 			dt, found = dtables.NewStashesTable(ctx, db.Name(), db.ddb, lwrName), true
 		}
+	case doltdb.NotesTableName, doltdb.GetNotesTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewNotesTable(ctx, lwrName, db.ddb), true
+		}
+	case doltdb.RerereTableName, doltdb.GetRerereTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewRerereTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb), true
+		}
+	case doltdb.WebhookDeliveriesTableName, doltdb.GetWebhookDeliveriesTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewWebhookDeliveriesTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb), true
+		}
+	case doltdb.PullRequestsTableName, doltdb.GetPullRequestsTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			hasConflicts := func(ctx *sql.Context, target, source string) (bool, error) {
+				return dtablefunctions.HasMergeConflicts(ctx, db, target, source)
+			}
+			dt, found = dtables.NewPullRequestsTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb, hasConflicts), true
+		}
+	case doltdb.PullRequestReviewsTableName, doltdb.GetPullRequestReviewsTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewPullRequestReviewsTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb), true
+		}
+	case doltdb.PullRequestEventsTableName, doltdb.GetPullRequestEventsTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewPullRequestEventsTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb), true
+		}
+	case doltdb.CIRunsTableName, doltdb.GetCIRunsTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewCIRunsTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb), true
+		}
+	case doltdb.CIStepResultsTableName, doltdb.GetCIStepResultsTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewCIStepResultsTable(ctx, db.RevisionQualifiedName(), lwrName, db.ddb), true
+		}
 	case doltdb.CommitsTableName, doltdb.GetCommitsTableName():
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
@@ -834,6 +919,61 @@ func (db Database) getTableInsensitiveWithRoot(ctx *sql.Context, head *doltdb.Co
 			versionableTable := backingTable.(dtables.VersionableTable)
 			dt, found = dtables.NewIgnoreTable(ctx, versionableTable, db.schemaName), true
 		}
+	case doltdb.MergePoliciesTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.MergePoliciesTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyMergePoliciesTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewMergePoliciesTable(ctx, versionableTable, db.schemaName), true
+		}
+	case doltdb.IgnoreColumnsTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.IgnoreColumnsTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyIgnoreColumnsTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewIgnoreColumnsTable(ctx, versionableTable, db.schemaName), true
+		}
+	case doltdb.ProtectedBranchesTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.ProtectedBranchesTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyProtectedBranchesTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewProtectedBranchesTable(ctx, versionableTable, db.schemaName), true
+		}
+	case doltdb.QueryCatalogParametersTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.QueryCatalogParametersTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyQueryCatalogParametersTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewQueryCatalogParametersTable(ctx, versionableTable, db.schemaName), true
+		}
+	case doltdb.TestFixturesTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.TestFixturesTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyTestFixturesTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewTestFixturesTable(ctx, versionableTable, db.schemaName), true
+		}
 	case doltdb.GetDocTableName(), doltdb.DocTableName:
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
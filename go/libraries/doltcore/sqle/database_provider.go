@@ -32,6 +32,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/pullrequest"
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/clusterdb"
@@ -90,6 +91,8 @@ type DoltDatabaseProvider struct {
 	dbFactoryUrl      string
 	DropDatabaseHooks []DropDatabaseHook
 	InitDatabaseHooks []InitDatabaseHook
+	// CIRunner runs the dolt_ci workflows triggered by the activity of pull requests, and is nil if they aren't run
+	CIRunner *CIRunner
 }
 
 type remoteDialerWithGitCacheRoot struct {
@@ -111,6 +114,7 @@ var _ sql.CollatedDatabaseProvider = (*DoltDatabaseProvider)(nil)
 var _ sql.ExternalStoredProcedureProvider = (*DoltDatabaseProvider)(nil)
 var _ sql.TableFunctionProvider = (*DoltDatabaseProvider)(nil)
 var _ dsess.DoltDatabaseProvider = (*DoltDatabaseProvider)(nil)
+var _ dprocedures.PullRequestWorkflowQueue = (*DoltDatabaseProvider)(nil)
 
 func (p *DoltDatabaseProvider) DefaultBranch() string {
 	return p.defaultBranch
@@ -258,6 +262,13 @@ func (p *DoltDatabaseProvider) applyDBLoadParamsToEnv(dEnv *env.DoltEnv) {
 	maps.Copy(dEnv.DBLoadParams, p.dbLoadParams)
 }
 
+// QueuePullRequestWorkflows implements dprocedures.PullRequestWorkflowQueue
+func (p *DoltDatabaseProvider) QueuePullRequestWorkflows(dbName string, ddb *doltdb.DoltDB, pr pullrequest.PullRequest, activity string) {
+	if p.CIRunner != nil {
+		p.CIRunner.queuePullRequest(dbName, ddb, pr, activity)
+	}
+}
+
 // AddInitDatabaseHook adds an InitDatabaseHook to this provider. The hook will be invoked
 // whenever this provider creates a new database.
 func (p *DoltDatabaseProvider) AddInitDatabaseHook(hook InitDatabaseHook) {
@@ -0,0 +1,152 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/patch"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var ErrEmptyPatch = errors.New("error: no patch given")
+
+var doltApplySchema = []*sql.Column{
+	{
+		Name:     "data_conflicts",
+		Type:     gmstypes.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "schema_conflicts",
+		Type:     gmstypes.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "constraint_violations",
+		Type:     gmstypes.Int64,
+		Nullable: false,
+	},
+}
+
+var doltAmSchema = []*sql.Column{
+	{
+		Name:     "hash",
+		Type:     gmstypes.LongText,
+		Nullable: true,
+	},
+	{
+		Name:     "data_conflicts",
+		Type:     gmstypes.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "schema_conflicts",
+		Type:     gmstypes.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "constraint_violations",
+		Type:     gmstypes.Int64,
+		Nullable: false,
+	},
+}
+
+// doltApply is the stored procedure version for the CLI command `dolt apply`. Its arguments are the contents of the
+// patches to apply, rather than file names.
+func doltApply(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	apr, err := cli.CreateApplyArgParser().Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	patches, err := parsePatchArgs(ctx, apr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range patches {
+		result, err := patch.Apply(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil && result.HasMergeArtifacts() {
+			return rowToIter(
+				int64(result.CountOfTablesWithDataConflicts()),
+				int64(result.CountOfTablesWithSchemaConflicts()),
+				int64(result.CountOfTablesWithConstraintViolations())), nil
+		}
+	}
+	return rowToIter(int64(0), int64(0), int64(0)), nil
+}
+
+// doltAm is the stored procedure version for the CLI command `dolt am`. Its arguments are the contents of the
+// patches to commit, rather than file names. It returns the hash of the last commit made.
+func doltAm(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	apr, err := cli.CreateAmArgParser().Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	patches, err := parsePatchArgs(ctx, apr)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, result, err := patch.Am(ctx, patches, patch.AmOptions{SkipVerification: apr.Contains(cli.SkipVerificationFlag)})
+	if err != nil {
+		return nil, err
+	}
+
+	var lastCommit interface{}
+	if len(commits) > 0 {
+		lastCommit = commits[len(commits)-1]
+	}
+	if result != nil {
+		return rowToIter(lastCommit,
+			int64(result.CountOfTablesWithDataConflicts()),
+			int64(result.CountOfTablesWithSchemaConflicts()),
+			int64(result.CountOfTablesWithConstraintViolations())), nil
+	}
+	return rowToIter(lastCommit, int64(0), int64(0), int64(0)), nil
+}
+
+// parsePatchArgs checks that the current database can be written to, and parses the patches in the arguments of
+// |apr|, in order.
+func parsePatchArgs(ctx *sql.Context, apr *argparser.ArgParseResults) ([]*patch.Patch, error) {
+	if len(ctx.GetCurrentDatabase()) == 0 {
+		return nil, fmt.Errorf("error: empty database name")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return nil, err
+	}
+
+	var patches []*patch.Patch
+	for _, arg := range apr.Args {
+		parsed, err := patch.Parse(arg)
+		if err != nil {
+			return nil, err
+		}
+		patches = append(patches, parsed...)
+	}
+	if len(patches) == 0 {
+		return nil, ErrEmptyPatch
+	}
+	return patches, nil
+}
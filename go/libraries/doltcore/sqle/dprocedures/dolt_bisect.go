@@ -0,0 +1,674 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bisect"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	BisectStartCmd = "start"
+	BisectBadCmd   = "bad"
+	BisectGoodCmd  = "good"
+	BisectSkipCmd  = "skip"
+	BisectRunCmd   = "run"
+	BisectResetCmd = "reset"
+
+	// BisectBranchPrefix is the prefix of the temporary branch used to check out the commits being tested. In Git, a
+	// bisect happens with a detached HEAD, but Dolt doesn't support that, so we use a temporary branch, just as
+	// dolt_rebase does.
+	BisectBranchPrefix = "dolt_bisect_"
+
+	// BisectFirstBadCommitSuffix is included in the message returned once the first bad commit has been found.
+	BisectFirstBadCommitSuffix = "is the first bad commit"
+	// BisectResetMessage is the message returned when a bisect is reset.
+	BisectResetMessage = "bisect reset"
+)
+
+var doltBisectProcedureSchema = []*sql.Column{
+	{
+		Name:     "status",
+		Type:     types.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "message",
+		Type:     types.LongText,
+		Nullable: true,
+	},
+}
+
+// ErrNoBisectInProgress is returned when a bisect subcommand other than start is used without an active bisect.
+var ErrNoBisectInProgress = errors.New("no bisect in progress; start one with dolt_bisect('start')")
+
+// doltBisect is the stored procedure version for the CLI command `dolt bisect`.
+func doltBisect(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, message, err := doDoltBisect(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res), message), nil
+}
+
+func doDoltBisect(ctx *sql.Context, args []string) (int, string, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if dbName == "" {
+		return 1, "", sql.ErrNoDatabaseSelected.New()
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return 1, "", err
+	}
+
+	apr, err := cli.CreateBisectArgParser().Parse(args)
+	if err != nil {
+		return 1, "", err
+	}
+	if apr.NArg() == 0 {
+		return 1, "", fmt.Errorf("error: missing subcommand; expected one of start, bad, good, skip, run, or reset")
+	}
+
+	isReadOnly, err := isReadOnlyDatabase(ctx, dbName)
+	if err != nil {
+		return 1, "", err
+	}
+	if isReadOnly {
+		return 1, "", fmt.Errorf("unable to bisect in read-only databases")
+	}
+
+	subcommand := strings.ToLower(apr.Arg(0))
+	revs := apr.Args[1:]
+
+	var message string
+	switch subcommand {
+	case BisectStartCmd:
+		message, err = startBisect(ctx, revs)
+	case BisectBadCmd, BisectGoodCmd, BisectSkipCmd:
+		message, err = markBisectCommits(ctx, subcommand, revs)
+	case BisectRunCmd:
+		if len(revs) != 1 {
+			return 1, "", fmt.Errorf("error: dolt_bisect('run') requires exactly one test group or test name")
+		}
+		message, err = runBisect(ctx, revs[0])
+	case BisectResetCmd:
+		if len(revs) != 0 {
+			return 1, "", fmt.Errorf("error: dolt_bisect('reset') does not take any arguments")
+		}
+		err = resetBisect(ctx)
+		message = BisectResetMessage
+	default:
+		return 1, "", fmt.Errorf("error: unknown bisect subcommand '%s'", apr.Arg(0))
+	}
+	if err != nil {
+		return 1, "", err
+	}
+
+	return 0, message, nil
+}
+
+// startBisect starts a new bisect on the current branch, by creating and checking out a temporary branch that is
+// moved to each commit that needs to be tested. If |revs| is not empty, the first rev is marked as bad and any others
+// are marked as good.
+func startBisect(ctx *sql.Context, revs []string) (string, error) {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", err
+	}
+	if ws.MergeActive() {
+		return "", fmt.Errorf("unable to start bisect while a merge is in progress – abort the current merge before proceeding")
+	}
+	if ws.RebaseActive() {
+		return "", fmt.Errorf("unable to start bisect while a rebase is in progress – abort the current rebase before proceeding")
+	}
+	if ws.BisectActive() {
+		return "", fmt.Errorf("a bisect is already in progress; use dolt_bisect('reset') to end it before starting a new one")
+	}
+
+	roots, ok := doltSession.GetRoots(ctx, dbName)
+	if !ok {
+		return "", fmt.Errorf("unable to get roots for database %s", dbName)
+	}
+	wsOnlyHasIgnoredTables, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
+	if err != nil {
+		return "", err
+	}
+	if !wsOnlyHasIgnoredTables {
+		return "", fmt.Errorf("cannot start a bisect with uncommitted changes")
+	}
+
+	startBranch, err := currentBranch(ctx)
+	if err != nil {
+		return "", err
+	}
+	dbData, ok := doltSession.GetDbData(ctx, dbName)
+	if !ok {
+		return "", fmt.Errorf("unable to find database %s", dbName)
+	}
+
+	bisectBranch := BisectBranchPrefix + startBranch
+	var rsc doltdb.ReplicationStatusController
+	err = actions.CreateBranchWithStartPt(ctx, dbData, bisectBranch, "HEAD", false, &rsc)
+	if err != nil {
+		return "", err
+	}
+	err = commitTransaction(ctx, doltSession, &rsc)
+	if err != nil {
+		return "", err
+	}
+
+	wsRef, err := ref.WorkingSetRefForHead(ref.NewBranchRef(bisectBranch))
+	if err != nil {
+		return "", err
+	}
+	err = doltSession.SwitchWorkingSet(ctx, dbName, wsRef)
+	if err != nil {
+		return "", err
+	}
+
+	ws, err = doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", err
+	}
+	bisectState := ws.StartBisect(startBranch).BisectState()
+
+	if len(revs) > 0 {
+		bisectState, err = recordBisectCommits(ctx, bisectState, BisectBadCmd, revs[:1])
+		if err != nil {
+			return "", err
+		}
+		bisectState, err = recordBisectCommits(ctx, bisectState, BisectGoodCmd, revs[1:])
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return advanceBisect(ctx, ws.WithBisectState(bisectState))
+}
+
+// markBisectCommits marks the commits in |revs| as bad, good, or skipped, depending on |subcommand|, and then moves
+// the bisect branch to the next commit to test. With no |revs|, the currently checked out commit is marked.
+func markBisectCommits(ctx *sql.Context, subcommand string, revs []string) (string, error) {
+	ws, err := activeBisectWorkingSet(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(revs) == 0 {
+		revs = []string{"HEAD"}
+	} else if subcommand == BisectBadCmd && len(revs) > 1 {
+		return "", fmt.Errorf("error: only one commit can be marked as bad")
+	}
+
+	bisectState, err := recordBisectCommits(ctx, ws.BisectState(), subcommand, revs)
+	if err != nil {
+		return "", err
+	}
+
+	return advanceBisect(ctx, ws.WithBisectState(bisectState))
+}
+
+// recordBisectCommits resolves each of |revs| and records it in |bisectState| according to |subcommand|.
+func recordBisectCommits(ctx *sql.Context, bisectState *doltdb.BisectState, subcommand string, revs []string) (*doltdb.BisectState, error) {
+	for _, rev := range revs {
+		cm, err := resolveBisectCommit(ctx, rev)
+		if err != nil {
+			return nil, err
+		}
+		h, err := cm.HashOf()
+		if err != nil {
+			return nil, err
+		}
+
+		switch subcommand {
+		case BisectBadCmd:
+			bisectState = bisectState.WithBadCommit(h.String())
+		case BisectGoodCmd:
+			bisectState = bisectState.WithGoodCommit(h.String())
+		case BisectSkipCmd:
+			bisectState = bisectState.WithSkippedCommit(h.String())
+		}
+	}
+	return bisectState, nil
+}
+
+// advanceBisect determines the next commit to test for the bisect recorded in |ws|, checks it out on the bisect
+// branch, and returns a message describing the new state of the bisect.
+func advanceBisect(ctx *sql.Context, ws *doltdb.WorkingSet) (string, error) {
+	step, err := nextBisectStep(ctx, ws.BisectState())
+	if err != nil {
+		return "", err
+	}
+
+	if step == nil {
+		err = setBisectWorkingSet(ctx, ws)
+		if err != nil {
+			return "", err
+		}
+		return bisectWaitingMessage(ws.BisectState()), nil
+	}
+
+	switch {
+	case step.Candidate != nil:
+		err = checkoutBisectCommit(ctx, ws, step.Candidate)
+		if err != nil {
+			return "", err
+		}
+		return bisectCandidateMessage(ctx, step)
+	case step.FirstBad != nil:
+		err = checkoutBisectCommit(ctx, ws, step.FirstBad)
+		if err != nil {
+			return "", err
+		}
+		return bisectFirstBadMessage(ctx, step.FirstBad, step.FirstBadHash)
+	default:
+		err = setBisectWorkingSet(ctx, ws)
+		if err != nil {
+			return "", err
+		}
+		possible := make([]string, len(step.PossibleFirstBad))
+		for i, h := range step.PossibleFirstBad {
+			possible[i] = h.String()
+		}
+		return fmt.Sprintf("There are only 'skip'ped commits left to test.\n"+
+			"The first bad commit could be any of:\n%s\n"+
+			"We cannot bisect more!", strings.Join(possible, "\n")), nil
+	}
+}
+
+// nextBisectStep loads the commits recorded in |bisectState| and computes the next bisect step. If there isn't yet
+// both a bad commit and at least one good commit, nil is returned.
+func nextBisectStep(ctx *sql.Context, bisectState *doltdb.BisectState) (*bisect.Step, error) {
+	if bisectState.BadCommit() == "" || len(bisectState.GoodCommits()) == 0 {
+		return nil, nil
+	}
+
+	bad, err := resolveBisectCommit(ctx, bisectState.BadCommit())
+	if err != nil {
+		return nil, err
+	}
+	good := make([]*doltdb.Commit, len(bisectState.GoodCommits()))
+	for i, h := range bisectState.GoodCommits() {
+		good[i], err = resolveBisectCommit(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+	}
+	skipped := make([]hash.Hash, len(bisectState.SkippedCommits()))
+	for i, h := range bisectState.SkippedCommits() {
+		skipped[i] = hash.Parse(h)
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	ddb, ok := doltSession.GetDoltDB(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return nil, fmt.Errorf("unable to access doltDB for database %s", ctx.GetCurrentDatabase())
+	}
+	return bisect.NextStep(ctx, ddb, bad, good, skipped)
+}
+
+// runBisect automatically classifies commits until the first bad commit is found. Each commit is checked out on the
+// bisect branch, and the dolt_tests matching |testSelector| are run against it with dolt_test_run(). The test
+// definitions are always taken from the branch the bisect was started on, so tests that were written after the
+// change being searched for can still be used. A commit is good if every test passes, bad if any test fails, and is
+// skipped if the tests can't be run at all.
+func runBisect(ctx *sql.Context, testSelector string) (string, error) {
+	ws, err := activeBisectWorkingSet(ctx)
+	if err != nil {
+		return "", err
+	}
+	bisectState := ws.BisectState()
+	if bisectState.BadCommit() == "" || len(bisectState.GoodCommits()) == 0 {
+		return "", fmt.Errorf("dolt_bisect('run') requires a bad commit and at least one good commit; %s",
+			strings.ToLower(bisectWaitingMessage(bisectState)))
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	ddb, ok := doltSession.GetDoltDB(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return "", fmt.Errorf("unable to access doltDB for database %s", ctx.GetCurrentDatabase())
+	}
+	startRoots, err := ddb.ResolveBranchRoots(ctx, ref.NewBranchRef(bisectState.Branch()))
+	if err != nil {
+		return "", err
+	}
+	testsTableName := doltdb.TableName{Name: doltdb.GetTestsTableName(), Schema: doltdb.DefaultSchemaName}
+	// testsTable is nil if the starting branch has no tests
+	testsTable, _, err := startRoots.Working.GetTable(ctx, testsTableName)
+	if err != nil {
+		return "", err
+	}
+
+	engine := gms.NewDefault(doltSession.Provider())
+	var log []string
+	for {
+		step, err := nextBisectStep(ctx, ws.BisectState())
+		if err != nil {
+			return "", err
+		}
+		if step.Candidate == nil {
+			msg, err := advanceBisect(ctx, ws)
+			if err != nil {
+				return "", err
+			}
+			return strings.Join(append(log, msg), "\n"), nil
+		}
+
+		err = checkoutBisectCommit(ctx, ws, step.Candidate)
+		if err != nil {
+			return "", err
+		}
+		verdict, err := classifyWithBisectTests(ctx, engine, testSelector, testsTableName, testsTable)
+		if err != nil {
+			return "", err
+		}
+		log = append(log, fmt.Sprintf("%s: %s", step.CandidateHash.String(), verdict))
+
+		ws, err = activeBisectWorkingSet(ctx)
+		if err != nil {
+			return "", err
+		}
+		bisectState, err = recordBisectCommits(ctx, ws.BisectState(), verdict, []string{step.CandidateHash.String()})
+		if err != nil {
+			return "", err
+		}
+		ws = ws.WithBisectState(bisectState)
+	}
+}
+
+// classifyWithBisectTests classifies the currently checked out commit like classifyBisectCommit, with the dolt_tests
+// table replaced by |testsTable| unless it's nil. The working root of the bisect branch is restored afterwards, even if
+// the tests can't be run, so that the replaced table is never left behind.
+func classifyWithBisectTests(ctx *sql.Context, engine *gms.Engine, testSelector string, testsTableName doltdb.TableName, testsTable *doltdb.Table) (verdict string, err error) {
+	if testsTable != nil {
+		restore, err := overlayBisectTests(ctx, testsTableName, testsTable)
+		if err != nil {
+			return "", err
+		}
+		defer func() {
+			if rerr := restore(); err == nil {
+				err = rerr
+			}
+		}()
+	}
+	return classifyBisectCommit(ctx, engine, testSelector)
+}
+
+// overlayBisectTests replaces the dolt_tests table in the working root of the bisect branch with |testsTable|, and
+// returns a function which restores the working root it replaced.
+func overlayBisectTests(ctx *sql.Context, testsTableName doltdb.TableName, testsTable *doltdb.Table) (func() error, error) {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	origRoot := ws.WorkingRoot()
+	root, err := origRoot.PutTable(ctx, testsTableName, testsTable)
+	if err != nil {
+		return nil, err
+	}
+	err = doltSession.SetWorkingSet(ctx, dbName, ws.WithWorkingRoot(root))
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		// the bisect state may have changed since, so only the working root is restored
+		ws, err := doltSession.WorkingSet(ctx, dbName)
+		if err != nil {
+			return err
+		}
+		return doltSession.SetWorkingSet(ctx, dbName, ws.WithWorkingRoot(origRoot))
+	}, nil
+}
+
+// classifyBisectCommit runs the dolt_tests matching |testSelector| against the currently checked out commit and
+// returns the bisect subcommand that the result corresponds to. A test which errored, because its query or the setup
+// of its test group failed, usually means that the commit predates the schema the test relies on, so the commit is
+// skipped rather than marked as bad. Errors running dolt_test_run itself, such as a selector that doesn't match any
+// test, are returned rather than classifying the commit.
+func classifyBisectCommit(ctx *sql.Context, engine *gms.Engine, testSelector string) (string, error) {
+	query, err := dbr.InterpolateForDialect("SELECT * FROM dolt_test_run('--details', ?)", []interface{}{testSelector}, dialect.MySQL)
+	if err != nil {
+		return "", err
+	}
+	// The tests run in the transaction of dolt_bisect, which mustn't be committed when their iter is closed, and
+	// closing it mustn't end the dolt_bisect call
+	if !ctx.GetIgnoreAutoCommit() {
+		ctx.SetIgnoreAutoCommit(true)
+		defer ctx.SetIgnoreAutoCommit(false)
+	}
+	queryCtx := sqlutil.NestedQueryContext(ctx)
+	sch, iter, _, err := engine.Query(queryCtx, query)
+	if err != nil {
+		return "", err
+	}
+	rows, err := sql.RowIterToRows(queryCtx, iter)
+	if err != nil {
+		return "", err
+	}
+	statusCol, erroredCol := sch.IndexOfColName("status"), sch.IndexOfColName("errored")
+	if statusCol < 0 || erroredCol < 0 {
+		return "", fmt.Errorf("dolt_test_run results are missing the status or errored columns")
+	}
+
+	verdict := BisectGoodCmd
+	for _, row := range rows {
+		// Like commit verification, we only look at the status of the results
+		if fmt.Sprintf("%v", row[statusCol]) == "PASS" {
+			continue
+		}
+		errored, err := sql.ConvertToBool(ctx, row[erroredCol])
+		if err != nil {
+			return "", err
+		}
+		if errored {
+			verdict = BisectSkipCmd
+		} else if verdict != BisectSkipCmd {
+			verdict = BisectBadCmd
+		}
+	}
+	return verdict, nil
+}
+
+// resetBisect ends the active bisect, deleting the bisect branch and checking out the branch the bisect was started on.
+func resetBisect(ctx *sql.Context) error {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := activeBisectWorkingSet(ctx)
+	if err != nil {
+		return err
+	}
+	startBranch := ws.BisectState().Branch()
+
+	// Clear the bisect state (even though we're going to delete this branch next)
+	err = doltSession.SetWorkingSet(ctx, dbName, ws.ClearBisect())
+	if err != nil {
+		return err
+	}
+
+	var rsc doltdb.ReplicationStatusController
+	dbData, ok := doltSession.GetDbData(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("unable to get DbData for database %s", dbName)
+	}
+	headRef, err := doltSession.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	err = actions.DeleteBranch(ctx, dbData, headRef.GetPath(), actions.DeleteOptions{
+		Force:                      true,
+		AllowDeletingCurrentBranch: true,
+	}, doltSession.Provider(), &rsc)
+	if err != nil {
+		return err
+	}
+
+	wsRef, err := ref.WorkingSetRefForHead(ref.NewBranchRef(startBranch))
+	if err != nil {
+		return err
+	}
+	return doltSession.SwitchWorkingSet(ctx, dbName, wsRef)
+}
+
+// activeBisectWorkingSet returns the working set for the current session, or an error if no bisect is in progress.
+func activeBisectWorkingSet(ctx *sql.Context) (*doltdb.WorkingSet, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	ws, err := doltSession.WorkingSet(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	if !ws.BisectActive() {
+		return nil, ErrNoBisectInProgress
+	}
+	return ws, nil
+}
+
+// resolveBisectCommit resolves |rev| relative to the currently checked out branch.
+func resolveBisectCommit(ctx *sql.Context, rev string) (*doltdb.Commit, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+	dbData, ok := doltSession.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("unable to find database %s", dbName)
+	}
+	headRef, err := doltSession.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := doltdb.NewCommitSpec(rev)
+	if err != nil {
+		return nil, err
+	}
+	optCmt, err := dbData.Ddb.Resolve(ctx, spec, headRef)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm, nil
+}
+
+// checkoutBisectCommit moves the bisect branch to |cm| and checks it out again, resetting the session's working set to
+// the commit's root value, and records |ws|'s bisect state in the new working set. Like dolt_checkout -B, the branch is
+// moved between transactions, so that the new transaction sees the new head.
+func checkoutBisectCommit(ctx *sql.Context, ws *doltdb.WorkingSet, cm *doltdb.Commit) error {
+	dbName := ctx.GetCurrentDatabase()
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := doltSession.GetDbData(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("unable to find database %s", dbName)
+	}
+	headRef, err := doltSession.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+
+	// Moving the branch keeps the state of its working set, but not its roots, so the bisect state is committed first
+	err = doltSession.SetWorkingSet(ctx, dbName, ws)
+	if err != nil {
+		return err
+	}
+	var rsc doltdb.ReplicationStatusController
+	err = commitTransaction(ctx, doltSession, &rsc)
+	if err != nil {
+		return err
+	}
+	err = actions.CreateBranchWithStartPt(ctx, dbData, headRef.GetPath(), h.String(), true, &rsc)
+	if err != nil {
+		return err
+	}
+	err = commitTransaction(ctx, doltSession, &rsc)
+	if err != nil {
+		return err
+	}
+
+	wsRef, err := ref.WorkingSetRefForHead(headRef)
+	if err != nil {
+		return err
+	}
+	err = doltSession.SwitchWorkingSet(ctx, dbName, wsRef)
+	if err != nil {
+		return err
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	return doltSession.ResetGlobals(ctx, dbName, root)
+}
+
+// setBisectWorkingSet records |ws|, and the bisect state it holds, in the current session.
+func setBisectWorkingSet(ctx *sql.Context, ws *doltdb.WorkingSet) error {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	return doltSession.SetWorkingSet(ctx, ctx.GetCurrentDatabase(), ws)
+}
+
+func bisectWaitingMessage(bisectState *doltdb.BisectState) string {
+	switch {
+	case bisectState.BadCommit() == "" && len(bisectState.GoodCommits()) == 0:
+		return "status: waiting for both good and bad commits"
+	case bisectState.BadCommit() == "":
+		return "status: waiting for bad commit, good commit(s) known"
+	default:
+		return "status: waiting for good commit(s), bad commit known"
+	}
+}
+
+func bisectCandidateMessage(ctx *sql.Context, step *bisect.Step) (string, error) {
+	meta, err := step.Candidate.GetCommitMeta(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Bisecting: %d revisions left to test after this (roughly %d steps)\n[%s] %s",
+		step.Remaining, step.Steps, step.CandidateHash.String(), meta.Description), nil
+}
+
+func bisectFirstBadMessage(ctx *sql.Context, cm *doltdb.Commit, h hash.Hash) (string, error) {
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s\ncommit %s\nAuthor: %s <%s>\nDate:  %s\n\n\t%s",
+		h.String(), BisectFirstBadCommitSuffix, h.String(), meta.Author.Name, meta.Author.Email,
+		meta.FormatTS(), meta.Description), nil
+}
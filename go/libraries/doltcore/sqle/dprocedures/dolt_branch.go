@@ -135,6 +135,9 @@ func renameBranch(ctx *sql.Context, dbData env.DbData[*sql.Context], apr *argpar
 		if err != nil {
 			return err
 		}
+		if err = checkBranchNotInOtherWorktree(ctx, dbName, oldBranchName); err != nil {
+			return err
+		}
 		var headOnCLI string
 		fs, err := sess.Provider().FileSystemForDatabase(dbName)
 		if err == nil {
@@ -239,6 +242,11 @@ func deleteBranches(ctx *sql.Context, dbData env.DbData[*sql.Context], apr *argp
 		if err = branch_control.CanDeleteBranch(ctx, branchName); err != nil {
 			return err
 		}
+		if !apr.Contains(cli.RemoteParam) {
+			if err = checkBranchNotInOtherWorktree(ctx, dbName, branchName); err != nil {
+				return err
+			}
+		}
 	}
 
 	dSess := dsess.DSessFromSess(ctx.Session)
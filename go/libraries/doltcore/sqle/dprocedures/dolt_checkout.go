@@ -444,6 +444,12 @@ func checkoutNewBranch(
 	if optionBBranch != "" {
 		newBranchName = optionBBranch
 	}
+	if createBranchForcibly {
+		err = checkBranchNotInOtherWorktree(ctx, dbName, newBranchName)
+		if err != nil {
+			return "", "", err
+		}
+	}
 
 	err = actions.CreateBranchWithStartPt(ctx, dbData, newBranchName, startPt, createBranchForcibly, rsc)
 	if err != nil {
@@ -587,7 +593,12 @@ func checkoutExistingBranchWithWorkingSetFallback(
 		return fmt.Errorf("could not load database %s", dbName)
 	}
 
-	err := checkoutExistingBranch(ctx, dbName, branchName, apr, overwriteIgnore)
+	err := checkBranchNotInOtherWorktree(ctx, dbName, branchName)
+	if err != nil {
+		return err
+	}
+
+	err = checkoutExistingBranch(ctx, dbName, branchName, apr, overwriteIgnore)
 	if errors.Is(err, doltdb.ErrWorkingSetNotFound) {
 		// If there is a branch but there is no working set,
 		// somehow the local branch ref was created without a
@@ -813,3 +824,14 @@ func validateNoDetachedHead(ctx *sql.Context, ddb *doltdb.DoltDB, branchName str
 
 	return nil
 }
+
+// checkBranchNotInOtherWorktree returns an error if |branchName| is checked out in a worktree of database |dbName|
+// other than the one the database is loaded from, since the worktrees would share the branch's working set.
+func checkBranchNotInOtherWorktree(ctx *sql.Context, dbName, branchName string) error {
+	fs, err := dsess.DSessFromSess(ctx.Session).Provider().FileSystemForDatabase(dbName)
+	if err != nil {
+		// Only databases loaded from a directory can have worktrees
+		return nil
+	}
+	return env.CheckBranchNotInOtherWorktree(fs, branchName)
+}
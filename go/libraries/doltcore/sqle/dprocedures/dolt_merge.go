@@ -147,6 +147,10 @@ func doDoltMerge(ctx *sql.Context, args []string) (string, int, int, string, err
 			return "", noConflictsOrViolations, threeWayMerge, "", err
 		}
 
+		if err = dsess.ClearRecordedConflicts(ctx, dbName); err != nil {
+			return "", noConflictsOrViolations, threeWayMerge, "", err
+		}
+
 		err := sess.SetWorkingSet(ctx, dbName, ws)
 		if err != nil {
 			return "", noConflictsOrViolations, threeWayMerge, "", err
@@ -265,11 +269,26 @@ func performMerge(
 			return ws, cmtHash, noConflictsOrViolations, threeWayMerge, "merge successful", nil
 		}
 
-		ws, err = executeFFMerge(ctx, dbName, spec.Squash, ws, dbData, spec.MergeC, spec)
+		h, err := spec.MergeC.HashOf()
 		if err != nil {
 			return ws, "", noConflictsOrViolations, fastForwardMerge, "", err
 		}
-		h, err := spec.MergeC.HashOf()
+		if !skipVerification {
+			// fast-forwards don't create a commit, so only the change assertions are verified, against the commits
+			// being fast-forwarded over
+			headHash, err := spec.HeadC.HashOf()
+			if err != nil {
+				return ws, "", noConflictsOrViolations, fastForwardMerge, "", err
+			}
+			err = actions.RunChangeVerification(ctx, headHash.String(), h.String())
+			if actions.ErrCommitVerificationFailed.Is(err) {
+				return ws, "", noConflictsOrViolations, fastForwardMerge, err.Error(), nil
+			} else if err != nil {
+				return ws, "", noConflictsOrViolations, fastForwardMerge, "", err
+			}
+		}
+
+		ws, err = executeFFMerge(ctx, dbName, spec.Squash, ws, dbData, spec.MergeC, spec)
 		if err != nil {
 			return ws, "", noConflictsOrViolations, fastForwardMerge, "", err
 		}
@@ -359,6 +378,9 @@ func executeMerge(
 			return nil, err
 		}
 	}
+	if err = dsess.ReuseRecordedResolutions(ctx, dbName, result); err != nil {
+		return nil, err
+	}
 	return mergeRootToWorking(ctx, sess, dbName, squash, force, ws, result, workingDiffs, cm, cmSpec, head)
 }
 
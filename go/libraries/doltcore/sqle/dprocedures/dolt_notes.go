@@ -0,0 +1,144 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// doltNotes is the stored procedure version for the CLI command `dolt notes`.
+func doltNotes(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, err := doDoltNotes(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res)), nil
+}
+
+// doDoltNotes is used as sql dolt_notes command for only adding or removing notes, not showing or listing them.
+// To read notes, the dolt_notes system table is used.
+func doDoltNotes(ctx *sql.Context, args []string) (int, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return 1, fmt.Errorf("Empty database name.")
+	}
+	dSess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return 1, fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	apr, err := cli.CreateNotesArgParser().Parse(args)
+	if err != nil {
+		return 1, err
+	}
+	if apr.NArg() == 0 {
+		return 1, fmt.Errorf("error: missing subcommand, expected add or remove")
+	}
+
+	headRef, err := dbData.Rsr.CWBHeadRef(ctx)
+	if err != nil {
+		return 1, err
+	}
+	revs := apr.Args[1:]
+	if len(revs) == 0 {
+		revs = []string{"HEAD"}
+	}
+
+	switch subcommand := strings.ToLower(apr.Arg(0)); subcommand {
+	case "add":
+		if len(revs) > 1 {
+			return 1, fmt.Errorf("error: too many arguments, add takes at most one commit")
+		}
+		msg, ok := apr.GetValue(cli.MessageArg)
+		if !ok || len(strings.TrimSpace(msg)) == 0 {
+			return 1, fmt.Errorf("error: a note message must be given with -m")
+		}
+
+		var name, email string
+		if authorStr, ok := apr.GetValue(cli.AuthorParam); ok {
+			name, email, err = cli.ParseAuthor(authorStr)
+			if err != nil {
+				return 1, err
+			}
+		} else {
+			name, email, _, _, err = dsess.ResolveNameEmail(ctx, dsess.DoltCommitterName, dsess.DoltCommitterEmail)
+			if err != nil {
+				return 1, err
+			}
+		}
+
+		h, err := resolveNotesCommit(ctx, dbData.Ddb, headRef, revs[0])
+		if err != nil {
+			return 1, err
+		}
+		err = dbData.Ddb.AddNote(ctx, h, datas.NewNote(name, email, msg), apr.Contains(cli.ForceFlag))
+		if errors.Is(err, doltdb.ErrNoteExists) {
+			return 1, fmt.Errorf("error: commit %s already has a note, use -f to replace it", h.String())
+		} else if err != nil {
+			return 1, err
+		}
+	case "remove":
+		if apr.Contains(cli.MessageArg) || apr.Contains(cli.ForceFlag) || apr.Contains(cli.AuthorParam) {
+			return 1, fmt.Errorf("error: remove does not take -m, -f, or --author")
+		}
+		for _, rev := range revs {
+			h, err := resolveNotesCommit(ctx, dbData.Ddb, headRef, rev)
+			if err != nil {
+				return 1, err
+			}
+			err = dbData.Ddb.RemoveNote(ctx, h)
+			if errors.Is(err, datas.ErrNoteNotFound) {
+				return 1, fmt.Errorf("error: commit %s has no note", h.String())
+			} else if err != nil {
+				return 1, err
+			}
+		}
+	case "show", "list":
+		return 1, fmt.Errorf("error: invalid argument, use the 'dolt_notes' system table to read notes")
+	default:
+		return 1, fmt.Errorf("error: unknown notes subcommand '%s'", apr.Arg(0))
+	}
+
+	return 0, nil
+}
+
+// resolveNotesCommit returns the hash of the commit |rev| names, relative to |headRef|.
+func resolveNotesCommit(ctx *sql.Context, ddb *doltdb.DoltDB, headRef ref.DoltRef, rev string) (hash.Hash, error) {
+	spec, err := doltdb.NewCommitSpec(rev)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	optCmt, err := ddb.Resolve(ctx, spec, headRef)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return hash.Hash{}, doltdb.ErrGhostCommitEncountered
+	}
+	return cm.HashOf()
+}
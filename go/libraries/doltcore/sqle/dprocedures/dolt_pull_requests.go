@@ -0,0 +1,468 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/doltcore/pullrequest"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+)
+
+var doltPrOpenSchema = []*sql.Column{
+	{
+		Name:     "pull_request_id",
+		Type:     types.Int64,
+		Nullable: false,
+	},
+}
+
+// doltPrOpen is the stored procedure which opens a pull request of a source branch into a target branch.
+func doltPrOpen(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	id, err := doDoltPrOpen(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(id), nil
+}
+
+// doltPrComment is the stored procedure which comments on a pull request.
+func doltPrComment(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if err := doDoltPrReview(ctx, "dolt_pr_comment", pullrequest.ReviewComment, args); err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(0)), nil
+}
+
+// doltPrApprove is the stored procedure which approves the current source commit of a pull request.
+func doltPrApprove(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if err := doDoltPrReview(ctx, "dolt_pr_approve", pullrequest.ReviewApproval, args); err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(0)), nil
+}
+
+// doltPrMerge is the stored procedure which merges a pull request into its target branch.
+func doltPrMerge(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	commit, err := doDoltPrMerge(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(commit), nil
+}
+
+// PullRequestWorkflowQueue is implemented by database providers which run the dolt_ci workflows triggered by the
+// activity of pull requests, such as the provider of a sql-server.
+type PullRequestWorkflowQueue interface {
+	// QueuePullRequestWorkflows queues the workflows on the target branch of |pr| in the database |dbName| whose
+	// pull_request event is triggered by |activity|, to run against the source commit of |pr|.
+	QueuePullRequestWorkflows(dbName string, ddb *doltdb.DoltDB, pr pullrequest.PullRequest, activity string)
+}
+
+// pullRequestDb is the database whose pull requests are read and written by a procedure.
+type pullRequestDb struct {
+	// baseName is the name of the database without a revision
+	baseName string
+	ddb      *doltdb.DoltDB
+	// fired are the activities which triggered workflows, queued once the pull requests are saved
+	fired []firedActivity
+}
+
+type firedActivity struct {
+	pr       pullrequest.PullRequest
+	activity string
+}
+
+func loadPullRequestDb(ctx *sql.Context) (*pullRequestDb, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return nil, fmt.Errorf("Empty database name.")
+	}
+	dSess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+	baseName, _ := doltdb.SplitRevisionDbName(dbName)
+	return &pullRequestDb{baseName: baseName, ddb: dbData.Ddb}, nil
+}
+
+// getOpen returns the open pull request in |s| whose id is |arg|.
+func getOpen(s *pullrequest.Store, arg string) (*pullrequest.PullRequest, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error: invalid pull request id '%s'", arg)
+	}
+	pr, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status != pullrequest.StatusOpen {
+		return nil, fmt.Errorf("error: pull request %d is %s", pr.ID, pr.Status)
+	}
+	return pr, nil
+}
+
+// sourceHead returns the head of the source branch of |pr|. A deleted source branch leaves the source commit as is.
+func (db *pullRequestDb) sourceHead(ctx *sql.Context, pr *pullrequest.PullRequest) (string, error) {
+	cm, err := db.ddb.ResolveCommitRef(ctx, ref.NewBranchRef(pr.SourceBranch))
+	if errors.Is(err, doltdb.ErrBranchNotFound) {
+		return pr.SourceCommit, nil
+	} else if err != nil {
+		return "", err
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return "", err
+	}
+	return h.String(), nil
+}
+
+// synchronize updates the source commit of |pr| in |s| to |commit|, firing the synchronized activity if the source
+// branch moved since the pull request was last used.
+func (db *pullRequestDb) synchronize(ctx *sql.Context, s *pullrequest.Store, pr *pullrequest.PullRequest, commit string) error {
+	if commit == pr.SourceCommit {
+		return nil
+	}
+	pr.SourceCommit = commit
+	pr.UpdatedAt = time.Now()
+	return db.fire(ctx, s, pr, pullrequest.ActivitySynchronized)
+}
+
+// fire records |activity| of |pr| in |s|, with the dolt_ci workflows on its target branch whose pull_request event it
+// triggers. The workflows are queued to run when the pull requests are saved.
+func (db *pullRequestDb) fire(ctx *sql.Context, s *pullrequest.Store, pr *pullrequest.PullRequest, activity string) error {
+	workflows, err := db.pullRequestWorkflows(ctx, pr.TargetBranch, activity)
+	if err != nil {
+		return err
+	}
+	s.AddEvent(pr, activity, workflows, time.Now())
+	if len(workflows) > 0 {
+		db.fired = append(db.fired, firedActivity{pr: *pr, activity: activity})
+	}
+	return nil
+}
+
+// update applies |edit| to the pull requests of the database and saves them, then queues the workflows triggered by
+// their activity on the provider of the session, if it runs them. |edit| is applied again if another session saves
+// the pull requests first. Workflows are only run by a sql-server with behavior.run_ci_workflows enabled, elsewhere
+// they're only recorded in the events of the pull requests.
+func (db *pullRequestDb) update(ctx *sql.Context, edit func(s *pullrequest.Store) error) error {
+	err := pullrequest.Update(ctx, db.ddb, func(s *pullrequest.Store) error {
+		db.fired = nil
+		return edit(s)
+	})
+	if err != nil {
+		return err
+	}
+	queue, ok := dsess.DSessFromSess(ctx.Session).Provider().(PullRequestWorkflowQueue)
+	if !ok {
+		return nil
+	}
+	for _, f := range db.fired {
+		queue.QueuePullRequestWorkflows(db.baseName, db.ddb, f.pr, f.activity)
+	}
+	db.fired = nil
+	return nil
+}
+
+// pullRequestWorkflows returns the names of the dolt_ci workflows on |target| whose pull_request event is triggered by
+// |activity| on a pull request into |target|.
+func (db *pullRequestDb) pullRequestWorkflows(ctx *sql.Context, target, activity string) ([]string, error) {
+	roots, err := db.ddb.ResolveBranchRoots(ctx, ref.NewBranchRef(target))
+	if err != nil {
+		return nil, err
+	}
+	hasWorkflows, err := roots.Head.HasTable(ctx, doltdb.TableName{Name: doltdb.WorkflowsTableName})
+	if err != nil || !hasWorkflows {
+		return nil, err
+	}
+
+	// The workflow manager runs its queries in the transaction of this procedure, which mustn't be committed by them
+	if !ctx.GetIgnoreAutoCommit() {
+		ctx.SetIgnoreAutoCommit(true)
+		defer ctx.SetIgnoreAutoCommit(false)
+	}
+
+	var workflows []string
+	engine := gms.NewDefault(dsess.DSessFromSess(ctx.Session).Provider())
+	queryCtx := sqlutil.NestedQueryContext(ctx)
+	err = withCurrentDatabase(ctx, doltdb.RevisionDbName(db.baseName, target), func() error {
+		wm := dolt_ci.NewWorkflowManager("", "", engine.Query)
+		names, err := wm.ListWorkflows(queryCtx)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			config, err := wm.GetWorkflowConfig(queryCtx, name)
+			if err != nil {
+				return err
+			}
+			if config.TriggeredByPullRequest(target, activity) {
+				workflows = append(workflows, name)
+			}
+		}
+		return nil
+	})
+	return workflows, err
+}
+
+// withCurrentDatabase calls |f| with |dbName| as the current database of |ctx|.
+func withCurrentDatabase(ctx *sql.Context, dbName string, f func() error) error {
+	prev := ctx.GetCurrentDatabase()
+	ctx.SetCurrentDatabase(dbName)
+	defer ctx.SetCurrentDatabase(prev)
+	return f()
+}
+
+func doDoltPrOpen(ctx *sql.Context, args []string) (int64, error) {
+	apr, err := cli.CreatePullRequestOpenArgParser().Parse(args)
+	if err != nil {
+		return 0, err
+	}
+	if apr.NArg() != 2 {
+		return 0, fmt.Errorf("error: dolt_pr_open requires a source branch and a target branch")
+	}
+	title, ok := apr.GetValue(cli.MessageArg)
+	if !ok || strings.TrimSpace(title) == "" {
+		return 0, fmt.Errorf("error: dolt_pr_open requires a title, specified with -m")
+	}
+	source, target := apr.Arg(0), apr.Arg(1)
+	if source == target {
+		return 0, fmt.Errorf("error: the source and target branches of a pull request must differ")
+	}
+
+	db, err := loadPullRequestDb(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sourceCm, err := db.ddb.ResolveCommitRef(ctx, ref.NewBranchRef(source))
+	if err != nil {
+		return 0, fmt.Errorf("error: unable to resolve source branch '%s': %w", source, err)
+	}
+	if _, err = db.ddb.ResolveCommitRef(ctx, ref.NewBranchRef(target)); err != nil {
+		return 0, fmt.Errorf("error: unable to resolve target branch '%s': %w", target, err)
+	}
+	sourceHash, err := sourceCm.HashOf()
+	if err != nil {
+		return 0, err
+	}
+
+	description, _ := apr.GetValue(cli.DescriptionParam)
+	reviewers, _ := apr.GetValueList(cli.ReviewersParam)
+	var id int64
+	err = db.update(ctx, func(s *pullrequest.Store) error {
+		if existing, ok := s.FindOpen(source, target); ok {
+			return fmt.Errorf("error: pull request %d from %s into %s is already open", existing.ID, source, target)
+		}
+		pr := s.Open(title, description, ctx.Client().User, source, target, sourceHash.String(), reviewers, time.Now())
+		id = pr.ID
+		return db.fire(ctx, s, pr, pullrequest.ActivityOpened)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// doDoltPrReview adds a review of the kind |kind| to a pull request, by the current user.
+func doDoltPrReview(ctx *sql.Context, procName, kind string, args []string) error {
+	apr, err := cli.CreatePullRequestReviewArgParser(procName).Parse(args)
+	if err != nil {
+		return err
+	}
+	if apr.NArg() != 1 {
+		return fmt.Errorf("error: %s requires the id of a pull request", procName)
+	}
+	body, _ := apr.GetValue(cli.MessageArg)
+	if kind == pullrequest.ReviewComment && strings.TrimSpace(body) == "" {
+		return fmt.Errorf("error: %s requires a comment, specified with -m", procName)
+	}
+
+	db, err := loadPullRequestDb(ctx)
+	if err != nil {
+		return err
+	}
+	reviewer := ctx.Client().User
+	return db.update(ctx, func(s *pullrequest.Store) error {
+		pr, err := getOpen(s, apr.Arg(0))
+		if err != nil {
+			return err
+		}
+		if kind == pullrequest.ReviewApproval && reviewer == pr.Author {
+			return fmt.Errorf("error: pull request %d can't be approved by its author", pr.ID)
+		}
+		head, err := db.sourceHead(ctx, pr)
+		if err != nil {
+			return err
+		}
+		if err = db.synchronize(ctx, s, pr, head); err != nil {
+			return err
+		}
+		s.AddReview(pr, reviewer, kind, body, time.Now())
+		return nil
+	})
+}
+
+// doDoltPrMerge merges the source commit of a pull request into its target branch with a merge commit, and returns the
+// hash of the commit. Pull requests into a protected branch need the approvals the branch requires, and pull requests
+// which would conflict aren't merged.
+func doDoltPrMerge(ctx *sql.Context, args []string) (string, error) {
+	apr, err := cli.CreatePullRequestReviewArgParser("dolt_pr_merge").Parse(args)
+	if err != nil {
+		return "", err
+	}
+	if apr.NArg() != 1 {
+		return "", fmt.Errorf("error: dolt_pr_merge requires the id of a pull request")
+	}
+
+	db, err := loadPullRequestDb(ctx)
+	if err != nil {
+		return "", err
+	}
+	// The pull request is merged before it's saved, so it's checked against the pull requests as they were read, and
+	// saving it only fails if another session merged it first
+	store, err := pullrequest.Load(ctx, db.ddb)
+	if err != nil {
+		return "", err
+	}
+	pr, err := getOpen(store, apr.Arg(0))
+	if err != nil {
+		return "", err
+	}
+	if _, err = db.ddb.ResolveCommitRef(ctx, ref.NewBranchRef(pr.SourceBranch)); err != nil {
+		return "", fmt.Errorf("error: unable to resolve source branch '%s': %w", pr.SourceBranch, err)
+	}
+	if pr.SourceCommit, err = db.sourceHead(ctx, pr); err != nil {
+		return "", err
+	}
+
+	required, err := db.requiredApprovals(ctx, pr.TargetBranch)
+	if err != nil {
+		return "", err
+	}
+	if approvals := len(store.Approvals(pr)); approvals < required {
+		return "", fmt.Errorf("error: pull request %d needs %d approvals of its latest commit to merge into protected branch %s, but has %d",
+			pr.ID, required, pr.TargetBranch, approvals)
+	}
+
+	msg, ok := apr.GetValue(cli.MessageArg)
+	if !ok {
+		msg = fmt.Sprintf("Merge pull request #%d from %s into %s", pr.ID, pr.SourceBranch, pr.TargetBranch)
+	}
+	var commit string
+	err = withCurrentDatabase(ctx, doltdb.RevisionDbName(db.baseName, pr.TargetBranch), func() error {
+		var hasConflicts int
+		commit, hasConflicts, _, _, err = doDoltMerge(ctx, []string{"--" + cli.NoFFParam, "-m", msg, pr.SourceCommit})
+		if err != nil {
+			return err
+		}
+		if hasConflicts == noConflictsOrViolations {
+			return nil
+		}
+		tables, err := conflictingTables(ctx)
+		if err != nil {
+			return err
+		}
+		if _, _, _, _, err = doDoltMerge(ctx, []string{"--" + cli.AbortParam}); err != nil {
+			return err
+		}
+		return fmt.Errorf("error: pull request %d can't be merged, it has conflicts in tables: %s", pr.ID, strings.Join(tables, ", "))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	merged := pr.SourceCommit
+	err = db.update(ctx, func(s *pullrequest.Store) error {
+		pr, err := getOpen(s, apr.Arg(0))
+		if err != nil {
+			return err
+		}
+		if err = db.synchronize(ctx, s, pr, merged); err != nil {
+			return err
+		}
+		now := time.Now()
+		pr.Status = pullrequest.StatusMerged
+		pr.MergeCommit = commit
+		pr.UpdatedAt = now
+		return db.fire(ctx, s, pr, pullrequest.ActivityClosed)
+	})
+	if err != nil {
+		return "", err
+	}
+	return commit, nil
+}
+
+// requiredApprovals returns the number of approvals required by the dolt_protected_branches table on |target| to merge
+// a pull request into it, which is zero for branches that aren't protected.
+func (db *pullRequestDb) requiredApprovals(ctx *sql.Context, target string) (int, error) {
+	roots, err := db.ddb.ResolveBranchRoots(ctx, ref.NewBranchRef(target))
+	if err != nil {
+		return 0, err
+	}
+	protected, err := doltdb.GetProtectedBranches(ctx, roots.Head, doltdb.DefaultSchemaName)
+	if err != nil {
+		return 0, err
+	}
+	for _, pb := range protected {
+		if pb.BranchName == target {
+			return pb.RequiredApprovals, nil
+		}
+	}
+	return 0, nil
+}
+
+// conflictingTables returns the tables with conflicts or constraint violations in the working set of the current
+// database, after a merge which didn't complete cleanly.
+func conflictingTables(ctx *sql.Context) ([]string, error) {
+	dbName := ctx.GetCurrentDatabase()
+	roots, ok := dsess.DSessFromSess(ctx.Session).GetRoots(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+	withConflicts, err := doltdb.TablesWithDataConflicts(ctx, roots.Working)
+	if err != nil {
+		return nil, err
+	}
+	withViolations, err := doltdb.TablesWithConstraintViolations(ctx, roots.Working)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	seen := make(map[string]struct{})
+	for _, tn := range append(withConflicts, withViolations...) {
+		if _, ok := seen[tn.String()]; ok {
+			continue
+		}
+		seen[tn.String()] = struct{}{}
+		tables = append(tables, tn.String())
+	}
+	return tables, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
 	"github.com/dolthub/dolt/go/store/datas"
@@ -84,6 +85,12 @@ func doDoltPush(ctx *sql.Context, args []string) (int, string, error) {
 		return cmdFailure, "", err
 	}
 
+	if !apr.Contains(cli.SkipVerificationFlag) {
+		if err = verifyPushTargets(ctx, dbData.Ddb, targets); err != nil {
+			return cmdFailure, "", err
+		}
+	}
+
 	if user, hasUser := apr.GetValue(cli.UserFlag); hasUser {
 		rmt := (*remote).WithParams(map[string]string{
 			dbfactory.GRPCUsernameAuthParam: user,
@@ -139,3 +146,45 @@ func doDoltPush(ctx *sql.Context, args []string) (int, string, error) {
 	// TODO : set upstream should be persisted outside of session
 	return cmdSuccess, returnMsg, nil
 }
+
+// verifyPushTargets runs the change assertions of commit verification against the commits being pushed to each branch
+// of |targets|, from its remote tracking branch. Branches which aren't tracked yet, and deletions, aren't verified.
+func verifyPushTargets(ctx *sql.Context, ddb *doltdb.DoltDB, targets []*env.PushTarget) error {
+	for _, target := range targets {
+		if target.SrcRef.GetType() != ref.BranchRefType || target.SrcRef == ref.EmptyBranchRef || target.RemoteRef == nil {
+			continue
+		}
+		tracked, err := ddb.HasRef(ctx, target.RemoteRef)
+		if err != nil {
+			return err
+		} else if !tracked {
+			continue
+		}
+
+		srcCm, err := ddb.ResolveCommitRef(ctx, target.SrcRef)
+		if err != nil {
+			return err
+		}
+		remoteCm, err := ddb.ResolveCommitRef(ctx, target.RemoteRef)
+		if err != nil {
+			return err
+		}
+		srcHash, err := srcCm.HashOf()
+		if err != nil {
+			return err
+		}
+		remoteHash, err := remoteCm.HashOf()
+		if err != nil {
+			return err
+		}
+		if srcHash == remoteHash {
+			continue
+		}
+
+		err = actions.RunChangeVerification(ctx, remoteHash.String(), srcHash.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/rerere"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// doltRerere is the stored procedure version for the CLI command `dolt rerere`.
+func doltRerere(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, err := doDoltRerere(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res)), nil
+}
+
+// doDoltRerere is used as sql dolt_rerere command for only forgetting and clearing recorded conflicts. To read the
+// recorded resolutions and pending conflicts, the dolt_rerere system table is used.
+func doDoltRerere(ctx *sql.Context, args []string) (int, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return 1, fmt.Errorf("Empty database name.")
+	}
+	dSess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return 1, fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	apr, err := cli.CreateRerereArgParser().Parse(args)
+	if err != nil {
+		return 1, err
+	}
+	if apr.NArg() == 0 {
+		return 1, fmt.Errorf("error: missing subcommand, expected forget or clear")
+	}
+
+	switch subcommand := strings.ToLower(apr.Arg(0)); subcommand {
+	case "forget":
+		if apr.NArg() == 1 {
+			return 1, fmt.Errorf("error: forget requires at least one table")
+		}
+		tblNames := make([]doltdb.TableName, apr.NArg()-1)
+		for i, name := range apr.Args[1:] {
+			tblNames[i] = doltdb.TableName{Name: name}
+		}
+		if err = dsess.ForgetRecordedResolutions(ctx, dbName, tblNames); err != nil {
+			return 1, err
+		}
+	case "clear":
+		if apr.NArg() > 1 {
+			return 1, fmt.Errorf("error: too many arguments, clear takes no tables")
+		}
+		headRef, err := dbData.Rsr.CWBHeadRef(ctx)
+		if err != nil {
+			return 1, err
+		}
+		if err = rerere.Clear(ctx, dbData.Ddb, headRef); err != nil {
+			return 1, err
+		}
+		// conflicts recorded by a merge earlier in this transaction would otherwise be saved when it commits
+		if err = dsess.ClearRecordedConflicts(ctx, dbName); err != nil {
+			return 1, err
+		}
+	case "status":
+		return 1, fmt.Errorf("error: invalid argument, use the 'dolt_rerere' system table to read recorded conflicts")
+	default:
+		return 1, fmt.Errorf("error: unknown rerere subcommand '%s'", apr.Arg(0))
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"fmt"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+)
+
+var doltTestSnapshotSchema = stringSchema("test_name", "snapshot")
+
+// expectedSnapshotAssertion is the assertion type of dolt_tests whose snapshots are recorded by dolt_test_snapshot
+const expectedSnapshotAssertion = "expected_snapshot"
+
+// snapshotTest is an expected_snapshot test of dolt_tests.
+type snapshotTest struct {
+	name, group, query string
+}
+
+// doltTestSnapshot is the stored procedure which records the current results of expected_snapshot tests as their
+// snapshots. Like dolt_test_run, each argument is a test name or a test group, and no arguments or a * selects every
+// test. It returns the name and new snapshot of each test.
+func doltTestSnapshot(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return nil, fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return nil, err
+	}
+
+	// the tests are updated in the transaction of this procedure, which mustn't be committed by its queries
+	if !ctx.GetIgnoreAutoCommit() {
+		ctx.SetIgnoreAutoCommit(true)
+		defer ctx.SetIgnoreAutoCommit(false)
+	}
+	engine := gms.NewDefault(dsess.DSessFromSess(ctx.Session).Provider())
+	// closing the iters of the nested queries mustn't end the query of this procedure
+	ctx = sqlutil.NestedQueryContext(ctx)
+
+	tests, err := selectSnapshotTests(ctx, engine, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for _, t := range tests {
+		if node, err := sqlutil.BindQuery(ctx, engine.Analyzer.Catalog, t.query); err != nil {
+			return nil, fmt.Errorf("could not record snapshot of test %s: %w", t.name, err)
+		} else if !node.IsReadOnly() {
+			return nil, fmt.Errorf("could not record snapshot of test %s: cannot execute write queries", t.name)
+		}
+		_, iter, _, err := engine.Query(ctx, t.query)
+		if err != nil {
+			return nil, fmt.Errorf("could not record snapshot of test %s: %w", t.name, err)
+		}
+		snapshot, err := sqlutil.ResultSnapshot(ctx, iter)
+		if err != nil {
+			return nil, fmt.Errorf("could not record snapshot of test %s: %w", t.name, err)
+		}
+
+		update, err := dbr.InterpolateForDialect(
+			fmt.Sprintf("UPDATE %s SET assertion_value = ? WHERE test_name = ?", doltdb.TestsTableName),
+			[]interface{}{snapshot, t.name}, dialect.MySQL)
+		if err != nil {
+			return nil, err
+		}
+		_, iter, _, err = engine.Query(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = sql.RowIterToRows(ctx, iter); err != nil {
+			return nil, err
+		}
+		rows = append(rows, sql.NewRow(t.name, snapshot))
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// selectSnapshotTests returns the expected_snapshot tests selected by |args|.
+func selectSnapshotTests(ctx *sql.Context, engine *gms.Engine, args []string) ([]snapshotTest, error) {
+	query, err := dbr.InterpolateForDialect(
+		fmt.Sprintf("SELECT test_name, test_group, test_query FROM %s WHERE assertion_type = ? ORDER BY test_name", doltdb.TestsTableName),
+		[]interface{}{expectedSnapshotAssertion}, dialect.MySQL)
+	if err != nil {
+		return nil, err
+	}
+	_, iter, _, err := engine.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return nil, err
+	}
+
+	all := make([]snapshotTest, len(rows))
+	for i, row := range rows {
+		vals := make([]string, len(row))
+		for j := range row {
+			v, err := sql.UnwrapAny(ctx, row[j])
+			if err != nil {
+				return nil, err
+			}
+			if str, ok := v.(string); ok {
+				vals[j] = str
+			}
+		}
+		all[i] = snapshotTest{name: vals[0], group: vals[1], query: vals[2]}
+	}
+
+	if len(args) == 0 {
+		return all, nil
+	}
+	var selected []snapshotTest
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		var matches []snapshotTest
+		for _, t := range all {
+			if arg == "*" || t.name == arg {
+				matches = append(matches, t)
+			}
+		}
+		if len(matches) == 0 {
+			for _, t := range all {
+				if t.group == arg {
+					matches = append(matches, t)
+				}
+			}
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("could not find %s tests for argument: %s", expectedSnapshotAssertion, arg)
+		}
+		for _, t := range matches {
+			if !seen[t.name] {
+				seen[t.name] = true
+				selected = append(selected, t)
+			}
+		}
+	}
+	return selected, nil
+}
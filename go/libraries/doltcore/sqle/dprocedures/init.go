@@ -21,7 +21,10 @@ import (
 
 var DoltProcedures = []sql.ExternalStoredProcedureDetails{
 	{Name: "dolt_add", Schema: int64Schema("status"), Function: doltAdd},
+	{Name: "dolt_am", Schema: doltAmSchema, Function: doltAm},
+	{Name: "dolt_apply", Schema: doltApplySchema, Function: doltApply},
 	{Name: "dolt_backup", Schema: int64Schema("status"), Function: doltBackup, ReadOnly: true, AdminOnly: true},
+	{Name: "dolt_bisect", Schema: doltBisectProcedureSchema, Function: doltBisect},
 	{Name: "dolt_branch", Schema: int64Schema("status"), Function: doltBranch},
 	{Name: "dolt_checkout", Schema: doltCheckoutSchema, Function: doltCheckout, ReadOnly: true},
 	{Name: "dolt_cherry_pick", Schema: cherryPickSchema, Function: doltCherryPick},
@@ -36,12 +39,18 @@ var DoltProcedures = []sql.ExternalStoredProcedureDetails{
 	{Name: "dolt_update_column_tag", Schema: int64Schema("status"), Function: doltUpdateColumnTag, AdminOnly: true},
 	{Name: "dolt_purge_dropped_databases", Schema: int64Schema("status"), Function: doltPurgeDroppedDatabases, AdminOnly: true},
 	{Name: "dolt_rebase", Schema: doltRebaseProcedureSchema, Function: doltRebase},
+	{Name: "dolt_rerere", Schema: int64Schema("status"), Function: doltRerere},
 	{Name: "dolt_rm", Schema: int64Schema("status"), Function: doltRm},
 
 	{Name: "dolt_gc", Schema: int64Schema("status"), Function: doltGC, ReadOnly: true, AdminOnly: true},
 	{Name: "dolt_thread_dump", Schema: stringSchema("thread_dump"), Function: doltThreadDump, ReadOnly: true, AdminOnly: true},
 
 	{Name: "dolt_merge", Schema: doltMergeSchema, Function: doltMerge},
+	{Name: "dolt_notes", Schema: int64Schema("status"), Function: doltNotes},
+	{Name: "dolt_pr_approve", Schema: int64Schema("status"), Function: doltPrApprove},
+	{Name: "dolt_pr_comment", Schema: int64Schema("status"), Function: doltPrComment},
+	{Name: "dolt_pr_merge", Schema: stringSchema("hash"), Function: doltPrMerge},
+	{Name: "dolt_pr_open", Schema: doltPrOpenSchema, Function: doltPrOpen},
 	{Name: "dolt_pull", Schema: doltPullSchema, Function: doltPull, AdminOnly: true},
 	{Name: "dolt_push", Schema: doltPushSchema, Function: doltPush, AdminOnly: true},
 	{Name: "dolt_remote", Schema: int64Schema("status"), Function: doltRemote, AdminOnly: true},
@@ -49,6 +58,7 @@ var DoltProcedures = []sql.ExternalStoredProcedureDetails{
 	{Name: "dolt_revert", Schema: doltRevertSchema, Function: doltRevert},
 	{Name: "dolt_stash", Schema: int64Schema("status"), Function: doltStash},
 	{Name: "dolt_tag", Schema: int64Schema("status"), Function: doltTag},
+	{Name: "dolt_test_snapshot", Schema: doltTestSnapshotSchema, Function: doltTestSnapshot},
 	{Name: "dolt_verify_constraints", Schema: int64Schema("violations"), Function: doltVerifyConstraints},
 
 	{Name: "dolt_stats_restart", Schema: statsFuncSchema, Function: statsFunc(statsRestart)},
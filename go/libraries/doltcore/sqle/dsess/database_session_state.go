@@ -21,6 +21,7 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/rerere"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/globalstate"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/libraries/utils/concurrentmap"
@@ -121,6 +122,9 @@ type branchState struct {
 	readOnly bool
 	// dirty is true if this branch state has uncommitted changes
 	dirty bool
+	// rerereUpdate holds the conflicts recorded for reuse by a merge in this transaction, which are saved when the
+	// transaction commits
+	rerereUpdate *rerere.Update
 }
 
 // NewEmptyBranchState creates a new branch state for the given head name with the head provided, adds it to the db
@@ -0,0 +1,153 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/rerere"
+)
+
+// ReuseRecordedResolutions resolves the conflicts in the merge |result| for the database |dbName| which have recorded
+// resolutions, and records the rest so their resolutions can be reused once they're committed. The conflicts are
+// only saved once the transaction commits. It does nothing unless @@dolt_rerere_enabled is set.
+func ReuseRecordedResolutions(ctx *sql.Context, dbName string, result *merge.Result) error {
+	if enabled, err := GetBooleanSystemVar(ctx, DoltRerereEnabled); err != nil || !enabled {
+		return err
+	}
+	sess := DSessFromSess(ctx.Session)
+	ddb, ok := sess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	headRef, err := sess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	update, err := rerere.ApplyToMergeResult(ctx, ddb, headRef, result)
+	if err != nil || update == nil {
+		return err
+	}
+	return sess.setRerereUpdate(ctx, dbName, func(prev *rerere.Update) *rerere.Update {
+		if prev != nil {
+			update.Used = append(prev.Used, update.Used...)
+		}
+		return update
+	})
+}
+
+// ClearRecordedConflicts forgets the unresolved conflicts recorded for the current branch of |dbName|, when the
+// merge that produced them is aborted. Like the conflicts themselves, this is only saved once the transaction
+// commits. It does nothing unless @@dolt_rerere_enabled is set.
+func ClearRecordedConflicts(ctx *sql.Context, dbName string) error {
+	if enabled, err := GetBooleanSystemVar(ctx, DoltRerereEnabled); err != nil || !enabled {
+		return err
+	}
+	sess := DSessFromSess(ctx.Session)
+	headRef, err := sess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	return sess.setRerereUpdate(ctx, dbName, func(prev *rerere.Update) *rerere.Update {
+		update := &rerere.Update{Branch: headRef}
+		if prev != nil {
+			update.Used = prev.Used
+		}
+		return update
+	})
+}
+
+// ForgetRecordedResolutions removes the recorded resolutions and pending conflicts of the tables named from |dbName|,
+// including any conflicts recorded in this transaction which haven't been saved yet.
+func ForgetRecordedResolutions(ctx *sql.Context, dbName string, tblNames []doltdb.TableName) error {
+	sess := DSessFromSess(ctx.Session)
+	ddb, ok := sess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	if err := rerere.Forget(ctx, ddb, tblNames); err != nil {
+		return err
+	}
+	return sess.setRerereUpdate(ctx, dbName, func(prev *rerere.Update) *rerere.Update {
+		if prev != nil {
+			prev.Forget(tblNames)
+		}
+		return prev
+	})
+}
+
+// RecordedConflicts returns the recorded resolutions of |dbName|, and the pending conflicts of its current branch,
+// including those recorded in this transaction which haven't been saved yet.
+func RecordedConflicts(ctx *sql.Context, dbName string, ddb *doltdb.DoltDB) ([]rerere.Entry, error) {
+	sess := DSessFromSess(ctx.Session)
+	headRef, err := sess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		// Only recorded resolutions are returned when there's no current branch, such as when a commit is checked out
+		return rerere.Entries(ctx, ddb, nil, nil)
+	}
+	bs, ok, err := sess.lookupDbState(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	var unsaved *rerere.Update
+	if ok {
+		unsaved = bs.rerereUpdate
+	}
+	return rerere.Entries(ctx, ddb, headRef, unsaved)
+}
+
+// setRerereUpdate replaces the unsaved rerere update of the current branch of |dbName| with the one |update| returns
+// for it.
+func (d *DoltSession) setRerereUpdate(ctx *sql.Context, dbName string, update func(prev *rerere.Update) *rerere.Update) error {
+	bs, ok, err := d.lookupDbState(ctx, dbName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	bs.rerereUpdate = update(bs.rerereUpdate)
+	return nil
+}
+
+// saveRecordedConflicts saves the rerere update made in the transaction committed for |branchState|. The transaction
+// has already been committed, so failing to save it only loses the conflicts for reuse.
+func (d *DoltSession) saveRecordedConflicts(ctx *sql.Context, branchState *branchState) {
+	if branchState.rerereUpdate == nil {
+		return
+	}
+	if err := branchState.rerereUpdate.Save(ctx, branchState.dbData.Ddb); err != nil {
+		ctx.GetLogger().Warnf("unable to record conflicts for reuse: %v", err)
+	}
+	branchState.rerereUpdate = nil
+}
+
+// recordResolutions records the resolutions of the conflicts of the current branch of |dbName|, which were committed
+// with the root value |root|, when @@dolt_rerere_enabled is set.
+func (d *DoltSession) recordResolutions(ctx *sql.Context, dbName string, root doltdb.RootValue) error {
+	if enabled, err := GetBooleanSystemVar(ctx, DoltRerereEnabled); err != nil || !enabled {
+		return err
+	}
+	ddb, ok := d.GetDoltDB(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	headRef, err := d.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	return rerere.RecordResolutions(ctx, ddb, headRef, root)
+}
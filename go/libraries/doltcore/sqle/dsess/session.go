@@ -621,7 +621,9 @@ func (d *DoltSession) DoltCommit(
 	tx sql.Transaction,
 	commit *doltdb.PendingCommit,
 ) (*doltdb.Commit, error) {
+	var mergeActive bool
 	commitFunc := func(ctx *sql.Context, dtx *DoltTransaction, workingSet *doltdb.WorkingSet) (*doltdb.WorkingSet, *doltdb.Commit, error) {
+		mergeActive = workingSet.MergeActive()
 		ws, commit, err := dtx.DoltCommit(
 			ctx,
 			workingSet.WithWorkingRoot(commit.Roots.Working).WithStagedRoot(commit.Roots.Staged),
@@ -638,6 +640,13 @@ func (d *DoltSession) DoltCommit(
 		return nil, err
 	}
 
+	// The commit has already been written, so failing to record conflict resolutions only loses them for reuse
+	if mergeActive {
+		if err = d.recordResolutions(ctx, dbName, commit.Roots.Staged); err != nil {
+			ctx.GetLogger().Warnf("unable to record conflict resolutions: %v", err)
+		}
+	}
+
 	branch, b, e := d.CurrentHead(ctx, dbName)
 	if e == nil && b {
 		doltdb.BranchActivityWriteEvent(ctx, dbName, branch)
@@ -665,6 +674,7 @@ func (d *DoltSession) commitBranchState(
 	if err != nil {
 		return nil, err
 	}
+	d.saveRecordedConflicts(ctx, branchState)
 
 	// When a dolt commit was created, update the branch state to reflect the new HEAD. Without this, subsequent
 	// operations within the same stored procedure call (e.g. a multi-commit revert loop) would see a stale
@@ -44,6 +44,7 @@ const (
 	CurrentBatchModeKey                  = "batch_mode"
 	DoltOverrideSchema                   = "dolt_override_schema"
 	AllowCommitConflicts                 = "dolt_allow_commit_conflicts"
+	DoltRerereEnabled                    = "dolt_rerere_enabled"
 	ReplicateToRemote                    = "dolt_replicate_to_remote"
 	ReadReplicaRemote                    = "dolt_read_replica_remote"
 	ReadReplicaForcePull                 = "dolt_read_replica_force_pull"
@@ -0,0 +1,609 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// The operations of change events, which are the same as Debezium's.
+const (
+	ChangeOpCreate = "c"
+	ChangeOpUpdate = "u"
+	ChangeOpDelete = "d"
+)
+
+// changesPollInterval is how often dolt_changes checks for new commits when it's waiting for them.
+const changesPollInterval = 100 * time.Millisecond
+
+// ErrNotInFirstParentHistory is returned when the commit to read changes since isn't on the first-parent history of
+// the branch.
+var ErrNotInFirstParentHistory = errors.NewKind("commit %s is not in the first-parent history of %s")
+
+var changesSchema = sql.Schema{
+	&sql.Column{Name: "commit_hash", Type: types.Text, Nullable: false},
+	&sql.Column{Name: "committer", Type: types.Text, Nullable: false},
+	&sql.Column{Name: "commit_date", Type: types.Datetime3, Nullable: false},
+	&sql.Column{Name: "table_name", Type: types.Text, Nullable: false},
+	&sql.Column{Name: "op", Type: types.Text, Nullable: false},
+	&sql.Column{Name: "before", Type: types.JSON, Nullable: true},
+	&sql.Column{Name: "after", Type: types.JSON, Nullable: true},
+}
+
+var _ sql.TableFunction = (*ChangesTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*ChangesTableFunction)(nil)
+
+// ChangesTableFunction implements the dolt_changes table function, which returns the row-level changes made by the
+// commits on the first-parent history of a branch after a given commit, oldest first, as change events in the style
+// of Debezium. If no commit has made a change yet, it can wait for one for a number of seconds, so that consumers of
+// the changes can long-poll for them.
+type ChangesTableFunction struct {
+	database sql.Database
+	exprs    []sql.Expression
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (ct *ChangesTableFunction) NewInstance(ctx *sql.Context, db sql.Database, exprs []sql.Expression) (sql.Node, error) {
+	newInstance := &ChangesTableFunction{
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(ctx, exprs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (ct *ChangesTableFunction) Name() string {
+	return "dolt_changes"
+}
+
+// String implements the Stringer interface
+func (ct *ChangesTableFunction) String() string {
+	exprStrs := make([]string, len(ct.exprs))
+	for i, expr := range ct.exprs {
+		exprStrs[i] = expr.String()
+	}
+	return fmt.Sprintf("DOLT_CHANGES(%s)", strings.Join(exprStrs, ", "))
+}
+
+// Database implements the sql.Databaser interface
+func (ct *ChangesTableFunction) Database() sql.Database {
+	return ct.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (ct *ChangesTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nct := *ct
+	nct.database = database
+	return &nct, nil
+}
+
+// Expressions implements the sql.Expressioner interface
+func (ct *ChangesTableFunction) Expressions() []sql.Expression {
+	return ct.exprs
+}
+
+// WithExpressions implements the sql.Expressioner interface
+func (ct *ChangesTableFunction) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 2 || len(exprs) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New(ct.Name(), "2 or 3", len(exprs))
+	}
+
+	nct := *ct
+	nct.exprs = exprs
+	return &nct, nil
+}
+
+// Schema implements the sql.Node interface
+func (ct *ChangesTableFunction) Schema(_ *sql.Context) sql.Schema {
+	return changesSchema
+}
+
+// Resolved implements the sql.Resolvable interface
+func (ct *ChangesTableFunction) Resolved() bool {
+	for _, expr := range ct.exprs {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface
+func (ct *ChangesTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface
+func (ct *ChangesTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface
+func (ct *ChangesTableFunction) WithChildren(_ *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return ct, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (ct *ChangesTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	baseDB, _ := doltdb.SplitRevisionDbName(ct.database.Name())
+	subject := sql.PrivilegeCheckSubject{Database: baseDB}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// RowIter implements the sql.Node interface
+func (ct *ChangesTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	args := make([]interface{}, len(ct.exprs))
+	for i, expr := range ct.exprs {
+		v, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	branch, ok := args[0].(string)
+	if !ok {
+		return nil, sql.ErrInvalidArgumentDetails.New(ct.Name(), ct.exprs[0].String())
+	}
+	var since string
+	if args[1] != nil {
+		if since, ok = args[1].(string); !ok {
+			return nil, sql.ErrInvalidArgumentDetails.New(ct.Name(), ct.exprs[1].String())
+		}
+	}
+	var wait time.Duration
+	if len(args) == 3 && args[2] != nil {
+		secs, _, err := types.Float64.Convert(ctx, args[2])
+		if err != nil {
+			return nil, sql.ErrInvalidArgumentDetails.New(ct.Name(), ct.exprs[2].String())
+		}
+		wait = time.Duration(secs.(float64) * float64(time.Second))
+	}
+
+	sqledb, ok := ct.database.(dsess.SqlDatabase)
+	if !ok {
+		return nil, fmt.Errorf("unexpected database type: %T", ct.database)
+	}
+	ddb := sqledb.DbData().Ddb
+	branchRef := ref.NewBranchRef(branch)
+
+	var sinceCm *doltdb.Commit
+	if since != "" {
+		cs, err := doltdb.NewCommitSpec(since)
+		if err != nil {
+			return nil, err
+		}
+		optCm, err := ddb.Resolve(ctx, cs, branchRef)
+		if err != nil {
+			return nil, err
+		}
+		if sinceCm, ok = optCm.ToCommit(); !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+	}
+
+	// The branch is read from the database rather than the session, so that commits made after this transaction
+	// began are seen while waiting for them. While waiting, the history is only walked again when the branch moves,
+	// and then only back to the last head seen, which had no changes since |sinceCm|.
+	deadline := time.Now().Add(wait)
+	var lastHead *doltdb.Commit
+	var lastHash hash.Hash
+	for {
+		head, err := ddb.ResolveCommitRef(ctx, branchRef)
+		if err != nil {
+			return nil, err
+		}
+		h, err := head.HashOf()
+		if err != nil {
+			return nil, err
+		}
+
+		if lastHead == nil || h != lastHash {
+			var events []ChangeEvent
+			if lastHead != nil {
+				events, err = ChangesSince(ctx, head, lastHead)
+			}
+			if lastHead == nil || ErrNotInFirstParentHistory.Is(err) {
+				// the branch was reset rather than advanced, so every change since |sinceCm| is looked at again
+				events, err = ChangesSince(ctx, head, sinceCm)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if len(events) > 0 {
+				return changeEventsRowIter(events), nil
+			}
+			lastHead, lastHash = head, h
+		}
+
+		if !time.Now().Before(deadline) {
+			return changeEventsRowIter(nil), nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, context.Cause(ctx)
+		case <-time.After(min(changesPollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// changeEventsRowIter returns a row iter of the rows of dolt_changes for |events|.
+func changeEventsRowIter(events []ChangeEvent) sql.RowIter {
+	rows := make([]sql.Row, len(events))
+	for i, e := range events {
+		rows[i] = e.toRow()
+	}
+	return sql.RowsToRowIter(rows...)
+}
+
+// ChangeEvent is a change to a row of a table made by a commit, in the style of a Debezium change event.
+type ChangeEvent struct {
+	CommitHash string
+	Committer  string
+	CommitDate time.Time
+	Table      string
+	// Op is one of ChangeOpCreate, ChangeOpUpdate or ChangeOpDelete
+	Op string
+	// Before and After are the row before and after the change, keyed by column name, or nil if the row didn't
+	// exist. Their values can be represented as JSON.
+	Before map[string]interface{}
+	After  map[string]interface{}
+}
+
+// toRow returns the row of dolt_changes for |e|.
+func (e ChangeEvent) toRow() sql.Row {
+	var before, after interface{}
+	if e.Before != nil {
+		before = types.JSONDocument{Val: e.Before}
+	}
+	if e.After != nil {
+		after = types.JSONDocument{Val: e.After}
+	}
+	return sql.Row{e.CommitHash, e.Committer, e.CommitDate, e.Table, e.Op, before, after}
+}
+
+// ChangesSince returns the change events of the commits on the first-parent history of |head| after |since|, oldest
+// first. If |since| is nil, the change events of the whole history are returned. ErrNotInFirstParentHistory is
+// returned if |since| isn't on the first-parent history of |head|.
+func ChangesSince(ctx *sql.Context, head, since *doltdb.Commit) ([]ChangeEvent, error) {
+	var sinceHash string
+	if since != nil {
+		h, err := since.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		sinceHash = h.String()
+	}
+
+	var commits []*doltdb.Commit
+	for cm := head; ; {
+		h, err := cm.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		if h.String() == sinceHash {
+			break
+		}
+		commits = append(commits, cm)
+		if cm.NumParents() == 0 {
+			if since != nil {
+				headHash, err := head.HashOf()
+				if err != nil {
+					return nil, err
+				}
+				return nil, ErrNotInFirstParentHistory.New(sinceHash, headHash.String())
+			}
+			break
+		}
+		optParent, err := cm.GetParent(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if cm, ok = optParent.ToCommit(); !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+	}
+
+	var events []ChangeEvent
+	for i := len(commits) - 1; i >= 0; i-- {
+		cmEvents, err := CommitChanges(ctx, commits[i])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, cmEvents...)
+	}
+	return events, nil
+}
+
+// CommitChanges returns the change events of the changes |cm| made to the rows of the tables of its first parent,
+// ordered by table name and then by primary key. Changes to system tables aren't included.
+func CommitChanges(ctx *sql.Context, cm *doltdb.Commit) ([]ChangeEvent, error) {
+	h, err := cm.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentRoot doltdb.RootValue
+	if cm.NumParents() == 0 {
+		parentRoot, err = doltdb.EmptyRootValue(ctx, root.VRW(), root.NodeStore())
+	} else {
+		var optParent *doltdb.OptionalCommit
+		if optParent, err = cm.GetParent(ctx, 0); err == nil {
+			parent, ok := optParent.ToCommit()
+			if !ok {
+				return nil, doltdb.ErrGhostCommitEncountered
+			}
+			parentRoot, err = parent.GetRootValue(ctx)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deltas, err := diff.GetTableDeltas(ctx, parentRoot, root)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].CurName() < deltas[j].CurName()
+	})
+
+	cr := &changeReader{
+		template: ChangeEvent{
+			CommitHash: h.String(),
+			Committer:  meta.Committer.Name,
+			CommitDate: meta.Committer.Date.Time().UTC(),
+		},
+	}
+	for _, td := range deltas {
+		if td.FromTable == nil && td.ToTable == nil || doltdb.HasDoltPrefix(td.CurName()) {
+			continue
+		}
+		if changed, err := td.HasHashChanged(); err != nil {
+			return nil, err
+		} else if !changed {
+			continue
+		}
+		if err = cr.readTableChanges(ctx, td); err != nil {
+			return nil, err
+		}
+	}
+	return cr.events, nil
+}
+
+// changeReader reads the change events of the tables changed by a commit.
+type changeReader struct {
+	// template has the commit's fields of the events
+	template ChangeEvent
+	events   []ChangeEvent
+}
+
+// changeSide reads the rows of a table on one side of a change.
+type changeSide struct {
+	sch  schema.Schema
+	rows prolly.Map
+	conv dtables.ProllyRowConverter
+}
+
+// newChangeSide returns the changeSide for the rows |idx| of a table with schema |sch|, or nil if the table doesn't
+// exist.
+func newChangeSide(ctx *sql.Context, sch schema.Schema, idx durable.Index) (*changeSide, error) {
+	if idx == nil {
+		return nil, nil
+	}
+	rows, err := durable.ProllyMapFromIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	conv, err := dtables.NewProllyRowConverter(ctx, sch, sch, ctx.Warn, rows.NodeStore())
+	if err != nil {
+		return nil, err
+	}
+	return &changeSide{sch: sch, rows: rows, conv: conv}, nil
+}
+
+// image returns the row with key |k| and value |v|, keyed by column name.
+func (s *changeSide) image(ctx *sql.Context, k, v val.Tuple) (map[string]interface{}, error) {
+	cols := s.sch.GetAllCols().GetColumns()
+	row := make(sql.Row, len(cols))
+	if err := s.conv.PutConverted(ctx, k, v, row); err != nil {
+		return nil, err
+	}
+	img := make(map[string]interface{}, len(cols))
+	for i, col := range cols {
+		if col.Virtual {
+			continue
+		}
+		jv, err := changeJSONValue(ctx, row[i])
+		if err != nil {
+			return nil, err
+		}
+		img[col.Name] = jv
+	}
+	return img, nil
+}
+
+// changeJSONValue returns |v| as a value that can be represented as JSON.
+func changeJSONValue(ctx *sql.Context, v interface{}) (interface{}, error) {
+	v, err := sql.UnwrapAny(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case sql.JSONWrapper:
+		return v.ToInterface(ctx)
+	case []byte:
+		// like Debezium, binary values are base64 encoded
+		return base64.StdEncoding.EncodeToString(v), nil
+	}
+	return v, nil
+}
+
+// readTableChanges adds the change events of the table delta |td|.
+func (cr *changeReader) readTableChanges(ctx *sql.Context, td diff.TableDelta) error {
+	fromSch, toSch, err := td.GetSchemas(ctx)
+	if err != nil {
+		return err
+	}
+	fromIdx, toIdx, err := td.GetRowData(ctx)
+	if err != nil {
+		return err
+	}
+	from, err := newChangeSide(ctx, fromSch, fromIdx)
+	if err != nil {
+		return err
+	}
+	to, err := newChangeSide(ctx, toSch, toIdx)
+	if err != nil {
+		return err
+	}
+	table := td.CurName()
+
+	// rows can only be matched by key when the table's key didn't change
+	if from == nil || to == nil || schema.IsKeyless(fromSch) != schema.IsKeyless(toSch) ||
+		!from.rows.KeyDesc().Equals(to.rows.KeyDesc()) {
+		if from != nil {
+			if err = cr.readAll(ctx, table, from, ChangeOpDelete); err != nil {
+				return err
+			}
+		}
+		if to != nil {
+			return cr.readAll(ctx, table, to, ChangeOpCreate)
+		}
+		return nil
+	}
+
+	keyless := schema.IsKeyless(toSch)
+	err = prolly.DiffMaps(ctx, from.rows, to.rows, false, func(_ context.Context, d tree.Diff) error {
+		n := uint64(1)
+		if keyless {
+			// the rows of keyless tables are only ever added or removed, as many times as their cardinality changed
+			fromN, toN := uint64(0), uint64(0)
+			if d.From != nil {
+				fromN = val.ReadKeylessCardinality(val.Tuple(d.From))
+			}
+			if d.To != nil {
+				toN = val.ReadKeylessCardinality(val.Tuple(d.To))
+			}
+			if fromN < toN {
+				n, d.Type = toN-fromN, tree.AddedDiff
+			} else {
+				n, d.Type = fromN-toN, tree.RemovedDiff
+			}
+		}
+
+		e := cr.template
+		e.Table = table
+		var err error
+		switch d.Type {
+		case tree.AddedDiff:
+			e.Op = ChangeOpCreate
+			e.After, err = to.image(ctx, val.Tuple(d.Key), val.Tuple(d.To))
+		case tree.RemovedDiff:
+			e.Op = ChangeOpDelete
+			e.Before, err = from.image(ctx, val.Tuple(d.Key), val.Tuple(d.From))
+		case tree.ModifiedDiff:
+			e.Op = ChangeOpUpdate
+			if e.Before, err = from.image(ctx, val.Tuple(d.Key), val.Tuple(d.From)); err == nil {
+				e.After, err = to.image(ctx, val.Tuple(d.Key), val.Tuple(d.To))
+			}
+		}
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < n; i++ {
+			cr.events = append(cr.events, e)
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// readAll adds an event with the operation |op| for each of the rows of |side|.
+func (cr *changeReader) readAll(ctx *sql.Context, table string, side *changeSide, op string) error {
+	keyless := schema.IsKeyless(side.sch)
+	iter, err := side.rows.IterAll(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		img, err := side.image(ctx, k, v)
+		if err != nil {
+			return err
+		}
+		e := cr.template
+		e.Table, e.Op = table, op
+		if op == ChangeOpCreate {
+			e.After = img
+		} else {
+			e.Before = img
+		}
+		n := uint64(1)
+		if keyless {
+			n = val.ReadKeylessCardinality(v)
+		}
+		for i := uint64(0); i < n; i++ {
+			cr.events = append(cr.events, e)
+		}
+	}
+}
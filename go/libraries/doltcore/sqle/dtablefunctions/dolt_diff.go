@@ -228,6 +228,7 @@ func (dtf *DiffTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter,
 	}
 
 	dp := dtables.NewDiffPartition(dtf.tableDelta.ToTable, dtf.tableDelta.FromTable, toCommitStr, fromCommitStr, dtf.toDate, dtf.fromDate, toSchForPartition, fromSchForPartition, nil)
+	dp.SetIgnoredColumns(dtf.tableDelta.IgnoredColumns)
 
 	return dtables.NewDiffPartitionRowIter(dp, ddb), nil
 }
@@ -693,6 +694,9 @@ func (dtf *DiffTableFunction) cacheTableDelta(ctx *sql.Context, fromCommitVal, t
 	if err != nil {
 		return diff.TableDelta{}, err
 	}
+	if err = applyIgnoredColumnsFromContext(ctx, db, deltas); err != nil {
+		return diff.TableDelta{}, err
+	}
 
 	dtf.fromDate = fromRefDetails.commitTime
 	dtf.toDate = toRefDetails.commitTime
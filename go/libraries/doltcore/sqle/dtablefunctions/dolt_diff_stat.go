@@ -289,6 +289,9 @@ func (ds *DiffStatTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.Row
 	if err != nil {
 		return nil, err
 	}
+	if err = applyIgnoredColumnsFromContext(ctx, ds.database, deltas); err != nil {
+		return nil, err
+	}
 
 	// If tableNameExpr defined, return a single table diff stat result
 	if ds.tableNameExpr != nil {
@@ -281,6 +281,9 @@ func (ds *DiffSummaryTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.
 	if err != nil {
 		return nil, err
 	}
+	if err = applyIgnoredColumnsFromContext(ctx, ds.database, deltas); err != nil {
+		return nil, err
+	}
 
 	sort.Slice(deltas, func(i, j int) bool {
 		return deltas[i].ToName.Less(deltas[j].ToName)
@@ -369,6 +372,11 @@ func getSummaryForDelta(ctx *sql.Context, delta diff.TableDelta, sqledb dsess.Sq
 		return nil, err
 	}
 
+	// a table whose only changes are to ignored columns has no diff
+	if len(delta.IgnoredColumns) > 0 && summ.DiffType == diff.DiffTypeModified && !summ.DataChange && !summ.SchemaChange {
+		return nil, nil
+	}
+
 	return summ, nil
 }
 
@@ -481,3 +489,15 @@ func getIgnorePatternsFromContext(ctx *sql.Context, database sql.Database) (dolt
 	// Return patterns for default schema
 	return ignorePatternMap[""], nil
 }
+
+// applyIgnoredColumnsFromContext sets the ignored columns of |deltas| from the dolt_ignore_columns table in the working
+// set of |database|.
+func applyIgnoredColumnsFromContext(ctx *sql.Context, database sql.Database, deltas []diff.TableDelta) error {
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbName := database.Name()
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("Could not load database %s", dbName)
+	}
+	return diff.ApplyIgnoredColumns(ctx, roots.Working, deltas)
+}
@@ -37,8 +37,8 @@ import (
 const logTableDefaultRowCount = 10
 
 // LogTableFunctionArgs represents the information derived from arguments to DOLT_LOG(...).
-// The schema is fixed; the parents and signature columns are populated only when --parents
-// and --show-signature are passed.
+// The schema is fixed; the parents, signature and notes columns are populated only when
+// --parents, --show-signature and --notes are passed.
 type LogTableFunctionArgs struct {
 	revisionStrs    []string
 	notRevisionStrs []string
@@ -47,6 +47,7 @@ type LogTableFunctionArgs struct {
 	decoration      string
 	showParents     bool
 	showSignature   bool
+	showNotes       bool
 }
 
 // Name implements the sql.TableFunction interface
@@ -140,6 +141,7 @@ func (ltfa *LogTableFunctionArgs) addOptions(ctx *sql.Context, expressions []sql
 
 	ltfa.showParents = apr.Contains(cli.ParentsFlag)
 	ltfa.showSignature = apr.Contains(cli.ShowSignatureFlag)
+	ltfa.showNotes = apr.Contains(cli.NotesFlag)
 
 	// Default to short so ad-hoc SQL callers see refs without an extra flag. auto has no
 	// defined meaning here because the server has no tty signal, so accept it for back
@@ -273,6 +275,10 @@ func (ltf *LogTableFunction) getOptionsString() string {
 		options = append(options, fmt.Sprintf("--%s", cli.ShowSignatureFlag))
 	}
 
+	if ltf.showNotes {
+		options = append(options, fmt.Sprintf("--%s", cli.NotesFlag))
+	}
+
 	if len(ltf.tableNames) > 0 {
 		options = append(options, "--tables", strings.Join(ltf.tableNames, ","))
 	}
@@ -476,6 +482,11 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 		return nil, err
 	}
 
+	rowOpts := dtables.LogRowOptions{ShowParents: args.showParents, ShowSignature: args.showSignature, ShowNotes: args.showNotes}
+	if err = rowOpts.LoadNotes(ctx, sqledb.DbData().Ddb); err != nil {
+		return nil, err
+	}
+
 	var commits []*doltdb.Commit
 	if len(revisionValStrs) == 0 {
 		// If no revisions given, use session head
@@ -555,14 +566,14 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 
 		notCommits = append(notCommits, mergeCommit)
 
-		return ltf.NewDotDotLogTableFunctionRowIter(ctx, sqledb.DbData().Ddb, commits, notCommits, matchFunc, cHashToRefs, ltf.tableNames, dtables.LogRowOptions{ShowParents: args.showParents, ShowSignature: args.showSignature})
+		return ltf.NewDotDotLogTableFunctionRowIter(ctx, sqledb.DbData().Ddb, commits, notCommits, matchFunc, cHashToRefs, ltf.tableNames, rowOpts)
 	}
 
 	if len(revisionValStrs) <= 1 && len(notRevisionValStrs) == 0 {
-		return ltf.NewLogTableFunctionRowIter(ctx, sqledb.DbData().Ddb, commits[0], matchFunc, cHashToRefs, ltf.tableNames, dtables.LogRowOptions{ShowParents: args.showParents, ShowSignature: args.showSignature})
+		return ltf.NewLogTableFunctionRowIter(ctx, sqledb.DbData().Ddb, commits[0], matchFunc, cHashToRefs, ltf.tableNames, rowOpts)
 	}
 
-	return ltf.NewDotDotLogTableFunctionRowIter(ctx, sqledb.DbData().Ddb, commits, notCommits, matchFunc, cHashToRefs, ltf.tableNames, dtables.LogRowOptions{ShowParents: args.showParents, ShowSignature: args.showSignature})
+	return ltf.NewDotDotLogTableFunctionRowIter(ctx, sqledb.DbData().Ddb, commits, notCommits, matchFunc, cHashToRefs, ltf.tableNames, rowOpts)
 }
 
 var _ sql.RowIter = (*logTableFunctionRowIter)(nil)
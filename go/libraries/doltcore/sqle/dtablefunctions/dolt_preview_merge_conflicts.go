@@ -506,7 +506,10 @@ func (pm *PreviewMergeConflictsTableFunction) RowIter(ctx *sql.Context, row sql.
 	cds := dtables.GetConflictDescriptors(ctx, pm.baseSch, pm.ourSch, pm.theirSch, pm.rootInfo.baseRoot.NodeStore())
 	offsets := dtables.GetConflictOffsets(keyless, cds)
 
-	valueMerger := tm.GetNewValueMerger(ctx, mergeSch, leftRows)
+	valueMerger, err := tm.GetNewValueMerger(ctx, mergeSch, leftRows)
+	if err != nil {
+		return nil, err
+	}
 
 	differ, err := tree.NewThreeWayDiffer(
 		ctx,
@@ -405,6 +405,16 @@ func getTablesWithConflicts(ctx *sql.Context, db dsess.SqlDatabase, baseBranch,
 	return conflicted, nil
 }
 
+// HasMergeConflicts returns whether merging |mergeBranch| into |baseBranch| in |db| would have schema or data
+// conflicts, as reported by dolt_preview_merge_conflicts_summary.
+func HasMergeConflicts(ctx *sql.Context, db dsess.SqlDatabase, baseBranch, mergeBranch string) (bool, error) {
+	conflicts, err := getTablesWithConflicts(ctx, db, baseBranch, mergeBranch)
+	if err != nil {
+		return false, err
+	}
+	return len(conflicts) > 0, nil
+}
+
 // getDataConflictsForTable calculates the number of data conflicts for a specific table.
 // It performs a three-way diff to identify rows that cannot be automatically merged.
 // Returns nil if no data conflicts are found.
@@ -424,7 +434,10 @@ func getDataConflictsForTable(ctx *sql.Context, tm *merge.TableMerger, tblName d
 		return nil, err
 	}
 
-	valueMerger := tm.GetNewValueMerger(ctx, mergeSch, leftRows)
+	valueMerger, err := tm.GetNewValueMerger(ctx, mergeSch, leftRows)
+	if err != nil {
+		return nil, err
+	}
 
 	differ, err := tree.NewThreeWayDiffer(
 		ctx,
@@ -0,0 +1,409 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/overrides"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+)
+
+const queryRunDefaultRowCount = 100
+
+var _ sql.TableFunction = (*QueryRunTableFunction)(nil)
+var _ sql.CatalogTableFunction = (*QueryRunTableFunction)(nil)
+var _ sql.ExecSourceRel = (*QueryRunTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*QueryRunTableFunction)(nil)
+
+// QueryRunTableFunction runs a saved query of dolt_query_catalog, binding the parameters it declares in
+// dolt_query_catalog_parameters to the arguments given. Its schema is the schema of the saved query.
+type QueryRunTableFunction struct {
+	ctx           *sql.Context
+	database      sql.Database
+	catalog       sql.Catalog
+	argumentExprs []sql.Expression
+	engine        *gms.Engine
+
+	query    string
+	parsed   sqlparser.Statement
+	bindings map[string]sqlparser.Expr
+	sqlSch   sql.Schema
+}
+
+// queryParameter is a parameter declared by a saved query
+type queryParameter struct {
+	name       string
+	order      int32
+	typ        string
+	defaultVal *string
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (qrtf *QueryRunTableFunction) NewInstance(ctx *sql.Context, database sql.Database, expressions []sql.Expression) (sql.Node, error) {
+	newInstance := &QueryRunTableFunction{
+		ctx:      ctx,
+		database: database,
+	}
+	node, err := newInstance.WithExpressions(ctx, expressions...)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// WithCatalog implements the sql.CatalogTableFunction interface
+func (qrtf *QueryRunTableFunction) WithCatalog(c sql.Catalog) (sql.TableFunction, error) {
+	newInstance := *qrtf
+	newInstance.catalog = c
+	pro, ok := c.(sql.DatabaseProvider)
+	if !ok {
+		return nil, fmt.Errorf("unable to get database provider")
+	}
+	newInstance.engine = gms.NewDefault(pro)
+	err := newInstance.bindSavedQuery()
+	if err != nil {
+		return nil, err
+	}
+	return &newInstance, nil
+}
+
+func (qrtf *QueryRunTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(qrtf.Schema(ctx))
+	numRows, _, err := qrtf.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (qrtf *QueryRunTableFunction) RowCount(_ *sql.Context) (uint64, bool, error) {
+	return queryRunDefaultRowCount, false, nil
+}
+
+// bindSavedQuery loads the saved query named by the first argument and its parameters, binds the parameters to the
+// rest of the arguments, and generates the schema of the table function from the plan of the bound query.
+func (qrtf *QueryRunTableFunction) bindSavedQuery() error {
+	args := make([]interface{}, len(qrtf.argumentExprs))
+	for i, expr := range qrtf.argumentExprs {
+		val, err := expr.Eval(qrtf.ctx, nil)
+		if err != nil {
+			return err
+		}
+		args[i] = val
+	}
+	name, ok, err := sql.Unwrap[string](qrtf.ctx, args[0])
+	if err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("saved query name must be a string, not %T", args[0])
+	}
+
+	query, err := qrtf.getSavedQuery(qrtf.ctx, name)
+	if err != nil {
+		return err
+	}
+	params, err := qrtf.getQueryParameters(qrtf.ctx, name)
+	if err != nil {
+		return err
+	}
+	qrtf.bindings, err = bindQueryParameters(qrtf.ctx, name, params, args[1:])
+	if err != nil {
+		return err
+	}
+
+	qrtf.query = query
+	qrtf.parsed, _, _, err = overrides.ParserFromContext(qrtf.ctx).Parse(qrtf.ctx, query, false)
+	if err != nil {
+		return err
+	}
+	node, err := qrtf.engine.BoundQueryPlan(qrtf.ctx, query, qrtf.parsed, qrtf.bindings)
+	if err != nil {
+		return err
+	}
+	if !node.IsReadOnly() {
+		return fmt.Errorf("saved query %s is not read-only", name)
+	}
+
+	qrtf.sqlSch = node.Schema(qrtf.ctx).Copy()
+	for _, col := range qrtf.sqlSch {
+		col.Source = qrtf.Name()
+	}
+	return nil
+}
+
+// getSavedQuery returns the query of the saved query |name| of dolt_query_catalog
+func (qrtf *QueryRunTableFunction) getSavedQuery(ctx *sql.Context, name string) (string, error) {
+	query, err := dbr.InterpolateForDialect(fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", doltdb.QueryCatalogQueryCol,
+		doltdb.DoltQueryCatalogTableName, doltdb.QueryCatalogIdCol), []interface{}{name}, dialect.MySQL)
+	if err != nil {
+		return "", err
+	}
+	rows, err := readSelectRows(ctx, qrtf.engine, query)
+	if err != nil {
+		return "", err
+	} else if len(rows) == 0 {
+		return "", fmt.Errorf("saved query %s not found", name)
+	}
+
+	savedQuery, err := getStringColAsString(ctx, rows[0][0])
+	if err != nil {
+		return "", err
+	} else if savedQuery == nil {
+		return "", fmt.Errorf("saved query %s not found", name)
+	}
+	return *savedQuery, nil
+}
+
+// getQueryParameters returns the parameters declared by the saved query |name|, in their declared order
+func (qrtf *QueryRunTableFunction) getQueryParameters(ctx *sql.Context, name string) ([]queryParameter, error) {
+	query, err := dbr.InterpolateForDialect(fmt.Sprintf("SELECT %s, %s, %s, %s FROM %s WHERE %s = ?",
+		doltdb.QueryCatalogParametersNameCol, doltdb.QueryCatalogParametersOrderCol, doltdb.QueryCatalogParametersTypeCol,
+		doltdb.QueryCatalogParametersDefaultCol, doltdb.QueryCatalogParametersTableName,
+		doltdb.QueryCatalogParametersQueryIdCol), []interface{}{name}, dialect.MySQL)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := readSelectRows(ctx, qrtf.engine, query)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]queryParameter, len(rows))
+	for i, row := range rows {
+		paramName, err := getStringColAsString(ctx, row[0])
+		if err != nil {
+			return nil, err
+		}
+		order, _, err := types.Int32.Convert(ctx, row[1])
+		if err != nil {
+			return nil, err
+		}
+		typ, err := getStringColAsString(ctx, row[2])
+		if err != nil {
+			return nil, err
+		}
+		defaultVal, err := getStringColAsString(ctx, row[3])
+		if err != nil {
+			return nil, err
+		}
+		params[i] = queryParameter{name: *paramName, order: order.(int32), typ: *typ, defaultVal: defaultVal}
+	}
+	sort.SliceStable(params, func(i, j int) bool {
+		return params[i].order < params[j].order
+	})
+	return params, nil
+}
+
+// bindQueryParameters returns the bindings of the :name placeholders of the saved query |name| for |params|. |args| are
+// either positional, in the order of |params|, or named, as a "--name" argument followed by the value of the parameter.
+// Any string argument starting with "--" names a parameter, so an argument of just "--" ends the named arguments, and
+// the arguments after it are positional even if they start with "--". Parameters without an argument take their
+// default value. Values are converted to the types of their parameters, so
+// they are bound as literals rather than interpolated into the query.
+func bindQueryParameters(ctx *sql.Context, name string, params []queryParameter, args []interface{}) (map[string]sqlparser.Expr, error) {
+	paramsByName := make(map[string]queryParameter, len(params))
+	for _, param := range params {
+		paramsByName[strings.ToLower(param.name)] = param
+	}
+
+	values := make(map[string]interface{})
+	position := 0
+	named := true
+	for i := 0; i < len(args); i++ {
+		if s, ok := args[i].(string); ok && named && s == "--" {
+			named = false
+			continue
+		} else if ok && named && strings.HasPrefix(s, "--") {
+			param, ok := paramsByName[strings.ToLower(strings.TrimPrefix(s, "--"))]
+			if !ok {
+				return nil, fmt.Errorf("unknown parameter %s for saved query %s", strings.TrimPrefix(s, "--"), name)
+			} else if i+1 == len(args) {
+				return nil, fmt.Errorf("missing value for parameter %s of saved query %s", param.name, name)
+			}
+			values[param.name] = args[i+1]
+			i++
+			continue
+		}
+
+		if position == len(params) {
+			return nil, fmt.Errorf("too many arguments for saved query %s: expected at most %d", name, len(params))
+		}
+		values[params[position].name] = args[i]
+		position++
+	}
+
+	bindings := make(map[string]sqlparser.Expr, len(params))
+	for _, param := range params {
+		val, ok := values[param.name]
+		if !ok {
+			if param.defaultVal == nil {
+				return nil, fmt.Errorf("missing value for parameter %s of saved query %s", param.name, name)
+			}
+			val = *param.defaultVal
+		}
+
+		typ, err := planbuilder.ParseColumnTypeString(param.typ)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type %s for parameter %s of saved query %s: %w", param.typ, param.name, name, err)
+		}
+		converted, inRange, err := typ.Convert(ctx, val)
+		if err == nil && inRange != sql.InRange {
+			err = sql.ErrValueOutOfRange.New(val, typ)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for parameter %s of saved query %s: %w", param.name, name, err)
+		}
+		sqlVal, err := typ.SQL(ctx, nil, converted)
+		if err != nil {
+			return nil, err
+		}
+		bindings[param.name], err = sqlparser.ExprFromValue(sqlVal)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bindings, nil
+}
+
+// Database implements the sql.Databaser interface
+func (qrtf *QueryRunTableFunction) Database() sql.Database {
+	return qrtf.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (qrtf *QueryRunTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	ntf := *qrtf
+	ntf.database = database
+	return &ntf, nil
+}
+
+// Expressions implements the sql.Expressioner interface
+func (qrtf *QueryRunTableFunction) Expressions() []sql.Expression {
+	return qrtf.argumentExprs
+}
+
+// WithExpressions implements the sql.Expressioner interface
+func (qrtf *QueryRunTableFunction) WithExpressions(ctx *sql.Context, expressions ...sql.Expression) (sql.Node, error) {
+	if len(expressions) < 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New(qrtf.Name(), "1 or more", len(expressions))
+	}
+
+	for _, expr := range expressions {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(qrtf.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(qrtf.Name(), expr.String())
+		}
+	}
+
+	newQrtf := *qrtf
+	newQrtf.argumentExprs = expressions
+	return &newQrtf, nil
+}
+
+// Children implements the sql.Node interface
+func (qrtf *QueryRunTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface
+func (qrtf *QueryRunTableFunction) WithChildren(ctx *sql.Context, node ...sql.Node) (sql.Node, error) {
+	if len(node) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return qrtf, nil
+}
+
+// RowIter implements the sql.Node interface
+func (qrtf *QueryRunTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	node, err := qrtf.engine.BoundQueryPlan(ctx, qrtf.query, qrtf.parsed, qrtf.bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	// closing the iter of the saved query mustn't end the query of this table function
+	queryCtx := sqlutil.NestedQueryContext(ctx)
+	_, iter, _, err := qrtf.engine.PrepQueryPlanForExecution(queryCtx, qrtf.query, node, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(queryCtx, iter)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (qrtf *QueryRunTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	baseDB, _ := doltdb.SplitRevisionDbName(qrtf.database.Name())
+	subject := sql.PrivilegeCheckSubject{Database: baseDB}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// Schema implements the sql.Node interface
+func (qrtf *QueryRunTableFunction) Schema(ctx *sql.Context) sql.Schema {
+	if !qrtf.Resolved() {
+		return nil
+	}
+	if qrtf.sqlSch == nil {
+		panic("schema hasn't been generated yet")
+	}
+	return qrtf.sqlSch
+}
+
+// Resolved implements the sql.Resolvable interface
+func (qrtf *QueryRunTableFunction) Resolved() bool {
+	for _, expr := range qrtf.argumentExprs {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+func (qrtf *QueryRunTableFunction) IsReadOnly() bool {
+	// saved queries which aren't read-only are rejected when they're bound
+	return true
+}
+
+// String implements the Stringer interface
+func (qrtf *QueryRunTableFunction) String() string {
+	args := make([]string, len(qrtf.argumentExprs))
+	for i, expr := range qrtf.argumentExprs {
+		args[i] = expr.String()
+	}
+	return fmt.Sprintf("DOLT_QUERY_RUN(%s)", strings.Join(args, ", "))
+}
+
+// Name implements the sql.TableFunction interface
+func (qrtf *QueryRunTableFunction) Name() string {
+	return "dolt_query_run"
+}
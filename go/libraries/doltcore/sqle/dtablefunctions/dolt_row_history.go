@@ -0,0 +1,492 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	rowHistoryDiffTypeAdded    = "added"
+	rowHistoryDiffTypeModified = "modified"
+	rowHistoryDiffTypeRemoved  = "removed"
+)
+
+var _ sql.TableFunction = (*RowHistoryTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*RowHistoryTableFunction)(nil)
+
+// RowHistoryTableFunction implements the dolt_row_history table function, which returns every version of a single
+// row of a table, identified by its primary key, in the history of the current HEAD. Each row is the table's columns
+// as of that commit, followed by the commit's hash, author, date and message and how the row changed in it.
+type RowHistoryTableFunction struct {
+	database sql.Database
+	exprs    []sql.Expression
+
+	tableName string
+	keyVals   sql.Row
+	headCm    *doltdb.Commit
+	tblSch    schema.Schema
+	sqlSch    sql.Schema
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (rh *RowHistoryTableFunction) NewInstance(ctx *sql.Context, db sql.Database, exprs []sql.Expression) (sql.Node, error) {
+	newInstance := &RowHistoryTableFunction{
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(ctx, exprs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (rh *RowHistoryTableFunction) Name() string {
+	return "dolt_row_history"
+}
+
+// String implements the Stringer interface
+func (rh *RowHistoryTableFunction) String() string {
+	exprStrs := make([]string, len(rh.exprs))
+	for i, expr := range rh.exprs {
+		exprStrs[i] = expr.String()
+	}
+	return fmt.Sprintf("DOLT_ROW_HISTORY(%s)", strings.Join(exprStrs, ", "))
+}
+
+// Database implements the sql.Databaser interface
+func (rh *RowHistoryTableFunction) Database() sql.Database {
+	return rh.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (rh *RowHistoryTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nrh := *rh
+	nrh.database = database
+	return &nrh, nil
+}
+
+// Expressions implements the sql.Expressioner interface
+func (rh *RowHistoryTableFunction) Expressions() []sql.Expression {
+	return rh.exprs
+}
+
+// WithExpressions implements the sql.Expressioner interface
+func (rh *RowHistoryTableFunction) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(rh.Name(), "at least 2", len(exprs))
+	}
+
+	// Like dolt_diff, only literal arguments are supported, since the table's schema is needed before the arguments
+	// could otherwise be evaluated.
+	for _, expr := range exprs {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(rh.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(rh.Name(), expr.String())
+		}
+	}
+
+	nrh := *rh
+	nrh.exprs = exprs
+	if err := nrh.generateSchema(ctx); err != nil {
+		return nil, err
+	}
+	return &nrh, nil
+}
+
+// generateSchema evaluates the arguments, loads the table's schema as of HEAD and converts the primary key values
+// given to the types of the table's primary key columns.
+func (rh *RowHistoryTableFunction) generateSchema(ctx *sql.Context) error {
+	if !types.IsText(rh.exprs[0].Type(ctx)) {
+		return sql.ErrInvalidArgumentDetails.New(rh.Name(), rh.exprs[0].String())
+	}
+	tableNameVal, err := rh.exprs[0].Eval(ctx, nil)
+	if err != nil {
+		return err
+	}
+	tableName, ok := tableNameVal.(string)
+	if !ok {
+		return ErrInvalidTableName.New(rh.exprs[0].String())
+	}
+
+	sqledb, ok := rh.database.(dsess.SqlDatabase)
+	if !ok {
+		return fmt.Errorf("unexpected database type: %T", rh.database)
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	headCm, err := sess.GetHeadCommit(ctx, sqledb.RevisionQualifiedName())
+	if err != nil {
+		return err
+	}
+	root, err := headCm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	tName, tbl, ok, err := resolve.Table(ctx, root, tableName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrTableNotFound.New(tableName)
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	if schema.IsKeyless(sch) {
+		return fmt.Errorf("%s requires a table with a primary key, but %s has none", rh.Name(), tName.Name)
+	}
+
+	pkCols := sch.GetPKCols().GetColumns()
+	if len(rh.exprs)-1 != len(pkCols) {
+		return fmt.Errorf("%s: table %s has %d primary key columns, but %d values were given",
+			rh.Name(), tName.Name, len(pkCols), len(rh.exprs)-1)
+	}
+	keyVals := make(sql.Row, len(pkCols))
+	for i, col := range pkCols {
+		v, err := rh.exprs[i+1].Eval(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			return fmt.Errorf("%s: primary key value for column %s cannot be NULL", rh.Name(), col.Name)
+		}
+		keyVals[i], _, err = col.TypeInfo.ToSqlType().Convert(ctx, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	pkSch, err := sqlutil.FromDoltSchema(ctx, "", "", sch)
+	if err != nil {
+		return err
+	}
+	// The table's columns are returned as plain, nullable columns, since removed versions of the row only have their
+	// primary key values.
+	sqlSch := make(sql.Schema, 0, len(pkSch.Schema)+6)
+	for _, col := range pkSch.Schema {
+		sqlSch = append(sqlSch, &sql.Column{Name: col.Name, Type: col.Type, Nullable: true})
+	}
+	sqlSch = append(sqlSch,
+		&sql.Column{Name: "commit_hash", Type: types.Text, Nullable: false},
+		&sql.Column{Name: "author", Type: types.Text, Nullable: false},
+		&sql.Column{Name: "author_email", Type: types.Text, Nullable: false},
+		&sql.Column{Name: "author_date", Type: types.Datetime3, Nullable: false},
+		&sql.Column{Name: "message", Type: types.Text, Nullable: false},
+		&sql.Column{Name: "diff_type", Type: types.Text, Nullable: false},
+	)
+
+	rh.tableName = tName.Name
+	rh.keyVals = keyVals
+	rh.headCm = headCm
+	rh.tblSch = sch
+	rh.sqlSch = sqlSch
+	return nil
+}
+
+// Schema implements the sql.Node interface
+func (rh *RowHistoryTableFunction) Schema(_ *sql.Context) sql.Schema {
+	return rh.sqlSch
+}
+
+// Resolved implements the sql.Resolvable interface
+func (rh *RowHistoryTableFunction) Resolved() bool {
+	for _, expr := range rh.exprs {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface
+func (rh *RowHistoryTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface
+func (rh *RowHistoryTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface
+func (rh *RowHistoryTableFunction) WithChildren(_ *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return rh, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (rh *RowHistoryTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	baseDB, _ := doltdb.SplitRevisionDbName(rh.database.Name())
+	subject := sql.PrivilegeCheckSubject{Database: baseDB, Table: rh.tableName}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// RowIter implements the sql.Node interface
+func (rh *RowHistoryTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	sqledb, ok := rh.database.(dsess.SqlDatabase)
+	if !ok {
+		return nil, fmt.Errorf("unexpected database type: %T", rh.database)
+	}
+	ddb := sqledb.DbData().Ddb
+
+	h, err := rh.headCm.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	commits, err := commitwalk.GetTopologicalOrderIterator[*sql.Context](ctx, ddb, []hash.Hash{h}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keyVals := make(map[uint64]interface{})
+	for i, col := range rh.tblSch.GetPKCols().GetColumns() {
+		keyVals[col.Tag] = rh.keyVals[i]
+	}
+
+	return &rowHistoryIter{
+		commits:   commits,
+		tableName: rh.tableName,
+		rows:      dtables.NewTaggedRowReader(rh.tblSch, rh.sqlSch),
+		numCols:   rh.tblSch.GetAllCols().Size(),
+		keyVals:   keyVals,
+		tables:    make(map[hash.Hash]hash.Hash),
+		versions:  make(map[hash.Hash]sql.Row),
+	}, nil
+}
+
+// rowHistoryIter walks the commit graph from HEAD and returns a row for each commit that changed the row. Commits
+// are skipped without reading the row whenever the table's hash is the same as in one of their parents, and the row
+// is looked up once for each distinct version of the table.
+type rowHistoryIter struct {
+	commits   doltdb.CommitItr[*sql.Context]
+	tableName string
+	// rows reads versions of the row in the schema of the table at HEAD, which has numCols columns
+	rows    *dtables.TaggedRowReader
+	numCols int
+	// keyVals are the primary key values of the row, by column tag
+	keyVals map[uint64]interface{}
+
+	// tables caches the hash of the table at each commit seen, which is empty if the table doesn't exist
+	tables map[hash.Hash]hash.Hash
+	// versions caches the row in each version of the table seen, which is nil if the row doesn't exist
+	versions map[hash.Hash]sql.Row
+}
+
+var _ sql.RowIter = (*rowHistoryIter)(nil)
+
+// Next implements sql.RowIter
+func (itr *rowHistoryIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		h, optCmt, meta, _, err := itr.commits.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+
+		tblHash, err := itr.tableHashAt(ctx, h, cm)
+		if err != nil {
+			return nil, err
+		}
+
+		parents := make([]*doltdb.Commit, cm.NumParents())
+		parentTblHashes := make([]hash.Hash, len(parents))
+		unchanged := false
+		for i := range parents {
+			optParent, err := cm.GetParent(ctx, i)
+			if err != nil {
+				return nil, err
+			}
+			parent, ok := optParent.ToCommit()
+			if !ok {
+				return nil, doltdb.ErrGhostCommitEncountered
+			}
+			parentHash, err := parent.HashOf()
+			if err != nil {
+				return nil, err
+			}
+			parents[i] = parent
+			parentTblHashes[i], err = itr.tableHashAt(ctx, parentHash, parent)
+			if err != nil {
+				return nil, err
+			}
+			if parentTblHashes[i] == tblHash {
+				unchanged = true
+				break
+			}
+		}
+		if unchanged {
+			continue
+		}
+
+		r, err := itr.rowAt(ctx, cm, tblHash)
+		if err != nil {
+			return nil, err
+		}
+
+		// Like the rest of history, a merge commit only shows up if the row differs from all of its parents.
+		var parentRow sql.Row
+		for i, parent := range parents {
+			pr, err := itr.rowAt(ctx, parent, parentTblHashes[i])
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 {
+				parentRow = pr
+			}
+			if unchanged, err = itr.rows.RowsEqual(ctx, r, pr); err != nil {
+				return nil, err
+			} else if unchanged {
+				break
+			}
+		}
+		if unchanged || (len(parents) == 0 && r == nil) {
+			continue
+		}
+
+		if meta == nil {
+			meta, err = cm.GetCommitMeta(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return itr.historyRow(r, parentRow, h, meta), nil
+	}
+}
+
+// historyRow returns the result row for |r| changing from |parentRow| in the commit |h|.
+func (itr *rowHistoryIter) historyRow(r, parentRow sql.Row, h hash.Hash, meta *datas.CommitMeta) sql.Row {
+	diffType := rowHistoryDiffTypeModified
+	if parentRow == nil {
+		diffType = rowHistoryDiffTypeAdded
+	} else if r == nil {
+		diffType = rowHistoryDiffTypeRemoved
+		r = make(sql.Row, itr.numCols)
+		for tag, v := range itr.keyVals {
+			i, _ := itr.rows.Index(tag)
+			r[i] = v
+		}
+	}
+
+	out := make(sql.Row, len(r), len(r)+6)
+	copy(out, r)
+	return append(out,
+		h.String(),
+		meta.Author.Name,
+		meta.Author.Email,
+		meta.Author.Date.Time(),
+		meta.Description,
+		diffType,
+	)
+}
+
+// tableHashAt returns the hash of the table at the commit |cm| with hash |h|, or an empty hash if it doesn't exist.
+func (itr *rowHistoryIter) tableHashAt(ctx *sql.Context, h hash.Hash, cm *doltdb.Commit) (hash.Hash, error) {
+	if tblHash, ok := itr.tables[h]; ok {
+		return tblHash, nil
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	var tblHash hash.Hash
+	tName, ok, err := resolve.TableName(ctx, root, itr.tableName)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	if ok {
+		tblHash, _, err = root.GetTableHash(ctx, tName)
+		if err != nil {
+			return hash.Hash{}, err
+		}
+	}
+	itr.tables[h] = tblHash
+	return tblHash, nil
+}
+
+// rowAt returns the row in the version of the table with hash |tblHash| at the commit |cm|, reading it only if that
+// version hasn't been read yet.
+func (itr *rowHistoryIter) rowAt(ctx *sql.Context, cm *doltdb.Commit, tblHash hash.Hash) (sql.Row, error) {
+	if tblHash.IsEmpty() {
+		return nil, nil
+	}
+	if r, ok := itr.versions[tblHash]; ok {
+		return r, nil
+	}
+
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, tbl, ok, err := resolve.Table(ctx, root, itr.tableName)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sql.ErrTableNotFound.New(itr.tableName)
+	}
+	r, err := itr.lookupRow(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+	itr.versions[tblHash] = r
+	return r, nil
+}
+
+// lookupRow returns the row in |tbl|, converted to the result schema by column tag, or nil if it doesn't exist.
+func (itr *rowHistoryIter) lookupRow(ctx *sql.Context, tbl *doltdb.Table) (sql.Row, error) {
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := durable.ProllyMapFromIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	return itr.rows.LookupRow(ctx, sch, m, itr.keyVals)
+}
+
+// Close implements sql.RowIter
+func (itr *rowHistoryIter) Close(_ *sql.Context) error {
+	return nil
+}
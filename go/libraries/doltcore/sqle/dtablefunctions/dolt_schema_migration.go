@@ -0,0 +1,391 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+)
+
+const schemaMigrationDefaultRowCount = 100
+
+const (
+	// SchemaMigrationUp is the direction of the statements that migrate the schema of the from revision to the schema
+	// of the to revision
+	SchemaMigrationUp = "up"
+	// SchemaMigrationDown is the direction of the statements that migrate the schema of the to revision back to the
+	// schema of the from revision
+	SchemaMigrationDown = "down"
+)
+
+var _ sql.TableFunction = (*SchemaMigrationTableFunction)(nil)
+var _ sql.ExecSourceRel = (*SchemaMigrationTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*SchemaMigrationTableFunction)(nil)
+
+// SchemaMigrationTableFunction implements the dolt_schema_migration table function, which returns the ordered DDL
+// statements that migrate the schema of one revision to the schema of another, along with the statements that
+// reverse that migration.
+type SchemaMigrationTableFunction struct {
+	ctx *sql.Context
+
+	// fromCommitExpr and toCommitExpr are set when the function is given two revisions
+	// dolt_schema_migration('from_commit', 'to_commit') -> dolt_schema_migration('123', '456')
+	fromCommitExpr sql.Expression
+	toCommitExpr   sql.Expression
+
+	// dotCommitExpr is set when the function is given a single revision range
+	// dolt_schema_migration('dot_commit') -> dolt_schema_migration('HEAD^..HEAD')
+	dotCommitExpr sql.Expression
+
+	database sql.Database
+}
+
+var schemaMigrationTableSchema = sql.Schema{
+	&sql.Column{Name: "direction", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "statement_order", Type: types.Uint64, Nullable: false},
+	&sql.Column{Name: "object_type", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "object_name", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "statement", Type: types.LongText, Nullable: false},
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (sm *SchemaMigrationTableFunction) NewInstance(ctx *sql.Context, db sql.Database, expressions []sql.Expression) (sql.Node, error) {
+	newInstance := &SchemaMigrationTableFunction{
+		ctx:      ctx,
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(ctx, expressions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func (sm *SchemaMigrationTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(sm.Schema(ctx))
+	numRows, _, err := sm.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (sm *SchemaMigrationTableFunction) RowCount(_ *sql.Context) (uint64, bool, error) {
+	return schemaMigrationDefaultRowCount, false, nil
+}
+
+// Database implements the sql.Databaser interface
+func (sm *SchemaMigrationTableFunction) Database() sql.Database {
+	return sm.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (sm *SchemaMigrationTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nsm := *sm
+	nsm.database = database
+	return &nsm, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (sm *SchemaMigrationTableFunction) Name() string {
+	return "dolt_schema_migration"
+}
+
+// Resolved implements the sql.Resolvable interface
+func (sm *SchemaMigrationTableFunction) Resolved() bool {
+	if sm.dotCommitExpr != nil {
+		return sm.dotCommitExpr.Resolved()
+	}
+	return sm.fromCommitExpr.Resolved() && sm.toCommitExpr.Resolved()
+}
+
+func (sm *SchemaMigrationTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// String implements the Stringer interface
+func (sm *SchemaMigrationTableFunction) String() string {
+	if sm.dotCommitExpr != nil {
+		return fmt.Sprintf("DOLT_SCHEMA_MIGRATION(%s)", sm.dotCommitExpr.String())
+	}
+	return fmt.Sprintf("DOLT_SCHEMA_MIGRATION(%s, %s)", sm.fromCommitExpr.String(), sm.toCommitExpr.String())
+}
+
+// Schema implements the sql.Node interface.
+func (sm *SchemaMigrationTableFunction) Schema(ctx *sql.Context) sql.Schema {
+	return schemaMigrationTableSchema
+}
+
+// Children implements the sql.Node interface.
+func (sm *SchemaMigrationTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (sm *SchemaMigrationTableFunction) WithChildren(ctx *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return sm, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (sm *SchemaMigrationTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	baseDB, _ := doltdb.SplitRevisionDbName(sm.Database().Name())
+	tblNames, err := sm.database.GetTableNames(ctx)
+	if err != nil {
+		return false
+	}
+	operations := make([]sql.PrivilegedOperation, 0, len(tblNames))
+	for _, tblName := range tblNames {
+		subject := sql.PrivilegeCheckSubject{Database: baseDB, Table: tblName}
+		operations = append(operations, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+	}
+
+	return opChecker.UserHasPrivileges(ctx, operations...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (sm *SchemaMigrationTableFunction) Expressions() []sql.Expression {
+	if sm.dotCommitExpr != nil {
+		return []sql.Expression{sm.dotCommitExpr}
+	}
+	return []sql.Expression{sm.fromCommitExpr, sm.toCommitExpr}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (sm *SchemaMigrationTableFunction) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 1 || len(exprs) > 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(sm.Name(), "1 to 2", len(exprs))
+	}
+
+	for _, expr := range exprs {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(sm.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(sm.Name(), expr.String())
+		}
+		if !types.IsText(expr.Type(ctx)) && !expression.IsBindVar(expr) {
+			return nil, sql.ErrInvalidArgumentDetails.New(sm.Name(), expr.String())
+		}
+	}
+
+	newSm := *sm
+	if len(exprs) == 1 {
+		if !strings.Contains(exprs[0].String(), "..") {
+			return nil, sql.ErrInvalidArgumentDetails.New(newSm.Name(), "There are less than 2 arguments present, and the first does not contain '..'")
+		}
+		newSm.dotCommitExpr = exprs[0]
+	} else {
+		newSm.fromCommitExpr = exprs[0]
+		newSm.toCommitExpr = exprs[1]
+	}
+
+	return &newSm, nil
+}
+
+// RowIter implements the sql.Node interface
+func (sm *SchemaMigrationTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	fromCommitVal, toCommitVal, dotCommitVal, err := sm.evaluateArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	sqledb, ok := sm.database.(dsess.SqlDatabase)
+	if !ok {
+		return nil, fmt.Errorf("unexpected database type: %T", sm.database)
+	}
+
+	fromCommitStr, toCommitStr, err := loadCommitStrings(ctx, fromCommitVal, toCommitVal, dotCommitVal, sqledb)
+	if err != nil {
+		return nil, err
+	}
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	fromRoot, _, _, err := sess.ResolveRootForRef(ctx, sm.database.Name(), fromCommitStr)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, _, _, err := sess.ResolveRootForRef(ctx, sm.database.Name(), toCommitStr)
+	if err != nil {
+		return nil, err
+	}
+
+	fromObjects, err := readSchemaObjects(ctx, fromRoot)
+	if err != nil {
+		return nil, err
+	}
+	toObjects, err := readSchemaObjects(ctx, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := sqlfmt.GenerateSchemaMigrationStatements(ctx, fromRoot, toRoot, fromObjects, toObjects)
+	if err != nil {
+		return nil, err
+	}
+	down, err := sqlfmt.GenerateSchemaMigrationStatements(ctx, toRoot, fromRoot, toObjects, fromObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, 0, len(up)+len(down))
+	for i, stmt := range up {
+		rows = append(rows, sql.Row{SchemaMigrationUp, uint64(i + 1), stmt.ObjectType, stmt.ObjectName, stmt.Statement})
+	}
+	for i, stmt := range down {
+		rows = append(rows, sql.Row{SchemaMigrationDown, uint64(i + 1), stmt.ObjectType, stmt.ObjectName, stmt.Statement})
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// evaluateArguments returns fromCommitVal, toCommitVal and dotCommitVal. It only evaluates the argument expressions,
+// and doesn't validate the values.
+func (sm *SchemaMigrationTableFunction) evaluateArguments() (interface{}, interface{}, interface{}, error) {
+	if sm.dotCommitExpr != nil {
+		dotCommitVal, err := sm.dotCommitExpr.Eval(sm.ctx, nil)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return nil, nil, dotCommitVal, nil
+	}
+
+	fromCommitVal, err := sm.fromCommitExpr.Eval(sm.ctx, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	toCommitVal, err := sm.toCommitExpr.Eval(sm.ctx, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return fromCommitVal, toCommitVal, nil, nil
+}
+
+// readSchemaObjects returns the views, triggers and events stored in the dolt_schemas table of |root|, and the
+// stored procedures stored in its dolt_procedures table.
+func readSchemaObjects(ctx *sql.Context, root doltdb.RootValue) ([]sqlfmt.SchemaObject, error) {
+	var objects []sqlfmt.SchemaObject
+
+	sch, rows, err := readSystemTableRows(ctx, root, doltdb.SchemasTableName)
+	if err != nil {
+		return nil, err
+	}
+	typeIdx, nameIdx, fragmentIdx := sch.IndexOfColName(doltdb.SchemasTablesTypeCol), sch.IndexOfColName(doltdb.SchemasTablesNameCol), sch.IndexOfColName(doltdb.SchemasTablesFragmentCol)
+	for _, r := range rows {
+		var typ, name, fragment string
+		if typ, err = unwrapString(ctx, r[typeIdx]); err != nil {
+			return nil, err
+		}
+		if name, err = unwrapString(ctx, r[nameIdx]); err != nil {
+			return nil, err
+		}
+		if fragment, err = unwrapString(ctx, r[fragmentIdx]); err != nil {
+			return nil, err
+		}
+		if typ == sqlfmt.SchemaObjectView && strings.HasPrefix(strings.ToLower(fragment), "select") {
+			// views created by older versions only store their definition
+			fragment = fmt.Sprintf("CREATE VIEW `%s` AS %s", name, fragment)
+		}
+		objects = append(objects, sqlfmt.SchemaObject{Type: typ, Name: name, CreateStatement: fragment})
+	}
+
+	sch, rows, err = readSystemTableRows(ctx, root, doltdb.ProceduresTableName)
+	if err != nil {
+		return nil, err
+	}
+	nameIdx, stmtIdx := sch.IndexOfColName(doltdb.ProceduresTableNameCol), sch.IndexOfColName(doltdb.ProceduresTableCreateStmtCol)
+	for _, r := range rows {
+		var name, stmt string
+		if name, err = unwrapString(ctx, r[nameIdx]); err != nil {
+			return nil, err
+		}
+		if stmt, err = unwrapString(ctx, r[stmtIdx]); err != nil {
+			return nil, err
+		}
+		objects = append(objects, sqlfmt.SchemaObject{Type: sqlfmt.SchemaObjectProcedure, Name: name, CreateStatement: stmt})
+	}
+
+	return objects, nil
+}
+
+// readSystemTableRows returns the schema and the rows of the system table |tableName| of |root|, which are empty if
+// the table doesn't exist.
+func readSystemTableRows(ctx *sql.Context, root doltdb.RootValue, tableName string) (sql.Schema, []sql.Row, error) {
+	tbl, ok, err := root.GetTable(ctx, doltdb.TableName{Name: tableName})
+	if err != nil || !ok {
+		return nil, nil, err
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sqlSch, err := sqlutil.FromDoltSchema(ctx, "", tableName, sch)
+	if err != nil {
+		return nil, nil, err
+	}
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := durable.ProllyMapFromIndex(idx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader := dtables.NewTaggedRowReader(sch, sqlSch.Schema)
+	iter, err := m.IterAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows []sql.Row
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+		r, err := reader.ConvertRow(ctx, sch, m, k, v)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, r)
+	}
+	return sqlSch.Schema, rows, nil
+}
+
+// unwrapString returns |v|, a text value that may be stored out of band, as a string.
+func unwrapString(ctx *sql.Context, v interface{}) (string, error) {
+	s, _, err := sql.Unwrap[string](ctx, v)
+	return s, err
+}
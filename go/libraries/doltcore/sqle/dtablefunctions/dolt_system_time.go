@@ -0,0 +1,597 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// The periods of FOR SYSTEM_TIME supported by dolt_system_time, which queries are rewritten to calls of it with.
+const (
+	SystemTimeAll       = "all"
+	SystemTimeFrom      = "from"
+	SystemTimeBetween   = "between"
+	SystemTimeContained = "contained"
+
+	SystemTimeValidFromCol = "valid_from"
+	SystemTimeValidToCol   = "valid_to"
+)
+
+var _ sql.TableFunction = (*SystemTimeTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*SystemTimeTableFunction)(nil)
+
+// SystemTimeTableFunction implements the dolt_system_time table function, which returns the versions of the rows of a
+// table on the first-parent history of the current HEAD that were current during a period of time. Each version is a
+// row of the table, followed by the commit times at which it became current and stopped being current. A NULL
+// valid_to means the version is still current at HEAD. The FOR SYSTEM_TIME clauses ALL, FROM ... TO ...,
+// BETWEEN ... AND ... and CONTAINED IN (...) are rewritten to calls of this function.
+type SystemTimeTableFunction struct {
+	database sql.Database
+	exprs    []sql.Expression
+
+	tableName string
+	period    string
+	headCm    *doltdb.Commit
+	tblSch    schema.Schema
+	sqlSch    sql.Schema
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (st *SystemTimeTableFunction) NewInstance(ctx *sql.Context, db sql.Database, exprs []sql.Expression) (sql.Node, error) {
+	newInstance := &SystemTimeTableFunction{
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(ctx, exprs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (st *SystemTimeTableFunction) Name() string {
+	return "dolt_system_time"
+}
+
+// String implements the Stringer interface
+func (st *SystemTimeTableFunction) String() string {
+	exprStrs := make([]string, len(st.exprs))
+	for i, expr := range st.exprs {
+		exprStrs[i] = expr.String()
+	}
+	return fmt.Sprintf("DOLT_SYSTEM_TIME(%s)", strings.Join(exprStrs, ", "))
+}
+
+// Database implements the sql.Databaser interface
+func (st *SystemTimeTableFunction) Database() sql.Database {
+	return st.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (st *SystemTimeTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nst := *st
+	nst.database = database
+	return &nst, nil
+}
+
+// Expressions implements the sql.Expressioner interface
+func (st *SystemTimeTableFunction) Expressions() []sql.Expression {
+	return st.exprs
+}
+
+// WithExpressions implements the sql.Expressioner interface
+func (st *SystemTimeTableFunction) WithExpressions(ctx *sql.Context, exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(st.Name(), "2 or 4", len(exprs))
+	}
+
+	// The table name and period must be literals, since the table's schema is needed before the arguments could
+	// otherwise be evaluated. The bounds of the period are evaluated when the rows are read.
+	for _, expr := range exprs[:2] {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(st.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(st.Name(), expr.String())
+		}
+	}
+
+	nst := *st
+	nst.exprs = exprs
+	if err := nst.generateSchema(ctx); err != nil {
+		return nil, err
+	}
+	return &nst, nil
+}
+
+// generateSchema evaluates the table name and period arguments and loads the table's schema as of HEAD.
+func (st *SystemTimeTableFunction) generateSchema(ctx *sql.Context) error {
+	args := make([]string, 2)
+	for i, expr := range st.exprs[:2] {
+		if !types.IsText(expr.Type(ctx)) {
+			return sql.ErrInvalidArgumentDetails.New(st.Name(), expr.String())
+		}
+		v, err := expr.Eval(ctx, nil)
+		if err != nil {
+			return err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return sql.ErrInvalidArgumentDetails.New(st.Name(), expr.String())
+		}
+		args[i] = s
+	}
+	tableName, period := args[0], strings.ToLower(args[1])
+
+	switch period {
+	case SystemTimeAll:
+		if len(st.exprs) != 2 {
+			return sql.ErrInvalidArgumentNumber.New(st.Name(), 2, len(st.exprs))
+		}
+	case SystemTimeFrom, SystemTimeBetween, SystemTimeContained:
+		if len(st.exprs) != 4 {
+			return sql.ErrInvalidArgumentNumber.New(st.Name(), 4, len(st.exprs))
+		}
+	default:
+		return fmt.Errorf("%s: unknown period %s, expected one of %s, %s, %s or %s", st.Name(), args[1],
+			SystemTimeAll, SystemTimeFrom, SystemTimeBetween, SystemTimeContained)
+	}
+
+	sqledb, ok := st.database.(dsess.SqlDatabase)
+	if !ok {
+		return fmt.Errorf("unexpected database type: %T", st.database)
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	headCm, err := sess.GetHeadCommit(ctx, sqledb.RevisionQualifiedName())
+	if err != nil {
+		return err
+	}
+	root, err := headCm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	tName, tbl, ok, err := resolve.Table(ctx, root, tableName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrTableNotFound.New(tableName)
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	pkSch, err := sqlutil.FromDoltSchema(ctx, "", "", sch)
+	if err != nil {
+		return err
+	}
+	// The table's columns are returned as plain, nullable columns, since versions from before a column was added
+	// don't have a value for it.
+	sqlSch := make(sql.Schema, 0, len(pkSch.Schema)+2)
+	for _, col := range pkSch.Schema {
+		sqlSch = append(sqlSch, &sql.Column{Name: col.Name, Type: col.Type, Nullable: true})
+	}
+	sqlSch = append(sqlSch,
+		&sql.Column{Name: SystemTimeValidFromCol, Type: types.Datetime3, Nullable: false},
+		&sql.Column{Name: SystemTimeValidToCol, Type: types.Datetime3, Nullable: true},
+	)
+
+	st.tableName = tName.Name
+	st.period = period
+	st.headCm = headCm
+	st.tblSch = sch
+	st.sqlSch = sqlSch
+	return nil
+}
+
+// Schema implements the sql.Node interface
+func (st *SystemTimeTableFunction) Schema(_ *sql.Context) sql.Schema {
+	return st.sqlSch
+}
+
+// Resolved implements the sql.Resolvable interface
+func (st *SystemTimeTableFunction) Resolved() bool {
+	for _, expr := range st.exprs {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReadOnly implements the sql.Node interface
+func (st *SystemTimeTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// Children implements the sql.Node interface
+func (st *SystemTimeTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface
+func (st *SystemTimeTableFunction) WithChildren(_ *sql.Context, children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return st, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (st *SystemTimeTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	baseDB, _ := doltdb.SplitRevisionDbName(st.database.Name())
+	subject := sql.PrivilegeCheckSubject{Database: baseDB, Table: st.tableName}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// RowIter implements the sql.Node interface
+func (st *SystemTimeTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var start, end time.Time
+	if st.period != SystemTimeAll {
+		bounds := make([]time.Time, 2)
+		for i, expr := range st.exprs[2:] {
+			v, err := expr.Eval(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			// like any comparison with NULL, no version is current during a period with a NULL bound
+			if v == nil {
+				return sql.RowsToRowIter(), nil
+			}
+			v, _, err = types.DatetimeMaxPrecision.Convert(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+			bounds[i] = v.(time.Time)
+		}
+		start, end = bounds[0], bounds[1]
+	}
+
+	vr := &systemTimeVersionReader{
+		tableName: st.tableName,
+		rows:      dtables.NewTaggedRowReader(st.tblSch, st.sqlSch[:len(st.sqlSch)-2]),
+		include: func(validFrom time.Time, validTo *time.Time) bool {
+			return systemTimeIncludes(st.period, start, end, validFrom, validTo)
+		},
+		open: make(map[string][]systemTimeVersion),
+	}
+	rows, err := vr.readVersions(ctx, st.headCm)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// systemTimeIncludes returns whether a version of a row that was current from |validFrom| until |validTo|, which is
+// nil if it's still current, was current during the |period| given by |start| and |end|, with the semantics of SQL:2011.
+func systemTimeIncludes(period string, start, end, validFrom time.Time, validTo *time.Time) bool {
+	switch period {
+	case SystemTimeFrom:
+		return validFrom.Before(end) && (validTo == nil || validTo.After(start))
+	case SystemTimeBetween:
+		return !validFrom.After(end) && (validTo == nil || validTo.After(start))
+	case SystemTimeContained:
+		return !validFrom.Before(start) && validTo != nil && !validTo.After(end)
+	default:
+		return true
+	}
+}
+
+// systemTimeVersion is a version of a row, and the time it became current.
+type systemTimeVersion struct {
+	row       sql.Row
+	validFrom time.Time
+}
+
+// systemTimeVersionReader reads the versions of the rows of a table along the first-parent history of a commit. The
+// rows of consecutive versions of the table are diffed, so the rows of each version aren't read in full unless the
+// table's primary key changed. The rows of keyless tables are keyed by the hash of their values, and each of the
+// identical copies of a row is a version of its own.
+type systemTimeVersionReader struct {
+	tableName string
+	// rows reads the rows of each version of the table in the schema of the table at HEAD
+	rows *dtables.TaggedRowReader
+	// include returns whether a version that was current from validFrom until validTo should be returned
+	include func(validFrom time.Time, validTo *time.Time) bool
+
+	// open are the versions of the rows which are current as of the last version of the table read, by key. Rows of
+	// tables with a primary key have a single version open, and rows of keyless tables one for each copy.
+	open map[string][]systemTimeVersion
+	// result are the versions to return
+	result []sql.Row
+}
+
+// readVersions returns the versions of the rows of the table along the first-parent history of |headCm|.
+func (vr *systemTimeVersionReader) readVersions(ctx *sql.Context, headCm *doltdb.Commit) ([]sql.Row, error) {
+	commits := []*doltdb.Commit{headCm}
+	for cm := headCm; cm.NumParents() > 0; {
+		optParent, err := cm.GetParent(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		cm, ok = optParent.ToCommit()
+		if !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+		commits = append(commits, cm)
+	}
+
+	var prevHash hash.Hash
+	var prevSch schema.Schema
+	var prevRows prolly.Map
+	for i := len(commits) - 1; i >= 0; i-- {
+		cm := commits[i]
+		root, err := cm.GetRootValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tName, tbl, ok, err := resolve.Table(ctx, root, vr.tableName)
+		if err != nil {
+			return nil, err
+		}
+		var tblHash hash.Hash
+		if ok {
+			tblHash, _, err = root.GetTableHash(ctx, tName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if tblHash == prevHash {
+			continue
+		}
+
+		meta, err := cm.GetCommitMeta(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t := meta.Committer.Date.Time().UTC()
+
+		if !ok {
+			if err = vr.closeAll(ctx, prevRows, t); err != nil {
+				return nil, err
+			}
+			prevHash, prevSch, prevRows = hash.Hash{}, nil, prolly.Map{}
+			continue
+		}
+
+		sch, err := tbl.GetSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := tbl.GetRowData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := durable.ProllyMapFromIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+
+		keyless := schema.IsKeyless(sch)
+		if prevSch != nil && keyless && schema.IsKeyless(prevSch) && prevRows.ValDesc().Equals(rows.ValDesc()) {
+			err = vr.applyKeylessDiff(ctx, prevRows, sch, rows, t)
+		} else if prevSch != nil && !keyless && sameKey(prevSch, sch) && prevRows.KeyDesc().Equals(rows.KeyDesc()) {
+			err = vr.applyDiff(ctx, prevRows, sch, rows, t)
+		} else {
+			// rows can't be matched by key with the previous version of the table, so all of them are replaced
+			if err = vr.closeAll(ctx, prevRows, t); err == nil {
+				err = vr.openAll(ctx, sch, rows, t)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		prevHash, prevSch, prevRows = tblHash, sch, rows
+	}
+
+	// the versions still open are current at HEAD, where the table exists
+	iter, err := prevRows.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		k, _, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		for _, v := range vr.open[string(k)] {
+			vr.emit(v, nil)
+		}
+	}
+	return vr.result, nil
+}
+
+// sameKey returns whether the primary keys of |a| and |b| have the same columns.
+func sameKey(a, b schema.Schema) bool {
+	aTags, bTags := a.GetPKCols().Tags, b.GetPKCols().Tags
+	if len(aTags) != len(bTags) {
+		return false
+	}
+	for i := range aTags {
+		if aTags[i] != bTags[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// applyDiff updates the open versions for the changes from |from| to |to|, the rows of a table with schema |sch|
+// made at time |t|.
+func (vr *systemTimeVersionReader) applyDiff(ctx *sql.Context, from prolly.Map, sch schema.Schema, to prolly.Map, t time.Time) error {
+	err := prolly.DiffMaps(ctx, from, to, false, func(_ context.Context, d tree.Diff) error {
+		key := string(d.Key)
+		open, hasPrev := vr.open[key]
+		if d.Type == tree.RemovedDiff {
+			if hasPrev {
+				vr.emit(open[0], &t)
+				delete(vr.open, key)
+			}
+			return nil
+		}
+
+		r, err := vr.rows.ConvertRow(ctx, sch, to, val.Tuple(d.Key), val.Tuple(d.To))
+		if err != nil {
+			return err
+		}
+		if hasPrev {
+			// changes to columns that were dropped since don't make a new version
+			if unchanged, err := vr.rows.RowsEqual(ctx, open[0].row, r); err != nil || unchanged {
+				return err
+			}
+			vr.emit(open[0], &t)
+		}
+		vr.open[key] = []systemTimeVersion{{row: r, validFrom: t}}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// applyKeylessDiff updates the open versions for the changes from |from| to |to|, the rows of a keyless table with
+// schema |sch| made at time |t|. Since rows are keyed by the hash of their values, a row is only ever added or removed,
+// or has its cardinality changed, and a version is opened or closed for each copy added or removed.
+func (vr *systemTimeVersionReader) applyKeylessDiff(ctx *sql.Context, from prolly.Map, sch schema.Schema, to prolly.Map, t time.Time) error {
+	err := prolly.DiffMaps(ctx, from, to, false, func(_ context.Context, d tree.Diff) error {
+		key := string(d.Key)
+		open := vr.open[key]
+		var card uint64
+		if d.To != nil {
+			card = val.ReadKeylessCardinality(val.Tuple(d.To))
+		}
+
+		if card < uint64(len(open)) {
+			for _, v := range open[card:] {
+				vr.emit(v, &t)
+			}
+			if card == 0 {
+				delete(vr.open, key)
+			} else {
+				vr.open[key] = open[:card]
+			}
+			return nil
+		} else if card == uint64(len(open)) {
+			return nil
+		}
+
+		r, err := vr.rows.ConvertRow(ctx, sch, to, val.Tuple(d.Key), val.Tuple(d.To))
+		if err != nil {
+			return err
+		}
+		for i := uint64(len(open)); i < card; i++ {
+			open = append(open, systemTimeVersion{row: r, validFrom: t})
+		}
+		vr.open[key] = open
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// openAll opens a version of every row of |rows|, the rows of a table with schema |sch|, at time |t|, and of each copy
+// of the rows of keyless tables.
+func (vr *systemTimeVersionReader) openAll(ctx *sql.Context, sch schema.Schema, rows prolly.Map, t time.Time) error {
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		r, err := vr.rows.ConvertRow(ctx, sch, rows, k, v)
+		if err != nil {
+			return err
+		}
+		card := uint64(1)
+		if schema.IsKeyless(sch) {
+			card = val.ReadKeylessCardinality(v)
+		}
+		open := make([]systemTimeVersion, card)
+		for i := range open {
+			open[i] = systemTimeVersion{row: r, validFrom: t}
+		}
+		vr.open[string(k)] = open
+	}
+}
+
+// closeAll closes the open versions of the rows of |rows| at time |t|.
+func (vr *systemTimeVersionReader) closeAll(ctx *sql.Context, rows prolly.Map, t time.Time) error {
+	if len(vr.open) == 0 {
+		return nil
+	}
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		k, _, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		for _, v := range vr.open[string(k)] {
+			vr.emit(v, &t)
+		}
+	}
+	vr.open = make(map[string][]systemTimeVersion)
+	return nil
+}
+
+// emit adds |v| to the result, if it should be returned, with |validTo| as the time it stopped being current.
+func (vr *systemTimeVersionReader) emit(v systemTimeVersion, validTo *time.Time) {
+	if !vr.include(v.validFrom, validTo) {
+		return
+	}
+	out := make(sql.Row, len(v.row), len(v.row)+2)
+	copy(out, v.row)
+	if validTo == nil {
+		out = append(out, v.validFrom, nil)
+	} else {
+		out = append(out, v.validFrom, *validTo)
+	}
+	vr.result = append(vr.result, out)
+}
@@ -23,17 +23,24 @@ import (
 
 	gms "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/gocraft/dbr/v2"
 	"github.com/gocraft/dbr/v2/dialect"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"golang.org/x/exp/constraints"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
-	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/overrides"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 	"github.com/dolthub/dolt/go/store/val"
 )
 
@@ -51,6 +58,14 @@ type TestResult struct {
 	Query     string
 	Status    string
 	Message   string
+	// Expected is the assertion of the test, e.g. "expected_rows == 1"
+	Expected string
+	// Actual is the value the assertion was checked against, or nil if it wasn't checked against one, e.g. because the
+	// query of the test failed
+	Actual *string
+	// Errored is whether the test couldn't be checked, because its query, or the setup of its test group, failed with
+	// an error
+	Errored bool
 }
 
 type TestsRunTableFunction struct {
@@ -58,6 +73,8 @@ type TestsRunTableFunction struct {
 	database      sql.Database
 	argumentExprs []sql.Expression
 	engine        *gms.Engine
+	// writes is whether the run can write to the database, which isolated test groups and fixtures do
+	writes bool
 }
 
 var testRunTableSchema = sql.Schema{
@@ -68,6 +85,15 @@ var testRunTableSchema = sql.Schema{
 	&sql.Column{Name: "message", Type: types.Text},
 }
 
+// testRunDetailsTableSchema is the schema of the results of dolt_test_run with the --details option, which appends the
+// expected and actual values of each assertion, and whether the test errored, so that the results of runs without it
+// don't change.
+var testRunDetailsTableSchema = append(testRunTableSchema.Copy(),
+	&sql.Column{Name: "expected", Type: types.Text},
+	&sql.Column{Name: "actual", Type: types.Text, Nullable: true},
+	&sql.Column{Name: "errored", Type: types.Boolean},
+)
+
 func (trtf *TestsRunTableFunction) NewInstance(ctx *sql.Context, database sql.Database, expressions []sql.Expression) (sql.Node, error) {
 	newInstance := &TestsRunTableFunction{
 		database: database,
@@ -76,7 +102,123 @@ func (trtf *TestsRunTableFunction) NewInstance(ctx *sql.Context, database sql.Da
 	if err != nil {
 		return nil, err
 	}
-	return node, nil
+	ntf := node.(*TestsRunTableFunction)
+	if ntf.writes, err = ntf.canWrite(ctx); err != nil {
+		return nil, err
+	}
+	return ntf, nil
+}
+
+// canWrite returns whether the run can write to the database, so that it's checked like other writes: the server's
+// read-only mode rejects it, and the user needs write privileges. Isolated test groups create and delete temporary
+// branches, fixtures run setup and teardown queries, and expected_error tests can run INSERT, UPDATE and DELETE
+// statements. Only the tests selected by the arguments, and the fixtures of their groups, are checked.
+func (trtf *TestsRunTableFunction) canWrite(ctx *sql.Context) (bool, error) {
+	opts, selectors, err := parseTestRunArgs(trtf.args())
+	if err != nil || opts.isolated {
+		return opts.isolated, err
+	}
+	var rows []sql.Row
+	if _, err = anyTableRow(ctx, trtf.database, doltdb.TestsTableName, func(row sql.Row) (bool, error) {
+		rows = append(rows, row)
+		return false, nil
+	}); err != nil {
+		return false, err
+	}
+	rows, err = selectDoltTestsRows(ctx, rows, selectors)
+	if err != nil {
+		return false, err
+	}
+
+	groups := make(map[string]bool)
+	for _, row := range rows {
+		_, groupName, query, assertion, _, _, err := parseDoltTestsRow(ctx, row)
+		if err != nil {
+			return false, err
+		}
+		var group string
+		if groupName != nil {
+			group = *groupName
+		}
+		groups[group] = true
+		if query == nil || assertion == nil || *assertion != AssertionExpectedError {
+			continue
+		}
+		// queries which don't parse fail when they're run, without writing
+		switch stmt, _ := sqlparser.Parse(*query); stmt.(type) {
+		case *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete:
+			return true, nil
+		}
+	}
+	return anyTableRow(ctx, trtf.database, doltdb.TestFixturesTableName, func(row sql.Row) (bool, error) {
+		group, err := getStringColAsString(ctx, row[0])
+		if err != nil || group == nil {
+			return groups[""], err
+		}
+		return groups[*group], nil
+	})
+}
+
+// selectDoltTestsRows returns the rows of |rows|, which are rows of dolt_tests, selected by |selectors| like
+// getDoltTestsData selects them: every test for "*", and otherwise the test with that name, or the tests of the group
+// with that name if there's no such test.
+func selectDoltTestsRows(ctx *sql.Context, rows []sql.Row, selectors []string) ([]sql.Row, error) {
+	selected := make([]bool, len(rows))
+	for _, selector := range selectors {
+		for _, byGroup := range []bool{false, true} {
+			found := false
+			for i, row := range rows {
+				col := row[0]
+				if byGroup {
+					col = row[1]
+				}
+				v, err := getStringColAsString(ctx, col)
+				if err != nil {
+					return nil, err
+				}
+				if selector == "*" || (v != nil && *v == selector) {
+					selected[i] = true
+					found = true
+				}
+			}
+			if found {
+				break
+			}
+		}
+	}
+	var selectedRows []sql.Row
+	for i, row := range rows {
+		if selected[i] {
+			selectedRows = append(selectedRows, row)
+		}
+	}
+	return selectedRows, nil
+}
+
+// anyTableRow returns whether |f| returns true for any row of the table |tableName| in |db|, which it's called with
+// until it does.
+func anyTableRow(ctx *sql.Context, db sql.Database, tableName string, f func(row sql.Row) (bool, error)) (bool, error) {
+	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
+	if err != nil || !ok {
+		return false, err
+	}
+	partitions, err := tbl.Partitions(ctx)
+	if err != nil {
+		return false, err
+	}
+	iter := sql.NewTableRowIter(ctx, tbl, partitions)
+	defer iter.Close(ctx)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		if ok, err := f(row); err != nil || ok {
+			return ok, err
+		}
+	}
 }
 
 // WithCatalog implements the sql.CatalogTableFunction interface
@@ -143,11 +285,18 @@ func (trtf *TestsRunTableFunction) WithChildren(ctx *sql.Context, node ...sql.No
 func (trtf *TestsRunTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	baseDB, _ := doltdb.SplitRevisionDbName(trtf.database.Name())
 	subject := sql.PrivilegeCheckSubject{Database: baseDB}
-	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+	privileges := []sql.PrivilegeType{sql.PrivilegeType_Select}
+	if trtf.writes {
+		privileges = append(privileges, sql.PrivilegeType_Insert, sql.PrivilegeType_Update, sql.PrivilegeType_Delete)
+	}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, privileges...))
 }
 
 // Schema implements the sql.Node interface
 func (trtf *TestsRunTableFunction) Schema(ctx *sql.Context) sql.Schema {
+	if opts, _, err := parseTestRunArgs(trtf.args()); err == nil && opts.details {
+		return testRunDetailsTableSchema
+	}
 	return testRunTableSchema
 }
 
@@ -162,7 +311,7 @@ func (trtf *TestsRunTableFunction) Resolved() bool {
 }
 
 func (trtf *TestsRunTableFunction) IsReadOnly() bool {
-	return true
+	return !trtf.writes
 }
 
 // String implements the Stringer interface
@@ -179,38 +328,487 @@ func (trtf *TestsRunTableFunction) getOptionsString() []string {
 	return options
 }
 
+// args returns the arguments passed into dolt_test_run, without quotes.
+func (trtf *TestsRunTableFunction) args() []string {
+	var args []string
+	for _, arg := range trtf.getOptionsString() {
+		args = append(args, strings.Trim(arg, "'"))
+	}
+	return args
+}
+
 // Name implements the sql.TableFunction interface
 func (trtf *TestsRunTableFunction) Name() string {
 	return "dolt_test_run"
 }
 
+const (
+	// isolatedOption is the argument of dolt_test_run which runs each test group on a temporary branch created from the
+	// HEAD commit of the current branch, rather than in the current working set
+	isolatedOption = "--isolated"
+	// changesOption is the argument of dolt_test_run which only runs change assertions
+	changesOption = "--changes"
+	// fromOption and toOption are the arguments of dolt_test_run which set the revisions of the change checked by change
+	// assertions, which default to HEAD and STAGED
+	fromOption = "--from"
+	toOption   = "--to"
+	// detailsOption is the argument of dolt_test_run which adds the expected and actual columns to its results
+	detailsOption = "--details"
+)
+
+const (
+	// ChangeFromVariable and ChangeToVariable are the user variables which hold the revisions of the change checked by
+	// a change assertion while its query runs, e.g. SELECT * FROM dolt_diff_stat(@dolt_change_from, @dolt_change_to)
+	ChangeFromVariable = "dolt_change_from"
+	ChangeToVariable   = "dolt_change_to"
+)
+
+// testRunOptions are the options of a run of dolt_test_run.
+type testRunOptions struct {
+	isolated    bool
+	changesOnly bool
+	details     bool
+	changeFrom  string
+	changeTo    string
+}
+
+// parseTestRunArgs returns the options of dolt_test_run in |args|, and the tests and test groups to run.
+func parseTestRunArgs(args []string) (testRunOptions, []string, error) {
+	opts := testRunOptions{changeFrom: "HEAD", changeTo: "STAGED"}
+	var selectors []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case isolatedOption:
+			opts.isolated = true
+		case changesOption:
+			opts.changesOnly = true
+		case detailsOption:
+			opts.details = true
+		case fromOption, toOption:
+			if i+1 == len(args) {
+				return testRunOptions{}, nil, fmt.Errorf("%s requires a revision", args[i])
+			}
+			if args[i] == fromOption {
+				opts.changeFrom = args[i+1]
+			} else {
+				opts.changeTo = args[i+1]
+			}
+			i++
+		default:
+			selectors = append(selectors, args[i])
+		}
+	}
+	if len(selectors) == 0 { // We treat no arguments as a wildcard
+		selectors = append(selectors, "*")
+	}
+	return opts, selectors, nil
+}
+
 // RowIter implements the sql.Node interface
 func (trtf *TestsRunTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
-	args := trtf.getOptionsString()
-	if len(args) == 0 { // We treat no arguments as a wildcard
-		args = append(args, "*")
+	opts, args, err := parseTestRunArgs(trtf.args())
+	if err != nil {
+		return nil, err
 	}
 
-	var resultRows []sql.Row
+	fixtures, err := trtf.getTestFixtures(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tests selected by every argument are grouped together, so that the fixtures of each group run once, and a
+	// test selected by more than one argument runs once
+	var testRows []sql.Row
+	selected := make(map[string]bool)
 	for _, arg := range args {
-		testRows, err := trtf.getDoltTestsData(ctx, strings.Trim(arg, "'"))
+		argRows, err := trtf.getDoltTestsData(ctx, arg)
 		if err != nil {
 			return nil, err
 		}
-
-		for _, row := range testRows {
-			result, err := trtf.queryAndAssert(ctx, row)
+		for _, row := range argRows {
+			testName, err := getStringColAsString(ctx, row[0])
 			if err != nil {
 				return nil, err
 			}
+			if testName != nil && !selected[*testName] {
+				selected[*testName] = true
+				testRows = append(testRows, row)
+			}
+		}
+	}
+	if opts.changesOnly {
+		if testRows, err = changeAssertionRows(ctx, testRows); err != nil {
+			return nil, err
+		}
+	}
 
+	groups, err := groupDoltTestsRows(ctx, testRows)
+	if err != nil {
+		return nil, err
+	}
+	var resultRows []sql.Row
+	for _, group := range groups {
+		results, err := trtf.runTestGroup(ctx, group, fixtures[group.name], opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
 			resultRow := sql.NewRow(result.TestName, result.GroupName, result.Query, result.Status, result.Message)
+			if opts.details {
+				var actual interface{}
+				if result.Actual != nil {
+					actual = *result.Actual
+				}
+				resultRow = resultRow.Append(sql.NewRow(result.Expected, actual, result.Errored))
+			}
 			resultRows = append(resultRows, resultRow)
 		}
 	}
 	return sql.RowsToRowIter(resultRows...), nil
 }
 
+// changeAssertionRows returns the rows of |rows| which are change assertions.
+func changeAssertionRows(ctx *sql.Context, rows []sql.Row) ([]sql.Row, error) {
+	var changeRows []sql.Row
+	for _, row := range rows {
+		assertion, err := getStringColAsString(ctx, row[3])
+		if err != nil {
+			return nil, err
+		}
+		if assertion != nil && IsChangeAssertion(*assertion) {
+			changeRows = append(changeRows, row)
+		}
+	}
+	return changeRows, nil
+}
+
+// testGroup is the rows of dolt_tests of a test group selected by an argument of dolt_test_run.
+type testGroup struct {
+	name string
+	rows []sql.Row
+}
+
+// testFixture is the setup and teardown queries of a test group, from dolt_test_fixtures.
+type testFixture struct {
+	setup, teardown string
+}
+
+// groupDoltTestsRows groups the dolt_tests rows |rows| by their test group, in the order the groups first appear.
+// Tests without a group are grouped together.
+func groupDoltTestsRows(ctx *sql.Context, rows []sql.Row) ([]*testGroup, error) {
+	var groups []*testGroup
+	byName := make(map[string]*testGroup)
+	for _, row := range rows {
+		groupName, err := getStringColAsString(ctx, row[1])
+		if err != nil {
+			return nil, err
+		}
+		var name string
+		if groupName != nil {
+			name = *groupName
+		}
+		group, ok := byName[name]
+		if !ok {
+			group = &testGroup{name: name}
+			byName[name] = group
+			groups = append(groups, group)
+		}
+		group.rows = append(group.rows, row)
+	}
+	return groups, nil
+}
+
+// getTestFixtures returns the fixtures of dolt_test_fixtures by test group.
+func (trtf *TestsRunTableFunction) getTestFixtures(ctx *sql.Context) (map[string]testFixture, error) {
+	query := fmt.Sprintf("SELECT %s, %s, %s FROM %s", doltdb.TestFixturesTestGroupCol, doltdb.TestFixturesSetupCol,
+		doltdb.TestFixturesTeardownCol, doltdb.TestFixturesTableName)
+	_, iter, _, err := trtf.engine.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := make(map[string]testFixture)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		var vals [3]string
+		for i := range vals {
+			v, err := getStringColAsString(ctx, row[i])
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				vals[i] = *v
+			}
+		}
+		fixtures[vals[0]] = testFixture{setup: vals[1], teardown: vals[2]}
+	}
+	return fixtures, nil
+}
+
+// runTestGroup runs the tests of |group|, after the setup queries of |fixture| and before its teardown queries. The
+// changes made by the fixture are always discarded afterward. With the isolated option, the group runs on a temporary
+// branch created from HEAD, which is deleted afterward. A failed setup or teardown fails each test of the group.
+func (trtf *TestsRunTableFunction) runTestGroup(ctx *sql.Context, group *testGroup, fixture testFixture, opts testRunOptions) (results []TestResult, err error) {
+	if opts.isolated || fixture.setup != "" || fixture.teardown != "" {
+		// the queries of the group mustn't commit the transaction of dolt_test_run before their changes are discarded
+		if !ctx.GetIgnoreAutoCommit() {
+			ctx.SetIgnoreAutoCommit(true)
+			defer ctx.SetIgnoreAutoCommit(false)
+		}
+
+		var restore func() error
+		if opts.isolated {
+			restore, err = trtf.checkoutTestBranch(ctx)
+		} else {
+			restore, err = trtf.saveRoots(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if rErr := restore(); err == nil {
+				err = rErr
+			}
+		}()
+	}
+
+	setupMessage, err := trtf.runFixture(ctx, "setup", fixture.setup)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range group.rows {
+		var result TestResult
+		if setupMessage != "" {
+			result, err = failedTestResult(ctx, row, setupMessage)
+		} else {
+			result, err = trtf.queryAndAssert(ctx, row, opts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	teardownMessage, err := trtf.runFixture(ctx, "teardown", fixture.teardown)
+	if err != nil {
+		return nil, err
+	}
+	if teardownMessage != "" {
+		for i := range results {
+			if results[i].Status == "PASS" {
+				results[i].Status = "FAIL"
+				results[i].Message = teardownMessage
+			}
+		}
+	}
+	return results, nil
+}
+
+// failedTestResult returns the result of the test of the dolt_tests row |row| erroring with |message| without being
+// run.
+func failedTestResult(ctx *sql.Context, row sql.Row, message string) (TestResult, error) {
+	testName, groupName, query, assertion, comparison, value, err := parseDoltTestsRow(ctx, row)
+	if err != nil {
+		return TestResult{}, err
+	}
+	var groupString string
+	if groupName != nil {
+		groupString = *groupName
+	}
+	return TestResult{
+		TestName:  *testName,
+		GroupName: groupString,
+		Query:     *query,
+		Status:    "FAIL",
+		Message:   message,
+		Expected:  testExpectation(*assertion, *comparison, value),
+		Errored:   true,
+	}, nil
+}
+
+// runFixture runs the |stage| queries |queries| of a test fixture, which are separated by semicolons. It returns a
+// message describing the first query which fails, or an empty string if they all succeed.
+func (trtf *TestsRunTableFunction) runFixture(ctx *sql.Context, stage string, queries string) (message string, err error) {
+	if strings.TrimSpace(queries) == "" {
+		return "", nil
+	}
+	statements, err := sqlparser.SplitStatementToPieces(queries)
+	if err != nil {
+		return fmt.Sprintf("%s query error: %s", stage, err.Error()), nil
+	}
+
+	// the iters of writes are closed so that they complete, which mustn't end the query of dolt_test_run
+	queryCtx := sqlutil.NestedQueryContext(ctx)
+	dbName := ctx.GetCurrentDatabase()
+	for _, statement := range statements {
+		if strings.Contains(strings.ToLower(statement), "dolt_test_run(") {
+			return "Cannot call dolt_test_run in dolt_test_fixtures", nil
+		}
+		node, err := sqlutil.BindQuery(queryCtx, trtf.catalog, statement)
+		if err != nil {
+			return fmt.Sprintf("%s query error: %s", stage, err.Error()), nil
+		}
+		// dolt procedures could commit, or change branches, which the tests can't be isolated from
+		if call, ok := node.(*plan.Call); ok && strings.HasPrefix(strings.ToLower(call.Name), doltdb.DoltNamespace+"_") {
+			return fmt.Sprintf("Cannot call %s in dolt_test_fixtures", call.Name), nil
+		}
+		// only the changes to the current database are discarded, even when the group runs on a temporary branch
+		callsProcedure, otherDb, queryErr := trtf.checkDiscardable(queryCtx, statement, dbName)
+		if queryErr != nil {
+			return fmt.Sprintf("%s query error: %s", stage, queryErr.Error()), nil
+		} else if callsProcedure {
+			return "Cannot call stored procedures, or fire triggers which call them, in dolt_test_fixtures", nil
+		} else if otherDb != "" {
+			return fmt.Sprintf("Cannot write to databases other than %s in dolt_test_fixtures", dbName), nil
+		}
+
+		_, iter, _, err := trtf.engine.Query(queryCtx, statement)
+		if err == nil {
+			_, err = sql.RowIterToRows(queryCtx, iter)
+		}
+		if err != nil {
+			return fmt.Sprintf("%s query error: %s", stage, err.Error()), nil
+		}
+	}
+	return "", nil
+}
+
+// testBranchLease is how long after a temporary test branch is created it's considered to be left behind by a run
+// which didn't finish, e.g. because its process stopped, and is deleted by the next isolated run. The branches are
+// shared by every process using the database, so they're not deleted any sooner, while another run may be using them.
+const testBranchLease = 24 * time.Hour
+
+// testBranchName returns the name of a new temporary test branch created at |now|, which records when it was created.
+func testBranchName(now time.Time) string {
+	return fmt.Sprintf("%s%d_%s", doltdb.TestRunBranchPrefix, now.Unix(), uuid.NewString())
+}
+
+// isStaleTestBranch returns whether the temporary test branch |name| was created more than testBranchLease before
+// |now|. A branch whose name doesn't record when it was created is stale.
+func isStaleTestBranch(name string, now time.Time) bool {
+	created, _, _ := strings.Cut(strings.TrimPrefix(name, doltdb.TestRunBranchPrefix), "_")
+	secs, err := strconv.ParseInt(created, 10, 64)
+	return err != nil || now.Sub(time.Unix(secs, 0)) > testBranchLease
+}
+
+// checkoutTestBranch creates a temporary branch from the HEAD commit of the current database and makes it the current
+// database. It returns a function which discards the session state of the branch, deletes it, and restores the current
+// database and transaction.
+func (trtf *TestsRunTableFunction) checkoutTestBranch(ctx *sql.Context) (restore func() error, err error) {
+	dbName := ctx.GetCurrentDatabase()
+	db, err := trtf.catalog.Database(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if ro, ok := db.(sql.ReadOnlyDatabase); ok && ro.IsReadOnly() {
+		return nil, fmt.Errorf("cannot run isolated tests against read-only database %s", dbName)
+	}
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	baseName, _ := doltdb.SplitRevisionDbName(dbName)
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("could not load database %s", dbName)
+	}
+	checkedOut, err := sess.CWBHeadRef(ctx, baseName)
+	if err != nil {
+		return nil, err
+	}
+	head, err := sess.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	headHash, err := head.HashOf()
+	if err != nil {
+		return nil, err
+	}
+
+	branch := testBranchName(time.Now())
+	if err = branch_control.CanCreateBranch(ctx, branch); err != nil {
+		return nil, err
+	}
+	var rsc doltdb.ReplicationStatusController
+	if err = deleteStaleTestBranches(ctx, dbData, sess.Provider(), &rsc); err != nil {
+		return nil, err
+	}
+	// unlike dolt_branch, the branch doesn't grant the user any branch permissions, which would outlive it
+	if err = actions.CreateBranchOnDB(ctx, dbData.Ddb, branch, headHash.String(), false, checkedOut, &rsc); err != nil {
+		return nil, err
+	}
+
+	// the branch can't be loaded by the transaction of dolt_test_run, which started before it was created, so the group
+	// runs in a transaction started after it. The queries of the group never commit it.
+	tx := ctx.GetTransaction()
+	if _, ok := tx.(*dsess.DoltTransaction); ok {
+		branchTx, err := dsess.NewDoltTransaction(ctx, sess.Provider().DoltDatabases(), sql.ReadWrite)
+		if err != nil {
+			return nil, err
+		}
+		ctx.SetTransaction(branchTx)
+	}
+	ctx.SetCurrentDatabase(doltdb.RevisionDbName(baseName, branch))
+
+	return func() error {
+		// the session state of the branch is removed so that its changes aren't committed with the transaction of
+		// dolt_test_run, which also checks out the default branch, so the checked out branch is restored
+		ctx.SetTransaction(tx)
+		ctx.SetCurrentDatabase(dbName)
+		if err := sess.RemoveBranchState(ctx, baseName, branch); err != nil {
+			return err
+		}
+		wsRef, err := ref.WorkingSetRefForHead(checkedOut)
+		if err != nil {
+			return err
+		}
+		if err = sess.SwitchWorkingSet(ctx, baseName, wsRef); err != nil {
+			return err
+		}
+		ctx.SetCurrentDatabase(dbName)
+		return actions.DeleteBranch(ctx, dbData, branch, actions.DeleteOptions{Force: true}, sess.Provider(), &rsc)
+	}, nil
+}
+
+// deleteStaleTestBranches deletes the temporary branches of isolated test groups which are stale, because they were
+// left behind by a run which didn't finish.
+func deleteStaleTestBranches(ctx *sql.Context, dbData env.DbData[*sql.Context], pro env.RemoteDbProvider, rsc *doltdb.ReplicationStatusController) error {
+	branches, err := dbData.Ddb.GetBranches(ctx)
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches {
+		name := branch.GetPath()
+		if !strings.HasPrefix(name, doltdb.TestRunBranchPrefix) {
+			continue
+		}
+		if !isStaleTestBranch(name, time.Now()) {
+			continue
+		}
+		if err = actions.DeleteBranch(ctx, dbData, name, actions.DeleteOptions{Force: true}, pro, rsc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveRoots returns a function which restores the roots of the current database to their current values, discarding
+// any changes made in the meantime.
+func (trtf *TestsRunTableFunction) saveRoots(ctx *sql.Context) (restore func() error, err error) {
+	dbName := ctx.GetCurrentDatabase()
+	sess := dsess.DSessFromSess(ctx.Session)
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("could not load database %s", dbName)
+	}
+	return func() error {
+		return sess.SetRoots(ctx, dbName, roots)
+	}, nil
+}
+
 // DataLength estimates total data size for query planning.
 func (trtf *TestsRunTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
 	numBytesPerRow := schema.SchemaAvgLength(trtf.Schema(ctx))
@@ -225,26 +823,64 @@ func (trtf *TestsRunTableFunction) RowCount(_ *sql.Context) (uint64, bool, error
 	return testsRunDefaultRowCount, false, nil
 }
 
-func (trtf *TestsRunTableFunction) queryAndAssert(ctx *sql.Context, row sql.Row) (result TestResult, err error) {
+func (trtf *TestsRunTableFunction) queryAndAssert(ctx *sql.Context, row sql.Row, opts testRunOptions) (result TestResult, err error) {
+	return trtf.queryAndAssertWithFunc(ctx, row, opts, AssertData)
+}
+
+func (trtf *TestsRunTableFunction) queryAndAssertWithFunc(ctx *sql.Context, row sql.Row, opts testRunOptions, assertDataFunc AssertDataFunc) (result TestResult, err error) {
 	testName, groupName, query, assertion, comparison, value, err := parseDoltTestsRow(ctx, row)
 	if err != nil {
 		return
 	}
+	expected := testExpectation(*assertion, *comparison, value)
 
-	message, err := validateQuery(ctx, trtf.catalog, *query)
-	if err != nil && message == "" {
-		message = fmt.Sprintf("query error: %s", err.Error())
+	// change assertions are checked like the assertions they're based on, with the revisions of the change bound
+	if IsChangeAssertion(*assertion) {
+		restore, err := bindChangeVariables(ctx, opts.changeFrom, opts.changeTo)
+		if err != nil {
+			return TestResult{}, err
+		}
+		defer func() {
+			if rErr := restore(); err == nil {
+				err = rErr
+			}
+		}()
 	}
 
-	var testPassed bool
-	if message == "" {
-		_, queryResult, _, err := trtf.engine.Query(ctx, *query)
+	var testPassed, errored bool
+	var message string
+	var actual *string
+	if *assertion == AssertionExpectedError {
+		testPassed, message, actual, err = trtf.assertError(ctx, *query, *comparison, value)
 		if err != nil {
-			message = fmt.Sprintf("Query error: %s", err.Error())
-		} else {
-			testPassed, message, err = AssertData(ctx, *assertion, *comparison, value, queryResult)
+			return TestResult{}, err
+		}
+	} else {
+		message, err = validateQuery(ctx, trtf.catalog, *query)
+		if err != nil && message == "" {
+			message = fmt.Sprintf("query error: %s", err.Error())
+			errored = true
+		}
+
+		if message == "" {
+			start := time.Now()
+			_, queryResult, _, err := trtf.engine.Query(ctx, *query)
 			if err != nil {
-				return TestResult{}, err
+				message = fmt.Sprintf("Query error: %s", err.Error())
+				errored = true
+			} else {
+				observed := &observedRowIter{RowIter: queryResult, keepRows: *assertion == AssertionExpectedSnapshot}
+				if *assertion == AssertionExpectedDurationMs {
+					testPassed, message, actual, err = expectDuration(ctx, *comparison, value, observed, start)
+				} else {
+					testPassed, message, err = assertDataFunc(ctx, *assertion, *comparison, value, observed)
+					if err == nil {
+						actual, err = observed.actual(ctx, baseAssertion(*assertion), value)
+					}
+				}
+				if err != nil {
+					return TestResult{}, err
+				}
 			}
 		}
 	}
@@ -258,45 +894,282 @@ func (trtf *TestsRunTableFunction) queryAndAssert(ctx *sql.Context, row sql.Row)
 	if groupName != nil {
 		groupString = *groupName
 	}
-	result = TestResult{*testName, groupString, *query, status, message}
+	result = TestResult{
+		TestName:  *testName,
+		GroupName: groupString,
+		Query:     *query,
+		Status:    status,
+		Message:   message,
+		Expected:  expected,
+		Actual:    actual,
+		Errored:   errored,
+	}
 	return result, nil
 }
 
-func (trtf *TestsRunTableFunction) queryAndAssertWithFunc(ctx *sql.Context, row sql.Row, assertDataFunc AssertDataFunc) (result TestResult, err error) {
-	testName, groupName, query, assertion, comparison, value, err := parseDoltTestsRow(ctx, row)
+// testExpectation returns the assertion of a test as it's reported by dolt_test_run, e.g. "expected_rows == 1".
+func testExpectation(assertion, comparison string, value *string) string {
+	expectedValue := "NULL"
+	if value != nil {
+		expectedValue = *value
+	}
+	return fmt.Sprintf("%s %s %s", assertion, comparison, expectedValue)
+}
+
+// observedRowIter is a sql.RowIter which records the rows an assertion reads from the results of a test query, so
+// that dolt_test_run can report the actual value the assertion was checked against.
+type observedRowIter struct {
+	sql.RowIter
+	// keepRows is set to keep every row read, which snapshots are computed from
+	keepRows bool
+	rows     []sql.Row
+	first    sql.Row
+	count    int
+	done     bool
+}
+
+var _ sql.RowIter = (*observedRowIter)(nil)
+
+// Next implements sql.RowIter.
+func (o *observedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := o.RowIter.Next(ctx)
+	if err == io.EOF {
+		o.done = true
+	}
 	if err != nil {
-		return
+		return nil, err
+	}
+	if o.count == 0 {
+		o.first = row
 	}
+	if o.keepRows {
+		o.rows = append(o.rows, row)
+	}
+	o.count++
+	return row, nil
+}
 
-	message, err := validateQuery(ctx, trtf.catalog, *query)
-	if err != nil && message == "" {
-		message = fmt.Sprintf("query error: %s", err.Error())
+// actual returns the value of the rows read by |assertion| that it was checked against, or nil if the assertion
+// didn't read enough of them to be checked, e.g. because its expected value wasn't valid. |expected| is the expected
+// value of the assertion, which decides how a single value is reported.
+func (o *observedRowIter) actual(ctx *sql.Context, assertion string, expected *string) (*string, error) {
+	var actual string
+	switch {
+	case assertion == AssertionExpectedRows && o.done:
+		actual = strconv.Itoa(o.count)
+	case assertion == AssertionExpectedColumns && (o.first != nil || o.done):
+		actual = strconv.Itoa(len(o.first))
+	case assertion == AssertionExpectedSingleValue && o.done && o.count == 1 && len(o.first) == 1:
+		var err error
+		if actual, err = singleValueString(ctx, o.first[0], expected); err != nil {
+			return nil, err
+		}
+	case assertion == AssertionExpectedSnapshot && o.done:
+		var err error
+		if actual, err = sqlutil.ResultSnapshot(ctx, sql.RowsToRowIter(o.rows...)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
 	}
+	return &actual, nil
+}
 
-	var testPassed bool
-	if message == "" {
-		_, queryResult, _, err := trtf.engine.Query(ctx, *query)
-		if err != nil {
-			message = fmt.Sprintf("Query error: %s", err.Error())
+// singleValueString returns the string an actual value of an expected_single_value assertion is reported as. Values
+// compared to a boolean |expected| value are reported as booleans, like they are in the message of a failed assertion.
+func singleValueString(ctx *sql.Context, v interface{}, expected *string) (string, error) {
+	v, err := sql.UnwrapAny(ctx, v)
+	if err != nil {
+		return "", err
+	}
+	if expected != nil && *expected != "0" && *expected != "1" {
+		if _, err := strconv.ParseBool(*expected); err == nil {
+			if b, err := getInterfaceAsBool(v); err == nil {
+				return strconv.FormatBool(b), nil
+			}
+		}
+	}
+	switch v := v.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		// dates are reported without a time, like the expected values they're compared to
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			return v.Format(time.DateOnly), nil
+		}
+		return v.Format("2006-01-02 15:04:05.999999"), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// assertError runs |query| and checks the error it fails with against |value| with |comparison|. Unlike the queries of
+// other assertions, the query may be an INSERT, UPDATE or DELETE statement, so that tests can check that bad data is
+// rejected. Changes made by the statement are always discarded.
+func (trtf *TestsRunTableFunction) assertError(ctx *sql.Context, query string, comparison string, value *string) (testPassed bool, message string, actual *string, err error) {
+	if comparison != "==" && comparison != "!=" {
+		return false, fmt.Sprintf("%s is not a valid comparison for %s. Only '==' and '!=' are supported", comparison, AssertionExpectedError), nil, nil
+	}
+	if statements, err := sqlparser.SplitStatementToPieces(query); err != nil {
+		return false, "", nil, err
+	} else if len(statements) != 1 {
+		return false, "Can only run exactly one query", nil, nil
+	}
+	if strings.Contains(strings.ToLower(query), "dolt_test_run(") {
+		return false, "Cannot call dolt_test_run in dolt_tests", nil, nil
+	}
+
+	// a query which can't be planned, e.g. because a table doesn't exist, fails with that error
+	node, queryErr := sqlutil.BindQuery(ctx, trtf.catalog, query)
+	if queryErr == nil {
+		if node.IsReadOnly() {
+			queryErr = trtf.runQuery(ctx, query)
 		} else {
-			testPassed, message, err = assertDataFunc(ctx, *assertion, *comparison, value, queryResult)
-			if err != nil {
-				return TestResult{}, err
+			switch node.(type) {
+			case *plan.InsertInto, *plan.Update, *plan.DeleteFrom:
+			default:
+				return false, fmt.Sprintf("%s can only run read queries and INSERT, UPDATE and DELETE statements", AssertionExpectedError), nil, nil
+			}
+			message, queryErr, err = trtf.runDiscardingChanges(ctx, query)
+			if err != nil || message != "" {
+				return false, message, nil, err
 			}
 		}
 	}
 
-	status := "PASS"
-	if !testPassed {
-		status = "FAIL"
+	message, got := compareError(comparison, value, queryErr)
+	return message == "", message, &got, nil
+}
+
+// runQuery runs |query| and reads all of its rows, returning the error it fails with, if any.
+func (trtf *TestsRunTableFunction) runQuery(ctx *sql.Context, query string) error {
+	_, iter, _, err := trtf.engine.Query(ctx, query)
+	if err != nil {
+		return err
 	}
+	for {
+		_, err = iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
 
-	var groupString string
-	if groupName != nil {
-		groupString = *groupName
+// runDiscardingChanges runs the write statement |query| against the current database, and returns the error it fails
+// with. The roots of the database are restored afterward, and the statement isn't committed, even if it succeeds.
+func (trtf *TestsRunTableFunction) runDiscardingChanges(ctx *sql.Context, query string) (message string, queryErr error, err error) {
+	dbName := ctx.GetCurrentDatabase()
+	db, err := trtf.catalog.Database(ctx, dbName)
+	if err != nil {
+		return "", nil, err
 	}
-	result = TestResult{*testName, groupString, *query, status, message}
-	return result, nil
+	if ro, ok := db.(sql.ReadOnlyDatabase); ok && ro.IsReadOnly() {
+		return fmt.Sprintf("Cannot run write statements against read-only database %s", dbName), nil, nil
+	}
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return "", nil, fmt.Errorf("could not load database %s", dbName)
+	}
+	if !ctx.GetIgnoreAutoCommit() {
+		ctx.SetIgnoreAutoCommit(true)
+		defer ctx.SetIgnoreAutoCommit(false)
+	}
+
+	queryCtx := sqlutil.NestedQueryContext(ctx)
+	callsProcedure, otherDb, queryErr := trtf.checkDiscardable(queryCtx, query, dbName)
+	if queryErr != nil {
+		return "", queryErr, nil
+	} else if callsProcedure {
+		return fmt.Sprintf("%s statements cannot fire triggers which call stored procedures", AssertionExpectedError), nil, nil
+	} else if otherDb != "" {
+		return fmt.Sprintf("%s statements cannot write to databases other than %s", AssertionExpectedError, dbName), nil, nil
+	}
+
+	// the iter is closed so that the statement completes, and its changes can be discarded
+	_, iter, _, queryErr := trtf.engine.Query(queryCtx, query)
+	if queryErr == nil {
+		_, queryErr = sql.RowIterToRows(queryCtx, iter)
+	}
+	return "", queryErr, sess.SetRoots(ctx, dbName, roots)
+}
+
+// checkDiscardable analyzes the statement |query|, and returns whether it calls a stored procedure, directly or from
+// a trigger, and the name of a database other than |dbName| which it writes to, if any. Stored procedures can commit,
+// create branches and make other changes which can't be discarded, and only the changes to |dbName| are discarded.
+// It returns the error the statement fails to be analyzed with, if any.
+func (trtf *TestsRunTableFunction) checkDiscardable(ctx *sql.Context, query, dbName string) (callsProcedure bool, otherDb string, queryErr error) {
+	node, queryErr := trtf.engine.AnalyzeQuery(ctx, query)
+	if queryErr != nil {
+		return false, "", queryErr
+	}
+	transform.Inspect(node, func(n sql.Node) bool {
+		if _, ok := n.(*plan.Call); ok {
+			callsProcedure = true
+		} else if name := writtenDatabaseName(n); name != "" && !strings.EqualFold(name, dbName) {
+			otherDb = name
+		}
+		return !callsProcedure && otherDb == ""
+	})
+	return callsProcedure, otherDb, nil
+}
+
+// writtenDatabaseName returns the name of the database which |n| writes to, or an empty string if it doesn't write to
+// a database, or if the database can't be determined.
+func writtenDatabaseName(n sql.Node) string {
+	switch n := n.(type) {
+	case *plan.InsertInto:
+		if db := n.Database(); db != nil {
+			return db.Name()
+		}
+	case *plan.Update:
+		return n.Database()
+	case *plan.DeleteFrom:
+		return n.Database()
+	case *plan.CreateDB:
+		return n.DbName
+	case *plan.DropDB:
+		return n.DbName
+	}
+	// e.g. CREATE TABLE and other DDL statements
+	if d, ok := n.(sql.Databaser); ok && !n.IsReadOnly() && d.Database() != nil {
+		return d.Database().Name()
+	}
+	return ""
+}
+
+// compareError returns a message explaining how |queryErr| fails to match the expected error |value| with |comparison|,
+// or an empty string if it matches, and the error it was checked as. An error matches a value it contains, and a NULL
+// value matches any error.
+func compareError(comparison string, value *string, queryErr error) (message string, got string) {
+	got = "no error"
+	if queryErr != nil {
+		got = queryErr.Error()
+	}
+	expected := "any error"
+	if value != nil {
+		expected = *value
+	}
+	matches := queryErr != nil && (value == nil || strings.Contains(queryErr.Error(), *value))
+
+	switch comparison {
+	case "==":
+		if !matches {
+			return fmt.Sprintf("Assertion failed: %s equal to %s, got %s", AssertionExpectedError, expected, got), got
+		}
+	case "!=":
+		if matches {
+			return fmt.Sprintf("Assertion failed: %s not equal to %s, got %s", AssertionExpectedError, expected, got), got
+		}
+	}
+	return "", got
 }
 
 func (trtf *TestsRunTableFunction) getDoltTestsData(ctx *sql.Context, arg string) ([]sql.Row, error) {
@@ -322,23 +1195,10 @@ func (trtf *TestsRunTableFunction) getDoltTestsData(ctx *sql.Context, arg string
 	}
 
 	for _, query := range queries {
-		_, iter, _, err := trtf.engine.Query(ctx, query)
+		rows, err := readSelectRows(ctx, trtf.engine, query)
 		if err != nil {
 			return nil, err
 		}
-		// Calling iter.Close(ctx) will cause TrackedRowIter to cancel the context, causing problems when running with
-		// dolt sql-server. Since we only support `SELECT...` queries anyway, it's not necessary to Close() the iter.
-		var rows []sql.Row
-		for {
-			row, rErr := iter.Next(ctx)
-			if rErr == io.EOF {
-				break
-			}
-			if rErr != nil {
-				return nil, rErr
-			}
-			rows = append(rows, row)
-		}
 		if len(rows) > 0 {
 			return rows, nil
 		}
@@ -346,16 +1206,28 @@ func (trtf *TestsRunTableFunction) getDoltTestsData(ctx *sql.Context, arg string
 	return nil, fmt.Errorf("could not find tests for argument: %s", arg)
 }
 
-func IsWriteQuery(query string, ctx *sql.Context, catalog sql.Catalog) (bool, error) {
-	builder := planbuilder.New(ctx, catalog, nil)
-
-	parser := overrides.ParserFromContext(ctx)
-	parsed, _, _, err := parser.Parse(ctx, query, false)
+// readSelectRows returns the rows of the SELECT query |query|, run with |engine|.
+func readSelectRows(ctx *sql.Context, engine *gms.Engine, query string) ([]sql.Row, error) {
+	_, iter, _, err := engine.Query(ctx, query)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
+	// Calling iter.Close(ctx) will cause TrackedRowIter to cancel the context, causing problems when running with
+	// dolt sql-server. Since these are `SELECT...` queries, it's not necessary to Close() the iter.
+	var rows []sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return rows, nil
+		} else if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
 
-	node, _, err := builder.BindOnly(parsed, query, nil)
+func IsWriteQuery(query string, ctx *sql.Context, catalog sql.Catalog) (bool, error) {
+	node, err := sqlutil.BindQuery(ctx, catalog, query)
 	if err != nil {
 		return false, err
 	}
@@ -413,8 +1285,70 @@ const (
 	AssertionExpectedRows        = "expected_rows"
 	AssertionExpectedColumns     = "expected_columns"
 	AssertionExpectedSingleValue = "expected_single_value"
+	AssertionExpectedError       = "expected_error"
+	AssertionExpectedSnapshot    = "expected_snapshot"
+	AssertionExpectedDurationMs  = "expected_duration_ms"
+
+	AssertionExpectedChangeRows        = "expected_change_rows"
+	AssertionExpectedChangeColumns     = "expected_change_columns"
+	AssertionExpectedChangeSingleValue = "expected_change_single_value"
 )
 
+// changeAssertionBases are the assertions that change assertions are based on, by change assertion. The queries of
+// change assertions can reference the revisions of the change being checked, e.g. the staged changes of a commit, with
+// the ChangeFromVariable and ChangeToVariable user variables.
+var changeAssertionBases = map[string]string{
+	AssertionExpectedChangeRows:        AssertionExpectedRows,
+	AssertionExpectedChangeColumns:     AssertionExpectedColumns,
+	AssertionExpectedChangeSingleValue: AssertionExpectedSingleValue,
+}
+
+// IsChangeAssertion returns whether |assertion| is a change assertion.
+func IsChangeAssertion(assertion string) bool {
+	_, ok := changeAssertionBases[assertion]
+	return ok
+}
+
+// baseAssertion returns the assertion that |assertion| is checked like, which is |assertion| itself unless it's a
+// change assertion. Messages about a test still name its own assertion.
+func baseAssertion(assertion string) string {
+	if base, ok := changeAssertionBases[assertion]; ok {
+		return base
+	}
+	return assertion
+}
+
+// bindChangeVariables sets the ChangeFromVariable and ChangeToVariable user variables to |from| and |to|, and returns
+// a function which restores their previous values.
+func bindChangeVariables(ctx *sql.Context, from, to string) (restore func() error, err error) {
+	type userVariable struct {
+		typ sql.Type
+		val interface{}
+	}
+	prev := make(map[string]userVariable)
+	for name, val := range map[string]string{ChangeFromVariable: from, ChangeToVariable: to} {
+		typ, prevVal, err := ctx.GetUserVariable(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if typ == nil {
+			typ = types.Null
+		}
+		prev[name] = userVariable{typ: typ, val: prevVal}
+		if err = ctx.SetUserVariable(ctx, name, val, types.LongText); err != nil {
+			return nil, err
+		}
+	}
+	return func() error {
+		for name, v := range prev {
+			if err := ctx.SetUserVariable(ctx, name, v.val, v.typ); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
 // getStringColAsString safely converts a sql value to string
 func getStringColAsString(sqlCtx *sql.Context, tableValue interface{}) (*string, error) {
 	if tableValue == nil {
@@ -454,13 +1388,15 @@ func (trtf *TestsRunTableFunction) readTableDataFromDoltTable(table *doltdb.Tabl
 // message will be empty if the test passed.
 // err indicates runtime failures and will stop dolt_test_run from proceeding.
 func AssertData(sqlCtx *sql.Context, assertion string, comparison string, value *string, queryResult sql.RowIter) (testPassed bool, message string, err error) {
-	switch assertion {
+	switch baseAssertion(assertion) {
 	case AssertionExpectedRows:
-		message, err = expectRows(sqlCtx, comparison, value, queryResult)
+		message, err = expectRows(sqlCtx, assertion, comparison, value, queryResult)
 	case AssertionExpectedColumns:
-		message, err = expectColumns(sqlCtx, comparison, value, queryResult)
+		message, err = expectColumns(sqlCtx, assertion, comparison, value, queryResult)
 	case AssertionExpectedSingleValue:
-		message, err = expectSingleValue(sqlCtx, comparison, value, queryResult)
+		message, err = expectSingleValue(sqlCtx, assertion, comparison, value, queryResult)
+	case AssertionExpectedSnapshot:
+		message, err = expectSnapshot(sqlCtx, comparison, value, queryResult)
 	default:
 		return false, fmt.Sprintf("%s is not a valid assertion type", assertion), nil
 	}
@@ -473,26 +1409,26 @@ func AssertData(sqlCtx *sql.Context, assertion string, comparison string, value
 	return true, "", nil
 }
 
-func expectSingleValue(sqlCtx *sql.Context, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
+func expectSingleValue(sqlCtx *sql.Context, assertion string, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
 	row, err := queryResult.Next(sqlCtx)
 	if err == io.EOF {
-		return fmt.Sprintf("expected_single_value expects exactly one cell. Received 0 rows"), nil
+		return fmt.Sprintf("%s expects exactly one cell. Received 0 rows", assertion), nil
 	} else if err != nil {
 		return "", err
 	}
 
 	if len(row) != 1 {
-		return fmt.Sprintf("expected_single_value expects exactly one cell. Received multiple columns"), nil
+		return fmt.Sprintf("%s expects exactly one cell. Received multiple columns", assertion), nil
 	}
 	_, err = queryResult.Next(sqlCtx)
 	if err == nil { //If multiple rows were given, we should error out
-		return fmt.Sprintf("expected_single_value expects exactly one cell. Received multiple rows"), nil
+		return fmt.Sprintf("%s expects exactly one cell. Received multiple rows", assertion), nil
 	} else if err != io.EOF { // "True" error, so we should quit out
 		return "", err
 	}
 
 	if value == nil { // If we're expecting a null value, we don't need to type switch
-		return compareNullValue(comparison, row[0], AssertionExpectedSingleValue), nil
+		return compareNullValue(comparison, row[0], assertion), nil
 	}
 
 	// Check if the expected value is a boolean string, and if so, coerce the actual value to boolean, with the exception
@@ -503,7 +1439,7 @@ func expectSingleValue(sqlCtx *sql.Context, comparison string, value *string, qu
 			if boolErr != nil {
 				return fmt.Sprintf("Could not convert value to boolean: %v", boolErr), nil
 			}
-			return compareBooleans(comparison, expectedBool, actualBool, AssertionExpectedSingleValue), nil
+			return compareBooleans(comparison, expectedBool, actualBool, assertion), nil
 		}
 	}
 
@@ -513,99 +1449,99 @@ func expectSingleValue(sqlCtx *sql.Context, comparison string, value *string, qu
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, int8(expectedInt), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, int8(expectedInt), actualValue, assertion), nil
 	case int16:
 		expectedInt, err := strconv.ParseInt(*value, 10, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, int16(expectedInt), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, int16(expectedInt), actualValue, assertion), nil
 	case int32:
 		expectedInt, err := strconv.ParseInt(*value, 10, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, int32(expectedInt), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, int32(expectedInt), actualValue, assertion), nil
 	case int64:
 		expectedInt, err := strconv.ParseInt(*value, 10, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, expectedInt, actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, expectedInt, actualValue, assertion), nil
 	case int:
 		expectedInt, err := strconv.ParseInt(*value, 10, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, int(expectedInt), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, int(expectedInt), actualValue, assertion), nil
 	case uint8:
 		expectedUint, err := strconv.ParseUint(*value, 10, 32)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, uint8(expectedUint), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, uint8(expectedUint), actualValue, assertion), nil
 	case uint16:
 		expectedUint, err := strconv.ParseUint(*value, 10, 32)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, uint16(expectedUint), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, uint16(expectedUint), actualValue, assertion), nil
 	case uint32:
 		expectedUint, err := strconv.ParseUint(*value, 10, 32)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, uint32(expectedUint), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, uint32(expectedUint), actualValue, assertion), nil
 	case uint64:
 		expectedUint, err := strconv.ParseUint(*value, 10, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, expectedUint, actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, expectedUint, actualValue, assertion), nil
 	case uint:
 		expectedUint, err := strconv.ParseUint(*value, 10, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non integer value '%s', with %d", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, uint(expectedUint), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, uint(expectedUint), actualValue, assertion), nil
 	case float64:
 		expectedFloat, err := strconv.ParseFloat(*value, 64)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non float value '%s', with %f", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, expectedFloat, actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, expectedFloat, actualValue, assertion), nil
 	case float32:
 		expectedFloat, err := strconv.ParseFloat(*value, 32)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non float value '%s', with %f", *value, actualValue), nil
 		}
-		return compareTestAssertion(comparison, float32(expectedFloat), actualValue, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, float32(expectedFloat), actualValue, assertion), nil
 	case decimal.Decimal:
 		expectedDecimal, err := decimal.NewFromString(*value)
 		if err != nil {
 			return fmt.Sprintf("Could not compare non decimal value '%s', with %s", *value, actualValue), nil
 		}
-		return compareDecimals(comparison, expectedDecimal, actualValue, AssertionExpectedSingleValue), nil
+		return compareDecimals(comparison, expectedDecimal, actualValue, assertion), nil
 	case time.Time:
 		expectedTime, format, err := parseTestsDate(*value)
 		if err != nil {
 			return fmt.Sprintf("%s does not appear to be a valid date", *value), nil
 		}
-		return compareDates(comparison, expectedTime, actualValue, format, AssertionExpectedSingleValue), nil
+		return compareDates(comparison, expectedTime, actualValue, format, assertion), nil
 	case *val.TextStorage, string:
 		actualString, err := GetStringColAsString(sqlCtx, actualValue)
 		if err != nil {
 			return "", err
 		}
-		return compareTestAssertion(comparison, *value, *actualString, AssertionExpectedSingleValue), nil
+		return compareTestAssertion(comparison, *value, *actualString, assertion), nil
 	default:
 		return fmt.Sprintf("Type %T is not supported. Open an issue at https://github.com/dolthub/dolt/issues to see it added", actualValue), nil
 	}
 }
 
-func expectRows(sqlCtx *sql.Context, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
+func expectRows(sqlCtx *sql.Context, assertion string, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
 	if value == nil {
-		return "null is not a valid assertion for expected_rows", nil
+		return fmt.Sprintf("null is not a valid assertion for %s", assertion), nil
 	}
 	expectedRows, err := strconv.Atoi(*value)
 	if err != nil {
@@ -622,12 +1558,12 @@ func expectRows(sqlCtx *sql.Context, comparison string, value *string, queryResu
 		}
 		numRows++
 	}
-	return compareTestAssertion(comparison, expectedRows, numRows, AssertionExpectedRows), nil
+	return compareTestAssertion(comparison, expectedRows, numRows, assertion), nil
 }
 
-func expectColumns(sqlCtx *sql.Context, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
+func expectColumns(sqlCtx *sql.Context, assertion string, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
 	if value == nil {
-		return "null is not a valid assertion for expected_rows", nil
+		return fmt.Sprintf("null is not a valid assertion for %s", assertion), nil
 	}
 	expectedColumns, err := strconv.Atoi(*value)
 	if err != nil {
@@ -640,7 +1576,44 @@ func expectColumns(sqlCtx *sql.Context, comparison string, value *string, queryR
 		return "", err
 	}
 	numColumns = len(row)
-	return compareTestAssertion(comparison, expectedColumns, numColumns, AssertionExpectedColumns), nil
+	return compareTestAssertion(comparison, expectedColumns, numColumns, assertion), nil
+}
+
+func expectSnapshot(sqlCtx *sql.Context, comparison string, value *string, queryResult sql.RowIter) (message string, err error) {
+	if comparison != "==" && comparison != "!=" {
+		return fmt.Sprintf("%s is not a valid comparison for %s. Only '==' and '!=' are supported", comparison, AssertionExpectedSnapshot), nil
+	}
+	if value == nil {
+		return fmt.Sprintf("%s has no recorded snapshot, record one with dolt_test_snapshot()", AssertionExpectedSnapshot), nil
+	}
+	snapshot, err := sqlutil.ResultSnapshot(sqlCtx, queryResult)
+	if err != nil {
+		return "", err
+	}
+	return compareTestAssertion(comparison, *value, snapshot, AssertionExpectedSnapshot), nil
+}
+
+func expectDuration(sqlCtx *sql.Context, comparison string, value *string, queryResult sql.RowIter, start time.Time) (testPassed bool, message string, actual *string, err error) {
+	if value == nil {
+		return false, fmt.Sprintf("null is not a valid assertion for %s", AssertionExpectedDurationMs), nil, nil
+	}
+	expectedMs, err := strconv.ParseInt(*value, 10, 64)
+	if err != nil {
+		return false, fmt.Sprintf("cannot run assertion on non integer value: %s", *value), nil, nil
+	}
+
+	for {
+		_, err := queryResult.Next(sqlCtx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return false, "", nil, err
+		}
+	}
+	elapsedMs := time.Since(start).Milliseconds()
+	message = compareTestAssertion(comparison, expectedMs, elapsedMs, AssertionExpectedDurationMs)
+	elapsed := strconv.FormatInt(elapsedMs, 10)
+	return message == "", message, &elapsed, nil
 }
 
 // compareTestAssertion is a generic function used for comparing string, ints, floats.
@@ -26,8 +26,13 @@ var DoltTableFunctions = []sql.TableFunction{
 	&PreviewMergeConflictsSummaryTableFunction{},
 	&PreviewMergeConflictsTableFunction{},
 	&SchemaDiffTableFunction{},
+	&SchemaMigrationTableFunction{},
 	&ReflogTableFunction{},
 	&QueryDiffTableFunction{},
+	&QueryRunTableFunction{},
 	&TestsRunTableFunction{},
 	&JsonDiffTableFunction{},
+	&RowHistoryTableFunction{},
+	&SystemTimeTableFunction{},
+	&ChangesTableFunction{},
 }
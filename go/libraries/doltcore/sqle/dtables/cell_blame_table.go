@@ -0,0 +1,496 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+)
+
+var errUnblameableCellTable = errors.New("unable to generate cell blame for table without primary key")
+
+// CellBlameTable is a sql.Table that implements the dolt_cell_blame_<table> system table. For each cell of a table at
+// a commit, that is each column of each row, it shows the last commit that changed the cell's value.
+type CellBlameTable struct {
+	dbName    string
+	tableName doltdb.TableName
+	ddb       *doltdb.DoltDB
+	head      *doltdb.Commit
+
+	tblSch schema.Schema
+	sqlSch sql.Schema
+	// cols are the columns blamed, which are all the stored columns of the table
+	cols []schema.Column
+}
+
+var _ sql.Table = (*CellBlameTable)(nil)
+
+// NewCellBlameTable creates a CellBlameTable for the table named |tblName| as of the commit |head|.
+func NewCellBlameTable(ctx *sql.Context, dbName string, tblName doltdb.TableName, ddb *doltdb.DoltDB, head *doltdb.Commit) (sql.Table, error) {
+	root, err := head.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	table, tblName, err := getTableInsensitiveOrError(ctx, root, tblName)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if schema.IsKeyless(sch) {
+		return nil, errUnblameableCellTable
+	}
+
+	var cols []schema.Column
+	for _, col := range sch.GetAllCols().GetColumns() {
+		// virtual columns aren't stored, so no commit changes them
+		if !col.Virtual {
+			cols = append(cols, col)
+		}
+	}
+
+	return &CellBlameTable{
+		dbName:    dbName,
+		tableName: tblName,
+		ddb:       ddb,
+		head:      head,
+		tblSch:    sch,
+		cols:      cols,
+	}, nil
+}
+
+// Name is a sql.Table interface function which returns the name of the table.
+func (cbt *CellBlameTable) Name() string {
+	return doltdb.DoltCellBlameTablePrefix + cbt.tableName.Name
+}
+
+// String is a sql.Table interface function which returns the name of the table.
+func (cbt *CellBlameTable) String() string {
+	return cbt.Name()
+}
+
+// Schema is a sql.Table interface function that returns the primary key columns of the table, followed by the name
+// of the column blamed and the commit that last changed it.
+func (cbt *CellBlameTable) Schema(ctx *sql.Context) sql.Schema {
+	if cbt.sqlSch != nil {
+		return cbt.sqlSch
+	}
+
+	pkSch, err := sqlutil.FromDoltSchema(ctx, cbt.dbName, cbt.Name(), cbt.tblSch)
+	if err != nil {
+		panic(err)
+	}
+	var sqlSch sql.Schema
+	for _, pkCol := range cbt.tblSch.GetPKCols().GetColumns() {
+		col := pkSch.Schema[pkSch.Schema.IndexOfColName(pkCol.Name)]
+		sqlSch = append(sqlSch, &sql.Column{Name: col.Name, Type: col.Type, Source: cbt.Name(), PrimaryKey: true, DatabaseSource: cbt.dbName})
+	}
+	sqlSch = append(sqlSch,
+		&sql.Column{Name: "column_name", Type: types.Text, Source: cbt.Name(), PrimaryKey: true, DatabaseSource: cbt.dbName},
+		&sql.Column{Name: "commit", Type: types.Text, Source: cbt.Name(), DatabaseSource: cbt.dbName},
+		&sql.Column{Name: "author", Type: types.Text, Source: cbt.Name(), DatabaseSource: cbt.dbName},
+		&sql.Column{Name: "author_email", Type: types.Text, Source: cbt.Name(), DatabaseSource: cbt.dbName},
+		&sql.Column{Name: "author_date", Type: types.Datetime3, Source: cbt.Name(), DatabaseSource: cbt.dbName},
+		&sql.Column{Name: "message", Type: types.Text, Source: cbt.Name(), DatabaseSource: cbt.dbName},
+	)
+	cbt.sqlSch = sqlSch
+	return sqlSch
+}
+
+// Collation implements the sql.Table interface.
+func (cbt *CellBlameTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently the data is
+// unpartitioned.
+func (cbt *CellBlameTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition.
+func (cbt *CellBlameTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	pkSch, err := sqlutil.FromDoltSchema(ctx, cbt.dbName, cbt.Name(), cbt.tblSch)
+	if err != nil {
+		return nil, err
+	}
+	b := &cellBlamer{
+		ddb:       cbt.ddb,
+		tableName: cbt.tableName,
+		headSch:   cbt.tblSch,
+		cols:      cbt.cols,
+		rows:      NewTaggedRowReader(cbt.tblSch, pkSch.Schema),
+		versions:  make(map[hash.Hash]*cellBlameVersion),
+		metas:     make(map[hash.Hash]*datas.CommitMeta),
+	}
+	if err = b.blame(ctx, cbt.head); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(b.blameRows()...), nil
+}
+
+// cellBlameVersion is the version of the blamed table at a commit.
+type cellBlameVersion struct {
+	tblHash hash.Hash
+	sch     schema.Schema
+	m       prolly.Map
+}
+
+// cellBlameEntry is a row whose cells haven't all been blamed yet. pending has an element for each blamed column, which
+// is true if the value of that cell at the commit the entry is pending at is still its value at HEAD.
+type cellBlameEntry struct {
+	row     int
+	pending []bool
+}
+
+// cellBlamer computes the blame for each cell of a table. Starting with all the cells of every row pending at HEAD,
+// it walks the commit graph in topological order, passing each pending cell from a commit on to the first of its
+// parents that has the same value for it, and blaming the commit for the rest.
+type cellBlamer struct {
+	ddb       *doltdb.DoltDB
+	tableName doltdb.TableName
+	headSch   schema.Schema
+	cols      []schema.Column
+	rows      *TaggedRowReader
+
+	// headRows are the rows of the table at HEAD, and blamed holds the commit blamed for each of their cells
+	headRows []sql.Row
+	blamed   [][]hash.Hash
+	metas    map[hash.Hash]*datas.CommitMeta
+	// versions caches the version of the table at each commit that hasn't been visited yet, which is nil if the table
+	// doesn't exist
+	versions map[hash.Hash]*cellBlameVersion
+}
+
+// blame blames every cell of the table as of |head|.
+func (b *cellBlamer) blame(ctx *sql.Context, head *doltdb.Commit) error {
+	headHash, err := head.HashOf()
+	if err != nil {
+		return err
+	}
+	headVersion, err := b.versionAt(ctx, headHash, head)
+	if err != nil {
+		return err
+	} else if headVersion == nil {
+		return sql.ErrTableNotFound.New(b.tableName.String())
+	}
+
+	pending := make(map[string]*cellBlameEntry)
+	iter, err := headVersion.m.IterAll(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		r, err := b.rows.ConvertRow(ctx, b.headSch, headVersion.m, k, v)
+		if err != nil {
+			return err
+		}
+		entry := &cellBlameEntry{row: len(b.headRows), pending: make([]bool, len(b.cols))}
+		for i := range entry.pending {
+			entry.pending[i] = true
+		}
+		pending[string(k)] = entry
+		b.headRows = append(b.headRows, r)
+		b.blamed = append(b.blamed, make([]hash.Hash, len(b.cols)))
+	}
+
+	pendingAt := map[hash.Hash]map[string]*cellBlameEntry{headHash: pending}
+	commits, err := commitwalk.GetTopologicalOrderIterator[*sql.Context](ctx, b.ddb, []hash.Hash{headHash}, nil)
+	if err != nil {
+		return err
+	}
+	for len(pendingAt) > 0 {
+		h, optCmt, meta, _, err := commits.Next(ctx)
+		if err != nil {
+			return err
+		}
+		pending, ok := pendingAt[h]
+		if !ok {
+			delete(b.versions, h)
+			continue
+		}
+		delete(pendingAt, h)
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+		cur, err := b.versionAt(ctx, h, cm)
+		if err != nil {
+			return err
+		}
+		delete(b.versions, h)
+
+		for i := 0; i < cm.NumParents() && len(pending) > 0; i++ {
+			optParent, err := cm.GetParent(ctx, i)
+			if err != nil {
+				return err
+			}
+			parent, ok := optParent.ToCommit()
+			if !ok {
+				return doltdb.ErrGhostCommitEncountered
+			}
+			parentHash, err := parent.HashOf()
+			if err != nil {
+				return err
+			}
+			par, err := b.versionAt(ctx, parentHash, parent)
+			if err != nil {
+				return err
+			}
+			if par == nil {
+				continue
+			}
+			target := pendingAt[parentHash]
+			if target == nil {
+				target = make(map[string]*cellBlameEntry)
+			}
+			if pending, err = b.passToParent(ctx, cur, par, pending, target); err != nil {
+				return err
+			}
+			if len(target) > 0 {
+				pendingAt[parentHash] = target
+			}
+		}
+
+		if len(pending) == 0 {
+			continue
+		}
+		if meta == nil {
+			if meta, err = cm.GetCommitMeta(ctx); err != nil {
+				return err
+			}
+		}
+		b.metas[h] = meta
+		for _, entry := range pending {
+			for i, p := range entry.pending {
+				if p {
+					b.blamed[entry.row][i] = h
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// passToParent passes the cells in |pending| at the version |cur| of the table that have the same value in the
+// version |par| of its parent on to |target|, the cells pending at the parent. It returns the cells left.
+func (b *cellBlamer) passToParent(ctx *sql.Context, cur, par *cellBlameVersion, pending, target map[string]*cellBlameEntry) (map[string]*cellBlameEntry, error) {
+	if cur.tblHash == par.tblHash {
+		for k, entry := range pending {
+			passCells(target, k, entry, entry.pending)
+		}
+		return nil, nil
+	}
+
+	parentRows := make(map[string]sql.Row)
+	if b.canDiff(cur, par) {
+		// rows that aren't in the diff are the same in the parent, so only the rows changed need to be read
+		changed := make(map[string]struct{})
+		err := prolly.DiffMaps(ctx, par.m, cur.m, false, func(_ context.Context, diff tree.Diff) error {
+			k := string(diff.Key)
+			if _, ok := pending[k]; !ok {
+				return nil
+			}
+			changed[k] = struct{}{}
+			if diff.Type == tree.ModifiedDiff {
+				r, err := b.rows.ConvertRow(ctx, par.sch, par.m, []byte(diff.Key), []byte(diff.From))
+				if err != nil {
+					return err
+				}
+				parentRows[k] = r
+			}
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		for k, entry := range pending {
+			if _, ok := changed[k]; !ok {
+				passCells(target, k, entry, entry.pending)
+				delete(pending, k)
+			}
+		}
+	} else {
+		for k, entry := range pending {
+			keyVals := make(map[uint64]interface{})
+			for _, col := range b.headSch.GetPKCols().GetColumns() {
+				i, _ := b.rows.Index(col.Tag)
+				keyVals[col.Tag] = b.headRows[entry.row][i]
+			}
+			r, err := b.rows.LookupRow(ctx, par.sch, par.m, keyVals)
+			if err != nil {
+				return nil, err
+			}
+			if r != nil {
+				parentRows[k] = r
+			}
+		}
+	}
+
+	remaining := make(map[string]*cellBlameEntry)
+	for k, entry := range pending {
+		parentRow, ok := parentRows[k]
+		if !ok {
+			remaining[k] = entry
+			continue
+		}
+		same := make([]bool, len(b.cols))
+		rest := make([]bool, len(b.cols))
+		anySame, anyRest := false, false
+		for i, p := range entry.pending {
+			if !p {
+				continue
+			}
+			col := b.cols[i]
+			eq := false
+			if _, ok := par.sch.GetAllCols().GetByTag(col.Tag); ok {
+				j, _ := b.rows.Index(col.Tag)
+				var err error
+				if eq, err = b.rows.ValuesEqual(ctx, j, b.headRows[entry.row][j], parentRow[j]); err != nil {
+					return nil, err
+				}
+			}
+			if eq {
+				same[i], anySame = true, true
+			} else {
+				rest[i], anyRest = true, true
+			}
+		}
+		if anySame {
+			passCells(target, k, entry, same)
+		}
+		if anyRest {
+			remaining[k] = &cellBlameEntry{row: entry.row, pending: rest}
+		}
+	}
+	return remaining, nil
+}
+
+// passCells adds the cells |cells| of the row |entry| with key |k| to those pending in |target|.
+func passCells(target map[string]*cellBlameEntry, k string, entry *cellBlameEntry, cells []bool) {
+	existing, ok := target[k]
+	if !ok {
+		target[k] = &cellBlameEntry{row: entry.row, pending: cells}
+		return
+	}
+	for i, p := range cells {
+		existing.pending[i] = existing.pending[i] || p
+	}
+}
+
+// canDiff returns whether the versions |cur| and |par| of the table can be diffed to find the rows that changed
+// between them, which requires them to have the same schema, with the same primary key as the table at HEAD so that
+// their keys can be matched to those of the pending rows.
+func (b *cellBlamer) canDiff(cur, par *cellBlameVersion) bool {
+	if !schema.SchemasAreEqual(cur.sch, par.sch) {
+		return false
+	}
+	curPks, headPks := cur.sch.GetPKCols().GetColumns(), b.headSch.GetPKCols().GetColumns()
+	if len(curPks) != len(headPks) {
+		return false
+	}
+	for i := range curPks {
+		if curPks[i].Tag != headPks[i].Tag || !curPks[i].TypeInfo.Equals(headPks[i].TypeInfo) {
+			return false
+		}
+	}
+	return true
+}
+
+// versionAt returns the version of the table at the commit |cm| with hash |h|, or nil if the table doesn't exist.
+func (b *cellBlamer) versionAt(ctx *sql.Context, h hash.Hash, cm *doltdb.Commit) (*cellBlameVersion, error) {
+	if v, ok := b.versions[h]; ok {
+		return v, nil
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tbl, tName, ok, err := doltdb.GetTableInsensitive(ctx, root, b.tableName)
+	if err != nil {
+		return nil, err
+	}
+	var v *cellBlameVersion
+	if ok {
+		tblHash, _, err := root.GetTableHash(ctx, doltdb.TableName{Name: tName, Schema: b.tableName.Schema})
+		if err != nil {
+			return nil, err
+		}
+		sch, err := tbl.GetSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := tbl.GetRowData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m, err := durable.ProllyMapFromIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		v = &cellBlameVersion{tblHash: tblHash, sch: sch, m: m}
+	}
+	b.versions[h] = v
+	return v, nil
+}
+
+// blameRows returns a row for each cell blamed, ordered by the primary key of its row and then its column.
+func (b *cellBlamer) blameRows() []sql.Row {
+	pkCols := b.headSch.GetPKCols().GetColumns()
+	rows := make([]sql.Row, 0, len(b.headRows)*len(b.cols))
+	for r, headRow := range b.headRows {
+		for i, col := range b.cols {
+			h := b.blamed[r][i]
+			meta := b.metas[h]
+			row := make(sql.Row, 0, len(pkCols)+6)
+			for _, pkCol := range pkCols {
+				j, _ := b.rows.Index(pkCol.Tag)
+				row = append(row, headRow[j])
+			}
+			rows = append(rows, append(row,
+				col.Name,
+				h.String(),
+				meta.Author.Name,
+				meta.Author.Email,
+				meta.Author.Date.Time(),
+				meta.Description,
+			))
+		}
+	}
+	return rows
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+var _ sql.Table = (*CIRunsTable)(nil)
+var _ sql.StatisticsTable = (*CIRunsTable)(nil)
+
+// CIRunsTable is a sql.Table implementation that implements the system tables which show the dolt CI workflow runs of
+// a database: dolt_ci_runs, and the results of their steps in dolt_ci_step_results.
+type CIRunsTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+	schema    func(dbName, tableName string) sql.Schema
+	rows      func(wr *dolt_ci.WorkflowRuns) []sql.Row
+}
+
+// NewCIRunsTable creates the dolt_ci_runs table
+func NewCIRunsTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	return &CIRunsTable{ddb: ddb, dbName: dbName, tableName: tableName, schema: ciRunsSchema, rows: ciRunsRows}
+}
+
+// NewCIStepResultsTable creates the dolt_ci_step_results table
+func NewCIStepResultsTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	return &CIRunsTable{ddb: ddb, dbName: dbName, tableName: tableName, schema: ciStepResultsSchema, rows: ciStepResultsRows}
+}
+
+func (ct *CIRunsTable) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(ct.Schema(ctx))
+	numRows, _, err := ct.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (ct *CIRunsTable) RowCount(ctx *sql.Context) (uint64, bool, error) {
+	wr, err := dolt_ci.LoadWorkflowRuns(ctx, ct.ddb)
+	if err != nil {
+		return 0, false, err
+	}
+	return uint64(len(ct.rows(wr))), true, nil
+}
+
+// Name is a sql.Table interface function which returns the name of the table.
+func (ct *CIRunsTable) Name() string {
+	return ct.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table.
+func (ct *CIRunsTable) String() string {
+	return ct.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the workflow runs system table.
+func (ct *CIRunsTable) Schema(ctx *sql.Context) sql.Schema {
+	return ct.schema(ct.dbName, ct.tableName)
+}
+
+// Collation implements the sql.Table interface.
+func (ct *CIRunsTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently, the data is unpartitioned.
+func (ct *CIRunsTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (ct *CIRunsTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	wr, err := dolt_ci.LoadWorkflowRuns(ctx, ct.ddb)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(ct.rows(wr)...), nil
+}
+
+func ciRunsSchema(dbName, tableName string) sql.Schema {
+	return []*sql.Column{
+		{Name: "id", Type: types.Int64, Source: tableName, PrimaryKey: true, DatabaseSource: dbName},
+		{Name: "workflow", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "event", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "branch", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "commit", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "status", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "error", Type: types.LongText, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "started_at", Type: types.DatetimeMaxPrecision, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "finished_at", Type: types.DatetimeMaxPrecision, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "duration_ms", Type: types.Int64, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+	}
+}
+
+func ciRunsRows(wr *dolt_ci.WorkflowRuns) []sql.Row {
+	rows := make([]sql.Row, len(wr.Runs))
+	for i, run := range wr.Runs {
+		rows[i] = sql.NewRow(run.ID, run.Workflow, run.Event, run.Branch, run.Commit, run.Status, nullIfEmpty(run.Error),
+			run.StartedAt, run.FinishedAt, run.FinishedAt.Sub(run.StartedAt).Milliseconds())
+	}
+	return rows
+}
+
+func ciStepResultsSchema(dbName, tableName string) sql.Schema {
+	return []*sql.Column{
+		{Name: "run_id", Type: types.Int64, Source: tableName, PrimaryKey: true, DatabaseSource: dbName},
+		{Name: "step_index", Type: types.Int64, Source: tableName, PrimaryKey: true, DatabaseSource: dbName},
+		{Name: "job", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "step", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "status", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "duration_ms", Type: types.Int64, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "output", Type: types.LongText, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+	}
+}
+
+func ciStepResultsRows(wr *dolt_ci.WorkflowRuns) []sql.Row {
+	var rows []sql.Row
+	for _, run := range wr.Runs {
+		for i, sr := range run.Steps {
+			rows = append(rows, sql.NewRow(run.ID, int64(i), sr.Job, sr.Step, sr.Status, sr.DurationMs, nullIfEmpty(sr.Output)))
+		}
+	}
+	return rows
+}
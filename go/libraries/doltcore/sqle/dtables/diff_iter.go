@@ -21,6 +21,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
@@ -60,6 +61,8 @@ type prollyDiffIter struct {
 	from   prolly.Map
 	to     prolly.Map
 	ranges []prolly.Range
+	// ignoredFilter identifies modified rows whose only changes are to ignored columns
+	ignoredFilter *diff.IgnoredColumnsFilter
 
 	keyless bool
 }
@@ -156,6 +159,7 @@ func newProllyDiffIter(ctx *sql.Context, dp DiffPartition, targetFromSchema, tar
 		fromCm:        fromCm,
 		toCm:          toCm,
 		ranges:        ranges,
+		ignoredFilter: diff.NewIgnoredColumnsFilter(fsch, tsch, dp.ignoredColumns, nodeStore),
 		rows:          make(chan sql.Row, 64),
 		errChan:       make(chan error),
 		cancel:        cancel,
@@ -208,6 +212,9 @@ func (itr prollyDiffIter) Close(ctx *sql.Context) error {
 func (itr prollyDiffIter) queueRows(ctx context.Context) {
 	// TODO: Determine whether or not the schema has changed. If it has, then all rows should count as modifications in the diff.
 	cb := func(ctx context.Context, d tree.Diff) error {
+		if d.Type == tree.ModifiedDiff && itr.ignoredFilter.OnlyIgnoredChanged(val.Tuple(d.From), val.Tuple(d.To)) {
+			return nil
+		}
 		dItr, err := itr.makeDiffRowItr(ctx, d)
 		if err != nil {
 			return err
@@ -70,6 +70,8 @@ type DiffTable struct {
 	sqlSch            sql.PrimaryKeySchema
 	partitionFilters  []sql.Expression
 	headHash          hash.Hash
+	// ignoredColumns are the columns of the table whose changes are excluded by dolt_ignore_columns
+	ignoredColumns doltdb.IgnoredColumns
 }
 
 var PrimaryKeyChangeWarning = "cannot render full diff between commits %s and %s due to primary key set change"
@@ -113,6 +115,11 @@ func NewDiffTable(ctx *sql.Context, dbName string, tblName doltdb.TableName, ddb
 		return nil, err
 	}
 
+	ignoredColumns, err := doltdb.GetIgnoredColumns(ctx, root, tblName, sch)
+	if err != nil {
+		return nil, err
+	}
+
 	return &DiffTable{
 		tableName:        tblName,
 		ddb:              ddb,
@@ -123,6 +130,7 @@ func NewDiffTable(ctx *sql.Context, dbName string, tblName doltdb.TableName, ddb
 		sqlSch:           sqlSch,
 		partitionFilters: nil,
 		table:            table,
+		ignoredColumns:   ignoredColumns,
 	}, nil
 }
 
@@ -200,6 +208,7 @@ func (dt *DiffTable) PartitionRanges(ctx *sql.Context, ranges []prolly.Range) (s
 		selectFunc:      sf,
 		toSch:           dt.targetSch,
 		fromSch:         dt.targetSch,
+		ignoredColumns:  dt.ignoredColumns,
 		ranges:          ranges,
 	}, nil
 }
@@ -368,6 +377,7 @@ func (dt *DiffTable) fromCommitLookupPartitions(ctx *sql.Context, hashes []hash.
 		selectFunc:      sf,
 		toSch:           dt.targetSch,
 		fromSch:         dt.targetSch,
+		ignoredColumns:  dt.ignoredColumns,
 	}, nil
 }
 
@@ -571,6 +581,7 @@ func (dt *DiffTable) toCommitLookupPartitions(ctx *sql.Context, hashes []hash.Ha
 		selectFunc:      sf,
 		toSch:           dt.targetSch,
 		fromSch:         dt.targetSch,
+		ignoredColumns:  dt.ignoredColumns,
 	}, nil
 }
 
@@ -621,6 +632,8 @@ type DiffPartition struct {
 	toSch   schema.Schema
 	fromSch schema.Schema
 	ranges  []prolly.Range
+	// ignoredColumns are the columns whose changes alone don't make a row modified
+	ignoredColumns doltdb.IgnoredColumns
 }
 
 func NewDiffPartition(to, from *doltdb.Table, toName, fromName string, toDate, fromDate *types.Timestamp, toSch, fromSch schema.Schema, ranges []prolly.Range) *DiffPartition {
@@ -637,6 +650,12 @@ func NewDiffPartition(to, from *doltdb.Table, toName, fromName string, toDate, f
 	}
 }
 
+// SetIgnoredColumns sets the columns whose changes are excluded from this partition's diff. Rows whose only changes are
+// to these columns are skipped.
+func (dp *DiffPartition) SetIgnoredColumns(ignored doltdb.IgnoredColumns) {
+	dp.ignoredColumns = ignored
+}
+
 func (dp DiffPartition) Key() []byte {
 	// TODO: schema name
 	return []byte(dp.toName + dp.fromName)
@@ -725,6 +744,7 @@ type DiffPartitions struct {
 	selectFunc      partitionSelectFunc
 	tblName         doltdb.TableName
 	ranges          []prolly.Range
+	ignoredColumns  doltdb.IgnoredColumns
 	stopNext        bool
 }
 
@@ -750,15 +770,16 @@ func (dps *DiffPartitions) processCommit(ctx *sql.Context, cmHash hash.Hash, cm
 	var nextPartition *DiffPartition
 	if tblHash != toInfoForCommit.tblHash {
 		partition := DiffPartition{
-			to:       toInfoForCommit.tbl,
-			from:     tbl,
-			toName:   toInfoForCommit.name,
-			fromName: cmHashStr,
-			toDate:   toInfoForCommit.date,
-			fromDate: &ts,
-			fromSch:  dps.fromSch,
-			toSch:    dps.toSch,
-			ranges:   dps.ranges,
+			to:             toInfoForCommit.tbl,
+			from:           tbl,
+			toName:         toInfoForCommit.name,
+			fromName:       cmHashStr,
+			toDate:         toInfoForCommit.date,
+			fromDate:       &ts,
+			fromSch:        dps.fromSch,
+			toSch:          dps.toSch,
+			ranges:         dps.ranges,
+			ignoredColumns: dps.ignoredColumns,
 		}
 		selected, err := dps.selectFunc(ctx, partition)
 
@@ -0,0 +1,56 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+func doltIgnoreColumnsSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: doltdb.IgnoreColumnsTableNameCol, Type: sqlTypes.VarChar, Source: doltdb.IgnoreColumnsTableName, PrimaryKey: true},
+		{Name: doltdb.IgnoreColumnsColumnNameCol, Type: sqlTypes.VarChar, Source: doltdb.IgnoreColumnsTableName, PrimaryKey: true},
+	}
+}
+
+// GetDoltIgnoreColumnsSchema returns the schema of the dolt_ignore_columns system table. This is used by Doltgres to
+// update the dolt_ignore_columns schema using Doltgres types.
+var GetDoltIgnoreColumnsSchema = doltIgnoreColumnsSchema
+
+// NewIgnoreColumnsTable creates a dolt_ignore_columns table
+func NewIgnoreColumnsTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		backingTable: backingTable,
+		tableName: doltdb.TableName{
+			Name:   doltdb.IgnoreColumnsTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltIgnoreColumnsSchema(),
+	}
+}
+
+// NewEmptyIgnoreColumnsTable creates an empty dolt_ignore_columns table
+func NewEmptyIgnoreColumnsTable(_ *sql.Context, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		tableName: doltdb.TableName{
+			Name:   doltdb.IgnoreColumnsTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltIgnoreColumnsSchema(),
+	}
+}
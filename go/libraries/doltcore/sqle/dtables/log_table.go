@@ -97,10 +97,31 @@ func (dt *LogTable) Projections() []string {
 type LogRowOptions struct {
 	ShowParents   bool
 	ShowSignature bool
+	ShowNotes     bool
+
+	// notes maps each annotated commit to its note message, and is only loaded by LoadNotes when ShowNotes is set.
+	notes map[hash.Hash]string
+}
+
+// LoadNotes reads the notes attached to commits in |ddb| so BuildLogTableRow can populate the notes column. It's a
+// no-op unless ShowNotes is set.
+func (opts *LogRowOptions) LoadNotes(ctx *sql.Context, ddb *doltdb.DoltDB) error {
+	if !opts.ShowNotes {
+		return nil
+	}
+	notes, err := ddb.GetNotes(ctx)
+	if err != nil {
+		return err
+	}
+	opts.notes = make(map[hash.Hash]string, len(notes))
+	for _, n := range notes {
+		opts.notes[n.CommitHash] = n.Note.Message
+	}
+	return nil
 }
 
 // LogRowOptionsFromProjection returns the row options implied by a projected-columns list,
-// where the |parents| and |signature| columns are populated only when explicitly named.
+// where the |parents|, |signature| and |notes| columns are populated only when explicitly named.
 func LogRowOptionsFromProjection(projected []string) LogRowOptions {
 	var opts LogRowOptions
 	for _, p := range projected {
@@ -109,6 +130,8 @@ func LogRowOptionsFromProjection(projected []string) LogRowOptions {
 			opts.ShowParents = true
 		case "signature":
 			opts.ShowSignature = true
+		case "notes":
+			opts.ShowNotes = true
 		}
 	}
 	return opts
@@ -116,8 +139,8 @@ func LogRowOptionsFromProjection(projected []string) LogRowOptions {
 
 // LogTableSchema is the dolt_log column shape shared by the system table and the
 // dolt_log() table function. Source and DatabaseSource are left unset so multi-database
-// servers can stamp them per instance via NewLogTableSchema. The parents and signature
-// columns are nullable because they are populated only when explicitly requested.
+// servers can stamp them per instance via NewLogTableSchema. The parents, signature and
+// notes columns are nullable because they are populated only when explicitly requested.
 var LogTableSchema = sql.Schema{
 	&sql.Column{Name: "commit_hash", Type: types.Text, PrimaryKey: true},
 	&sql.Column{Name: "committer", Type: types.Text},
@@ -131,6 +154,7 @@ var LogTableSchema = sql.Schema{
 	&sql.Column{Name: "author", Type: types.Text},
 	&sql.Column{Name: "author_email", Type: types.Text},
 	&sql.Column{Name: "author_date", Type: types.Datetime3},
+	&sql.Column{Name: "notes", Type: types.LongText, Nullable: true},
 }
 
 // NewLogTableSchema returns LogTableSchema cloned with each column's Source and
@@ -196,7 +220,8 @@ func (dt *LogTable) String() string {
 
 // BuildLogTableRow builds a dolt_log row for |commit| at |height|, formatting the refs column
 // from |refs| (looked up by commit hash) and |headHash|. The |opts| flags select whether to
-// populate the opt-in parents and signature columns; both stay NULL when their flag is false.
+// populate the opt-in parents, signature and notes columns; each stays NULL when its flag is
+// false. The notes column is also NULL for a commit which has no note.
 func BuildLogTableRow(ctx *sql.Context, commit *doltdb.Commit, meta *datas.CommitMeta, height uint64, refs map[hash.Hash][]string, headHash hash.Hash, opts LogRowOptions) (sql.Row, error) {
 	commitHash, err := commit.HashOf()
 	if err != nil {
@@ -236,6 +261,12 @@ func BuildLogTableRow(ctx *sql.Context, commit *doltdb.Commit, meta *datas.Commi
 			signatureCol = ""
 		}
 	}
+	var notesCol interface{}
+	if opts.ShowNotes {
+		if note, ok := opts.notes[commitHash]; ok {
+			notesCol = note
+		}
+	}
 	return sql.NewRow(
 		commitHash.String(),
 		meta.Committer.Name,
@@ -249,6 +280,7 @@ func BuildLogTableRow(ctx *sql.Context, commit *doltdb.Commit, meta *datas.Commi
 		meta.Author.Name,
 		meta.Author.Email,
 		meta.Author.Date.Time(),
+		notesCol,
 	), nil
 }
 
@@ -269,8 +301,11 @@ func (dt *LogTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
 
 // PartitionRows is a sql.Table interface function that gets a row iterator for a partition
 func (dt *LogTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
-	// System table populates parents and signature only when the caller explicitly projects them.
+	// System table populates parents, signature and notes only when the caller explicitly projects them.
 	rowOpts := LogRowOptionsFromProjection(dt.projectedCols)
+	if err := rowOpts.LoadNotes(ctx, dt.ddb); err != nil {
+		return nil, err
+	}
 
 	refs, err := dt.getCachedRefs(ctx)
 	if err != nil {
@@ -0,0 +1,85 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+func doltMergePoliciesSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: doltdb.MergePoliciesTableNameCol, Type: sqlTypes.VarChar, Source: doltdb.MergePoliciesTableName, PrimaryKey: true},
+		{Name: doltdb.MergePoliciesColumnNameCol, Type: sqlTypes.VarChar, Source: doltdb.MergePoliciesTableName, PrimaryKey: true},
+		{Name: doltdb.MergePoliciesPolicyCol, Type: sqlTypes.VarChar, Source: doltdb.MergePoliciesTableName, Nullable: false},
+		{Name: doltdb.MergePoliciesExpressionCol, Type: sqlTypes.VarChar, Source: doltdb.MergePoliciesTableName, Nullable: true},
+	}
+}
+
+// GetDoltMergePoliciesSchema returns the schema of the dolt_merge_policies system table. This is used by Doltgres to
+// update the dolt_merge_policies schema using Doltgres types.
+var GetDoltMergePoliciesSchema = doltMergePoliciesSchema
+
+// doltMergePoliciesChecks rejects unknown policies, and expression policies without an expression, when they're
+// written rather than when they're used by a merge.
+func doltMergePoliciesChecks() []sql.CheckDefinition {
+	policies := make([]string, len(doltdb.MergePolicies))
+	for i, p := range doltdb.MergePolicies {
+		policies[i] = "'" + p + "'"
+	}
+	return []sql.CheckDefinition{
+		{
+			Name:            "policy_check",
+			CheckExpression: fmt.Sprintf("%s IN (%s)", doltdb.MergePoliciesPolicyCol, strings.Join(policies, ", ")),
+			Enforced:        true,
+		},
+		{
+			Name: "expression_check",
+			CheckExpression: fmt.Sprintf("%s <> '%s' OR %s IS NOT NULL", doltdb.MergePoliciesPolicyCol,
+				doltdb.MergePolicyExpression, doltdb.MergePoliciesExpressionCol),
+			Enforced: true,
+		},
+	}
+}
+
+// NewMergePoliciesTable creates a dolt_merge_policies table
+func NewMergePoliciesTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		backingTable: backingTable,
+		tableName: doltdb.TableName{
+			Name:   doltdb.MergePoliciesTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltMergePoliciesSchema(),
+		checks: doltMergePoliciesChecks(),
+	}
+}
+
+// NewEmptyMergePoliciesTable creates an empty dolt_merge_policies table
+func NewEmptyMergePoliciesTable(_ *sql.Context, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		tableName: doltdb.TableName{
+			Name:   doltdb.MergePoliciesTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltMergePoliciesSchema(),
+		checks: doltMergePoliciesChecks(),
+	}
+}
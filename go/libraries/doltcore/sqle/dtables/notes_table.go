@@ -0,0 +1,129 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+var _ sql.Table = (*NotesTable)(nil)
+var _ sql.StatisticsTable = (*NotesTable)(nil)
+
+// NotesTable is a sql.Table implementation that implements a system table which shows the notes attached to commits
+type NotesTable struct {
+	ddb       *doltdb.DoltDB
+	tableName string
+}
+
+// NewNotesTable creates a NotesTable
+func NewNotesTable(_ *sql.Context, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	return &NotesTable{tableName: tableName, ddb: ddb}
+}
+
+func (nt *NotesTable) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(nt.Schema(ctx))
+	numRows, _, err := nt.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (nt *NotesTable) RowCount(ctx *sql.Context) (uint64, bool, error) {
+	notes, err := nt.ddb.GetNotes(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	return uint64(len(notes)), true, nil
+}
+
+// Name is a sql.Table interface function which returns the name of the table.
+func (nt *NotesTable) Name() string {
+	return nt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table.
+func (nt *NotesTable) String() string {
+	return nt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the notes system table.
+func (nt *NotesTable) Schema(ctx *sql.Context) sql.Schema {
+	return []*sql.Column{
+		{Name: "commit_hash", Type: types.Text, Source: nt.tableName, PrimaryKey: true},
+		{Name: "author", Type: types.Text, Source: nt.tableName, PrimaryKey: false},
+		{Name: "email", Type: types.Text, Source: nt.tableName, PrimaryKey: false},
+		{Name: "date", Type: types.Datetime3, Source: nt.tableName, PrimaryKey: false},
+		{Name: "note", Type: types.LongText, Source: nt.tableName, PrimaryKey: false},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (nt *NotesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently, the data is unpartitioned.
+func (nt *NotesTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (nt *NotesTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	return NewNotesItr(ctx, nt.ddb)
+}
+
+// NotesItr is a sql.RowItr implementation which iterates over each note as if it's a row in the table.
+type NotesItr struct {
+	notes []doltdb.CommitNote
+	idx   int
+}
+
+// NewNotesItr creates a NotesItr from the current environment.
+func NewNotesItr(ctx *sql.Context, ddb *doltdb.DoltDB) (*NotesItr, error) {
+	notes, err := ddb.GetNotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotesItr{notes, 0}, nil
+}
+
+// Next retrieves the next row. It will return io.EOF if it's the last row.
+// After retrieving the last row, Close will be automatically closed.
+func (itr *NotesItr) Next(*sql.Context) (sql.Row, error) {
+	if itr.idx >= len(itr.notes) {
+		return nil, io.EOF
+	}
+
+	defer func() {
+		itr.idx++
+	}()
+
+	cn := itr.notes[itr.idx]
+	return sql.NewRow(cn.CommitHash.String(), cn.Note.Name, cn.Note.Email, cn.Note.Time(), cn.Note.Message), nil
+}
+
+// Close closes the iterator.
+func (itr *NotesItr) Close(*sql.Context) error {
+	return nil
+}
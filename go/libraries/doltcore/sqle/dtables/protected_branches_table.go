@@ -0,0 +1,70 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+func doltProtectedBranchesSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: doltdb.ProtectedBranchesBranchNameCol, Type: sqlTypes.VarChar, Source: doltdb.ProtectedBranchesTableName, PrimaryKey: true},
+		{Name: doltdb.ProtectedBranchesRequiredApprovalsCol, Type: sqlTypes.Int32, Source: doltdb.ProtectedBranchesTableName, Nullable: false},
+	}
+}
+
+// GetDoltProtectedBranchesSchema returns the schema of the dolt_protected_branches system table. This is used by
+// Doltgres to update the dolt_protected_branches schema using Doltgres types.
+var GetDoltProtectedBranchesSchema = doltProtectedBranchesSchema
+
+func doltProtectedBranchesChecks() []sql.CheckDefinition {
+	return []sql.CheckDefinition{
+		{
+			Name:            "required_approvals_check",
+			CheckExpression: fmt.Sprintf("%s >= 0", doltdb.ProtectedBranchesRequiredApprovalsCol),
+			Enforced:        true,
+		},
+	}
+}
+
+// NewProtectedBranchesTable creates a dolt_protected_branches table
+func NewProtectedBranchesTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		backingTable: backingTable,
+		tableName: doltdb.TableName{
+			Name:   doltdb.ProtectedBranchesTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltProtectedBranchesSchema(),
+		checks: doltProtectedBranchesChecks(),
+	}
+}
+
+// NewEmptyProtectedBranchesTable creates an empty dolt_protected_branches table
+func NewEmptyProtectedBranchesTable(_ *sql.Context, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		tableName: doltdb.TableName{
+			Name:   doltdb.ProtectedBranchesTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltProtectedBranchesSchema(),
+		checks: doltProtectedBranchesChecks(),
+	}
+}
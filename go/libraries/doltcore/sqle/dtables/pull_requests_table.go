@@ -0,0 +1,226 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/pullrequest"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+var _ sql.Table = (*PullRequestsTable)(nil)
+var _ sql.StatisticsTable = (*PullRequestsTable)(nil)
+
+// PullRequestsTable is a sql.Table implementation that implements the system tables which show the pull requests of a
+// database: dolt_pull_requests, and their reviews and events in dolt_pull_request_reviews and
+// dolt_pull_request_events.
+type PullRequestsTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+	schema    func(dbName, tableName string) sql.Schema
+	rows      func(ctx *sql.Context, s *pullrequest.Store) ([]sql.Row, error)
+	count     func(s *pullrequest.Store) int
+}
+
+// MergeConflictsFunc returns whether merging the branch |source| into the branch |target| would have conflicts.
+type MergeConflictsFunc func(ctx *sql.Context, target, source string) (bool, error)
+
+// NewPullRequestsTable creates the dolt_pull_requests table. The mergeable column of open pull requests is computed
+// with |hasConflicts| when the table is read, so that it reflects the current heads of their branches.
+func NewPullRequestsTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB, hasConflicts MergeConflictsFunc) sql.Table {
+	rows := func(ctx *sql.Context, s *pullrequest.Store) ([]sql.Row, error) {
+		return pullRequestsRows(ctx, s, ddb, hasConflicts)
+	}
+	count := func(s *pullrequest.Store) int {
+		return len(s.PullRequests)
+	}
+	return &PullRequestsTable{ddb: ddb, dbName: dbName, tableName: tableName, schema: pullRequestsSchema, rows: rows, count: count}
+}
+
+// NewPullRequestReviewsTable creates the dolt_pull_request_reviews table
+func NewPullRequestReviewsTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	count := func(s *pullrequest.Store) int {
+		return len(s.Reviews)
+	}
+	return &PullRequestsTable{ddb: ddb, dbName: dbName, tableName: tableName, schema: pullRequestReviewsSchema, rows: pullRequestReviewsRows, count: count}
+}
+
+// NewPullRequestEventsTable creates the dolt_pull_request_events table
+func NewPullRequestEventsTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	count := func(s *pullrequest.Store) int {
+		return len(s.Events)
+	}
+	return &PullRequestsTable{ddb: ddb, dbName: dbName, tableName: tableName, schema: pullRequestEventsSchema, rows: pullRequestEventsRows, count: count}
+}
+
+func (pt *PullRequestsTable) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(pt.Schema(ctx))
+	numRows, _, err := pt.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (pt *PullRequestsTable) RowCount(ctx *sql.Context) (uint64, bool, error) {
+	s, err := pullrequest.Load(ctx, pt.ddb)
+	if err != nil {
+		return 0, false, err
+	}
+	return uint64(pt.count(s)), true, nil
+}
+
+// Name is a sql.Table interface function which returns the name of the table.
+func (pt *PullRequestsTable) Name() string {
+	return pt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table.
+func (pt *PullRequestsTable) String() string {
+	return pt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the pull request system table.
+func (pt *PullRequestsTable) Schema(ctx *sql.Context) sql.Schema {
+	return pt.schema(pt.dbName, pt.tableName)
+}
+
+// Collation implements the sql.Table interface.
+func (pt *PullRequestsTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently, the data is unpartitioned.
+func (pt *PullRequestsTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (pt *PullRequestsTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	s, err := pullrequest.Load(ctx, pt.ddb)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pt.rows(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func pullRequestsSchema(dbName, tableName string) sql.Schema {
+	return []*sql.Column{
+		{Name: "id", Type: types.Int64, Source: tableName, PrimaryKey: true, DatabaseSource: dbName},
+		{Name: "title", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "description", Type: types.LongText, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "author", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "source_branch", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "target_branch", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "source_commit", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "reviewers", Type: types.Text, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "approvals", Type: types.Int64, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "status", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "mergeable", Type: types.Boolean, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "merge_commit", Type: types.Text, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "created_at", Type: types.DatetimeMaxPrecision, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "updated_at", Type: types.DatetimeMaxPrecision, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+	}
+}
+
+func pullRequestsRows(ctx *sql.Context, s *pullrequest.Store, ddb *doltdb.DoltDB, hasConflicts MergeConflictsFunc) ([]sql.Row, error) {
+	rows := make([]sql.Row, len(s.PullRequests))
+	for i, pr := range s.PullRequests {
+		var reviewers interface{}
+		if len(pr.Reviewers) > 0 {
+			reviewers = strings.Join(pr.Reviewers, ",")
+		}
+		mergeable, err := pullRequestMergeable(ctx, ddb, pr, hasConflicts)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = sql.NewRow(pr.ID, pr.Title, nullIfEmpty(pr.Description), pr.Author, pr.SourceBranch, pr.TargetBranch,
+			pr.SourceCommit, reviewers, int64(len(s.Approvals(pr))), pr.Status, mergeable, nullIfEmpty(pr.MergeCommit),
+			pr.CreatedAt, pr.UpdatedAt)
+	}
+	return rows, nil
+}
+
+// pullRequestMergeable returns whether the source branch of |pr| merges into its target branch without conflicts, or
+// nil if the pull request isn't open, or one of its branches was deleted.
+func pullRequestMergeable(ctx *sql.Context, ddb *doltdb.DoltDB, pr *pullrequest.PullRequest, hasConflicts MergeConflictsFunc) (interface{}, error) {
+	if pr.Status != pullrequest.StatusOpen {
+		return nil, nil
+	}
+	for _, branch := range []string{pr.SourceBranch, pr.TargetBranch} {
+		if _, ok, err := ddb.HasBranch(ctx, branch); err != nil || !ok {
+			return nil, err
+		}
+	}
+	conflicts, err := hasConflicts(ctx, pr.TargetBranch, pr.SourceBranch)
+	if err != nil {
+		return nil, err
+	}
+	return !conflicts, nil
+}
+
+func pullRequestReviewsSchema(dbName, tableName string) sql.Schema {
+	return []*sql.Column{
+		{Name: "id", Type: types.Int64, Source: tableName, PrimaryKey: true, DatabaseSource: dbName},
+		{Name: "pull_request_id", Type: types.Int64, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "reviewer", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "kind", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "body", Type: types.LongText, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "commit", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "created_at", Type: types.DatetimeMaxPrecision, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+	}
+}
+
+func pullRequestReviewsRows(_ *sql.Context, s *pullrequest.Store) ([]sql.Row, error) {
+	rows := make([]sql.Row, len(s.Reviews))
+	for i, r := range s.Reviews {
+		rows[i] = sql.NewRow(r.ID, r.PullRequestID, r.Reviewer, r.Kind, nullIfEmpty(r.Body), r.Commit, r.CreatedAt)
+	}
+	return rows, nil
+}
+
+func pullRequestEventsSchema(dbName, tableName string) sql.Schema {
+	return []*sql.Column{
+		{Name: "id", Type: types.Int64, Source: tableName, PrimaryKey: true, DatabaseSource: dbName},
+		{Name: "pull_request_id", Type: types.Int64, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "activity", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "commit", Type: types.Text, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+		{Name: "workflows", Type: types.Text, Source: tableName, PrimaryKey: false, Nullable: true, DatabaseSource: dbName},
+		{Name: "created_at", Type: types.DatetimeMaxPrecision, Source: tableName, PrimaryKey: false, DatabaseSource: dbName},
+	}
+}
+
+func pullRequestEventsRows(_ *sql.Context, s *pullrequest.Store) ([]sql.Row, error) {
+	rows := make([]sql.Row, len(s.Events))
+	for i, e := range s.Events {
+		var workflows interface{}
+		if len(e.Workflows) > 0 {
+			workflows = strings.Join(e.Workflows, ",")
+		}
+		rows[i] = sql.NewRow(e.ID, e.PullRequestID, e.Activity, e.Commit, workflows, e.CreatedAt)
+	}
+	return rows, nil
+}
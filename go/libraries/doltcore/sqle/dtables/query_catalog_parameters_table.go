@@ -0,0 +1,59 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+func doltQueryCatalogParametersSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: doltdb.QueryCatalogParametersQueryIdCol, Type: sqlTypes.Text, Source: doltdb.QueryCatalogParametersTableName, PrimaryKey: true},
+		{Name: doltdb.QueryCatalogParametersNameCol, Type: sqlTypes.Text, Source: doltdb.QueryCatalogParametersTableName, PrimaryKey: true},
+		{Name: doltdb.QueryCatalogParametersOrderCol, Type: sqlTypes.Int32, Source: doltdb.QueryCatalogParametersTableName, Nullable: false},
+		{Name: doltdb.QueryCatalogParametersTypeCol, Type: sqlTypes.Text, Source: doltdb.QueryCatalogParametersTableName, Nullable: false},
+		{Name: doltdb.QueryCatalogParametersDefaultCol, Type: sqlTypes.Text, Source: doltdb.QueryCatalogParametersTableName, Nullable: true},
+	}
+}
+
+// GetDoltQueryCatalogParametersSchema returns the schema of the dolt_query_catalog_parameters system table. This is
+// used by Doltgres to update the dolt_query_catalog_parameters schema using Doltgres types.
+var GetDoltQueryCatalogParametersSchema = doltQueryCatalogParametersSchema
+
+// NewQueryCatalogParametersTable creates a dolt_query_catalog_parameters table
+func NewQueryCatalogParametersTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		backingTable: backingTable,
+		tableName: doltdb.TableName{
+			Name:   doltdb.QueryCatalogParametersTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltQueryCatalogParametersSchema(),
+	}
+}
+
+// NewEmptyQueryCatalogParametersTable creates an empty dolt_query_catalog_parameters table
+func NewEmptyQueryCatalogParametersTable(_ *sql.Context, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		tableName: doltdb.TableName{
+			Name:   doltdb.QueryCatalogParametersTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltQueryCatalogParametersSchema(),
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/rerere"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+var _ sql.Table = (*RerereTable)(nil)
+var _ sql.StatisticsTable = (*RerereTable)(nil)
+
+// RerereTable is a sql.Table implementation that implements a system table which shows the recorded conflict
+// resolutions of a database, and the conflicts of the current branch whose resolutions will be recorded once they're
+// committed.
+type RerereTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+}
+
+// NewRerereTable creates a RerereTable
+func NewRerereTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	return &RerereTable{ddb: ddb, dbName: dbName, tableName: tableName}
+}
+
+func (rt *RerereTable) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(rt.Schema(ctx))
+	numRows, _, err := rt.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (rt *RerereTable) RowCount(ctx *sql.Context) (uint64, bool, error) {
+	entries, err := rt.entries(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	return uint64(len(entries)), true, nil
+}
+
+// Name is a sql.Table interface function which returns the name of the table.
+func (rt *RerereTable) Name() string {
+	return rt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table.
+func (rt *RerereTable) String() string {
+	return rt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the rerere system table.
+func (rt *RerereTable) Schema(ctx *sql.Context) sql.Schema {
+	return []*sql.Column{
+		{Name: "table_name", Type: types.Text, Source: rt.tableName, PrimaryKey: true, DatabaseSource: rt.dbName},
+		{Name: "row_key", Type: types.Text, Source: rt.tableName, PrimaryKey: true, DatabaseSource: rt.dbName},
+		{Name: "status", Type: types.Text, Source: rt.tableName, PrimaryKey: false, DatabaseSource: rt.dbName},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (rt *RerereTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently, the data is unpartitioned.
+func (rt *RerereTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (rt *RerereTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	entries, err := rt.entries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]sql.Row, len(entries))
+	for i, e := range entries {
+		rows[i] = sql.NewRow(e.Table.String(), e.Key, e.Status)
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// entries returns the recorded resolutions, and the pending conflicts of the current branch. Only recorded resolutions
+// are returned when there's no current branch, such as when a commit is checked out.
+func (rt *RerereTable) entries(ctx *sql.Context) ([]rerere.Entry, error) {
+	return dsess.RecordedConflicts(ctx, rt.dbName, rt.ddb)
+}
@@ -147,7 +147,30 @@ func getStatusRowsData(
 		return nil, nil, err
 	}
 
-	// Some tables may differ only in column tags and/or recorded conflicts.
+	if err = diff.ApplyIgnoredColumns(ctx, roots.Staged, stagedTables); err != nil {
+		return nil, nil, err
+	}
+	if err = diff.ApplyIgnoredColumns(ctx, roots.Working, unstagedTables); err != nil {
+		return nil, nil, err
+	}
+
+	// Staged tables whose only changes are to columns ignored by dolt_ignore_columns aren't displayed either.
+	changedStagedTables := make([]diff.TableDelta, 0, len(stagedTables))
+	for _, stagedTableDiff := range stagedTables {
+		if len(stagedTableDiff.IgnoredColumns) > 0 {
+			changed, err := stagedTableDiff.HasChangesIgnoringColumnTags(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !changed {
+				continue
+			}
+		}
+		changedStagedTables = append(changedStagedTables, stagedTableDiff)
+	}
+	stagedTables = changedStagedTables
+
+	// Some tables may differ only in column tags, recorded conflicts and/or columns ignored by dolt_ignore_columns.
 	// We try to make such changes invisible to users and shouldn't display them for unstaged tables.
 	changedUnstagedTables := make([]diff.TableDelta, 0, len(unstagedTables))
 	for _, unstagedTableDiff := range unstagedTables {
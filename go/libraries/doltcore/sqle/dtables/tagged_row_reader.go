@@ -0,0 +1,205 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// TaggedRowReader reads rows from past versions of a table in the schema of its latest version, matching columns by
+// tag, so that renamed columns and columns whose type changed are still read. It's used to follow rows through a
+// table's history.
+type TaggedRowReader struct {
+	sch  sql.Schema
+	tags map[uint64]int
+}
+
+// NewTaggedRowReader returns a TaggedRowReader for rows with the columns of |sch|, of the types in |sqlSch|, which
+// has a column for each of the columns of |sch| in the same order.
+func NewTaggedRowReader(sch schema.Schema, sqlSch sql.Schema) *TaggedRowReader {
+	tags := make(map[uint64]int)
+	for i, col := range sch.GetAllCols().GetColumns() {
+		tags[col.Tag] = i
+	}
+	return &TaggedRowReader{sch: sqlSch[:len(tags)], tags: tags}
+}
+
+// Index returns the index in the rows read of the column with tag |tag|.
+func (r *TaggedRowReader) Index(tag uint64) (int, bool) {
+	i, ok := r.tags[tag]
+	return i, ok
+}
+
+// ConvertRow converts the key and value tuples |k| and |v| of the map |m| of a table with schema |sch|, matching
+// columns by tag. Columns that don't exist in |sch| are NULL.
+func (r *TaggedRowReader) ConvertRow(ctx *sql.Context, sch schema.Schema, m prolly.Map, k, v val.Tuple) (sql.Row, error) {
+	row := make(sql.Row, len(r.sch))
+	kd, vd := m.Descriptors()
+	put := func(col schema.Column, desc *val.TupleDesc, tup val.Tuple, i int) error {
+		outIdx, ok := r.tags[col.Tag]
+		if !ok {
+			return nil
+		}
+		f, err := tree.GetField(ctx, desc, i, tup, m.NodeStore())
+		if err != nil {
+			return err
+		}
+		outType := r.sch[outIdx].Type
+		if f != nil && !col.TypeInfo.ToSqlType().Equals(outType) {
+			// values that don't fit the column's latest type are NULL, like in dolt_history tables
+			if f, _, err = outType.Convert(ctx, f); err != nil {
+				f = nil
+			}
+		}
+		row[outIdx] = f
+		return nil
+	}
+
+	for i, col := range sch.GetPKCols().GetColumns() {
+		if err := put(col, kd, k, i); err != nil {
+			return nil, err
+		}
+	}
+	i := 0
+	if schema.IsKeyless(sch) {
+		// the values of keyless rows start with their cardinality
+		i = 1
+	}
+	for _, col := range sch.GetNonPKCols().GetColumns() {
+		// virtual columns aren't stored
+		if col.Virtual {
+			continue
+		}
+		if err := put(col, vd, v, i); err != nil {
+			return nil, err
+		}
+		i++
+	}
+	return row, nil
+}
+
+// LookupRow returns the row of the map |m| of a table with schema |sch| with the primary key values |keyVals|, given
+// by column tag, or nil if it doesn't exist. When the table's primary key has the same columns as |keyVals|, the row
+// is found with a point lookup. Otherwise, the table is scanned for a row with the same values in those columns, as
+// long as all of them exist.
+func (r *TaggedRowReader) LookupRow(ctx *sql.Context, sch schema.Schema, m prolly.Map, keyVals map[uint64]interface{}) (sql.Row, error) {
+	pkCols := sch.GetPKCols().GetColumns()
+	samePk := len(pkCols) == len(keyVals)
+	for _, col := range pkCols {
+		if _, ok := keyVals[col.Tag]; !ok {
+			samePk = false
+		}
+	}
+
+	if samePk {
+		kd, _ := m.Descriptors()
+		kb := val.NewTupleBuilder(kd, m.NodeStore())
+		for i, col := range pkCols {
+			v, _, err := col.TypeInfo.ToSqlType().Convert(ctx, keyVals[col.Tag])
+			if err != nil {
+				// the key can't be represented in this version of the table
+				return nil, nil
+			}
+			if err = tree.PutField(ctx, m.NodeStore(), kb, i, v); err != nil {
+				return nil, err
+			}
+		}
+		key, err := kb.Build(ctx, m.Pool())
+		if err != nil {
+			return nil, err
+		}
+
+		var row sql.Row
+		err = m.Get(ctx, key, func(k, v val.Tuple) error {
+			if k == nil {
+				return nil
+			}
+			row, err = r.ConvertRow(ctx, sch, m, k, v)
+			return err
+		})
+		return row, err
+	}
+
+	for tag := range keyVals {
+		if _, ok := sch.GetAllCols().GetByTag(tag); !ok {
+			return nil, nil
+		}
+	}
+	iter, err := m.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		row, err := r.ConvertRow(ctx, sch, m, k, v)
+		if err != nil {
+			return nil, err
+		}
+		matches := true
+		for tag, keyVal := range keyVals {
+			i := r.tags[tag]
+			if row[i] == nil {
+				matches = false
+				break
+			}
+			if eq, err := r.ValuesEqual(ctx, i, row[i], keyVal); err != nil {
+				return nil, err
+			} else if !eq {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return row, nil
+		}
+	}
+}
+
+// ValuesEqual returns whether |a| and |b| are the same value of the column at index |i|, where NULL equals NULL.
+func (r *TaggedRowReader) ValuesEqual(ctx *sql.Context, i int, a, b interface{}) (bool, error) {
+	if a == nil || b == nil {
+		return a == nil && b == nil, nil
+	}
+	cmp, err := r.sch[i].Type.Compare(ctx, a, b)
+	if err != nil {
+		return false, err
+	}
+	return cmp == 0, nil
+}
+
+// RowsEqual returns whether |a| and |b| are the same version of a row, where nil means it doesn't exist.
+func (r *TaggedRowReader) RowsEqual(ctx *sql.Context, a, b sql.Row) (bool, error) {
+	if a == nil || b == nil {
+		return a == nil && b == nil, nil
+	}
+	for i := range r.sch {
+		if eq, err := r.ValuesEqual(ctx, i, a[i], b[i]); err != nil || !eq {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+func doltTestFixturesSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: doltdb.TestFixturesTestGroupCol, Type: sqlTypes.Text, Source: doltdb.TestFixturesTableName, PrimaryKey: true},
+		{Name: doltdb.TestFixturesSetupCol, Type: sqlTypes.Text, Source: doltdb.TestFixturesTableName, Nullable: true},
+		{Name: doltdb.TestFixturesTeardownCol, Type: sqlTypes.Text, Source: doltdb.TestFixturesTableName, Nullable: true},
+	}
+}
+
+// GetDoltTestFixturesSchema returns the schema of the dolt_test_fixtures system table. This is used by Doltgres to
+// update the dolt_test_fixtures schema using Doltgres types.
+var GetDoltTestFixturesSchema = doltTestFixturesSchema
+
+// NewTestFixturesTable creates a dolt_test_fixtures table
+func NewTestFixturesTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		backingTable: backingTable,
+		tableName: doltdb.TableName{
+			Name:   doltdb.TestFixturesTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltTestFixturesSchema(),
+	}
+}
+
+// NewEmptyTestFixturesTable creates an empty dolt_test_fixtures table
+func NewEmptyTestFixturesTable(_ *sql.Context, schemaName string) sql.Table {
+	return &UserSpaceSystemTable{
+		tableName: doltdb.TableName{
+			Name:   doltdb.TestFixturesTableName,
+			Schema: schemaName,
+		},
+		schema: GetDoltTestFixturesSchema(),
+	}
+}
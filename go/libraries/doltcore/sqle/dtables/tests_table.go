@@ -150,7 +150,7 @@ func (tt *TestsTable) GetChecks(_ *sql.Context) ([]sql.CheckDefinition, error) {
 	return []sql.CheckDefinition{
 		{
 			Name:            "assertion_type_check",
-			CheckExpression: "assertion_type IN ('expected_rows', 'expected_columns', 'expected_single_value')",
+			CheckExpression: "assertion_type IN ('expected_rows', 'expected_columns', 'expected_single_value', 'expected_error', 'expected_snapshot', 'expected_duration_ms', 'expected_change_rows', 'expected_change_columns', 'expected_change_single_value')",
 			Enforced:        true,
 		},
 		{
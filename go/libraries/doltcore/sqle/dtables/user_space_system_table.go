@@ -32,6 +32,7 @@ var _ sql.DeletableTable = (*UserSpaceSystemTable)(nil)
 var _ sql.InsertableTable = (*UserSpaceSystemTable)(nil)
 var _ sql.ReplaceableTable = (*UserSpaceSystemTable)(nil)
 var _ sql.IndexAddressableTable = (*UserSpaceSystemTable)(nil)
+var _ sql.CheckTable = (*UserSpaceSystemTable)(nil)
 
 // A UserSpaceSystemTable is a system table backed by a normal table in storage.
 // Like other system tables, it always exists. If the backing table doesn't exist, then reads return an empty table,
@@ -40,6 +41,8 @@ type UserSpaceSystemTable struct {
 	backingTable VersionableTable
 	tableName    doltdb.TableName
 	schema       sql.Schema
+	// checks are the constraints enforced on rows written to the table, if any
+	checks []sql.CheckDefinition
 }
 
 func (bst *UserSpaceSystemTable) Name() string {
@@ -122,6 +125,11 @@ func (bst *UserSpaceSystemTable) PreciseMatch() bool {
 	return true
 }
 
+// GetChecks implements sql.CheckTable, returning the constraints enforced on rows written to the table.
+func (bst *UserSpaceSystemTable) GetChecks(_ *sql.Context) ([]sql.CheckDefinition, error) {
+	return bst.checks, nil
+}
+
 var _ sql.RowReplacer = (*backedSystemTableWriter)(nil)
 var _ sql.RowUpdater = (*backedSystemTableWriter)(nil)
 var _ sql.RowInserter = (*backedSystemTableWriter)(nil)
@@ -0,0 +1,145 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+// WebhookDelivery is the status of the delivery of a webhook payload for an update of a branch or tag.
+type WebhookDelivery struct {
+	ID      string
+	Webhook string
+	URL     string
+	Event   string
+	Ref     string
+	OldHash string
+	NewHash string
+	// Status is one of pending, delivered or failed
+	Status   string
+	Attempts int
+	// ResponseCode is the HTTP status of the last attempt, or 0 if no response was received
+	ResponseCode  int
+	LastError     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	NextAttemptAt time.Time
+}
+
+// WebhookDeliveryLister is implemented by the commit hooks which deliver webhooks, so that the deliveries of a
+// database can be listed by its dolt_webhook_deliveries table.
+type WebhookDeliveryLister interface {
+	WebhookDeliveries() []WebhookDelivery
+}
+
+var _ sql.Table = (*WebhookDeliveriesTable)(nil)
+
+// WebhookDeliveriesTable is a sql.Table implementation that implements a system table which shows the deliveries of
+// the webhooks notified of the updates of the branches and tags of a database. It's empty unless the database is
+// served by a sql-server with webhooks configured.
+type WebhookDeliveriesTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+}
+
+// NewWebhookDeliveriesTable creates a WebhookDeliveriesTable
+func NewWebhookDeliveriesTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) sql.Table {
+	return &WebhookDeliveriesTable{ddb: ddb, dbName: dbName, tableName: tableName}
+}
+
+// Name is a sql.Table interface function which returns the name of the table.
+func (wt *WebhookDeliveriesTable) Name() string {
+	return wt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table.
+func (wt *WebhookDeliveriesTable) String() string {
+	return wt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the webhook deliveries system table.
+func (wt *WebhookDeliveriesTable) Schema(ctx *sql.Context) sql.Schema {
+	return []*sql.Column{
+		{Name: "id", Type: types.Text, Source: wt.tableName, PrimaryKey: true, DatabaseSource: wt.dbName},
+		{Name: "webhook", Type: types.Text, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "url", Type: types.Text, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "event", Type: types.Text, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "ref", Type: types.Text, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "old_hash", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "new_hash", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "status", Type: types.Text, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "attempts", Type: types.Int32, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "response_code", Type: types.Int32, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "last_error", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "created_at", Type: types.DatetimeMaxPrecision, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "updated_at", Type: types.DatetimeMaxPrecision, Source: wt.tableName, PrimaryKey: false, DatabaseSource: wt.dbName},
+		{Name: "next_attempt_at", Type: types.DatetimeMaxPrecision, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (wt *WebhookDeliveriesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently, the data is unpartitioned.
+func (wt *WebhookDeliveriesTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (wt *WebhookDeliveriesTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	var deliveries []WebhookDelivery
+	for _, hook := range wt.ddb.PostCommitHooks() {
+		if l, ok := hook.(WebhookDeliveryLister); ok {
+			deliveries = append(deliveries, l.WebhookDeliveries()...)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		if !deliveries[i].CreatedAt.Equal(deliveries[j].CreatedAt) {
+			return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+		}
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	rows := make([]sql.Row, len(deliveries))
+	for i, d := range deliveries {
+		var responseCode, nextAttemptAt interface{}
+		if d.ResponseCode != 0 {
+			responseCode = int32(d.ResponseCode)
+		}
+		if !d.NextAttemptAt.IsZero() {
+			nextAttemptAt = d.NextAttemptAt
+		}
+		rows[i] = sql.NewRow(d.ID, d.Webhook, d.URL, d.Event, d.Ref, nullIfEmpty(d.OldHash), nullIfEmpty(d.NewHash),
+			d.Status, int32(d.Attempts), responseCode, nullIfEmpty(d.LastError), d.CreatedAt, d.UpdatedAt, nextAttemptAt)
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -1302,6 +1302,36 @@ func TestDoltRebasePrepared(t *testing.T) {
 	RunDoltRebasePreparedTests(t, h)
 }
 
+func TestDoltBisect(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltBisectTests(t, h)
+}
+
+func TestDoltBisectPrepared(t *testing.T) {
+	h := newDoltHarness(t)
+	RunDoltBisectPreparedTests(t, h)
+}
+
+func TestDoltNotes(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltNotesTests(t, h)
+}
+
+func TestDoltNotesPrepared(t *testing.T) {
+	h := newDoltHarness(t)
+	RunDoltNotesPreparedTests(t, h)
+}
+
+func TestDoltApply(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltApplyTests(t, h)
+}
+
+func TestDoltApplyPrepared(t *testing.T) {
+	h := newDoltHarness(t)
+	RunDoltApplyPreparedTests(t, h)
+}
+
 func TestDoltRevert(t *testing.T) {
 	h := newDoltEnginetestHarness(t)
 	RunDoltRevertTests(t, h)
@@ -1473,6 +1503,56 @@ func TestHistorySystemTablePrepared(t *testing.T) {
 	RunHistorySystemTableTestsPrepared(t, harness)
 }
 
+func TestCellBlameSystemTable(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunCellBlameSystemTableTests(t, harness)
+}
+
+func TestCellBlameSystemTablePrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunCellBlameSystemTableTestsPrepared(t, harness)
+}
+
+func TestMergePolicies(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunMergePoliciesTests(t, harness)
+}
+
+func TestMergePoliciesPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunMergePoliciesTestsPrepared(t, harness)
+}
+
+func TestRerere(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunRerereTests(t, harness)
+}
+
+func TestRererePrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunRerereTestsPrepared(t, harness)
+}
+
+func TestIgnoreColumns(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunIgnoreColumnsTests(t, harness)
+}
+
+func TestIgnoreColumnsPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunIgnoreColumnsTestsPrepared(t, harness)
+}
+
+func TestPullRequests(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunPullRequestTests(t, harness)
+}
+
+func TestPullRequestsPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t).WithParallelism(2)
+	RunPullRequestTestsPrepared(t, harness)
+}
+
 func TestBrokenHistorySystemTablePrepared(t *testing.T) {
 	t.Skip()
 	harness := newDoltHarness(t)
@@ -1571,6 +1651,36 @@ func TestLogTableFunctionPrepared(t *testing.T) {
 	RunLogTableFunctionTestsPrepared(t, harness)
 }
 
+func TestRowHistoryTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunRowHistoryTableFunctionTests(t, harness)
+}
+
+func TestRowHistoryTableFunctionPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunRowHistoryTableFunctionTestsPrepared(t, harness)
+}
+
+func TestSystemTime(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunSystemTimeTests(t, harness)
+}
+
+func TestSystemTimePrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunSystemTimeTestsPrepared(t, harness)
+}
+
+func TestChangesTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunChangesTableFunctionTests(t, harness)
+}
+
+func TestChangesTableFunctionPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunChangesTableFunctionTestsPrepared(t, harness)
+}
+
 func TestJsonDiffTableFunction(t *testing.T) {
 	harness := newDoltEnginetestHarness(t)
 	RunJsonDiffTableFunctionTests(t, harness)
@@ -1641,6 +1751,16 @@ func TestSchemaDiffTableFunctionPrepared(t *testing.T) {
 	RunSchemaDiffTableFunctionTestsPrepared(t, harness)
 }
 
+func TestSchemaMigrationTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunSchemaMigrationTableFunctionTests(t, harness)
+}
+
+func TestSchemaMigrationTableFunctionPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunSchemaMigrationTableFunctionTestsPrepared(t, harness)
+}
+
 func TestDoltDatabaseCollationDiffs(t *testing.T) {
 	harness := newDoltEnginetestHarness(t)
 	RunDoltDatabaseCollationDiffsTests(t, harness)
@@ -1039,6 +1039,72 @@ func RunDoltRebasePreparedTests(t *testing.T, h DoltEnginetestHarness) {
 	}
 }
 
+func RunDoltBisectTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltBisectScriptTests {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			h.SkipSetupCommit()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltBisectPreparedTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltBisectScriptTests {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			h.SkipSetupCommit()
+			enginetest.TestScriptPrepared(t, h, script)
+		}()
+	}
+}
+
+func RunDoltNotesTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltNotesScriptTests {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			h.SkipSetupCommit()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltNotesPreparedTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltNotesScriptTests {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			h.SkipSetupCommit()
+			enginetest.TestScriptPrepared(t, h, script)
+		}()
+	}
+}
+
+func RunDoltApplyTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltApplyScriptTests {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			h.SkipSetupCommit()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltApplyPreparedTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltApplyScriptTests {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			h.SkipSetupCommit()
+			enginetest.TestScriptPrepared(t, h, script)
+		}()
+	}
+}
+
 func RunDoltRevertTests(t *testing.T, h DoltEnginetestHarness) {
 	for _, script := range RevertScripts {
 		// harness can't reset effectively. Use a new harness for each script
@@ -1268,6 +1334,106 @@ func RunHistorySystemTableTestsPrepared(t *testing.T, harness DoltEnginetestHarn
 	}
 }
 
+func RunCellBlameSystemTableTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range CellBlameSystemTableScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunCellBlameSystemTableTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range CellBlameSystemTableScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
+func RunMergePoliciesTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range MergePoliciesScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunMergePoliciesTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range MergePoliciesScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
+func RunRerereTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range RerereScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunRerereTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range RerereScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
+func RunIgnoreColumnsTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range IgnoreColumnsScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunIgnoreColumnsTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range IgnoreColumnsScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
+func RunPullRequestTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range PullRequestScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunPullRequestTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range PullRequestScriptTests {
+		harness = harness.NewHarness(t)
+		harness.Setup(setup.MydbData)
+		t.Run(test.Name, func(t *testing.T) {
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
 func RunDoltBranchesSystemTableTests(t *testing.T, harness DoltEnginetestHarness) {
 	for _, test := range BranchesSystemTableTests {
 		harness = harness.NewHarness(t)
@@ -1455,6 +1621,78 @@ func RunLogTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetestHarnes
 	}
 }
 
+func RunRowHistoryTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range RowHistoryTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			harness.SkipSetupCommit()
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunRowHistoryTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range RowHistoryTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			harness.SkipSetupCommit()
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
+func RunSystemTimeTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range SystemTimeScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			harness.SkipSetupCommit()
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunSystemTimeTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range SystemTimeScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			harness.SkipSetupCommit()
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
+func RunChangesTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range ChangesTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			harness.SkipSetupCommit()
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunChangesTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range ChangesTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			harness.SkipSetupCommit()
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
 func RunJsonDiffTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
 	for _, test := range JsonDiffTableFunctionScriptTests {
 		harness = harness.NewHarness(t)
@@ -1605,6 +1843,28 @@ func RunSchemaDiffTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetes
 	}
 }
 
+func RunSchemaMigrationTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range SchemaMigrationTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunSchemaMigrationTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range SchemaMigrationTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
 func RunDoltDatabaseCollationDiffsTests(t *testing.T, harness DoltEnginetestHarness) {
 	for _, test := range DoltDatabaseCollationScriptTests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -2052,6 +2312,7 @@ func RunThreeWayMergeWithSchemaChangeScripts(t *testing.T, h DoltEnginetestHarne
 	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsSchemaConflicts, "schema conflicts", false)
 	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsGeneratedColumns, "generated columns", false)
 	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsForJsonConflicts, "json merge", false)
+	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsForTextConflicts, "text merge", false)
 
 	// Run non-symmetric schema merge tests in just one direction
 	t.Run("type changes", func(t *testing.T) {
@@ -2074,6 +2335,7 @@ func RunThreeWayMergeWithSchemaChangeScriptsPrepared(t *testing.T, h DoltEnginet
 	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsSchemaConflicts, "schema conflicts", false)
 	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsGeneratedColumns, "generated columns", false)
 	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsForJsonConflicts, "json merge", false)
+	runMergeScriptTestsInBothDirections(t, SchemaChangeTestsForTextConflicts, "text merge", false)
 
 	// Run non-symmetric schema merge tests in just one direction
 	t.Run("type changes", func(t *testing.T) {
@@ -1538,6 +1538,101 @@ var DoltUserPrivTests = []queries.UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_test_run privilege checking",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.dolt_tests VALUES ('empty', 'g', 'SELECT * FROM test', 'expected_rows', '==', '0');",
+			"INSERT INTO mydb.dolt_tests VALUES ('other', 'h', 'SELECT * FROM test', 'expected_rows', '==', '0');",
+			"CALL DOLT_COMMIT('-Am', 'add tests');",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []queries.UserPrivilegeTestAssertion{
+			{
+				// Running tests without fixtures only reads
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT test_name, status FROM dolt_test_run('g');",
+				Expected: []sql.Row{{"empty", "PASS"}},
+			},
+			{
+				// Isolated runs create and delete a branch
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT test_name, status FROM dolt_test_run('--isolated', 'g');",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.dolt_test_fixtures VALUES ('g', 'INSERT INTO test VALUES (1)', 'DELETE FROM test');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				// Only the fixtures of the groups being run are checked
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT test_name, status FROM dolt_test_run('h');",
+				Expected: []sql.Row{{"other", "PASS"}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT test_name, status FROM dolt_test_run('other');",
+				Expected: []sql.Row{{"other", "PASS"}},
+			},
+			{
+				// Fixtures write to the tested tables
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT test_name, status FROM dolt_test_run('g');",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT INSERT, UPDATE, DELETE ON mydb.* TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT test_name, status FROM dolt_test_run('g');",
+				Expected: []sql.Row{{"empty", "FAIL"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_test_run expected_error write statement privilege checking",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.dolt_tests VALUES ('reads', 'g', 'SELECT * FROM missing', 'expected_error', '==', NULL);",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []queries.UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT test_name, status FROM dolt_test_run('g');",
+				Expected: []sql.Row{{"reads", "PASS"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.dolt_tests VALUES ('writes', 'g', 'INSERT INTO test VALUES (1)', 'expected_error', '!=', NULL);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				// expected_error tests can run INSERT, UPDATE and DELETE statements
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT test_name, status FROM dolt_test_run('g');",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+		},
+	},
 	{
 		Name: "table function privilege checking",
 		SetUpScript: []string{
@@ -5524,6 +5619,7 @@ var LogTableFunctionScriptTests = []queries.ScriptTest{
 					{"author", "text", "NO", "", nil, ""},
 					{"author_email", "text", "NO", "", nil, ""},
 					{"author_date", "datetime(3)", "NO", "", nil, ""},
+					{"notes", "longtext", "YES", "", nil, ""},
 				},
 			},
 			{
@@ -8630,6 +8726,8 @@ var DoltSystemVariables = []queries.ScriptTest{
 					{"dolt_backups"},
 					{"dolt_branch_activity"},
 					{"dolt_branches"},
+					{"dolt_ci_runs"},
+					{"dolt_ci_step_results"},
 					{"dolt_commit_ancestors"},
 					{"dolt_commit_diff_test"},
 					{"dolt_commits"},
@@ -8641,11 +8739,17 @@ var DoltSystemVariables = []queries.ScriptTest{
 					{"dolt_help"},
 					{"dolt_history_test"},
 					{"dolt_log"},
+					{"dolt_notes"},
+					{"dolt_pull_request_events"},
+					{"dolt_pull_request_reviews"},
+					{"dolt_pull_requests"},
 					{"dolt_remote_branches"},
 					{"dolt_remotes"},
+					{"dolt_rerere"},
 					{"dolt_stashes"},
 					{"dolt_status"},
 					{"dolt_status_ignored"},
+					{"dolt_webhook_deliveries"},
 					{"dolt_workspace_test"},
 					{"test"},
 				},
@@ -0,0 +1,361 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// setPatchFromBranch builds a patch of the commit at the tip of the feature branch in @patch, the way
+// `dolt format-patch` writes it, with |stmts| as the patch's statements.
+func setPatchFromBranch(stmts string) string {
+	return `SET @patch = CONCAT(
+		'# From: ', hashof('feature'), '\n',
+		'# Parent: ', hashof('feature~1'), '\n',
+		'# Author: Jane Doe <jane@doe.com>\n',
+		'# Date: 2022-01-01T12:00:00Z\n',
+		'#\n',
+		'#     update from feature\n',
+		'#\n',
+		'# ---\n',
+		'` + stmts + `');`
+}
+
+var DoltApplyScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_apply: plain SQL patch applies directly to the working set",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"SET @head = hashof('HEAD');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// a patch starting with a comment is passed after --, so it isn't read as an option
+				Query:    "CALL DOLT_APPLY('--', '-- from dolt diff\nUPDATE `t` SET `v`=''x'' WHERE `pk`=1;\nDELETE FROM `t` WHERE `pk`=2;\nINSERT INTO `t` (`pk`,`v`) VALUES (3,''c'');\nCREATE TABLE `u` (`id` int NOT NULL, PRIMARY KEY (`id`));');",
+				Expected: []sql.Row{{0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "x"}, {3, "c"}},
+			},
+			{
+				Query:    "SELECT table_name, staged, status FROM dolt_status ORDER BY table_name;",
+				Expected: []sql.Row{{"t", byte(0), "modified"}, {"u", byte(0), "new table"}},
+			},
+			{
+				Query:    "SELECT hashof('HEAD') = @head;",
+				Expected: []sql.Row{{true}},
+			},
+		},
+	},
+	{
+		Name: "dolt_apply: patch falls back to a three-way merge when it doesn't apply directly",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"INSERT INTO t VALUES (3, 'c');",
+			"UPDATE t SET v = 'main' WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'change main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"INSERT INTO t VALUES (3, 'c');",
+			"UPDATE t SET v = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			setPatchFromBranch("INSERT INTO `t` (`pk`,`v`) VALUES (3,''c'');\\nUPDATE `t` SET `v`=''feature'' WHERE `pk`=1;\\n"),
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_APPLY(@patch);",
+				Expected: []sql.Row{{0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "feature"}, {2, "main"}, {3, "c"}},
+			},
+			{
+				Query:    "SELECT is_merging FROM dolt_merge_status;",
+				Expected: []sql.Row{{false}},
+			},
+			{
+				Query:    "SELECT table_name, staged, status FROM dolt_status;",
+				Expected: []sql.Row{{"t", byte(0), "modified"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_apply: conflicts are recorded like a merge",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET v = 'main' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET v = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			setPatchFromBranch("UPDATE `t` SET `v`=''feature'' WHERE `pk`=1;\\n"),
+			"SET @@dolt_allow_commit_conflicts = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_APPLY(@patch);",
+				Expected: []sql.Row{{1, 0, 0}},
+			},
+			{
+				Query:    "SELECT our_pk, our_v, their_pk, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{1, "main", 1, "feature"}},
+			},
+			{
+				Query:    "SELECT is_merging, unmerged_tables FROM dolt_merge_status;",
+				Expected: []sql.Row{{true, "t"}},
+			},
+			{
+				Query:          "CALL DOLT_APPLY(@patch);",
+				ExpectedErrStr: "cannot apply a patch while a merge is in progress, resolve or abort it first",
+			},
+			{
+				Query:    "CALL DOLT_CONFLICTS_RESOLVE('--theirs', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_ADD('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('-m', 'apply patch');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "feature"}},
+			},
+			{
+				Query:    "SELECT author, message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"root", "apply patch"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_commit_ancestors WHERE commit_hash = HASHOF('HEAD');",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "dolt_apply: aborting a conflicted patch restores the working set",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET v = 'main' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET v = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			setPatchFromBranch("UPDATE `t` SET `v`=''feature'' WHERE `pk`=1;\\n"),
+			"SET @@dolt_allow_commit_conflicts = 1;",
+			"CREATE TABLE dirty (id int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_APPLY(@patch);",
+				Expected: []sql.Row{{1, 0, 0}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('--abort');",
+				Expected: []sql.Row{{"", 0, 0, "merge aborted"}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "main"}},
+			},
+			{
+				Query:    "SELECT table_name, staged, status FROM dolt_status;",
+				Expected: []sql.Row{{"dirty", byte(0), "new table"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_apply: errors",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_APPLY();",
+				ExpectedErrStr: "error: no patch given",
+			},
+			{
+				Query:          "CALL DOLT_APPLY('--', '-- nothing but a comment');",
+				ExpectedErrStr: "error: no patch given",
+			},
+			{
+				Query:          "CALL DOLT_APPLY('DELETE FROM `t` WHERE `pk`=2;');",
+				ExpectedErrStr: "patch does not apply: statement changed no rows: DELETE FROM `t` WHERE `pk`=2;",
+			},
+			{
+				Query:          "CALL DOLT_APPLY('INSERT INTO `t` VALUES (2, ''b'');\nINSERT INTO `t` VALUES (1, ''a'');');",
+				ExpectedErrStr: "patch does not apply: duplicate primary key given: [1]",
+			},
+			{
+				// a failed patch doesn't leave any of its statements applied
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "a"}},
+			},
+			{
+				Query:          "CALL DOLT_APPLY('# From: abc\n# Author: nobody\n#\n#     message\n#\n# ---\n');",
+				ExpectedErrStr: "invalid patch header for abc: author must be in the format 'Name <email>'",
+			},
+		},
+	},
+	{
+		Name: "dolt_am: commits patches with their original author and message",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET v = 'main' WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'change main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET v = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			setPatchFromBranch("UPDATE `t` SET `v`=''feature'' WHERE `pk`=1;\\n"),
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_AM(@patch);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "feature"}, {2, "main"}},
+			},
+			{
+				Query: "SELECT author, author_email, committer, email, message FROM dolt_log LIMIT 2;",
+				Expected: []sql.Row{
+					{"Jane Doe", "jane@doe.com", "root", "root@localhost", "update from feature"},
+					{"root", "root@localhost", "root", "root@localhost", "change main"},
+				},
+			},
+			{
+				Query:    "SELECT author_date FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{timeEquals("2022-01-01T12:00:00Z")}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_status;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "dolt_am: conflicts are recorded like a cherry-pick",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET v = 'main' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET v = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			setPatchFromBranch("UPDATE `t` SET `v`=''feature'' WHERE `pk`=1;\\n"),
+			"SET @@dolt_allow_commit_conflicts = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_AM(@patch);",
+				Expected: []sql.Row{{nil, 1, 0, 0}},
+			},
+			{
+				Query:    "SELECT our_pk, our_v, their_pk, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{1, "main", 1, "feature"}},
+			},
+			{
+				Query:    "SELECT is_merging, unmerged_tables FROM dolt_merge_status;",
+				Expected: []sql.Row{{true, "t"}},
+			},
+			{
+				Query:    "CALL DOLT_CONFLICTS_RESOLVE('--theirs', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_ADD('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_CHERRY_PICK('--continue');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "feature"}},
+			},
+			{
+				Query:    "SELECT author, author_email, author_date, committer, message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"Jane Doe", "jane@doe.com", timeEquals("2022-01-01T12:00:00Z"), "root", "update from feature"}},
+			},
+			{
+				// the patch is committed as if it had applied cleanly, rather than as a merge
+				Query:    "SELECT COUNT(*) FROM dolt_commit_ancestors WHERE commit_hash = HASHOF('HEAD');",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "dolt_am: errors",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"CALL DOLT_COMMIT('--allow-empty', '-m', 'empty');",
+			"CALL DOLT_CHECKOUT('main');",
+			setPatchFromBranch(""),
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_AM('UPDATE `t` SET `v`=''x'' WHERE `pk`=1;');",
+				ExpectedErrStr: "cannot commit a patch without a header, use dolt_apply instead",
+			},
+			{
+				Query:          "CALL DOLT_AM(@patch);",
+				ExpectedErrStr: "patch 'update from feature' is empty",
+			},
+			{
+				Query:    "INSERT INTO t VALUES (2, 'b');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "CALL DOLT_AM(@patch);",
+				ExpectedErrStr: "cannot apply patches with uncommitted changes",
+			},
+		},
+	},
+}
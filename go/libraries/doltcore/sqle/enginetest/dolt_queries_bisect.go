@@ -0,0 +1,328 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"regexp"
+
+	"github.com/dolthub/go-mysql-server/enginetest"
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+)
+
+// bisectSetupScript creates a history of six commits on main, where the commit with the message "three" introduces
+// a negative value into table t, followed by a commit that adds a test that fails for any negative values.
+var bisectSetupScript = []string{
+	"CREATE TABLE t (pk int primary key, v int);",
+	"CALL DOLT_COMMIT('-Am', 'create t');",
+	"INSERT INTO t VALUES (1, 1);",
+	"CALL DOLT_COMMIT('-am', 'one');",
+	"INSERT INTO t VALUES (2, 2);",
+	"CALL DOLT_COMMIT('-am', 'two');",
+	"INSERT INTO t VALUES (3, -3);",
+	"CALL DOLT_COMMIT('-am', 'three');",
+	"INSERT INTO t VALUES (4, 4);",
+	"CALL DOLT_COMMIT('-am', 'four');",
+	"INSERT INTO t VALUES (5, 5);",
+	"CALL DOLT_COMMIT('-am', 'five');",
+	"INSERT INTO dolt_tests VALUES ('no negatives', 'checks', 'SELECT COUNT(*) FROM t WHERE v < 0', 'expected_single_value', '==', '0');",
+	"CALL DOLT_COMMIT('-Am', 'add tests');",
+}
+
+// bisectSkippedMessageValidator validates the message of a dolt_bisect('run') call which skipped every commit it tested
+type bisectSkippedMessageValidator struct{}
+
+var _ enginetest.CustomValueValidator = &bisectSkippedMessageValidator{}
+var bisectSkippedRegex = regexp.MustCompile(`^([0-9a-v]{32}: skip\n)+There are only 'skip'ped commits left to test\.`)
+
+func (bsmv *bisectSkippedMessageValidator) Validate(val interface{}) (bool, error) {
+	message, ok := val.(string)
+	if !ok {
+		return false, nil
+	}
+	return bisectSkippedRegex.MatchString(message), nil
+}
+
+var bisectSkippedMessage = &bisectSkippedMessageValidator{}
+
+var DoltBisectScriptTests = []queries.ScriptTest{
+	{
+		Name:        "dolt_bisect: errors",
+		SetUpScript: bisectSetupScript,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_BISECT();",
+				ExpectedErrStr: "error: missing subcommand; expected one of start, bad, good, skip, run, or reset",
+			},
+			{
+				Query:          "CALL DOLT_BISECT('frobnicate');",
+				ExpectedErrStr: "error: unknown bisect subcommand 'frobnicate'",
+			},
+			{
+				Query:          "CALL DOLT_BISECT('bad');",
+				ExpectedErrStr: dprocedures.ErrNoBisectInProgress.Error(),
+			},
+			{
+				Query:          "CALL DOLT_BISECT('reset');",
+				ExpectedErrStr: dprocedures.ErrNoBisectInProgress.Error(),
+			},
+			{
+				Query:    "INSERT INTO t VALUES (6, 6);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "CALL DOLT_BISECT('start');",
+				ExpectedErrStr: "cannot start a bisect with uncommitted changes",
+			},
+			{
+				Query:            "CALL DOLT_RESET('--hard');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "CALL DOLT_BISECT('start');",
+				Expected: []sql.Row{{0, "status: waiting for both good and bad commits"}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"dolt_bisect_main"}},
+			},
+			{
+				Query:          "CALL DOLT_BISECT('start');",
+				ExpectedErrStr: "a bisect is already in progress; use dolt_bisect('reset') to end it before starting a new one",
+			},
+			{
+				Query:          "CALL DOLT_BISECT('run', 'checks');",
+				ExpectedErrStr: "dolt_bisect('run') requires a bad commit and at least one good commit; status: waiting for both good and bad commits",
+			},
+			{
+				Query:          "CALL DOLT_BISECT('bad', 'HEAD', 'HEAD~1');",
+				ExpectedErrStr: "error: only one commit can be marked as bad",
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"main"}},
+			},
+			{
+				Query:    "SELECT name FROM dolt_branches;",
+				Expected: []sql.Row{{"main"}},
+			},
+		},
+	},
+	{
+		Name:        "dolt_bisect: marking commits good and bad",
+		SetUpScript: bisectSetupScript,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_BISECT('start');",
+				Expected: []sql.Row{{0, "status: waiting for both good and bad commits"}},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('bad');",
+				Expected: []sql.Row{{0, "status: waiting for good commit(s), bad commit known"}},
+			},
+			{
+				Query:            "CALL DOLT_BISECT('good', 'HEAD~6');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"three"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, -3}},
+			},
+			{
+				Query:            "CALL DOLT_BISECT('bad');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"two"}},
+			},
+			{
+				Query:            "CALL DOLT_BISECT('good');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"three"}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"dolt_bisect_main"}},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"main"}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"add tests"}},
+			},
+		},
+	},
+	{
+		Name:        "dolt_bisect: start with bad and good commits, then skip",
+		SetUpScript: bisectSetupScript,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "CALL DOLT_BISECT('start', 'HEAD', 'HEAD~6');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"three"}},
+			},
+			{
+				Query:            "CALL DOLT_BISECT('skip');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"four"}},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+		},
+	},
+	{
+		Name:        "dolt_bisect: run finds the first bad commit with dolt_tests",
+		SetUpScript: bisectSetupScript,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "CALL DOLT_BISECT('start', 'HEAD', 'HEAD~6');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL DOLT_BISECT('run', 'checks');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"three"}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"dolt_bisect_main"}},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"main"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM t;",
+				Expected: []sql.Row{{5}},
+			},
+		},
+	},
+	{
+		Name:        "dolt_bisect: run uses the tests from the starting branch",
+		SetUpScript: bisectSetupScript,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// None of the commits in the search range have a dolt_tests table, so the tests have to come from main
+				Query:            "CALL DOLT_BISECT('start', 'HEAD~1', 'HEAD~6');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL DOLT_BISECT('run', 'checks');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"three"}},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+		},
+	},
+	{
+		Name:        "dolt_bisect: run returns errors running the tests",
+		SetUpScript: bisectSetupScript,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "CALL DOLT_BISECT('start', 'HEAD', 'HEAD~6');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "SET @@autocommit = 0;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:          "CALL DOLT_BISECT('run', 'chekcs');",
+				ExpectedErrStr: "could not find tests for argument: chekcs",
+			},
+			{
+				// the tests of the starting branch aren't left in the commit being tested
+				Query:    "SELECT * FROM dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+		},
+	},
+	{
+		Name: "dolt_bisect: run skips commits where the tests can't run",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"INSERT INTO t VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-am', 'one');",
+			"INSERT INTO t VALUES (2, -2);",
+			"CALL DOLT_COMMIT('-am', 'two');",
+			"CREATE TABLE allowed (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create allowed');",
+			"INSERT INTO dolt_tests VALUES ('no negatives', 'checks', 'SELECT COUNT(*) FROM t WHERE v < 0 AND pk NOT IN (SELECT pk FROM allowed)', 'expected_single_value', '==', '0');",
+			"CALL DOLT_COMMIT('-Am', 'add tests');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// The commits before the allowed table was created can't run the test, so they can only be skipped
+				Query:            "CALL DOLT_BISECT('start', 'HEAD~1', 'HEAD~4');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "CALL DOLT_BISECT('run', 'checks');",
+				Expected: []sql.Row{{0, bisectSkippedMessage}},
+			},
+			{
+				Query:    "CALL DOLT_BISECT('reset');",
+				Expected: []sql.Row{{0, dprocedures.BisectResetMessage}},
+			},
+		},
+	},
+}
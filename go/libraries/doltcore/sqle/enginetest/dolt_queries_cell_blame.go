@@ -0,0 +1,179 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var CellBlameSystemTableScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_cell_blame: each cell is blamed on the last commit that changed it",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a varchar(20), b int);",
+			"INSERT INTO t VALUES (1, 'a', 1), (2, 'b', 2);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"UPDATE t SET a = 'a2' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update a', '--author', 'Jane Doe <jane@doe.com>');",
+			"SET @update = hashof('HEAD');",
+			"UPDATE t SET b = 20 WHERE pk = 2;",
+			"INSERT INTO t VALUES (3, 'c', 3);",
+			"CALL DOLT_COMMIT('-am', 'update b and insert three');",
+			"UPDATE t SET b = 2 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'revert b');",
+			"UPDATE t SET a = 'uncommitted' WHERE pk = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT pk, column_name, message FROM dolt_cell_blame_t;",
+				Expected: []sql.Row{
+					{1, "pk", "create t"},
+					{1, "a", "update a"},
+					{1, "b", "create t"},
+					{2, "pk", "create t"},
+					{2, "a", "create t"},
+					{2, "b", "revert b"},
+					{3, "pk", "update b and insert three"},
+					{3, "a", "update b and insert three"},
+					{3, "b", "update b and insert three"},
+				},
+			},
+			{
+				Query:    "SELECT commit = @update, author, author_email FROM dolt_cell_blame_T WHERE pk = 1 AND column_name = 'a';",
+				Expected: []sql.Row{{true, "Jane Doe", "jane@doe.com"}},
+			},
+			{
+				Query: "SELECT pk, column_name, message FROM dolt_cell_blame_t AS OF 'HEAD~2' WHERE column_name = 'b';",
+				Expected: []sql.Row{
+					{1, "b", "create t"},
+					{2, "b", "create t"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_cell_blame: schema changes",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, name varchar(20), age int);",
+			"INSERT INTO t VALUES (1, 'alice', 30), (2, 'bob', 40);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"ALTER TABLE t RENAME COLUMN name TO full_name;",
+			"CALL DOLT_COMMIT('-am', 'rename name');",
+			"ALTER TABLE t ADD COLUMN email varchar(50);",
+			"CALL DOLT_COMMIT('-am', 'add email');",
+			"UPDATE t SET email = 'alice@example.com', age = 31 WHERE id = 1;",
+			"CALL DOLT_COMMIT('-am', 'update alice');",
+			"ALTER TABLE t MODIFY COLUMN id bigint;",
+			"CALL DOLT_COMMIT('-am', 'widen key');",
+			"UPDATE t SET full_name = 'robert' WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'rename bob');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// columns are followed through renames by tag, and a new column is blamed on the commit that added it
+				Query: "SELECT id, column_name, message FROM dolt_cell_blame_t;",
+				Expected: []sql.Row{
+					{int64(1), "id", "create t"},
+					{int64(1), "full_name", "create t"},
+					{int64(1), "age", "update alice"},
+					{int64(1), "email", "update alice"},
+					{int64(2), "id", "create t"},
+					{int64(2), "full_name", "rename bob"},
+					{int64(2), "age", "create t"},
+					{int64(2), "email", "add email"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_cell_blame: merges",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a varchar(20), b varchar(20));",
+			"INSERT INTO t VALUES (1, 'a', 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET a = 'main' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change a on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET b = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change b on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			"CALL DOLT_MERGE('feature', '--no-ff', '-m', 'merge feature');",
+			"INSERT INTO t VALUES (2, 'x', 'y');",
+			"CALL DOLT_COMMIT('-am', 'insert two');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// cells changed on either side of a merge are blamed on the commits that changed them
+				Query: "SELECT pk, column_name, message FROM dolt_cell_blame_t;",
+				Expected: []sql.Row{
+					{1, "pk", "create t"},
+					{1, "a", "change a on main"},
+					{1, "b", "change b on feature"},
+					{2, "pk", "insert two"},
+					{2, "a", "insert two"},
+					{2, "b", "insert two"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_cell_blame: primary key changes",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int, b int, v varchar(20), primary key (a));",
+			"INSERT INTO t VALUES (1, 10, 'x');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"UPDATE t SET v = 'x2' WHERE a = 1;",
+			"CALL DOLT_COMMIT('-am', 'update x');",
+			"ALTER TABLE t DROP PRIMARY KEY;",
+			"ALTER TABLE t ADD PRIMARY KEY (b, a);",
+			"CALL DOLT_COMMIT('-am', 'change primary key');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT b, a, column_name, message FROM dolt_cell_blame_t;",
+				Expected: []sql.Row{
+					{10, 1, "a", "create t"},
+					{10, 1, "b", "create t"},
+					{10, 1, "v", "update x"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_cell_blame: errors",
+		SetUpScript: []string{
+			"CREATE TABLE keyless (v int);",
+			"CREATE TABLE uncommitted (pk int primary key);",
+			"CALL DOLT_ADD('keyless');",
+			"CALL DOLT_COMMIT('-m', 'create keyless');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "SELECT * FROM dolt_cell_blame_keyless;",
+				ExpectedErrStr: "unable to generate cell blame for table without primary key",
+			},
+			{
+				Query:       "SELECT * FROM dolt_cell_blame_uncommitted;",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:       "SELECT * FROM dolt_cell_blame_doesnotexist;",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+}
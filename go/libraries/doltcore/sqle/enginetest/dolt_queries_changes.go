@@ -0,0 +1,140 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
+)
+
+var ChangesTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_changes: row events since a commit",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"SET @c0 = (SELECT hashof('main'));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-am', 'insert rows');",
+			"SET @c1 = (SELECT hashof('main'));",
+			"UPDATE t SET v = 'a2' WHERE pk = 1;",
+			"DELETE FROM t WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'update and delete');",
+			"SET @c2 = (SELECT hashof('main'));",
+			"INSERT INTO t VALUES (3, 'uncommitted');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT commit_hash = @c1, table_name, op, `before`, `after` FROM dolt_changes('main', @c0);",
+				Expected: []sql.Row{
+					{true, "t", dtablefunctions.ChangeOpCreate, nil, types.MustJSON(`{"pk": 1, "v": "a"}`)},
+					{true, "t", dtablefunctions.ChangeOpCreate, nil, types.MustJSON(`{"pk": 2, "v": "b"}`)},
+					{false, "t", dtablefunctions.ChangeOpUpdate, types.MustJSON(`{"pk": 1, "v": "a"}`), types.MustJSON(`{"pk": 1, "v": "a2"}`)},
+					{false, "t", dtablefunctions.ChangeOpDelete, types.MustJSON(`{"pk": 2, "v": "b"}`), nil},
+				},
+			},
+			{
+				Query: "SELECT commit_hash = @c2, op FROM dolt_changes('main', @c1);",
+				Expected: []sql.Row{
+					{true, dtablefunctions.ChangeOpUpdate},
+					{true, dtablefunctions.ChangeOpDelete},
+				},
+			},
+			{
+				// a consumer that has seen the head has nothing left to read
+				Query:    "SELECT * FROM dolt_changes('main', @c2);",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_changes('main', @c2, 0.2);",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_changes('main', NULL);",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_changes('main', '');",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_changes('main', 'HEAD~2');",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_changes('main', @c0) WHERE committer = (SELECT committer FROM dolt_log LIMIT 1);",
+				Expected: []sql.Row{{4}},
+			},
+		},
+	},
+	{
+		Name: "dolt_changes: keyless tables, schema changes and branches",
+		SetUpScript: []string{
+			"CREATE TABLE k (v int);",
+			"CALL DOLT_COMMIT('-Am', 'create k');",
+			"SET @c0 = (SELECT hashof('main'));",
+			"INSERT INTO k VALUES (1), (1), (2);",
+			"CALL DOLT_COMMIT('-am', 'insert duplicates');",
+			"DELETE FROM k WHERE v = 1;",
+			"CALL DOLT_COMMIT('-am', 'delete duplicates');",
+			"SET @c2 = (SELECT hashof('main'));",
+			"CALL DOLT_BRANCH('other');",
+			"CREATE TABLE p (pk int primary key);",
+			"INSERT INTO p VALUES (1);",
+			"CALL DOLT_COMMIT('-Am', 'create p');",
+			"ALTER TABLE p ADD COLUMN c int DEFAULT 7;",
+			"CALL DOLT_COMMIT('-am', 'add column');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT table_name, op, `before`, `after` FROM dolt_changes('main', @c0) WHERE table_name = 'k';",
+				Expected: []sql.Row{
+					{"k", dtablefunctions.ChangeOpCreate, nil, types.MustJSON(`{"v": 1}`)},
+					{"k", dtablefunctions.ChangeOpCreate, nil, types.MustJSON(`{"v": 1}`)},
+					{"k", dtablefunctions.ChangeOpCreate, nil, types.MustJSON(`{"v": 2}`)},
+					{"k", dtablefunctions.ChangeOpDelete, types.MustJSON(`{"v": 1}`), nil},
+					{"k", dtablefunctions.ChangeOpDelete, types.MustJSON(`{"v": 1}`), nil},
+				},
+			},
+			{
+				// rewriting a table's rows for a new schema is reported as a change of each row
+				Query: "SELECT table_name, op, `before`, `after` FROM dolt_changes('main', @c2);",
+				Expected: []sql.Row{
+					{"p", dtablefunctions.ChangeOpCreate, nil, types.MustJSON(`{"pk": 1}`)},
+					{"p", dtablefunctions.ChangeOpUpdate, types.MustJSON(`{"pk": 1}`), types.MustJSON(`{"pk": 1, "c": 7}`)},
+				},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_changes('other', @c2);",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "SELECT * FROM dolt_changes('other', hashof('main'));",
+				ExpectedErr: dtablefunctions.ErrNotInFirstParentHistory,
+			},
+			{
+				Query:       "SELECT * FROM dolt_changes('main');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:          "SELECT * FROM dolt_changes('nosuchbranch', NULL);",
+				ExpectedErrStr: "branch not found",
+			},
+		},
+	},
+}
@@ -692,4 +692,80 @@ var DoltCommitVerificationScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "commit verification runs change assertions against the staged changes",
+		SetUpScript: []string{
+			"SET GLOBAL dolt_commit_verification_groups = '*'",
+			"CREATE TABLE users (id INT PRIMARY KEY, name VARCHAR(100) NOT NULL)",
+			"INSERT INTO users VALUES (1, 'Alice'), (2, 'Bob')",
+			"INSERT INTO dolt_tests (test_name, test_group, test_query, assertion_type, assertion_comparator, assertion_value) VALUES " +
+				"('test_no_deletes', 'unit', 'SELECT COUNT(*) FROM dolt_diff(@dolt_change_from, @dolt_change_to, \"users\") WHERE diff_type = \"removed\"', 'expected_change_single_value', '==', '0')",
+			"CALL dolt_add('.')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL dolt_commit('-m', 'Add users')",
+				Expected: []sql.Row{{commitHash}},
+			},
+			{
+				Query:            "DELETE FROM users WHERE id = 2",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL dolt_add('.')",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:          "CALL dolt_commit('-m', 'Delete Bob')",
+				ExpectedErrStr: "commit verification failed: test_no_deletes (Assertion failed: expected_change_single_value equal to 0, got 1)",
+			},
+			{
+				Query:    "CALL dolt_commit('--skip-verification', '-m', 'Delete Bob')",
+				Expected: []sql.Row{{commitHash}},
+			},
+			{ // Test harness bleeds GLOBAL variable changes across tests, so reset after each test.
+				Query:            "SET GLOBAL dolt_commit_verification_groups = ''",
+				SkipResultsCheck: true,
+			},
+		},
+	},
+	{
+		Name: "fast-forward merge with test verification enabled - change assertions fail",
+		SetUpScript: []string{
+			"SET GLOBAL dolt_commit_verification_groups = '*'",
+			"CREATE TABLE users (id INT PRIMARY KEY, name VARCHAR(100) NOT NULL)",
+			"INSERT INTO users VALUES (1, 'Alice'), (2, 'Bob')",
+			"INSERT INTO dolt_tests (test_name, test_group, test_query, assertion_type, assertion_comparator, assertion_value) VALUES " +
+				"('test_no_deletes', 'unit', 'SELECT COUNT(*) FROM dolt_diff(@dolt_change_from, @dolt_change_to, \"users\") WHERE diff_type = \"removed\"', 'expected_change_single_value', '==', '0')",
+			"CALL dolt_add('.')",
+			"CALL dolt_commit('-m', 'Initial commit')",
+			"CALL dolt_checkout('-b', 'feature')",
+			"DELETE FROM users WHERE id = 2",
+			"CALL dolt_add('.')",
+			"CALL dolt_commit('--skip-verification', '-m', 'Delete Bob')",
+			"CALL dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL dolt_merge('feature')",
+				Expected: []sql.Row{{"", int64(1), int64(0), "commit verification failed: test_no_deletes (Assertion failed: expected_change_single_value equal to 0, got 1)"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM users",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "CALL dolt_merge('--skip-verification', 'feature')",
+				Expected: []sql.Row{{commitHash, int64(1), int64(0), "merge successful"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM users",
+				Expected: []sql.Row{{1}},
+			},
+			{ // Test harness bleeds GLOBAL variable changes across tests, so reset after each test.
+				Query:            "SET GLOBAL dolt_commit_verification_groups = ''",
+				SkipResultsCheck: true,
+			},
+		},
+	},
 }
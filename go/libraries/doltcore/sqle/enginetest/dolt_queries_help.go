@@ -59,6 +59,11 @@ var DoltHelpScripts = []queries.ScriptTest{
 			{"dolt_gc"},
 			{"dolt_stash"},
 			{"dolt_rebase"},
+			{"dolt_bisect"},
+			{"dolt_notes"},
+			{"dolt_rerere"},
+			{"dolt_apply"},
+			{"dolt_am"},
 			{"dolt_rm"},
 		},
 	},
@@ -0,0 +1,197 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var IgnoreColumnsScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_ignore_columns: changes to ignored columns don't show up in diffs",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, val varchar(20), updated_at int, etag int);",
+			"INSERT INTO t VALUES (1, 'one', 100, 1), (2, 'two', 100, 1), (3, 'three', 100, 1);",
+			"INSERT INTO dolt_ignore_columns VALUES ('t', 'updated_at'), ('%', 'etag');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"UPDATE t SET updated_at = 200, etag = 2;",
+			"UPDATE t SET val = 'TWO', updated_at = 300 WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'touch rows');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT to_id, to_val, from_val, diff_type FROM dolt_diff_t WHERE to_commit = HASHOF('HEAD');",
+				Expected: []sql.Row{{2, "TWO", "two", "modified"}},
+			},
+			{
+				Query:    "SELECT to_id, to_updated_at, from_updated_at, diff_type FROM dolt_diff('HEAD~', 'HEAD', 't');",
+				Expected: []sql.Row{{2, 300, 100, "modified"}},
+			},
+			{
+				Query:    "SELECT table_name, rows_unmodified, rows_added, rows_deleted, rows_modified FROM dolt_diff_stat('HEAD~', 'HEAD');",
+				Expected: []sql.Row{{"t", 2, 0, 0, 1}},
+			},
+			{
+				Query:    "SELECT to_table_name, data_change, schema_change FROM dolt_diff_summary('HEAD~', 'HEAD');",
+				Expected: []sql.Row{{"t", true, false}},
+			},
+		},
+	},
+	{
+		Name: "dolt_ignore_columns: tables with only ignored changes have no diff",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, val varchar(20), updated_at int);",
+			"INSERT INTO t VALUES (1, 'one', 100), (2, 'two', 100);",
+			"INSERT INTO dolt_ignore_columns VALUES ('t', 'updated_at');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"UPDATE t SET updated_at = 200;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM dolt_diff_summary('HEAD', 'WORKING');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM dolt_diff_stat('HEAD', 'WORKING', 't');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM dolt_diff('HEAD', 'WORKING', 't');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_diff_t WHERE to_commit = 'WORKING';",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:            "CALL DOLT_ADD('t');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT * FROM dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:            "UPDATE t SET val = 'ONE' WHERE id = 1;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT table_name, staged, status FROM dolt_status;",
+				Expected: []sql.Row{{"t", byte(0), "modified"}},
+			},
+			{
+				Query:            "CALL DOLT_ADD('t');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT table_name, staged, status FROM dolt_status;",
+				Expected: []sql.Row{{"t", byte(1), "modified"}},
+			},
+			{
+				Query:    "SELECT to_id, from_val, to_val FROM dolt_diff_t WHERE to_commit = 'WORKING';",
+				Expected: []sql.Row{{1, "one", "ONE"}},
+			},
+			{
+				Query:            "DELETE FROM dolt_ignore_columns;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT to_id FROM dolt_diff_t WHERE to_commit = 'WORKING' ORDER BY to_id;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+		},
+	},
+	{
+		Name: "dolt_ignore_columns: primary key columns are never ignored",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, val int);",
+			"INSERT INTO t VALUES (1, 1);",
+			"INSERT INTO dolt_ignore_columns VALUES ('t', '%');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"UPDATE t SET val = 2;",
+			"INSERT INTO t VALUES (2, 2);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT to_id, diff_type FROM dolt_diff_t WHERE to_commit = 'WORKING';",
+				Expected: []sql.Row{{2, "added"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_ignore_columns: concurrent changes to ignored columns don't conflict",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, val varchar(20), updated_at int);",
+			"INSERT INTO t VALUES (1, 'one', 100), (2, 'two', 100);",
+			"INSERT INTO dolt_ignore_columns VALUES ('t', 'updated_at');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('other');",
+			"UPDATE t SET updated_at = 200 WHERE id = 1;",
+			"UPDATE t SET val = 'TWO', updated_at = 200 WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'update on main');",
+			"CALL DOLT_CHECKOUT('other');",
+			"UPDATE t SET val = 'ONE', updated_at = 300 WHERE id = 1;",
+			"UPDATE t SET updated_at = 300 WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'update on other');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_preview_merge_conflicts_summary('main', 'other');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY id;",
+				Expected: []sql.Row{{1, "ONE", 200}, {2, "TWO", 200}},
+			},
+		},
+	},
+	{
+		Name: "dolt_ignore_columns: concurrent changes to other columns still conflict",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, val varchar(20), updated_at int);",
+			"INSERT INTO t VALUES (1, 'one', 100);",
+			"INSERT INTO dolt_ignore_columns VALUES ('t', 'updated_at');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('other');",
+			"UPDATE t SET val = 'uno', updated_at = 200;",
+			"CALL DOLT_COMMIT('-am', 'update on main');",
+			"CALL DOLT_CHECKOUT('other');",
+			"UPDATE t SET val = 'ein', updated_at = 300;",
+			"CALL DOLT_COMMIT('-am', 'update on other');",
+			"CALL DOLT_CHECKOUT('main');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT our_val, their_val FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{"uno", "ein"}},
+			},
+		},
+	},
+}
@@ -4947,7 +4947,7 @@ var SchemaConflictScripts = []queries.ScriptTest{
 					"CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `c0` varchar(20),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin;",
 					"CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `c0` datetime(6),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin;",
 					"CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `c0` int,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin;",
-					"different column definitions for our column c0 and their column c0",
+					"different column definitions for our column c0 and their column c0: type changed from varchar(20) to datetime(6) on our branch and to int on their branch",
 				}},
 			},
 			{
@@ -5732,7 +5732,7 @@ var PreviewMergeConflictsFunctionScripts = []queries.ScriptTest{
 		},
 	},
 	{
-		Name: "column type conflicts",
+		Name: "compatible column type changes",
 		SetUpScript: []string{
 			"create table t (pk int primary key, c1 varchar(20));",
 			"insert into t values (1, 'one');",
@@ -5751,6 +5751,41 @@ var PreviewMergeConflictsFunctionScripts = []queries.ScriptTest{
 			"call dolt_checkout('main')",
 			"call dolt_merge('branch1')",
 		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * from dolt_preview_merge_conflicts_summary('main', 'branch1')",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * from dolt_preview_merge_conflicts_summary('main', 'branch2')",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * from dolt_preview_merge_conflicts_summary('branch1', 'branch2')",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "column type conflicts",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 varchar(20));",
+			"insert into t values (1, 'one');",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'initial commit');",
+
+			"call dolt_branch('branch1')",
+			"call dolt_checkout('-b', 'branch2')",
+			"alter table t modify column c1 varchar(10);",
+			"call dolt_commit('-am', 'change column to varchar(10) on branch2');",
+
+			"call dolt_checkout('branch1')",
+			"alter table t modify column c1 text;",
+			"call dolt_commit('-am', 'change column to text on branch1');",
+
+			"call dolt_checkout('main')",
+			"call dolt_merge('branch1')",
+		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
 				Query:    "SELECT * from dolt_preview_merge_conflicts_summary('main', 'branch1')",
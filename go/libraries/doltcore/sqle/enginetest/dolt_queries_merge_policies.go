@@ -0,0 +1,275 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+)
+
+var MergePoliciesScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_merge_policies: cell-wise policies resolve concurrent updates",
+		SetUpScript: []string{
+			"CREATE TABLE inventory (id int primary key, qty int, price decimal(10,2), name varchar(50), note varchar(20), lo int);",
+			"INSERT INTO inventory VALUES (1, 10, 5.00, 'widget', 'base', 50);",
+			"INSERT INTO dolt_merge_policies VALUES " +
+				"('inventory', 'qty', 'sum_deltas', NULL), " +
+				"('inventory', 'price', 'max', NULL), " +
+				"('inventory', 'name', 'expression', 'concat(ours, \\'|\\', theirs)'), " +
+				"('inventory', 'note', 'prefer_theirs', NULL), " +
+				"('inventory', 'lo', 'min', NULL);",
+			"CALL DOLT_COMMIT('-Am', 'create inventory');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE inventory SET qty = 12, price = 6.00, name = 'widget-a', note = 'ours', lo = 40 WHERE id = 1;",
+			"CALL DOLT_COMMIT('-am', 'update on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE inventory SET qty = 7, price = 5.50, name = 'widget-b', note = 'theirs', lo = 45 WHERE id = 1;",
+			"CALL DOLT_COMMIT('-am', 'update on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_preview_merge_conflicts_summary('main', 'feature');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM inventory;",
+				Expected: []sql.Row{{1, 9, decimal.RequireFromString("6.00"), "widget-a|widget-b", "theirs", 40}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: latest_timestamp",
+		SetUpScript: []string{
+			"CREATE TABLE orders (id int primary key, status varchar(20), updated_at datetime);",
+			"INSERT INTO orders VALUES (1, 'new', '2024-01-01'), (2, 'new', '2024-01-01');",
+			"INSERT INTO dolt_merge_policies VALUES " +
+				"('orders', 'status', 'latest_timestamp', 'updated_at'), " +
+				"('orders', 'updated_at', 'latest_timestamp', NULL);",
+			"CALL DOLT_COMMIT('-Am', 'create orders');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE orders SET status = 'shipped', updated_at = '2024-01-02' WHERE id = 1;",
+			"UPDATE orders SET status = 'shipped', updated_at = '2024-01-05' WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'ship orders');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE orders SET status = 'cancelled', updated_at = '2024-01-03' WHERE id = 1;",
+			"UPDATE orders SET status = 'cancelled', updated_at = '2024-01-04' WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'cancel orders');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query: "SELECT * FROM orders;",
+				Expected: []sql.Row{
+					{1, "cancelled", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+					{2, "shipped", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: equal timestamps are a conflict",
+		SetUpScript: []string{
+			"CREATE TABLE orders (id int primary key, status varchar(20), updated_at datetime);",
+			"INSERT INTO orders VALUES (1, 'new', '2024-01-01');",
+			"INSERT INTO dolt_merge_policies VALUES ('orders', 'status', 'latest_timestamp', 'updated_at');",
+			"CALL DOLT_COMMIT('-Am', 'create orders');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE orders SET status = 'shipped', updated_at = '2024-01-02' WHERE id = 1;",
+			"CALL DOLT_COMMIT('-am', 'ship order');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE orders SET status = 'cancelled', updated_at = '2024-01-02' WHERE id = 1;",
+			"CALL DOLT_COMMIT('-am', 'cancel order');",
+			"CALL DOLT_CHECKOUT('main');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT our_status, their_status FROM dolt_conflicts_orders;",
+				Expected: []sql.Row{{"shipped", "cancelled"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: wildcard policies and conflicting inserts",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b int, c int as (a + 1));",
+			"INSERT INTO t (pk, a, b) VALUES (1, 1, 1);",
+			"INSERT INTO dolt_merge_policies VALUES ('T', '*', 'prefer_ours', NULL), ('t', 'B', 'prefer_theirs', NULL);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET a = 10, b = 10 WHERE pk = 1;",
+			"INSERT INTO t (pk, a, b) VALUES (2, 10, 10);",
+			"CALL DOLT_COMMIT('-am', 'changes on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET a = 20, b = 20 WHERE pk = 1;",
+			"INSERT INTO t (pk, a, b) VALUES (2, 20, 20);",
+			"CALL DOLT_COMMIT('-am', 'changes on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, 10, 20, 11}, {2, 10, 20, 11}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: unresolvable changes are still conflicts",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, counter tinyint, v int);",
+			"CREATE TABLE other (pk int primary key, v int);",
+			"INSERT INTO t VALUES (1, 100, 1), (2, 1, 1);",
+			"INSERT INTO other VALUES (1, 1);",
+			"INSERT INTO dolt_merge_policies VALUES ('t', 'counter', 'sum_deltas', NULL), ('t', 'doesnotexist', 'max', NULL);",
+			"CALL DOLT_COMMIT('-Am', 'create tables');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET counter = 120 WHERE pk = 1;",
+			"UPDATE t SET v = 2 WHERE pk = 2;",
+			"UPDATE other SET v = 2;",
+			"CALL DOLT_COMMIT('-am', 'changes on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET counter = 110 WHERE pk = 1;",
+			"UPDATE t SET v = 3 WHERE pk = 2;",
+			"UPDATE other SET v = 3;",
+			"CALL DOLT_COMMIT('-am', 'changes on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				// counter would overflow, and v has no policy
+				Query:    "SELECT our_pk, our_counter, their_counter, our_v, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{1, 120, 110, 1, 1}, {2, 1, 1, 2, 3}},
+			},
+			{
+				Query:    "SELECT our_v, their_v FROM dolt_conflicts_other;",
+				Expected: []sql.Row{{2, 3}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: virtual columns before columns with policies",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int as (a + 1) virtual, a int, b varchar(20));",
+			"INSERT INTO t (pk, a, b) VALUES (1, 1, 'base');",
+			"INSERT INTO dolt_merge_policies VALUES ('t', 'a', 'max', NULL), ('t', 'b', 'prefer_theirs', NULL);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET a = 20, b = 'ours' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'changes on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET a = 10, b = 'theirs' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'changes on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, 21, 20, "theirs"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: expressions that fail are conflicts",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, doc varchar(50), n tinyint);",
+			"INSERT INTO t VALUES (1, '{}', 1), (2, '{}', 1);",
+			"INSERT INTO dolt_merge_policies VALUES " +
+				"('t', 'doc', 'expression', 'json_extract(ours, \\'$.a\\')'), " +
+				"('t', 'n', 'expression', 'ours + theirs');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET doc = 'not json' WHERE pk = 1;",
+			"UPDATE t SET n = 100 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'changes on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET doc = '{\"a\": 1}' WHERE pk = 1;",
+			"UPDATE t SET n = 90 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'changes on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('feature');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				// ours isn't valid JSON, and the sum of the counters is out of range
+				Query:    "SELECT our_pk, our_doc, their_doc, our_n, their_n FROM dolt_conflicts_t ORDER BY our_pk;",
+				Expected: []sql.Row{{1, "not json", `{"a": 1}`, 1, 1}, {2, "{}", "{}", 100, 90}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge_policies: policies are validated when written",
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_merge_policies;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "INSERT INTO dolt_merge_policies VALUES ('t', 'c', 'bogus', NULL);",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:       "INSERT INTO dolt_merge_policies VALUES ('t', 'c', 'expression', NULL);",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "INSERT INTO dolt_merge_policies VALUES ('t', 'c', 'max', NULL);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_merge_policies;",
+				Expected: []sql.Row{{"t", "c", "max", nil}},
+			},
+			{
+				Query:    "SELECT table_name, status FROM dolt_status;",
+				Expected: []sql.Row{{"dolt_merge_policies", "new table"}},
+			},
+		},
+	},
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var DoltNotesScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_notes: add, replace, and remove notes",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"SET @first = hashof('HEAD');",
+			"INSERT INTO t VALUES (1);",
+			"CALL DOLT_COMMIT('-am', 'insert 1');",
+			"SET @second = hashof('HEAD');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_notes;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_NOTES('add', '-m', 'signed off');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_NOTES('add', '--author', 'John Doe <john@doe.com>', '-m', 'ticket 123', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT commit_hash = @second, author, email, note FROM dolt_notes ORDER BY note;",
+				Expected: []sql.Row{{true, "root", "root@localhost", "signed off"}, {false, "John Doe", "john@doe.com", "ticket 123"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_notes WHERE commit_hash = @first AND note = 'ticket 123';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT hashof('HEAD') = @second;",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "CALL DOLT_NOTES('add', '-f', '-m', 'replaced');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT note FROM dolt_notes WHERE commit_hash = @second;",
+				Expected: []sql.Row{{"replaced"}},
+			},
+			{
+				Query:    "CALL DOLT_NOTES('remove', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_notes WHERE commit_hash = @first;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT note FROM dolt_notes;",
+				Expected: []sql.Row{{"replaced"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_notes: notes don't change commits",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"SET @head = hashof('HEAD');",
+			"CALL DOLT_NOTES('add', '-m', 'audited');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT hashof('HEAD') = @head;",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_status;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_log;",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "dolt_notes: notes column in dolt_log",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"INSERT INTO t VALUES (1);",
+			"CALL DOLT_COMMIT('-am', 'insert 1');",
+			"CALL DOLT_NOTES('add', '-m', 'checked', 'HEAD~1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT message, notes FROM dolt_log('--notes');",
+				Expected: []sql.Row{{"insert 1", nil}, {"create t", "checked"}, {"Initialize data repository", nil}},
+			},
+			{
+				Query:    "SELECT message, notes FROM dolt_log();",
+				Expected: []sql.Row{{"insert 1", nil}, {"create t", nil}, {"Initialize data repository", nil}},
+			},
+			{
+				Query:    "SELECT message, notes FROM dolt_log('HEAD~1', '--notes');",
+				Expected: []sql.Row{{"create t", "checked"}, {"Initialize data repository", nil}},
+			},
+			{
+				Query:    "SELECT message, notes FROM dolt_log ORDER BY commit_order DESC;",
+				Expected: []sql.Row{{"insert 1", nil}, {"create t", "checked"}, {"Initialize data repository", nil}},
+			},
+		},
+	},
+	{
+		Name: "dolt_notes: errors",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_NOTES();",
+				ExpectedErrStr: "error: missing subcommand, expected add or remove",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('frobnicate');",
+				ExpectedErrStr: "error: unknown notes subcommand 'frobnicate'",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('add');",
+				ExpectedErrStr: "error: a note message must be given with -m",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('add', '-m', 'note', 'HEAD', 'HEAD~1');",
+				ExpectedErrStr: "error: too many arguments, add takes at most one commit",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('add', '-m', 'note', 'nosuchbranch');",
+				ExpectedErrStr: "branch not found: nosuchbranch",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('remove', '-m', 'note');",
+				ExpectedErrStr: "error: remove does not take -m, -f, or --author",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('show');",
+				ExpectedErrStr: "error: invalid argument, use the 'dolt_notes' system table to read notes",
+			},
+		},
+	},
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// pullRequestSetUp creates a table |t| on main, and a branch feature which inserts a row into it.
+var pullRequestSetUp = []string{
+	"CREATE TABLE t (pk int primary key, v int);",
+	"INSERT INTO t VALUES (1, 1);",
+	"CALL DOLT_COMMIT('-Am', 'create t');",
+	"CALL DOLT_BRANCH('feature');",
+	"CALL DOLT_CHECKOUT('feature');",
+	"INSERT INTO t VALUES (2, 2);",
+	"CALL DOLT_COMMIT('-am', 'insert on feature');",
+	"CALL DOLT_CHECKOUT('main');",
+}
+
+var PullRequestScriptTests = []queries.ScriptTest{
+	{
+		Name:        "pull requests: open, comment and merge",
+		SetUpScript: pullRequestSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_pull_requests;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_PR_OPEN('-m', 'add row 2', '--description', 'adds a second row', '--reviewers', 'alice,bob', 'feature', 'main');",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query: "SELECT id, title, description, author, source_branch, target_branch, source_commit = hashof('feature'), reviewers, approvals, status, mergeable, merge_commit FROM dolt_pull_requests;",
+				Expected: []sql.Row{
+					{int64(1), "add row 2", "adds a second row", "root", "feature", "main", true, "alice,bob", int64(0), "open", true, nil},
+				},
+			},
+			{
+				Query:          "CALL DOLT_PR_OPEN('-m', 'again', 'feature', 'main');",
+				ExpectedErrStr: "error: pull request 1 from feature into main is already open",
+			},
+			{
+				Query:    "CALL DOLT_PR_COMMENT('1', '-m', 'looks good');",
+				Expected: []sql.Row{{int64(0)}},
+			},
+			{
+				Query:    "SELECT id, pull_request_id, reviewer, kind, body, `commit` = hashof('feature') FROM dolt_pull_request_reviews;",
+				Expected: []sql.Row{{int64(1), int64(1), "root", "comment", "looks good", true}},
+			},
+			{
+				Query:          "CALL DOLT_PR_APPROVE('1');",
+				ExpectedErrStr: "error: pull request 1 can't be approved by its author",
+			},
+			{
+				Query:            "CALL DOLT_PR_MERGE('1');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"Merge pull request #1 from feature into main"}},
+			},
+			{
+				Query:    "SELECT status, mergeable, merge_commit = hashof('main') FROM dolt_pull_requests;",
+				Expected: []sql.Row{{"merged", nil, true}},
+			},
+			{
+				Query:    "SELECT pull_request_id, activity, workflows FROM dolt_pull_request_events ORDER BY id;",
+				Expected: []sql.Row{{int64(1), "opened", nil}, {int64(1), "closed", nil}},
+			},
+			{
+				Query:          "CALL DOLT_PR_COMMENT('1', '-m', 'too late');",
+				ExpectedErrStr: "error: pull request 1 is merged",
+			},
+		},
+	},
+	{
+		Name:        "pull requests: invalid arguments",
+		SetUpScript: pullRequestSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_PR_OPEN('feature', 'main');",
+				ExpectedErrStr: "error: dolt_pr_open requires a title, specified with -m",
+			},
+			{
+				Query:          "CALL DOLT_PR_OPEN('-m', 'title', 'feature');",
+				ExpectedErrStr: "error: dolt_pr_open requires a source branch and a target branch",
+			},
+			{
+				Query:          "CALL DOLT_PR_OPEN('-m', 'title', 'main', 'main');",
+				ExpectedErrStr: "error: the source and target branches of a pull request must differ",
+			},
+			{
+				Query:          "CALL DOLT_PR_OPEN('-m', 'title', 'nope', 'main');",
+				ExpectedErrStr: "error: unable to resolve source branch 'nope': branch not found",
+			},
+			{
+				Query:          "CALL DOLT_PR_COMMENT('1', '-m', 'hello');",
+				ExpectedErrStr: "pull request not found: 1",
+			},
+			{
+				Query:          "CALL DOLT_PR_COMMENT('x', '-m', 'hello');",
+				ExpectedErrStr: "error: invalid pull request id 'x'",
+			},
+			{
+				Query:    "CALL DOLT_PR_OPEN('-m', 'title', 'feature', 'main');",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:          "CALL DOLT_PR_COMMENT('1');",
+				ExpectedErrStr: "error: dolt_pr_comment requires a comment, specified with -m",
+			},
+		},
+	},
+	{
+		Name:        "pull requests: protected branches require approvals",
+		SetUpScript: pullRequestSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO dolt_protected_branches VALUES ('main', 1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "INSERT INTO dolt_protected_branches VALUES ('other', -1);",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:            "CALL DOLT_COMMIT('-Am', 'protect main');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "CALL DOLT_PR_OPEN('-m', 'add row 2', 'feature', 'main');",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:          "CALL DOLT_PR_MERGE('1');",
+				ExpectedErrStr: "error: pull request 1 needs 1 approvals of its latest commit to merge into protected branch main, but has 0",
+			},
+			{
+				Query:    "SELECT status FROM dolt_pull_requests WHERE id = 1;",
+				Expected: []sql.Row{{"open"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:            "UPDATE dolt_protected_branches SET required_approvals = 0;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL DOLT_COMMIT('-am', 'unprotect main');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL DOLT_PR_MERGE('1');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT status FROM dolt_pull_requests WHERE id = 1;",
+				Expected: []sql.Row{{"merged"}},
+			},
+		},
+	},
+	{
+		Name: "pull requests: conflicting pull requests aren't merged",
+		SetUpScript: append(append([]string{}, pullRequestSetUp...),
+			"INSERT INTO t VALUES (2, 20);",
+			"CALL DOLT_COMMIT('-am', 'conflicting insert on main');",
+		),
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_PR_OPEN('-m', 'add row 2', 'feature', 'main');",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:          "CALL DOLT_PR_MERGE('1');",
+				ExpectedErrStr: "error: pull request 1 can't be merged, it has conflicts in tables: t",
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 1}, {2, 20}},
+			},
+			{
+				Query:    "SELECT status, mergeable FROM dolt_pull_requests;",
+				Expected: []sql.Row{{"open", false}},
+			},
+			{
+				Query:            "UPDATE t SET v = 2 WHERE pk = 2;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL DOLT_COMMIT('-am', 'resolve conflicting insert on main');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT status, mergeable FROM dolt_pull_requests;",
+				Expected: []sql.Row{{"open", true}},
+			},
+			{
+				Query:            "CALL DOLT_BRANCH('-D', 'feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT status, mergeable FROM dolt_pull_requests;",
+				Expected: []sql.Row{{"open", nil}},
+			},
+		},
+	},
+	{
+		Name:        "pull requests: new commits on the source branch synchronize the pull request",
+		SetUpScript: pullRequestSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_PR_OPEN('-m', 'add rows', 'feature', 'main');",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:            "CALL DOLT_CHECKOUT('feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "INSERT INTO t VALUES (3, 3);",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "CALL DOLT_COMMIT('-am', 'insert again on feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "CALL DOLT_PR_COMMENT('1', '-m', 'added another row');",
+				Expected: []sql.Row{{int64(0)}},
+			},
+			{
+				Query:    "SELECT source_commit = hashof('feature') FROM dolt_pull_requests;",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "SELECT activity, `commit` = hashof('feature') FROM dolt_pull_request_events ORDER BY id;",
+				Expected: []sql.Row{{"opened", false}, {"synchronized", true}},
+			},
+			{
+				Query:            "CALL DOLT_PR_MERGE('1');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT * FROM t AS OF 'main' ORDER BY pk;",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+}
@@ -143,4 +143,75 @@ var DoltQueryCatalogScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_query_run binds the parameters of saved queries",
+		SetUpScript: []string{
+			"CREATE TABLE orders (id int primary key, customer varchar(20), amount decimal(10,2), placed date)",
+			"INSERT INTO orders VALUES (1, 'ann', 10, '2024-01-01'), (2, 'bob', 25.5, '2024-02-01'), (3, 'ann', 40, '2024-03-01')",
+			"INSERT INTO dolt_query_catalog VALUES ('big orders', 1, 'big orders', 'SELECT id, customer FROM orders WHERE amount >= :min_amount AND placed >= :since ORDER BY id', '')",
+			"INSERT INTO dolt_query_catalog VALUES ('all orders', 2, 'all orders', 'SELECT count(*) AS n FROM orders', '')",
+			"INSERT INTO dolt_query_catalog VALUES ('delete orders', 3, 'delete orders', 'DELETE FROM orders', '')",
+			"INSERT INTO dolt_query_catalog VALUES ('echo', 4, 'echo', 'SELECT :s AS s', '')",
+			"INSERT INTO dolt_query_catalog_parameters VALUES ('big orders', 'min_amount', 1, 'decimal(10,2)', NULL), ('big orders', 'since', 2, 'date', '2024-01-15')",
+			"INSERT INTO dolt_query_catalog_parameters VALUES ('echo', 's', 1, 'varchar(20)', NULL)",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_query_run('big orders', 20)",
+				Expected: []sql.Row{{2, "bob"}, {3, "ann"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_query_run('big orders', '5', '2024-01-01')",
+				Expected: []sql.Row{{1, "ann"}, {2, "bob"}, {3, "ann"}},
+			},
+			{
+				Query:    "SELECT customer FROM dolt_query_run('big orders', '--since', '2024-02-15', '--min_amount', 0)",
+				Expected: []sql.Row{{"ann"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_query_run('echo', '--', '--s')",
+				Expected: []sql.Row{{"--s"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_query_run('echo', '--s', '--s')",
+				Expected: []sql.Row{{"--s"}},
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('echo', '--x')",
+				ExpectedErrStr: "unknown parameter x for saved query echo",
+			},
+			{
+				Query:    "SELECT * FROM dolt_query_run('all orders')",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('big orders')",
+				ExpectedErrStr: "missing value for parameter min_amount of saved query big orders",
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('big orders', '0 OR 1=1')",
+				ExpectedErrStr: "invalid value for parameter min_amount of saved query big orders: Truncated incorrect decimal(10,2) value: 0 OR 1=1",
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('big orders', '--customer', 'ann')",
+				ExpectedErrStr: "unknown parameter customer for saved query big orders",
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('big orders', 1, '2024-01-01', 2)",
+				ExpectedErrStr: "too many arguments for saved query big orders: expected at most 2",
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('no such query')",
+				ExpectedErrStr: "saved query no such query not found",
+			},
+			{
+				Query:          "SELECT * FROM dolt_query_run('delete orders')",
+				ExpectedErrStr: "saved query delete orders is not read-only",
+			},
+			{
+				Query:    "SELECT id, customer FROM orders WHERE id IN (SELECT id FROM dolt_query_run('big orders', 30))",
+				Expected: []sql.Row{{3, "ann"}},
+			},
+		},
+	},
 }
@@ -0,0 +1,350 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// rerereSetUp creates a table |t| with a conflicting update to the row with pk 1 on the branches main and other.
+var rerereSetUp = []string{
+	"SET @@dolt_rerere_enabled = 1;",
+	"CREATE TABLE t (pk int primary key, v int);",
+	"INSERT INTO t VALUES (1, 1), (2, 2);",
+	"CALL DOLT_COMMIT('-Am', 'create t');",
+	"CALL DOLT_BRANCH('other');",
+	"UPDATE t SET v = 10 WHERE pk = 1;",
+	"CALL DOLT_COMMIT('-am', 'update on main');",
+	"CALL DOLT_CHECKOUT('other');",
+	"UPDATE t SET v = 20 WHERE pk = 1;",
+	"CALL DOLT_COMMIT('-am', 'update on other');",
+	"CALL DOLT_CHECKOUT('main');",
+	"SET @@autocommit = 0;",
+}
+
+// rerereRecordSetUp extends rerereSetUp by resolving the conflict with v = 15, recording it, and resetting main to
+// before the merge.
+var rerereRecordSetUp = append(append([]string{}, rerereSetUp...),
+	"CALL DOLT_MERGE('other');",
+	"UPDATE t SET v = 15 WHERE pk = 1;",
+	"DELETE FROM dolt_conflicts_t;",
+	"CALL DOLT_COMMIT('-am', 'resolve conflict');",
+	"CALL DOLT_RESET('--hard', 'HEAD~1');",
+)
+
+var RerereScriptTests = []queries.ScriptTest{
+	{
+		Name:        "rerere: resolutions are recorded when the merge is committed",
+		SetUpScript: rerereSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "pending"}},
+			},
+			{
+				Query:            "UPDATE t SET v = 15 WHERE pk = 1;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "DELETE FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:            "CALL DOLT_COMMIT('-am', 'resolve conflict');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "resolved"}},
+			},
+			{
+				Query:    "CALL DOLT_RESET('--hard', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, 15}, {2, 2}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "resolved"}},
+			},
+		},
+	},
+	{
+		Name:        "rerere: recorded resolutions are only used when enabled",
+		SetUpScript: rerereRecordSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SET @@dolt_rerere_enabled = 0;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT our_v, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{10, 20}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "resolved"}},
+			},
+		},
+	},
+	{
+		Name: "rerere: only matching conflicts are resolved",
+		SetUpScript: append(append([]string{}, rerereRecordSetUp...),
+			"CALL DOLT_CHECKOUT('-b', 'other2', 'HEAD~1');",
+			"UPDATE t SET v = 20 WHERE pk = 1;",
+			"UPDATE t SET v = 21 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'update on other2');",
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET v = 11 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'update pk 2 on main');",
+		),
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('other2');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT our_pk, our_v, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{2, 11, 21}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, 15}, {2, 11}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "resolved"}, {"t", "2", "pending"}},
+			},
+		},
+	},
+	{
+		Name: "rerere: deleted rows are recorded",
+		SetUpScript: append(append([]string{}, rerereSetUp...),
+			"CALL DOLT_MERGE('other');",
+			"DELETE FROM t WHERE pk = 1;",
+			"DELETE FROM dolt_conflicts_t;",
+			"CALL DOLT_COMMIT('-am', 'resolve conflict');",
+			"CALL DOLT_RESET('--hard', 'HEAD~1');",
+		),
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{2, 2}},
+			},
+		},
+	},
+	{
+		// resolutions are stored as raw tuples, which garbage collection doesn't walk, so rows which refer to chunks
+		// aren't recorded
+		Name: "rerere: resolutions of rows with values stored out-of-band aren't recorded",
+		SetUpScript: []string{
+			"SET @@dolt_rerere_enabled = 1;",
+			"CREATE TABLE t (pk int primary key, v text);",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('other');",
+			"UPDATE t SET v = 'main' WHERE pk in (1, 2);",
+			"CALL DOLT_COMMIT('-am', 'update on main');",
+			"CALL DOLT_CHECKOUT('other');",
+			"UPDATE t SET v = 'other' WHERE pk in (1, 2);",
+			"CALL DOLT_COMMIT('-am', 'update on other');",
+			"CALL DOLT_CHECKOUT('main');",
+			"SET @@autocommit = 0;",
+			"CALL DOLT_MERGE('other');",
+			"UPDATE t SET v = 'short' WHERE pk = 1;",
+			"UPDATE t SET v = repeat('long', 10000) WHERE pk = 2;",
+			"DELETE FROM dolt_conflicts_t;",
+			"CALL DOLT_COMMIT('-am', 'resolve conflict');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "resolved"}},
+			},
+			{
+				Query:    "CALL DOLT_RESET('--hard', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT our_pk, our_v, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{2, "main", "other"}},
+			},
+			{
+				Query:    "SELECT v FROM t WHERE pk = 1;",
+				Expected: []sql.Row{{"short"}},
+			},
+		},
+	},
+	{
+		Name:        "rerere: conflicts of a merge that's rolled back aren't recorded",
+		SetUpScript: rerereSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "pending"}},
+			},
+			{
+				Query:    "ROLLBACK;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "rerere: resolutions which collide with a unique key are recorded as violations",
+		SetUpScript: []string{
+			"SET @@dolt_rerere_enabled = 1;",
+			"CREATE TABLE t (pk int primary key, v int, UNIQUE KEY (v));",
+			"INSERT INTO t VALUES (1, 1), (2, 2);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('other');",
+			"UPDATE t SET v = 10 WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update on main');",
+			"CALL DOLT_CHECKOUT('other');",
+			"UPDATE t SET v = 20 WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update on other');",
+			"CALL DOLT_CHECKOUT('main');",
+			"SET @@autocommit = 0;",
+			"CALL DOLT_MERGE('other');",
+			"UPDATE t SET v = 15 WHERE pk = 1;",
+			"DELETE FROM dolt_conflicts_t;",
+			"CALL DOLT_COMMIT('-am', 'resolve conflict');",
+			"CALL DOLT_RESET('--hard', 'HEAD~1');",
+			"INSERT INTO t VALUES (3, 15);",
+			"CALL DOLT_COMMIT('-am', 'add a row with v = 15');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{{"t", "1", "resolved"}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_conflicts_t;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 15}, {2, 2}, {3, 15}},
+			},
+			{
+				Query:    "SELECT violation_type, pk, v FROM dolt_constraint_violations_t ORDER BY pk;",
+				Expected: []sql.Row{{"unique index", 1, 15}, {"unique index", 3, 15}},
+			},
+		},
+	},
+	{
+		Name:        "rerere: recorded resolutions are used by cherry-pick",
+		SetUpScript: rerereRecordSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_CHERRY_PICK('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, 15}, {2, 2}},
+			},
+		},
+	},
+	{
+		Name:        "rerere: forget and clear",
+		SetUpScript: rerereRecordSetUp,
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_RERERE('forget', 'T');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "CALL DOLT_RERERE('clear');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('--abort');",
+				Expected: []sql.Row{{"", 0, 0, "merge aborted"}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('other');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "CALL DOLT_MERGE('--abort');",
+				Expected: []sql.Row{{"", 0, 0, "merge aborted"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_rerere;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:          "CALL DOLT_RERERE('forget');",
+				ExpectedErrStr: "error: forget requires at least one table",
+			},
+			{
+				Query:          "CALL DOLT_RERERE('status');",
+				ExpectedErrStr: "error: invalid argument, use the 'dolt_rerere' system table to read recorded conflicts",
+			},
+		},
+	},
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
+)
+
+var RowHistoryTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_row_history: versions of a row",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-am', 'insert rows');",
+			"SET @insert = hashof('HEAD');",
+			"UPDATE t SET v = 'b2' WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'update two');",
+			"UPDATE t SET v = 'a2' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update one', '--author', 'Jane Doe <jane@doe.com>');",
+			"SET @update = hashof('HEAD');",
+			"DELETE FROM t WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'delete one');",
+			"SET @delete = hashof('HEAD');",
+			"INSERT INTO t VALUES (1, 'a3');",
+			"CALL DOLT_COMMIT('-am', 'insert one again');",
+			"SET @reinsert = hashof('HEAD');",
+			"UPDATE t SET v = 'uncommitted' WHERE pk = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT pk, v, commit_hash = @reinsert, message, diff_type FROM dolt_row_history('t', 1);",
+				Expected: []sql.Row{
+					{1, "a3", true, "insert one again", "added"},
+					{1, nil, false, "delete one", "removed"},
+					{1, "a2", false, "update one", "modified"},
+					{1, "a", false, "insert rows", "added"},
+				},
+			},
+			{
+				Query:    "SELECT commit_hash = @update, author, author_email FROM dolt_row_history('t', 1) WHERE diff_type = 'modified';",
+				Expected: []sql.Row{{true, "Jane Doe", "jane@doe.com"}},
+			},
+			{
+				Query:    "SELECT commit_hash = @delete FROM dolt_row_history('t', '1') WHERE diff_type = 'removed';",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "SELECT commit_hash = @insert FROM dolt_row_history('t', 1) ORDER BY author_date LIMIT 1;",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "SELECT v, message FROM dolt_row_history('T', 2);",
+				Expected: []sql.Row{{"b2", "update two"}, {"b", "insert rows"}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_row_history('t', 3);",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_history: schema changes are followed by column tag",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, name varchar(20), age int);",
+			"INSERT INTO t VALUES (1, 'alice', 30);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"ALTER TABLE t RENAME COLUMN id TO customer_id;",
+			"ALTER TABLE t RENAME COLUMN name TO full_name;",
+			"CALL DOLT_COMMIT('-am', 'rename columns');",
+			"ALTER TABLE t DROP COLUMN age;",
+			"ALTER TABLE t ADD COLUMN email varchar(50);",
+			"CALL DOLT_COMMIT('-am', 'replace age with email');",
+			"UPDATE t SET email = 'alice@example.com' WHERE customer_id = 1;",
+			"CALL DOLT_COMMIT('-am', 'set email');",
+			"ALTER TABLE t MODIFY COLUMN customer_id bigint;",
+			"CALL DOLT_COMMIT('-am', 'widen key');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// renaming columns and widening the key don't change the row, so those commits don't show up
+				Query: "SELECT customer_id, full_name, email, message, diff_type FROM dolt_row_history('t', 1);",
+				Expected: []sql.Row{
+					{int64(1), "alice", "alice@example.com", "set email", "modified"},
+					{int64(1), "alice", nil, "create t", "added"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_history: primary key changes",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int, b int, v varchar(20), primary key (a));",
+			"INSERT INTO t VALUES (1, 10, 'x'), (2, 20, 'y');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"UPDATE t SET v = 'x2' WHERE a = 1;",
+			"CALL DOLT_COMMIT('-am', 'update x');",
+			"ALTER TABLE t DROP PRIMARY KEY;",
+			"ALTER TABLE t ADD PRIMARY KEY (b, a);",
+			"CALL DOLT_COMMIT('-am', 'change primary key');",
+			"UPDATE t SET v = 'x3' WHERE a = 1;",
+			"CALL DOLT_COMMIT('-am', 'update x again');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT a, b, v, message, diff_type FROM dolt_row_history('t', 10, 1);",
+				Expected: []sql.Row{
+					{1, 10, "x3", "update x again", "modified"},
+					{1, 10, "x2", "update x", "modified"},
+					{1, 10, "x", "create t", "added"},
+				},
+			},
+			{
+				Query:    "SELECT * FROM dolt_row_history('t', 20, 1);",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_history: merges",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('feature');",
+			"UPDATE t SET v = 'main' WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'change two on main');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"UPDATE t SET v = 'feature' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'change one on feature');",
+			"CALL DOLT_CHECKOUT('main');",
+			"CALL DOLT_MERGE('feature', '--no-ff', '-m', 'merge feature');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// the merge commit brings the change in, but doesn't change the row itself
+				Query:    "SELECT v, message, diff_type FROM dolt_row_history('t', 1);",
+				Expected: []sql.Row{{"feature", "change one on feature", "modified"}, {"a", "create t", "added"}},
+			},
+			{
+				Query:    "SELECT v, message, diff_type FROM dolt_row_history('t', 2);",
+				Expected: []sql.Row{{"main", "change two on main", "modified"}, {"b", "create t", "added"}},
+			},
+			{
+				Query:    "USE `mydb/feature`;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT v, message FROM dolt_row_history('t', 2);",
+				Expected: []sql.Row{{"b", "create t"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_history: errors",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"CREATE TABLE keyless (v int);",
+			"CREATE TABLE uncommitted (pk int primary key);",
+			"CALL DOLT_ADD('t', 'keyless');",
+			"CALL DOLT_COMMIT('-m', 'create tables');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:       "SELECT * FROM dolt_row_history('t');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:          "SELECT * FROM dolt_row_history('t', 1, 2);",
+				ExpectedErrStr: "dolt_row_history: table t has 1 primary key columns, but 2 values were given",
+			},
+			{
+				Query:       "SELECT * FROM dolt_row_history('doesnotexist', 1);",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:       "SELECT * FROM dolt_row_history('uncommitted', 1);",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:          "SELECT * FROM dolt_row_history('keyless', 1);",
+				ExpectedErrStr: "dolt_row_history requires a table with a primary key, but keyless has none",
+			},
+			{
+				Query:          "SELECT * FROM dolt_row_history('t', NULL);",
+				ExpectedErrStr: "dolt_row_history: primary key value for column pk cannot be NULL",
+			},
+			{
+				Query:       "SELECT * FROM dolt_row_history('t', LOWER('1'));",
+				ExpectedErr: dtablefunctions.ErrInvalidNonLiteralArgument,
+			},
+		},
+	},
+}
@@ -1595,6 +1595,177 @@ var SchemaChangeTestsTypeChanges = []MergeScriptTest{
 			},
 		},
 	},
+	{
+		Name: "integer widening",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"CREATE table t (pk int primary key, col1 int, col2 smallint unsigned);",
+			"INSERT into t values (1, 1, 1), (2, 2, 2);",
+			"alter table t add index idx1 (col1);",
+		},
+		RightSetUpScript: []string{
+			"alter table t modify column col1 bigint;",
+			"alter table t modify column col2 int;",
+			"INSERT into t values (3, 3000000000, -3);",
+		},
+		LeftSetUpScript: []string{
+			"UPDATE t set col1 = 20, col2 = 20 where pk = 2;",
+			"INSERT into t values (4, 4, 4);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "select * from dolt_preview_merge_conflicts_summary('main', 'right');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 1, 1}, {2, 20, 20}, {3, 3000000000, -3}, {4, 4, 4}},
+			},
+			{
+				Query:    "select pk from t where col1 = 20;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `col1` bigint,\n" +
+					"  `col2` int,\n" +
+					"  PRIMARY KEY (`pk`),\n" +
+					"  KEY `idx1` (`col1`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "decimal widening",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"CREATE table t (pk int primary key, col1 decimal(4,2), col2 decimal(4,2));",
+			"INSERT into t values (1, 1.5, 1.5), (2, 2.5, 2.5);",
+			"alter table t add index idx1 (col1);",
+		},
+		RightSetUpScript: []string{
+			"alter table t modify column col1 decimal(8,4);",
+			"alter table t modify column col2 decimal(10,2);",
+			"INSERT into t values (3, 3000.1234, 30000000.5);",
+		},
+		LeftSetUpScript: []string{
+			"UPDATE t set col1 = 20.25 where pk = 2;",
+			"INSERT into t values (4, 4.75, 4.75);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query: "select pk, cast(col1 as char), cast(col2 as char) from t order by pk;",
+				Expected: []sql.Row{
+					{1, "1.5000", "1.50"},
+					{2, "20.2500", "2.50"},
+					{3, "3000.1234", "30000000.50"},
+					{4, "4.7500", "4.75"},
+				},
+			},
+			{
+				Query:    "select pk from t where col1 = 20.25;",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "type widening on both sides",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"CREATE table t (pk int primary key, col1 tinyint, col2 varchar(10), col3 enum('blue', 'green'));",
+			"INSERT into t values (1, 1, 'one', 'blue'), (2, 2, 'two', 'green');",
+		},
+		RightSetUpScript: []string{
+			"alter table t modify column col1 smallint;",
+			"alter table t modify column col2 varchar(20);",
+			"alter table t modify column col3 enum('blue', 'green', 'red');",
+			"INSERT into t values (3, 300, 'three', 'red');",
+		},
+		LeftSetUpScript: []string{
+			"alter table t modify column col1 bigint;",
+			"alter table t modify column col2 varchar(50);",
+			"alter table t modify column col3 enum('blue', 'green', 'red', 'yellow');",
+			"UPDATE t set col1 = 3000000000 where pk = 1;",
+			"INSERT into t values (4, 4, 'four', 'yellow');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query: "select * from t order by pk;",
+				Expected: []sql.Row{
+					{1, 3000000000, "one", "blue"},
+					{2, 2, "two", "green"},
+					{3, 300, "three", "red"},
+					{4, 4, "four", "yellow"},
+				},
+			},
+			{
+				Query: "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `col1` bigint,\n" +
+					"  `col2` varchar(50),\n" +
+					"  `col3` enum('blue','green','red','yellow'),\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "column renamed on one side, widened on the other",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"CREATE table t (pk int primary key, col1 int, col2 varchar(10));",
+			"INSERT into t values (1, 1, 'one'), (2, 2, 'two');",
+		},
+		RightSetUpScript: []string{
+			"alter table t rename column col1 to renamed1;",
+			"alter table t rename column col2 to renamed2;",
+			"UPDATE t set renamed1 = 20 where pk = 2;",
+			"INSERT into t values (3, 3, 'three');",
+		},
+		LeftSetUpScript: []string{
+			"alter table t modify column col1 bigint;",
+			"alter table t modify column col2 varchar(100);",
+			"UPDATE t set col2 = 'a much longer value' where pk = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query: "select * from t order by pk;",
+				Expected: []sql.Row{
+					{1, 1, "a much longer value"},
+					{2, 20, "two"},
+					{3, 3, "three"},
+				},
+			},
+			{
+				Query: "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `renamed1` bigint,\n" +
+					"  `renamed2` varchar(100),\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
 	{
 		Name: "TEXT index without schema change",
 		AncSetUpScript: []string{
@@ -2212,8 +2383,8 @@ var SchemaChangeTestsTypeChanges = []MergeScriptTest{
 var SchemaChangeTestsSchemaConflicts = []MergeScriptTest{
 	{
 		// Type widening - these changes move from smaller types to bigger types, so they are guaranteed to be safe.
-		// TODO: We don't support automatically converting all types in merges yet, so currently these won't
-		//       automatically merge and instead return schema conflicts.
+		// TODO: We don't support automatically converting all types in merges yet (e.g. FLOAT to DOUBLE, or
+		//       BIT(1) to BIT(2)), so currently these won't automatically merge and instead return schema conflicts.
 		Name: "type widening",
 		AncSetUpScript: []string{
 			"set @@autocommit=0;",
@@ -2238,7 +2409,7 @@ var SchemaChangeTestsSchemaConflicts = []MergeScriptTest{
 		Assertions: []queries.ScriptTestAssertion{
 			{
 				Query:    "select * from dolt_preview_merge_conflicts_summary('main', 'right');",
-				Expected: []sql.Row{{"t", nil, uint64(4)}},
+				Expected: []sql.Row{{"t", nil, uint64(2)}},
 			},
 			{
 				Query:    "call dolt_merge('right');",
@@ -2253,6 +2424,39 @@ var SchemaChangeTestsSchemaConflicts = []MergeScriptTest{
 			},
 		},
 	},
+	{
+		// Type changes on both sides that can't be combined into a single type are reported with the change
+		// made on each side.
+		Name: "divergent type changes",
+		AncSetUpScript: []string{
+			"set @@autocommit=0;",
+			"CREATE table t (pk int primary key, col1 enum('blue', 'green'), col2 decimal(4,2), col3 int);",
+			"INSERT into t values (1, 'blue', 1.5, 1);",
+		},
+		RightSetUpScript: []string{
+			"alter table t modify column col1 enum('blue', 'green', 'red');",
+			"alter table t modify column col2 decimal(8,2);",
+			"alter table t rename column col3 to right3;",
+		},
+		LeftSetUpScript: []string{
+			"alter table t modify column col1 enum('blue', 'green', 'yellow');",
+			"alter table t modify column col2 decimal(5,3);",
+			"alter table t rename column col3 to left3;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query: "select description like '%col1: type changed from enum(''blue'',''green'') to enum(''blue'',''green'',''%'') on our branch and to enum(''blue'',''green'',''%'') on their branch%', " +
+					"description like '%col2: type changed from decimal(4,2) to decimal(%) on our branch and to decimal(%) on their branch%', " +
+					"description like '%renamed from col3 to %3 on our branch and to %3 on their branch%' " +
+					"from dolt_schema_conflicts;",
+				Expected: []sql.Row{{true, true, true}},
+			},
+		},
+	},
 	{
 		// Type shortening – these changes move from a larger type to a smaller type and are not always safe.
 		// For now, we automatically fail all of these with a schema conflict that the user must resolve, but in
@@ -3118,6 +3322,89 @@ var SchemaChangeTestsForJsonConflicts = []MergeScriptTest{
 	},
 }
 
+var SchemaChangeTestsForTextConflicts = []MergeScriptTest{
+	{
+		Name: "text merge fails without @@dolt_merge_text_lines",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"CREATE table t (pk int primary key, doc text);",
+			"INSERT into t values (1, 'one\ntwo\nthree\nfour\n');",
+		},
+		RightSetUpScript: []string{
+			"update t set doc = 'ONE\ntwo\nthree\nfour\n';",
+		},
+		LeftSetUpScript: []string{
+			"update t set doc = 'one\ntwo\nthree\nFOUR\n';",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "select base_doc, our_doc, their_doc from dolt_conflicts_t;",
+				Expected: []sql.Row{{"one\ntwo\nthree\nfour\n", "one\ntwo\nthree\nFOUR\n", "ONE\ntwo\nthree\nfour\n"}},
+			},
+		},
+	},
+	{
+		Name: "text merge succeeds with @@dolt_merge_text_lines",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"set @@dolt_merge_text_lines = 1;",
+			"CREATE table t (pk int primary key, doc text, notes longtext, name varchar(100));",
+			"INSERT into t values (1, 'one\ntwo\nthree\nfour\n', 'a\nb\nc\nd\ne', 'one\ntwo\nthree\nfour\n');",
+		},
+		RightSetUpScript: []string{
+			"update t set doc = 'ONE\ntwo\nthree\nfour\n', notes = 'a\nb\nc\nd\ne\nf';",
+		},
+		LeftSetUpScript: []string{
+			"update t set doc = 'one\ntwo\nthree\nFOUR\n', notes = 'a\nc\nd\ne';",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "select * from dolt_preview_merge_conflicts_summary('main', 'right');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "ONE\ntwo\nthree\nFOUR\n", "a\nc\nd\ne\nf", "one\ntwo\nthree\nfour\n"}},
+			},
+		},
+	},
+	{
+		Name: "overlapping text changes are a conflict with @@dolt_merge_text_lines",
+		AncSetUpScript: []string{
+			"set autocommit = 0;",
+			"set @@dolt_merge_text_lines = 1;",
+			"CREATE table t (pk int primary key, doc text, name varchar(100));",
+			"INSERT into t values (1, 'one\ntwo\nthree\n', 'one\ntwo\n'), (2, NULL, NULL);",
+		},
+		RightSetUpScript: []string{
+			"update t set doc = 'one\n2\nthree\n', name = 'ONE\ntwo\n' where pk = 1;",
+			"update t set doc = 'right' where pk = 2;",
+		},
+		LeftSetUpScript: []string{
+			"update t set doc = 'one\nTWO\nthree\n', name = 'one\nTWO\n' where pk = 1;",
+			"update t set doc = 'left' where pk = 2;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "select our_pk, our_doc, their_doc from dolt_conflicts_t;",
+				Expected: []sql.Row{{1, "one\nTWO\nthree\n", "one\n2\nthree\n"}, {2, "left", "right"}},
+			},
+		},
+	},
+}
+
 // These tests are not run because they cause panics during set-up.
 // Each one is labeled with a GitHub issue.
 var DisabledSchemaChangeTests = []MergeScriptTest{}
@@ -0,0 +1,248 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var SchemaMigrationTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_schema_migration: invalid arguments",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "SELECT * FROM dolt_schema_migration();",
+				ExpectedErrStr: "function 'dolt_schema_migration' expected 1 to 2 arguments, 0 received",
+			},
+			{
+				Query:          "SELECT * FROM dolt_schema_migration('HEAD', 'WORKING', 't');",
+				ExpectedErrStr: "function 'dolt_schema_migration' expected 1 to 2 arguments, 3 received",
+			},
+			{
+				Query:          "SELECT * FROM dolt_schema_migration('HEAD');",
+				ExpectedErrStr: "Invalid argument to dolt_schema_migration: There are less than 2 arguments present, and the first does not contain '..'",
+			},
+			{
+				Query:          "SELECT * FROM dolt_schema_migration('HEAD', 123);",
+				ExpectedErrStr: "Invalid argument to dolt_schema_migration: 123",
+			},
+			{
+				Query:          "SELECT * FROM dolt_schema_migration('HEAD', 'unknown');",
+				ExpectedErrStr: "branch not found: unknown",
+			},
+			{
+				Query:    "SELECT * FROM dolt_schema_migration('HEAD', 'WORKING');",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_migration: tables, columns, indexes and checks",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b varchar(10), c int, CONSTRAINT chk_c CHECK (c > 0));",
+			"CREATE TABLE dropped (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create tables');",
+			"DROP TABLE dropped;",
+			"ALTER TABLE t DROP COLUMN a;",
+			"ALTER TABLE t MODIFY COLUMN b varchar(20) NOT NULL;",
+			"ALTER TABLE t ADD COLUMN d int;",
+			"ALTER TABLE t ADD UNIQUE INDEX b_idx (b);",
+			"ALTER TABLE t DROP CHECK chk_c;",
+			"ALTER TABLE t ADD CONSTRAINT chk_c CHECK (c > 1);",
+			"CREATE TABLE added (pk int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT statement_order, object_type, object_name, statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'up';",
+				Expected: []sql.Row{
+					{uint64(1), "table", "dropped", "DROP TABLE `dropped`;"},
+					{uint64(2), "table", "t", "ALTER TABLE `t` DROP CHECK `chk_c`;"},
+					{uint64(3), "table", "t", "ALTER TABLE `t` DROP `a`;"},
+					{uint64(4), "table", "t", "ALTER TABLE `t` MODIFY COLUMN `b` varchar(20) NOT NULL;"},
+					{uint64(5), "table", "t", "ALTER TABLE `t` ADD `d` int;"},
+					{uint64(6), "table", "t", "ALTER TABLE `t` ADD UNIQUE KEY `b_idx` (`b`);"},
+					{uint64(7), "table", "t", "ALTER TABLE `t` ADD CONSTRAINT `chk_c` CHECK ((`c` > 1));"},
+					{uint64(8), "table", "added", "CREATE TABLE `added` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin;"},
+				},
+			},
+			{
+				Query: "SELECT statement_order, object_type, object_name, statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'down';",
+				Expected: []sql.Row{
+					{uint64(1), "table", "added", "DROP TABLE `added`;"},
+					{uint64(2), "table", "t", "ALTER TABLE `t` DROP CHECK `chk_c`;"},
+					{uint64(3), "table", "t", "ALTER TABLE `t` DROP INDEX `b_idx`;"},
+					{uint64(4), "table", "t", "ALTER TABLE `t` DROP `d`;"},
+					{uint64(5), "table", "t", "ALTER TABLE `t` ADD `a` int AFTER `pk`;"},
+					{uint64(6), "table", "t", "ALTER TABLE `t` MODIFY COLUMN `b` varchar(10);"},
+					{uint64(7), "table", "t", "ALTER TABLE `t` ADD CONSTRAINT `chk_c` CHECK ((`c` > 0));"},
+					{uint64(8), "table", "dropped", "CREATE TABLE `dropped` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin;"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_migration: columns keep their positions",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b int, c int);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"ALTER TABLE t ADD COLUMN x int AFTER a;",
+			"ALTER TABLE t ADD COLUMN y int FIRST;",
+			"ALTER TABLE t MODIFY COLUMN c int AFTER pk;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT column_name FROM information_schema.columns WHERE table_name = 't' ORDER BY ordinal_position;",
+				Expected: []sql.Row{{"y"}, {"pk"}, {"c"}, {"a"}, {"x"}, {"b"}},
+			},
+			{
+				Query: "SELECT statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'up';",
+				Expected: []sql.Row{
+					{"ALTER TABLE `t` ADD `y` int FIRST;"},
+					{"ALTER TABLE `t` MODIFY COLUMN `c` int AFTER `pk`;"},
+					{"ALTER TABLE `t` ADD `x` int AFTER `a`;"},
+				},
+			},
+			{
+				Query: "SELECT statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'down';",
+				Expected: []sql.Row{
+					{"ALTER TABLE `t` DROP `y`;"},
+					{"ALTER TABLE `t` DROP `x`;"},
+					{"ALTER TABLE `t` MODIFY COLUMN `c` int AFTER `b`;"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_migration: renamed columns and tables are matched by tag",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b int, c int);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"ALTER TABLE t RENAME COLUMN a TO tmp;",
+			"ALTER TABLE t RENAME COLUMN b TO a;",
+			"ALTER TABLE t RENAME COLUMN tmp TO b;",
+			"ALTER TABLE t RENAME COLUMN c TO c2;",
+			"RENAME TABLE t TO t2;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// the columns that swap names are renamed through a temporary name
+				Query: "SELECT statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'up';",
+				Expected: []sql.Row{
+					{"RENAME TABLE `t` TO `t2`;"},
+					{"ALTER TABLE `t2` RENAME COLUMN `a` TO `a_tmp`;"},
+					{"ALTER TABLE `t2` RENAME COLUMN `b` TO `a`;"},
+					{"ALTER TABLE `t2` RENAME COLUMN `a_tmp` TO `b`;"},
+					{"ALTER TABLE `t2` RENAME COLUMN `c` TO `c2`;"},
+				},
+			},
+			{
+				Query: "SELECT statement FROM dolt_schema_migration('HEAD..WORKING') WHERE direction = 'down';",
+				Expected: []sql.Row{
+					{"RENAME TABLE `t2` TO `t`;"},
+					{"ALTER TABLE `t` RENAME COLUMN `b` TO `b_tmp`;"},
+					{"ALTER TABLE `t` RENAME COLUMN `a` TO `b`;"},
+					{"ALTER TABLE `t` RENAME COLUMN `b_tmp` TO `a`;"},
+					{"ALTER TABLE `t` RENAME COLUMN `c2` TO `c`;"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_migration: foreign keys are added after the tables they reference",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id int primary key);",
+			"CREATE TABLE child (id int primary key, pid int, CONSTRAINT fk_parent FOREIGN KEY (pid) REFERENCES parent (id));",
+			"CALL DOLT_COMMIT('-Am', 'create tables');",
+			"ALTER TABLE child DROP FOREIGN KEY fk_parent;",
+			"ALTER TABLE parent MODIFY COLUMN id bigint;",
+			"ALTER TABLE child MODIFY COLUMN pid bigint;",
+			"ALTER TABLE child ADD CONSTRAINT fk_parent FOREIGN KEY (pid) REFERENCES parent (id) ON DELETE CASCADE;",
+			"CREATE TABLE grandchild (id int primary key, cid int, CONSTRAINT fk_child FOREIGN KEY (cid) REFERENCES child (id));",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'up';",
+				Expected: []sql.Row{
+					{"ALTER TABLE `child` DROP FOREIGN KEY `fk_parent`;"},
+					{"ALTER TABLE `child` MODIFY COLUMN `pid` bigint;"},
+					{"ALTER TABLE `parent` MODIFY COLUMN `id` bigint NOT NULL;"},
+					{"CREATE TABLE `grandchild` (\n  `id` int NOT NULL,\n  `cid` int,\n  PRIMARY KEY (`id`),\n  KEY `fk_child` (`cid`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin;"},
+					{"ALTER TABLE `child` ADD CONSTRAINT `fk_parent` FOREIGN KEY (`pid`) REFERENCES `parent` (`id`) ON DELETE CASCADE;"},
+					{"ALTER TABLE `grandchild` ADD CONSTRAINT `fk_child` FOREIGN KEY (`cid`) REFERENCES `child` (`id`);"},
+				},
+			},
+			{
+				Query: "SELECT statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'down';",
+				Expected: []sql.Row{
+					{"ALTER TABLE `child` DROP FOREIGN KEY `fk_parent`;"},
+					{"ALTER TABLE `grandchild` DROP FOREIGN KEY `fk_child`;"},
+					{"DROP TABLE `grandchild`;"},
+					{"ALTER TABLE `child` MODIFY COLUMN `pid` int;"},
+					{"ALTER TABLE `parent` MODIFY COLUMN `id` int NOT NULL;"},
+					{"ALTER TABLE `child` ADD CONSTRAINT `fk_parent` FOREIGN KEY (`pid`) REFERENCES `parent` (`id`);"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_migration: views, triggers and procedures",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int);",
+			"CREATE VIEW v1 AS SELECT pk FROM t;",
+			"CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET new.v = 1;",
+			"CREATE PROCEDURE dropped_proc() SELECT 1;",
+			"CALL DOLT_COMMIT('-Am', 'create schema objects');",
+			"DROP VIEW v1;",
+			"CREATE VIEW v1 AS SELECT pk, v FROM t;",
+			"CREATE VIEW a_view AS SELECT * FROM v1;",
+			"DROP TRIGGER trg;",
+			"CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET new.v = 2;",
+			"DROP PROCEDURE dropped_proc;",
+			"CREATE PROCEDURE added_proc() SELECT 2;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// views are created after the views they select from
+				Query: "SELECT object_type, object_name, statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'up';",
+				Expected: []sql.Row{
+					{"trigger", "trg", "DROP TRIGGER `trg`;"},
+					{"view", "v1", "DROP VIEW `v1`;"},
+					{"procedure", "dropped_proc", "DROP PROCEDURE `dropped_proc`;"},
+					{"procedure", "added_proc", "CREATE PROCEDURE added_proc() SELECT 2;"},
+					{"view", "v1", "CREATE VIEW v1 AS SELECT pk, v FROM t;"},
+					{"view", "a_view", "CREATE VIEW a_view AS SELECT * FROM v1;"},
+					{"trigger", "trg", "CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET new.v = 2;"},
+				},
+			},
+			{
+				Query: "SELECT object_type, object_name, statement FROM dolt_schema_migration('HEAD', 'WORKING') WHERE direction = 'down';",
+				Expected: []sql.Row{
+					{"trigger", "trg", "DROP TRIGGER `trg`;"},
+					{"view", "a_view", "DROP VIEW `a_view`;"},
+					{"view", "v1", "DROP VIEW `v1`;"},
+					{"procedure", "added_proc", "DROP PROCEDURE `added_proc`;"},
+					{"procedure", "dropped_proc", "CREATE PROCEDURE dropped_proc() SELECT 1;"},
+					{"view", "v1", "CREATE VIEW v1 AS SELECT pk FROM t;"},
+					{"trigger", "trg", "CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET new.v = 1;"},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,309 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func systemTime(year int) time.Time {
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+var SystemTimeScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_system_time: periods of time",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"UPDATE t SET v = 'a2' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update one', '--date', '2021-01-01T00:00:00');",
+			"DELETE FROM t WHERE pk = 2;",
+			"INSERT INTO t VALUES (3, 'c');",
+			"CALL DOLT_COMMIT('-am', 'delete two, insert three', '--date', '2022-01-01T00:00:00');",
+			"UPDATE t SET v = 'uncommitted' WHERE pk = 3;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// changes in the working set aren't part of the commit history
+				Query: "SELECT * FROM dolt_system_time('t', 'all') AS t ORDER BY pk, valid_from;",
+				Expected: []sql.Row{
+					{1, "a", systemTime(2020), systemTime(2021)},
+					{1, "a2", systemTime(2021), nil},
+					{2, "b", systemTime(2020), systemTime(2022)},
+					{3, "c", systemTime(2022), nil},
+				},
+			},
+			{
+				Query: "SELECT pk, v FROM dolt_system_time('t', 'from', '2020-06-01', '2021-01-01') AS t ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, "a"},
+					{2, "b"},
+				},
+			},
+			{
+				Query: "SELECT pk, v FROM dolt_system_time('t', 'between', '2020-06-01', '2021-01-01') AS t ORDER BY pk, v;",
+				Expected: []sql.Row{
+					{1, "a"},
+					{1, "a2"},
+					{2, "b"},
+				},
+			},
+			{
+				Query: "SELECT pk, v FROM dolt_system_time('t', 'contained', '2020-01-01', '2022-01-01') AS t ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, "a"},
+					{2, "b"},
+				},
+			},
+			{
+				Query: "SELECT pk, v FROM dolt_system_time('t', 'from', '2021-06-01', NOW()) AS t ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, "a2"},
+					{2, "b"},
+					{3, "c"},
+				},
+			},
+			{
+				Query:    "SELECT * FROM dolt_system_time('t', 'from', '2020-01-01', NULL) AS t;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT h.v FROM dolt_system_time('t', 'all') AS h JOIN t ON h.pk = t.pk WHERE h.valid_to IS NOT NULL;",
+				Expected: []sql.Row{{"a"}},
+			},
+			{
+				Query:    "SELECT pk, v FROM dolt_system_time('t', 'all') AS t WHERE valid_to IS NULL ORDER BY pk;",
+				Expected: []sql.Row{{1, "a2"}, {3, "c"}},
+			},
+			{
+				Query:    "SELECT count(*) FROM (SELECT * FROM dolt_system_time('t', 'all') AS t) sq;",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				// FOR SYSTEM_TIME AS OF is the same as AS OF
+				Query:    "SELECT * FROM t FOR SYSTEM_TIME AS OF TIMESTAMP('2021-06-01') ORDER BY pk;",
+				Expected: []sql.Row{{1, "a2"}, {2, "b"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: views",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a');",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"UPDATE t SET v = 'a2' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update one', '--date', '2021-01-01T00:00:00');",
+			"CREATE VIEW t_history AS SELECT pk, v, valid_from FROM dolt_system_time('t', 'all') AS t;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t_history ORDER BY valid_from;",
+				Expected: []sql.Row{{1, "a", systemTime(2020)}, {1, "a2", systemTime(2021)}},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: schema changes",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, name varchar(20), age int);",
+			"INSERT INTO t VALUES (1, 'alice', 30);",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"UPDATE t SET age = 31;",
+			"CALL DOLT_COMMIT('-am', 'update age', '--date', '2021-01-01T00:00:00');",
+			"ALTER TABLE t RENAME COLUMN name TO full_name;",
+			"ALTER TABLE t DROP COLUMN age;",
+			"ALTER TABLE t ADD COLUMN email varchar(50);",
+			"CALL DOLT_COMMIT('-am', 'replace age with email', '--date', '2022-01-01T00:00:00');",
+			"UPDATE t SET email = 'alice@example.com';",
+			"CALL DOLT_COMMIT('-am', 'set email', '--date', '2023-01-01T00:00:00');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// changes to the dropped column and schema changes that don't change the row don't make new versions
+				Query: "SELECT * FROM dolt_system_time('t', 'all') AS t ORDER BY valid_from;",
+				Expected: []sql.Row{
+					{1, "alice", nil, systemTime(2020), systemTime(2023)},
+					{1, "alice", "alice@example.com", systemTime(2023), nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: primary key changes and dropped tables",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key, b int);",
+			"INSERT INTO t VALUES (1, 10);",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"ALTER TABLE t DROP PRIMARY KEY;",
+			"CALL DOLT_COMMIT('-am', 'change primary key', '--date', '2021-01-01T00:00:00');",
+			"DROP TABLE t;",
+			"CALL DOLT_COMMIT('-am', 'drop t', '--date', '2022-01-01T00:00:00');",
+			"CREATE TABLE t (a int primary key, b int);",
+			"INSERT INTO t VALUES (1, 10);",
+			"CALL DOLT_COMMIT('-Am', 'create t again', '--date', '2023-01-01T00:00:00');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// rows can't be followed across changes to the primary key, or when the table is dropped
+				Query: "SELECT * FROM dolt_system_time('t', 'all') AS t ORDER BY valid_from;",
+				Expected: []sql.Row{
+					{1, 10, systemTime(2020), systemTime(2021)},
+					{1, 10, systemTime(2021), systemTime(2022)},
+					{1, 10, systemTime(2023), nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: keyless tables",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int, b int);",
+			"INSERT INTO t VALUES (1, 10), (1, 10), (2, 20);",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"DELETE FROM t WHERE a = 1 LIMIT 1;",
+			"CALL DOLT_COMMIT('-am', 'delete a copy of one', '--date', '2021-01-01T00:00:00');",
+			"UPDATE t SET b = 21 WHERE a = 2;",
+			"INSERT INTO t VALUES (1, 10), (1, 10);",
+			"CALL DOLT_COMMIT('-am', 'update two, insert copies of one', '--date', '2022-01-01T00:00:00');",
+			"CREATE TABLE u (a int, b int);",
+			"INSERT INTO u VALUES (1, 10);",
+			"CALL DOLT_COMMIT('-Am', 'create u', '--date', '2023-01-01T00:00:00');",
+			"ALTER TABLE u ADD PRIMARY KEY (a);",
+			"CALL DOLT_COMMIT('-am', 'add primary key to u', '--date', '2024-01-01T00:00:00');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// each copy of a row is a version of its own, and updates replace the row
+				Query: "SELECT * FROM dolt_system_time('t', 'all') AS t ORDER BY a, valid_from, valid_to;",
+				Expected: []sql.Row{
+					{1, 10, systemTime(2020), nil},
+					{1, 10, systemTime(2020), systemTime(2021)},
+					{1, 10, systemTime(2022), nil},
+					{1, 10, systemTime(2022), nil},
+					{2, 20, systemTime(2020), systemTime(2022)},
+					{2, 21, systemTime(2022), nil},
+				},
+			},
+			{
+				Query: "SELECT * FROM dolt_system_time('u', 'all') AS u ORDER BY valid_from;",
+				Expected: []sql.Row{
+					{1, 10, systemTime(2023), systemTime(2024)},
+					{1, 10, systemTime(2024), nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: first-parent history",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"CALL DOLT_CHECKOUT('-b', 'other');",
+			"UPDATE t SET v = 'b2' WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'update two on other', '--date', '2021-01-01T00:00:00');",
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET v = 'a2' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update one on main', '--date', '2022-01-01T00:00:00');",
+			"CALL DOLT_MERGE('other', '--no-ff', '--no-commit');",
+			"CALL DOLT_COMMIT('-m', 'merge other', '--date', '2023-01-01T00:00:00');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// changes made on other branches are valid from the time they're merged
+				Query: "SELECT * FROM dolt_system_time('t', 'all') AS t ORDER BY pk, valid_from;",
+				Expected: []sql.Row{
+					{1, "a", systemTime(2020), systemTime(2022)},
+					{1, "a2", systemTime(2022), nil},
+					{2, "b", systemTime(2020), systemTime(2023)},
+					{2, "b2", systemTime(2023), nil},
+				},
+			},
+			{
+				Query:    "USE `mydb/other`;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SELECT * FROM dolt_system_time('t', 'all') AS t ORDER BY pk, valid_from;",
+				Expected: []sql.Row{
+					{1, "a", systemTime(2020), nil},
+					{2, "b", systemTime(2020), systemTime(2021)},
+					{2, "b2", systemTime(2021), nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: arguments",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CALL DOLT_COMMIT('-Am', 'create t', '--date', '2020-01-01T00:00:00');",
+			"UPDATE t SET v = 'a2' WHERE pk = 1;",
+			"CALL DOLT_COMMIT('-am', 'update one', '--date', '2021-01-01T00:00:00');",
+			"DELETE FROM t WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'delete two', '--date', '2022-01-01T00:00:00');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_system_time('t', 'all') ORDER BY pk, valid_from;",
+				Expected: []sql.Row{
+					{1, "a", systemTime(2020), systemTime(2021)},
+					{1, "a2", systemTime(2021), nil},
+					{2, "b", systemTime(2020), systemTime(2022)},
+				},
+			},
+			{
+				Query:    "SELECT pk, v, valid_to FROM dolt_system_time('t', 'between', '2021-01-01', '2021-06-01') ORDER BY pk;",
+				Expected: []sql.Row{{1, "a2", nil}, {2, "b", systemTime(2022)}},
+			},
+			{
+				Query:    "SELECT pk, v FROM dolt_system_time('T', 'FROM', '2020-01-01', '2021-01-01') ORDER BY pk;",
+				Expected: []sql.Row{{1, "a"}, {2, "b"}},
+			},
+			{
+				Query:    "SELECT pk, v FROM dolt_system_time('t', 'contained', '2020-01-01', '2021-01-01');",
+				Expected: []sql.Row{{1, "a"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_system_time: errors",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'create tables');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:       "SELECT * FROM dolt_system_time('missing', 'all');",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:          "SELECT * FROM dolt_system_time('t', 'sometime');",
+				ExpectedErrStr: "dolt_system_time: unknown period sometime, expected one of all, from, between or contained",
+			},
+			{
+				Query:       "SELECT * FROM dolt_system_time('t', 'from');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+		},
+	},
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/go-mysql-server/enginetest"
 	"github.com/dolthub/go-mysql-server/enginetest/queries"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -208,6 +209,14 @@ var DoltTestRunFunctionScripts = []queries.ScriptTest{
 					{"should pass columns", "column tests", "select * from dolt_branches;", "PASS", ""},
 				},
 			},
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', 'row tests')",
+				Expected: []sql.Row{
+					{"expect integer for rows", "row tests", "select * from dolt_branches;", "FAIL", "cannot run assertion on non integer value: 0.5", "expected_rows == 0.5", nil, false},
+					{"should fail rows", "row tests", "select * from dolt_branches;", "FAIL", "Assertion failed: expected_rows equal to 4, got 1", "expected_rows == 4", "1", false},
+					{"should pass rows", "row tests", "select * from dolt_branches;", "PASS", "", "expected_rows != 4", "1", false},
+				},
+			},
 		},
 	},
 	{
@@ -327,6 +336,13 @@ var DoltTestRunFunctionScripts = []queries.ScriptTest{
 					{"should pass", "boolean tests", "select * from booleans;", "PASS", ""},
 				},
 			},
+			{
+				Query: "SELECT test_name, expected, actual FROM dolt_test_run('--details', 'boolean tests')",
+				Expected: []sql.Row{
+					{"should fail", "expected_single_value == false", "true"},
+					{"should pass", "expected_single_value == true", "true"},
+				},
+			},
 		},
 	},
 	{
@@ -483,6 +499,13 @@ var DoltTestRunFunctionScripts = []queries.ScriptTest{
 					{"ungrouped test", "", "show tables;", "PASS", ""},
 				},
 			},
+			{
+				Query: "SELECT * FROM dolt_test_run('grouped test', 'wildcard tests')",
+				Expected: []sql.Row{
+					{"grouped test", "wildcard tests", "show tables;", "PASS", ""},
+					{"second grouped test", "wildcard tests", "show tables;", "PASS", ""},
+				},
+			},
 		},
 	},
 	{
@@ -525,6 +548,493 @@ var DoltTestRunFunctionScripts = []queries.ScriptTest{
 					{"should fail", "", "select * from invalid", "FAIL", "query error: table not found: invalid"},
 				},
 			},
+			{
+				Query:    "SELECT test_name, status, errored FROM dolt_test_run('--details', 'should fail')",
+				Expected: []sql.Row{{"should fail", "FAIL", true}},
+			},
+		},
+	},
+	{
+		Name: "expected_error checks the errors of queries",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int, CHECK (v > 0))",
+			"INSERT INTO t VALUES (1, 1)",
+			"INSERT INTO dolt_tests VALUES ('missing table', 'errors', 'select * from missing', 'expected_error', '==', 'table not found')",
+			"INSERT INTO dolt_tests VALUES ('any error', 'errors', 'select * from missing', 'expected_error', '==', NULL)",
+			"INSERT INTO dolt_tests VALUES ('no error', 'errors', 'select * from t', 'expected_error', '!=', NULL)",
+			"INSERT INTO dolt_tests VALUES ('wrong error', 'errors', 'select * from missing', 'expected_error', '==', 'column not found')",
+			"INSERT INTO dolt_tests VALUES ('unexpected success', 'errors', 'select * from t', 'expected_error', '==', NULL)",
+			"INSERT INTO dolt_tests VALUES ('bad comparison', 'errors', 'select * from missing', 'expected_error', '>', NULL)",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', 'errors')",
+				Expected: []sql.Row{
+					{"any error", "errors", "select * from missing", "PASS", "", "expected_error == NULL", "table not found: missing", false},
+					{"bad comparison", "errors", "select * from missing", "FAIL", "> is not a valid comparison for expected_error. Only '==' and '!=' are supported", "expected_error > NULL", nil, false},
+					{"missing table", "errors", "select * from missing", "PASS", "", "expected_error == table not found", "table not found: missing", false},
+					{"no error", "errors", "select * from t", "PASS", "", "expected_error != NULL", "no error", false},
+					{"unexpected success", "errors", "select * from t", "FAIL", "Assertion failed: expected_error equal to any error, got no error", "expected_error == NULL", "no error", false},
+					{"wrong error", "errors", "select * from missing", "FAIL", "Assertion failed: expected_error equal to column not found, got table not found: missing", "expected_error == column not found", "table not found: missing", false},
+				},
+			},
+		},
+	},
+	{
+		Name: "expected_error can test write statements, whose changes are discarded",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int, CHECK (v > 0))",
+			"INSERT INTO t VALUES (1, 1)",
+			"CALL dolt_commit('-Am', 'create t')",
+			"INSERT INTO dolt_tests VALUES ('rejects negative', 'writes', 'insert into t values (2, -1)', 'expected_error', '==', 'Check constraint')",
+			"INSERT INTO dolt_tests VALUES ('rejects duplicate', 'writes', 'insert into t values (1, 1)', 'expected_error', '==', 'duplicate primary key')",
+			"INSERT INTO dolt_tests VALUES ('accepts positive', 'writes', 'update t set v = 5 where pk = 1', 'expected_error', '!=', NULL)",
+			"INSERT INTO dolt_tests VALUES ('deletes', 'writes', 'delete from t', 'expected_error', '!=', NULL)",
+			"INSERT INTO dolt_tests VALUES ('no ddl', '', 'create table u (i int)', 'expected_error', '!=', NULL)",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', 'writes')",
+				Expected: []sql.Row{
+					{"accepts positive", "writes", "update t set v = 5 where pk = 1", "PASS", "", "expected_error != NULL", "no error", false},
+					{"deletes", "writes", "delete from t", "PASS", "", "expected_error != NULL", "no error", false},
+					{"rejects duplicate", "writes", "insert into t values (1, 1)", "PASS", "", "expected_error == duplicate primary key", "duplicate primary key given: [1]", false},
+					{"rejects negative", "writes", "insert into t values (2, -1)", "PASS", "", "expected_error == Check constraint", "Check constraint \"t_chk_pdodns96\" violated", false},
+				},
+			},
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', 'no ddl')",
+				Expected: []sql.Row{
+					{"no ddl", "", "create table u (i int)", "FAIL", "expected_error can only run read queries and INSERT, UPDATE and DELETE statements", "expected_error != NULL", nil, false},
+				},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_tests",
+				Expected: []sql.Row{{5}},
+			},
+		},
+	},
+	{
+		Name: "expected_error rejects statements whose triggers write to other databases or call stored procedures",
+		SetUpScript: []string{
+			"CREATE DATABASE other",
+			"CREATE TABLE other.log (v int)",
+			"CREATE TABLE t (pk int primary key, v int)",
+			"CREATE TRIGGER log_t AFTER INSERT ON t FOR EACH ROW INSERT INTO other.log VALUES (new.v)",
+			"CREATE PROCEDURE make_branch() BEGIN CALL dolt_branch('from_trigger'); END",
+			"CREATE TABLE u (pk int primary key)",
+			"CREATE TRIGGER branch_u AFTER INSERT ON u FOR EACH ROW CALL make_branch()",
+			"INSERT INTO dolt_tests VALUES ('logs', 'triggers', 'insert into t values (1, 1)', 'expected_error', '!=', NULL)",
+			"INSERT INTO dolt_tests VALUES ('branches', 'procedures', 'insert into u values (1)', 'expected_error', '!=', NULL)",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT test_name, status, message FROM dolt_test_run('triggers')",
+				Expected: []sql.Row{{"logs", "FAIL", "expected_error statements cannot write to databases other than mydb"}},
+			},
+			{
+				Query:    "SELECT (SELECT count(*) FROM t), (SELECT count(*) FROM other.log)",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:    "SELECT test_name, status, message FROM dolt_test_run('procedures')",
+				Expected: []sql.Row{{"branches", "FAIL", "expected_error statements cannot fire triggers which call stored procedures"}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_branches WHERE name = 'from_trigger'",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "expected_snapshot compares query results with recorded snapshots",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(20))",
+			"INSERT INTO t VALUES (1, 'a'), (2, NULL)",
+			"INSERT INTO dolt_tests VALUES ('t snapshot', 'snapshots', 'select * from t', 'expected_snapshot', '==', NULL)",
+			"INSERT INTO dolt_tests VALUES ('t reversed', 'snapshots', 'select * from t order by pk desc', 'expected_snapshot', '==', NULL)",
+			"INSERT INTO dolt_tests VALUES ('other', '', 'select 1', 'expected_rows', '==', '1')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', 't snapshot')",
+				Expected: []sql.Row{
+					{"t snapshot", "snapshots", "select * from t", "FAIL", "expected_snapshot has no recorded snapshot, record one with dolt_test_snapshot()", "expected_snapshot == NULL", nil, false},
+				},
+			},
+			{
+				Query:            "CALL dolt_test_snapshot('snapshots')",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT count(distinct assertion_value), min(length(assertion_value)) FROM dolt_tests WHERE assertion_type = 'expected_snapshot'",
+				Expected: []sql.Row{{1, 32}},
+			},
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', 'snapshots')",
+				Expected: []sql.Row{
+					{"t reversed", "snapshots", "select * from t order by pk desc", "PASS", "", "expected_snapshot == 8mko7634hlaqbnh6a3t8d0lguq304qrf", "8mko7634hlaqbnh6a3t8d0lguq304qrf", false},
+					{"t snapshot", "snapshots", "select * from t", "PASS", "", "expected_snapshot == 8mko7634hlaqbnh6a3t8d0lguq304qrf", "8mko7634hlaqbnh6a3t8d0lguq304qrf", false},
+				},
+			},
+			{
+				Query:    "UPDATE t SET v = 'b' WHERE pk = 2",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "SELECT test_name, status, message like 'Assertion failed: expected_snapshot equal to %, got %' FROM dolt_test_run('t snapshot')",
+				Expected: []sql.Row{{"t snapshot", "FAIL", true}},
+			},
+			{
+				Query:    "UPDATE dolt_tests SET assertion_comparator = '!=' WHERE test_name = 't snapshot'",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "SELECT test_name, status FROM dolt_test_run('t snapshot')",
+				Expected: []sql.Row{{"t snapshot", "PASS"}},
+			},
+			{
+				Query:            "CALL dolt_test_snapshot()",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT test_name, status FROM dolt_test_run('t reversed')",
+				Expected: []sql.Row{{"t reversed", "PASS"}},
+			},
+			{
+				Query:          "CALL dolt_test_snapshot('other')",
+				ExpectedErrStr: "could not find expected_snapshot tests for argument: other",
+			},
+		},
+	},
+	{
+		Name: "expected_duration_ms checks how long queries take",
+		SetUpScript: []string{
+			"INSERT INTO dolt_tests VALUES ('fast', 'durations', 'select 1', 'expected_duration_ms', '<', '10000')",
+			"INSERT INTO dolt_tests VALUES ('slow', 'durations', 'select sleep(0.05)', 'expected_duration_ms', '>=', '50')",
+			"INSERT INTO dolt_tests VALUES ('too slow', 'durations', 'select sleep(0.05)', 'expected_duration_ms', '<', '1')",
+			"INSERT INTO dolt_tests VALUES ('bad value', 'durations', 'select 1', 'expected_duration_ms', '<', 'fast')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT test_name, status, message like 'Assertion failed: expected_duration_ms less than 1, got %' FROM dolt_test_run('durations')",
+				Expected: []sql.Row{
+					{"bad value", "FAIL", false},
+					{"fast", "PASS", false},
+					{"slow", "PASS", false},
+					{"too slow", "FAIL", true},
+				},
+			},
+			{
+				Query:    "SELECT message FROM dolt_test_run('bad value')",
+				Expected: []sql.Row{{"cannot run assertion on non integer value: fast"}},
+			},
+			{
+				Query: "SELECT test_name, expected, actual >= 50 FROM dolt_test_run('--details', 'durations')",
+				Expected: []sql.Row{
+					{"bad value", "expected_duration_ms < fast", nil},
+					{"fast", "expected_duration_ms < 10000", false},
+					{"slow", "expected_duration_ms >= 50", true},
+					{"too slow", "expected_duration_ms < 1", true},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_test_fixtures run setup and teardown queries around test groups",
+		SetUpScript: []string{
+			"CREATE TABLE accounts (id int primary key, balance int, CHECK (balance >= 0))",
+			"CREATE TABLE audit (account int, delta int)",
+			"CREATE TRIGGER audit_balance AFTER UPDATE ON accounts FOR EACH ROW INSERT INTO audit VALUES (new.id, new.balance - old.balance)",
+			"INSERT INTO dolt_test_fixtures VALUES ('triggers', 'INSERT INTO accounts VALUES (1, 10); UPDATE accounts SET balance = 15 WHERE id = 1', 'DELETE FROM audit; DELETE FROM accounts')",
+			"INSERT INTO dolt_tests VALUES ('audits updates', 'triggers', 'SELECT delta FROM audit WHERE account = 1', 'expected_single_value', '==', '5')",
+			"INSERT INTO dolt_tests VALUES ('one account', 'triggers', 'SELECT * FROM accounts', 'expected_rows', '==', '1')",
+			"INSERT INTO dolt_tests VALUES ('no accounts', NULL, 'SELECT * FROM accounts', 'expected_rows', '==', '0')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_test_run()",
+				Expected: []sql.Row{
+					{"audits updates", "triggers", "SELECT delta FROM audit WHERE account = 1", "PASS", ""},
+					{"no accounts", "", "SELECT * FROM accounts", "PASS", ""},
+					{"one account", "triggers", "SELECT * FROM accounts", "PASS", ""},
+				},
+			},
+			{
+				Query:    "SELECT (SELECT count(*) FROM accounts), (SELECT count(*) FROM audit)",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:    "UPDATE dolt_test_fixtures SET teardown_query = NULL",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query: "SELECT test_name, status FROM dolt_test_run('triggers')",
+				Expected: []sql.Row{
+					{"audits updates", "PASS"},
+					{"one account", "PASS"},
+				},
+			},
+			{
+				// without a teardown, the changes of the setup are still discarded
+				Query:    "SELECT (SELECT count(*) FROM accounts), (SELECT count(*) FROM audit)",
+				Expected: []sql.Row{{0, 0}},
+			},
+		},
+	},
+	{
+		Name: "dolt_test_fixtures reject queries whose changes can't be discarded",
+		SetUpScript: []string{
+			"CREATE TABLE accounts (id int primary key, balance int)",
+			"CREATE DATABASE otherdb",
+			"CREATE TABLE otherdb.t (i int primary key)",
+			"CREATE PROCEDURE audit_proc() INSERT INTO otherdb.t VALUES (1)",
+			"CREATE TRIGGER audit_call AFTER INSERT ON accounts FOR EACH ROW CALL audit_proc()",
+			"CALL dolt_commit('-Am', 'create accounts')",
+			"INSERT INTO dolt_test_fixtures VALUES ('other database', 'INSERT INTO otherdb.t VALUES (2)', NULL)",
+			"INSERT INTO dolt_test_fixtures VALUES ('other database ddl', 'CREATE TABLE otherdb.u (i int primary key)', NULL)",
+			"INSERT INTO dolt_test_fixtures VALUES ('trigger procedure', 'INSERT INTO accounts VALUES (1, 10)', NULL)",
+			"INSERT INTO dolt_test_fixtures VALUES ('procedure', 'CALL audit_proc()', NULL)",
+			"INSERT INTO dolt_test_fixtures VALUES ('ddl', 'CREATE TABLE scratch (i int primary key); INSERT INTO scratch VALUES (1)', NULL)",
+			"INSERT INTO dolt_tests VALUES ('other database', 'other database', 'SELECT * FROM otherdb.t', 'expected_rows', '==', '1')",
+			"INSERT INTO dolt_tests VALUES ('other database ddl', 'other database ddl', 'SELECT * FROM otherdb.t', 'expected_rows', '==', '0')",
+			"INSERT INTO dolt_tests VALUES ('trigger procedure', 'trigger procedure', 'SELECT * FROM accounts', 'expected_rows', '==', '1')",
+			"INSERT INTO dolt_tests VALUES ('procedure', 'procedure', 'SELECT * FROM otherdb.t', 'expected_rows', '==', '1')",
+			"INSERT INTO dolt_tests VALUES ('ddl', 'ddl', 'SELECT * FROM scratch', 'expected_rows', '==', '1')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT test_name, status, message FROM dolt_test_run('other database', 'other database ddl', 'trigger procedure', 'procedure', 'ddl')",
+				Expected: []sql.Row{
+					{"ddl", "PASS", ""},
+					{"other database", "FAIL", "Cannot write to databases other than mydb in dolt_test_fixtures"},
+					{"other database ddl", "FAIL", "Cannot write to databases other than mydb in dolt_test_fixtures"},
+					{"procedure", "FAIL", "Cannot call stored procedures, or fire triggers which call them, in dolt_test_fixtures"},
+					{"trigger procedure", "FAIL", "Cannot call stored procedures, or fire triggers which call them, in dolt_test_fixtures"},
+				},
+			},
+			{
+				Query: "SELECT test_name, status FROM dolt_test_run('--isolated', 'other database', 'trigger procedure', 'ddl')",
+				Expected: []sql.Row{
+					{"ddl", "PASS"},
+					{"other database", "FAIL"},
+					{"trigger procedure", "FAIL"},
+				},
+			},
+			{
+				Query:    "SELECT (SELECT count(*) FROM otherdb.t), (SELECT count(*) FROM accounts)",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:    "SHOW TABLES FROM otherdb",
+				Expected: []sql.Row{{"t"}},
+			},
+			{
+				Query:    "SHOW TABLES LIKE 'scratch'",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_test_run --isolated runs test groups on a temporary branch created from HEAD",
+		SetUpScript: []string{
+			"CREATE TABLE accounts (id int primary key, balance int, CHECK (balance >= 0))",
+			"CALL dolt_commit('-Am', 'create accounts')",
+			"INSERT INTO accounts VALUES (7, 70)",
+			"INSERT INTO dolt_test_fixtures VALUES ('accounts', 'INSERT INTO accounts VALUES (1, 10), (2, 20)', NULL)",
+			"INSERT INTO dolt_test_fixtures VALUES ('bad setup', 'INSERT INTO accounts VALUES (3, -1)', NULL)",
+			"INSERT INTO dolt_test_fixtures VALUES ('commits', 'CALL dolt_commit(''-Am'', ''fixture'')', NULL)",
+			"INSERT INTO dolt_tests VALUES ('two accounts', 'accounts', 'SELECT * FROM accounts', 'expected_rows', '==', '2')",
+			"INSERT INTO dolt_tests VALUES ('total balance', 'accounts', 'SELECT sum(balance) FROM accounts', 'expected_single_value', '==', '30')",
+			"INSERT INTO dolt_tests VALUES ('rejected', 'bad setup', 'SELECT * FROM accounts', 'expected_rows', '==', '0')",
+			"INSERT INTO dolt_tests VALUES ('committed', 'commits', 'SELECT * FROM accounts', 'expected_rows', '==', '0')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_test_run('--details', '--isolated', 'accounts')",
+				Expected: []sql.Row{
+					{"total balance", "accounts", "SELECT sum(balance) FROM accounts", "PASS", "", "expected_single_value == 30", "30", false},
+					{"two accounts", "accounts", "SELECT * FROM accounts", "PASS", "", "expected_rows == 2", "2", false},
+				},
+			},
+			{
+				Query:    "SELECT * FROM accounts",
+				Expected: []sql.Row{{7, 70}},
+			},
+			{
+				Query: "SELECT test_name, status FROM dolt_test_run('accounts')",
+				Expected: []sql.Row{
+					{"total balance", "FAIL"},
+					{"two accounts", "FAIL"},
+				},
+			},
+			{
+				Query:    "SELECT * FROM accounts",
+				Expected: []sql.Row{{7, 70}},
+			},
+			{
+				Query: "SELECT test_name, status, message FROM dolt_test_run('bad setup', 'commits', '--isolated') WHERE message NOT LIKE 'setup query error: Check constraint % violated'",
+				Expected: []sql.Row{
+					{"committed", "FAIL", "Cannot call dolt_commit in dolt_test_fixtures"},
+				},
+			},
+			{
+				Query:    "SELECT test_name, status, errored FROM dolt_test_run('--details', '--isolated', 'bad setup')",
+				Expected: []sql.Row{{"rejected", "FAIL", true}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1",
+				Expected: []sql.Row{{"create accounts"}},
+			},
+			{
+				Query:    "SELECT * FROM accounts",
+				Expected: []sql.Row{{7, 70}},
+			},
+			{
+				Query:    "DELETE FROM accounts",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:            "CALL dolt_commit('-Am', 'add tests')",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "CALL dolt_checkout('-b', 'other')",
+				Expected: []sql.Row{{0, "Switched to branch 'other'"}},
+			},
+			{
+				Query: "SELECT test_name, status FROM dolt_test_run('--isolated', 'accounts')",
+				Expected: []sql.Row{
+					{"total balance", "PASS"},
+					{"two accounts", "PASS"},
+				},
+			},
+			{
+				Query:    "SELECT active_branch(), (SELECT count(*) FROM accounts)",
+				Expected: []sql.Row{{"other", 0}},
+			},
+			{
+				Query:    "SELECT name FROM dolt_branches",
+				Expected: []sql.Row{{"main"}, {"other"}},
+			},
+			{
+				// branches left behind by runs that never finished
+				Query:    "CALL dolt_branch('dolt_test_run_stale')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL dolt_branch(concat('dolt_test_run_', unix_timestamp() - 2 * 24 * 60 * 60, '_stale'))",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// the branch of a run that may still be running in another process
+				Query:    "CALL dolt_branch(concat('dolt_test_run_', unix_timestamp() - 60, '_running'))",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_test_run('--isolated', 'accounts') WHERE status = 'PASS'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT name FROM dolt_branches WHERE name NOT LIKE '%\\_running'",
+				Expected: []sql.Row{{"main"}, {"other"}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_branches WHERE name LIKE 'dolt\\_test\\_run\\_%\\_running'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// the changes of the transaction of dolt_test_run are still committed
+				Query:    "START TRANSACTION",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "INSERT INTO accounts VALUES (8, 80)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_test_run('--isolated', 'accounts') WHERE status = 'PASS'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "COMMIT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT active_branch(), (SELECT count(*) FROM accounts)",
+				Expected: []sql.Row{{"other", 1}},
+			},
+		},
+	},
+	{
+		Name: "change assertions check the diff between two revisions",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, c int)",
+			"INSERT INTO t VALUES (1, 10)",
+			"CALL dolt_add('.')",
+			"CALL dolt_commit('-m', 'create t')",
+			"INSERT INTO dolt_tests VALUES ('two rows added', 'changes', 'SELECT rows_added FROM dolt_diff_stat(@dolt_change_from, @dolt_change_to, \"t\")', 'expected_change_single_value', '==', '2')",
+			"INSERT INTO dolt_tests VALUES ('no schema change', 'changes', 'SELECT * FROM dolt_schema_diff(@dolt_change_from, @dolt_change_to, \"t\")', 'expected_change_rows', '==', '0')",
+			"INSERT INTO dolt_tests VALUES ('diff columns', 'changes', 'SELECT to_pk, to_c, diff_type FROM dolt_diff(@dolt_change_from, @dolt_change_to, \"t\")', 'expected_change_columns', '==', '3')",
+			"INSERT INTO dolt_tests VALUES ('row count', 'changes', 'SELECT count(*) FROM t', 'expected_single_value', '==', '3')",
+			"INSERT INTO t VALUES (2, 20), (3, 30)",
+			"CALL dolt_add('.')",
+			"INSERT INTO t VALUES (4, 40)",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT test_name, status, message FROM dolt_test_run('changes')",
+				Expected: []sql.Row{
+					{"two rows added", "PASS", ""},
+					{"no schema change", "PASS", ""},
+					{"diff columns", "PASS", ""},
+					{"row count", "FAIL", "Assertion failed: expected_single_value equal to 3, got 4"},
+				},
+			},
+			{
+				Query: "SELECT test_name, status FROM dolt_test_run('--changes', 'changes')",
+				Expected: []sql.Row{
+					{"two rows added", "PASS"},
+					{"no schema change", "PASS"},
+					{"diff columns", "PASS"},
+				},
+			},
+			{
+				Query: "SELECT test_name, status, message FROM dolt_test_run('--changes', '--to', 'WORKING', 'changes')",
+				Expected: []sql.Row{
+					{"two rows added", "FAIL", "Assertion failed: expected_change_single_value equal to 2, got 3"},
+					{"no schema change", "PASS", ""},
+					{"diff columns", "PASS", ""},
+				},
+			},
+			{
+				Query: "SELECT test_name, status, message FROM dolt_test_run('--changes', '--from', 'HEAD~1', '--to', 'HEAD', 'changes')",
+				Expected: []sql.Row{
+					{"two rows added", "FAIL", "Assertion failed: expected_change_single_value equal to 2, got 1"},
+					{"no schema change", "FAIL", "Assertion failed: expected_change_rows equal to 0, got 1"},
+					{"diff columns", "PASS", ""},
+				},
+			},
+			{
+				Query: "SELECT test_name, expected, actual FROM dolt_test_run('--details', '--changes', '--from', 'HEAD~1', '--to', 'HEAD', 'changes')",
+				Expected: []sql.Row{
+					{"two rows added", "expected_change_single_value == 2", "1"},
+					{"no schema change", "expected_change_rows == 0", "1"},
+					{"diff columns", "expected_change_columns == 3", "3"},
+				},
+			},
+			{
+				Query:    "SELECT @dolt_change_from, @dolt_change_to",
+				Expected: []sql.Row{{nil, nil}},
+			},
+			{
+				Query:          "SELECT * FROM dolt_test_run('--changes', '--from')",
+				ExpectedErrStr: "--from requires a revision",
+			},
 		},
 	},
 }
@@ -548,6 +1058,12 @@ func RunDoltTestsValidationTests(t *testing.T, harness DoltEnginetestHarness) {
 		// Each remaining valid assertion_type with at least one comparator.
 		{"expected_columns", "==", false},
 		{"expected_single_value", "==", false},
+		{"expected_error", "==", false},
+		{"expected_snapshot", "==", false},
+		{"expected_duration_ms", "<", false},
+		{"expected_change_rows", "==", false},
+		{"expected_change_columns", "==", false},
+		{"expected_change_single_value", "==", false},
 
 		// Invalid assertion_type, valid comparator.
 		{"row_count", "==", true},           // common mistake (issue #10568)
@@ -91,7 +91,7 @@ var DoltCommitPlanTests = []queries.QueryPlanTest{
 		ExpectedPlan: "Sort(dolt_log.commit_hash ASC)\n" +
 			" └─ Table\n" +
 			"     ├─ name: dolt_log\n" +
-			"     └─ columns: [commit_hash committer email date message commit_order parents refs signature author author_email author_date]\n" +
+			"     └─ columns: [commit_hash committer email date message commit_order parents refs signature author author_email author_date notes]\n" +
 			"",
 	},
 	{
@@ -76,6 +76,27 @@ func ResolveCheckExpression(ctx *sql.Context, tableName string, sch schema.Schem
 	return nil, fmt.Errorf("unable to find check expression")
 }
 
+// MergePolicyExpressionColumns are the names of the values a merge policy expression can refer to, in the order of
+// the row it's evaluated on: the value on our side of the merge, on their side, and in the merge base.
+var MergePolicyExpressionColumns = []string{"ours", "theirs", "base"}
+
+// ResolveMergePolicyExpression returns a sql.Expression for the merge policy expression |policyExpr| that resolves
+// conflicting values of the column |col|. The expression is evaluated on a row of the values named by
+// MergePolicyExpressionColumns, each of the column's type.
+func ResolveMergePolicyExpression(ctx *sql.Context, col schema.Column, policyExpr string) (sql.Expression, error) {
+	cols := make([]schema.Column, 0, len(MergePolicyExpressionColumns)+1)
+	for i, name := range MergePolicyExpressionColumns {
+		cols = append(cols, schema.Column{Name: name, Tag: uint64(i), Kind: col.Kind, TypeInfo: col.TypeInfo})
+	}
+	merged := schema.Column{Name: "merged", Tag: uint64(len(cols)), Kind: col.Kind, TypeInfo: col.TypeInfo, Generated: policyExpr}
+	cols = append(cols, merged)
+	sch, err := schema.NewSchema(schema.NewColCollection(cols...), nil, schema.Collation_Default, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ResolveDefaultExpression(ctx, "merge_policy", sch, merged)
+}
+
 func stripTableNamesFromExpression(ctx *sql.Context, formatter sql.SchemaFormatter, expr sql.Expression, quoted bool) sql.Expression {
 	e, _, _ := transform.Expr(ctx, expr, func(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		if col, ok := e.(*expression.GetField); ok {
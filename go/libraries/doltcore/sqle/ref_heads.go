@@ -0,0 +1,127 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// refHeads installs a commit hook on each database of a server for a service which reacts to the updates of their
+// branches, and records the last commits seen of the branches, and optionally the tags, of each database.
+type refHeads struct {
+	// tags is whether the commits of tags are recorded as well as the heads of branches
+	tags bool
+	// newHook returns the commit hook of the database |name|
+	newHook func(name string, ddb *doltdb.DoltDB) doltdb.CommitHook
+
+	mu    sync.Mutex
+	heads map[string]hash.Hash
+}
+
+func newRefHeads(tags bool, newHook func(name string, ddb *doltdb.DoltDB) doltdb.CommitHook) *refHeads {
+	return &refHeads{tags: tags, newHook: newHook, heads: make(map[string]hash.Hash)}
+}
+
+// ApplyCommitHooks installs the commit hooks on |dbs| during engine initialization.
+func (rh *refHeads) ApplyCommitHooks(ctx context.Context, mrEnv *env.MultiRepoEnv, dbs ...dsess.SqlDatabase) error {
+	for _, db := range dbs {
+		denv := mrEnv.GetEnv(db.Name())
+		if denv == nil {
+			continue
+		}
+		hook, err := rh.install(ctx, db.Name(), denv.DoltDB(ctx))
+		if err != nil {
+			return err
+		}
+		denv.DoltDB(ctx).PrependCommitHooks(ctx, hook)
+	}
+	return nil
+}
+
+// InitDatabaseHook returns a hook which installs the commit hook on newly created databases.
+func (rh *refHeads) InitDatabaseHook() InitDatabaseHook {
+	return func(ctx *sql.Context, _ *DoltDatabaseProvider, name string, env *env.DoltEnv, _ dsess.SqlDatabase) error {
+		ddb := env.DoltDB(ctx)
+		hook, err := rh.install(ctx, name, ddb)
+		if err != nil {
+			return err
+		}
+		ddb.PrependCommitHooks(ctx, hook)
+		return nil
+	}
+}
+
+// install records the current commits of the refs of the database |name|, so that their updates are compared to them,
+// and returns its commit hook.
+func (rh *refHeads) install(ctx context.Context, name string, ddb *doltdb.DoltDB) (doltdb.CommitHook, error) {
+	refs, err := ddb.GetBranchesWithHashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rh.tags {
+		tags, err := ddb.GetTagRefsWithHashes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, tags...)
+	}
+	rh.mu.Lock()
+	for _, r := range refs {
+		rh.heads[refHeadKey(name, r.Ref)] = r.Hash
+	}
+	rh.mu.Unlock()
+	return rh.newHook(name, ddb), nil
+}
+
+func refHeadKey(dbName string, rf ref.DoltRef) string {
+	return dbName + "/" + rf.String()
+}
+
+// get returns the last commit seen of |rf| in the database |dbName|.
+func (rh *refHeads) get(dbName string, rf ref.DoltRef) (hash.Hash, bool) {
+	rh.mu.Lock()
+	defer rh.mu.Unlock()
+	head, ok := rh.heads[refHeadKey(dbName, rf)]
+	return head, ok
+}
+
+// set records |head| as the commit of |rf| in the database |dbName|, or removes the ref if |head| is empty.
+func (rh *refHeads) set(dbName string, rf ref.DoltRef, head hash.Hash) {
+	rh.swap(dbName, rf, head)
+}
+
+// swap records |head| as the commit of |rf| in the database |dbName|, or removes the ref if |head| is empty, and
+// returns the commit it had before.
+func (rh *refHeads) swap(dbName string, rf ref.DoltRef, head hash.Hash) (hash.Hash, bool) {
+	key := refHeadKey(dbName, rf)
+	rh.mu.Lock()
+	defer rh.mu.Unlock()
+	prev, ok := rh.heads[key]
+	if head.IsEmpty() {
+		delete(rh.heads, key)
+	} else {
+		rh.heads[key] = head
+	}
+	return prev, ok
+}
@@ -520,3 +520,23 @@ func isPrimaryKeyIndex(index schema.Index, sch schema.Schema) bool {
 
 	return true
 }
+
+func AlterTableAddCheckStmt(formatter sql.SchemaFormatter, tableName string, check schema.Check) string {
+	var b strings.Builder
+	b.WriteString("ALTER TABLE ")
+	b.WriteString(formatter.QuoteIdentifier(tableName))
+	b.WriteString(" ADD ")
+	b.WriteString(strings.TrimSpace(GenerateCreateTableCheckConstraintClause(formatter, check)))
+	b.WriteRune(';')
+	return b.String()
+}
+
+func AlterTableDropCheckStmt(formatter sql.SchemaFormatter, tableName string, checkName string) string {
+	var b strings.Builder
+	b.WriteString("ALTER TABLE ")
+	b.WriteString(formatter.QuoteIdentifier(tableName))
+	b.WriteString(" DROP CHECK ")
+	b.WriteString(formatter.QuoteIdentifier(checkName))
+	b.WriteRune(';')
+	return b.String()
+}
@@ -0,0 +1,681 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlfmt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/overrides"
+)
+
+// The types of the schema elements changed by the statements of a schema migration
+const (
+	SchemaObjectDatabase  = "database"
+	SchemaObjectSchema    = "schema"
+	SchemaObjectTable     = "table"
+	SchemaObjectView      = "view"
+	SchemaObjectTrigger   = "trigger"
+	SchemaObjectEvent     = "event"
+	SchemaObjectProcedure = "procedure"
+)
+
+// SchemaObject is a schema element that is defined by its CREATE statement rather than stored as a table, such as a
+// view, trigger, event or stored procedure.
+type SchemaObject struct {
+	Type            string
+	Name            string
+	CreateStatement string
+}
+
+// MigrationStatement is a DDL statement of a schema migration, along with the type and the name of the schema element
+// it changes.
+type MigrationStatement struct {
+	ObjectType string
+	ObjectName string
+	Statement  string
+}
+
+// migrationScriptDelimiter is the statement delimiter written around the statements of a migration script which have
+// compound bodies, so that the script can be run by the mysql client.
+const migrationScriptDelimiter = "END_STATEMENT"
+
+// DelimitMigrationStatement returns |stmt|, which changes a schema object of type |objectType|, as it's written to a
+// migration script. Statements which create triggers, events and procedures are written with their own delimiter,
+// since their bodies may contain statements of their own.
+func DelimitMigrationStatement(objectType, stmt string) string {
+	switch objectType {
+	case SchemaObjectTrigger, SchemaObjectEvent, SchemaObjectProcedure:
+		if strings.HasPrefix(strings.ToUpper(stmt), "CREATE") {
+			return fmt.Sprintf("delimiter %[1]s\n%[2]s\n%[1]s\ndelimiter ;", migrationScriptDelimiter, strings.TrimSuffix(stmt, ";"))
+		}
+	}
+	return stmt
+}
+
+// migrationPhase is a step of a schema migration. The statements of each phase are executed after the statements of
+// the phases before it, so that no statement depends on a schema element that was already dropped or not yet created.
+type migrationPhase int
+
+const (
+	// views, triggers, events and procedures that are dropped or replaced
+	phaseDropObjects migrationPhase = iota
+	// foreign keys that are dropped or replaced, including those of dropped tables
+	phaseDropForeignKeys
+	phaseDropTables
+	phaseDropSchemas
+	phaseCreateSchemas
+	phaseAlterDatabase
+	phaseRenameTables
+	phaseAlterTables
+	phaseCreateTables
+	// foreign keys that are added or replaced, including those of created tables
+	phaseAddForeignKeys
+	// views, triggers, events and procedures that are created or replaced
+	phaseCreateObjects
+	numMigrationPhases
+)
+
+// schemaObjectOrder is the order in which schema objects of each type are created. They're dropped in the reverse
+// order, so that triggers, which may call procedures and read views, are dropped first.
+var schemaObjectOrder = map[string]int{
+	SchemaObjectProcedure: 0,
+	SchemaObjectView:      1,
+	SchemaObjectEvent:     2,
+	SchemaObjectTrigger:   3,
+}
+
+type schemaMigration struct {
+	formatter  sql.SchemaFormatter
+	statements [numMigrationPhases][]MigrationStatement
+}
+
+func (m *schemaMigration) add(phase migrationPhase, objectType, objectName, stmt string) {
+	m.statements[phase] = append(m.statements[phase], MigrationStatement{
+		ObjectType: objectType,
+		ObjectName: objectName,
+		Statement:  stmt,
+	})
+}
+
+// GenerateSchemaMigrationStatements returns the ordered DDL statements that migrate the schema of |fromRoot|, along
+// with the views, triggers, events and procedures of |fromObjects|, to the schema of |toRoot| and the schema objects
+// of |toObjects|. Columns are matched by tag, so renamed columns are renamed rather than dropped and added back, and
+// foreign keys are dropped before the tables and columns they reference change and added back afterward. The reverse
+// migration is generated by swapping the from and to arguments.
+func GenerateSchemaMigrationStatements(ctx *sql.Context, fromRoot, toRoot doltdb.RootValue, fromObjects, toObjects []SchemaObject) ([]MigrationStatement, error) {
+	m := &schemaMigration{formatter: overrides.SchemaFormatterFromContext(ctx)}
+
+	dbSchemaDeltas, err := diff.GetDatabaseSchemaDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dbSchemaDeltas {
+		if d.IsDrop() {
+			m.add(phaseDropSchemas, SchemaObjectSchema, d.FromName, fmt.Sprintf("DROP SCHEMA %s;", m.formatter.QuoteIdentifier(d.FromName)))
+		} else if d.IsAdd() {
+			m.add(phaseCreateSchemas, SchemaObjectSchema, d.ToName, fmt.Sprintf("CREATE SCHEMA %s;", m.formatter.QuoteIdentifier(d.ToName)))
+		}
+	}
+
+	if err = m.addTableStatements(ctx, fromRoot, toRoot); err != nil {
+		return nil, err
+	}
+	m.addSchemaObjectStatements(fromObjects, toObjects)
+
+	var stmts []MigrationStatement
+	for _, phaseStmts := range m.statements {
+		stmts = append(stmts, phaseStmts...)
+	}
+	return stmts, nil
+}
+
+// addTableStatements adds the statements that migrate the tables, columns, indexes, checks and foreign keys of
+// |fromRoot| to those of |toRoot|.
+func (m *schemaMigration) addTableStatements(ctx *sql.Context, fromRoot, toRoot doltdb.RootValue) error {
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return err
+	}
+	fromSchemas, err := doltdb.GetAllSchemas(ctx, fromRoot)
+	if err != nil {
+		return fmt.Errorf("could not read schemas from fromRoot, cause: %s", err.Error())
+	}
+	toSchemas, err := doltdb.GetAllSchemas(ctx, toRoot)
+	if err != nil {
+		return fmt.Errorf("could not read schemas from toRoot, cause: %s", err.Error())
+	}
+
+	// the tags of the columns whose type changes, by the name of their table in |fromRoot|. The foreign keys over
+	// these columns are dropped and added back around the change, since the columns of a foreign key must have
+	// compatible types.
+	retyped := make(map[doltdb.TableName]map[uint64]struct{})
+	var tableDeltas []diff.TableDelta
+	for _, td := range deltas {
+		if td.FromTable == nil && td.ToTable == nil {
+			if strings.HasPrefix(td.ToName.Name, diff.DBPrefix) {
+				if err = m.addDatabaseCollationStatement(ctx, fromRoot, toRoot, strings.TrimPrefix(td.ToName.Name, diff.DBPrefix)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if !isMigratedTable(td.FromName.Name) || !isMigratedTable(td.ToName.Name) {
+			continue
+		}
+		tableDeltas = append(tableDeltas, td)
+
+		if td.IsAdd() || td.IsDrop() {
+			continue
+		}
+		colDiffs, _ := diff.DiffSchColumns(td.FromSch, td.ToSch)
+		for tag, cd := range colDiffs {
+			if cd.DiffType == diff.SchDiffModified && !cd.Old.TypeInfo.Equals(cd.New.TypeInfo) {
+				if retyped[td.FromName] == nil {
+					retyped[td.FromName] = make(map[uint64]struct{})
+				}
+				retyped[td.FromName][tag] = struct{}{}
+			}
+		}
+	}
+
+	// tables are renamed after the tables that are dropped, so tables can take the names of dropped tables
+	liveTables := make(map[string]struct{})
+	for name := range fromSchemas {
+		liveTables[strings.ToLower(name.Name)] = struct{}{}
+	}
+	var tableRenames [][2]string
+
+	for _, td := range tableDeltas {
+		if td.IsDrop() {
+			m.add(phaseDropTables, SchemaObjectTable, td.FromName.Name, DropTableStmt(m.formatter, td.FromName.Name))
+			delete(liveTables, strings.ToLower(td.FromName.Name))
+			continue
+		}
+
+		if td.IsAdd() {
+			stmt, err := GenerateCreateTableStatement(ctx, td.ToName.Name, td.ToSch, nil, nil)
+			if err != nil {
+				return err
+			}
+			m.add(phaseCreateTables, SchemaObjectTable, td.ToName.Name, stmt)
+			continue
+		}
+
+		if td.FromName.Name != td.ToName.Name {
+			tableRenames = append(tableRenames, [2]string{td.FromName.Name, td.ToName.Name})
+		}
+		if err = m.addAlterTableStatements(ctx, td); err != nil {
+			return err
+		}
+	}
+
+	// foreign keys are diffed across all tables, since the foreign keys of tables that don't change may reference
+	// columns that do
+	fromFks, err := getMigratedForeignKeys(ctx, fromRoot)
+	if err != nil {
+		return err
+	}
+	toFks, err := getMigratedForeignKeys(ctx, toRoot)
+	if err != nil {
+		return err
+	}
+	for _, fkDiff := range diff.DiffForeignKeys(fromFks, toFks) {
+		switch fkDiff.DiffType {
+		case diff.SchDiffNone:
+			if usesColumns(retyped[fkDiff.From.TableName], fkDiff.From.TableColumns) ||
+				usesColumns(retyped[fkDiff.From.ReferencedTableName], fkDiff.From.ReferencedTableColumns) {
+				m.dropForeignKey(fkDiff.From)
+				m.addForeignKey(fkDiff.To, toSchemas)
+			}
+		case diff.SchDiffAdded:
+			m.addForeignKey(fkDiff.To, toSchemas)
+		case diff.SchDiffRemoved:
+			m.dropForeignKey(fkDiff.From)
+		case diff.SchDiffModified:
+			m.dropForeignKey(fkDiff.From)
+			m.addForeignKey(fkDiff.To, toSchemas)
+		}
+	}
+
+	for _, rename := range orderRenames(tableRenames, liveTables) {
+		m.add(phaseRenameTables, SchemaObjectTable, rename[1], RenameTableStmt(m.formatter, rename[0], rename[1]))
+	}
+
+	return nil
+}
+
+// addAlterTableStatements adds the statements that migrate the columns, primary key, indexes, checks and table
+// options of the table of |td|, which exists in both roots. They're executed after the table is renamed.
+func (m *schemaMigration) addAlterTableStatements(ctx *sql.Context, td diff.TableDelta) error {
+	fromSch, toSch, err := td.GetSchemas(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot retrieve schema for table %s, cause: %s", td.ToName, err.Error())
+	}
+	tableName := td.ToName.Name
+	collation := sql.CollationID(toSch.GetCollation())
+	add := func(stmt string) {
+		m.add(phaseAlterTables, SchemaObjectTable, tableName, stmt)
+	}
+
+	// checks and indexes that change are dropped before the columns they're defined over
+	checkDiffs := diff.DiffSchChecks(fromSch, toSch)
+	for _, cd := range checkDiffs {
+		if cd.DiffType == diff.SchDiffRemoved || cd.DiffType == diff.SchDiffModified {
+			add(AlterTableDropCheckStmt(m.formatter, tableName, cd.From.Name()))
+		}
+	}
+	idxDiffs := diff.DiffSchIndexes(fromSch, toSch)
+	for _, idxDiff := range idxDiffs {
+		if idxDiff.DiffType == diff.SchDiffRemoved || idxDiff.DiffType == diff.SchDiffModified {
+			add(AlterTableDropIndexStmt(m.formatter, tableName, idxDiff.From))
+		}
+	}
+
+	colDiffs, unionTags := pairColumnsByName(diff.DiffSchColumns(fromSch, toSch))
+	liveCols := make(map[string]struct{})
+	for _, col := range fromSch.GetAllCols().GetColumns() {
+		liveCols[strings.ToLower(col.Name)] = struct{}{}
+	}
+	for _, tag := range unionTags {
+		if cd := colDiffs[tag]; cd.DiffType == diff.SchDiffRemoved {
+			add(AlterTableDropColStmt(m.formatter, tableName, cd.Old.Name))
+			delete(liveCols, strings.ToLower(cd.Old.Name))
+		}
+	}
+
+	// the primary key is dropped before its columns are modified, since primary key columns can't be nullable
+	pkChanged := primaryKeyChanged(fromSch, toSch, colDiffs)
+	if pkChanged && fromSch.GetPKCols().Size() > 0 {
+		add(AlterTableDropPks(m.formatter, tableName))
+	}
+
+	var colRenames [][2]string
+	for _, tag := range unionTags {
+		if cd := colDiffs[tag]; cd.DiffType == diff.SchDiffModified && cd.Old.Name != cd.New.Name {
+			colRenames = append(colRenames, [2]string{cd.Old.Name, cd.New.Name})
+		}
+	}
+	for _, rename := range orderRenames(colRenames, liveCols) {
+		add(AlterTableRenameColStmt(m.formatter, tableName, rename[0], rename[1]))
+	}
+
+	// columns are modified and added in the order of |toSch|. The fewest columns are moved to the positions they have
+	// in |toSch|, each after the column that precedes it, and columns added anywhere but the end are placed the same way.
+	toCols := toSch.GetAllCols().GetColumns()
+	toPos := make(map[uint64]int, len(toCols))
+	for i, col := range toCols {
+		toPos[col.Tag] = i
+	}
+	byNewTag := make(map[uint64]diff.ColumnDifference, len(toCols))
+	var kept []int
+	for _, col := range fromSch.GetAllCols().GetColumns() {
+		if cd, ok := colDiffs[col.Tag]; ok && cd.New != nil {
+			byNewTag[cd.New.Tag] = cd
+			kept = append(kept, toPos[cd.New.Tag])
+		}
+	}
+	unmoved := longestIncreasing(kept)
+	lastKept := -1
+	for _, pos := range kept {
+		lastKept = max(lastKept, pos)
+	}
+	for i, col := range toCols {
+		position := " FIRST"
+		if i > 0 {
+			position = " AFTER " + m.formatter.QuoteIdentifier(toCols[i-1].Name)
+		}
+		newDef := GenerateCreateTableColumnDefinition(ctx, m.formatter, col, collation)
+
+		cd, ok := byNewTag[col.Tag]
+		if !ok {
+			if i < lastKept {
+				newDef += position
+			}
+			add(AlterTableAddColStmt(m.formatter, tableName, newDef))
+			continue
+		}
+
+		oldCol := *cd.Old
+		oldCol.Name = col.Name
+		oldDef := GenerateCreateTableColumnDefinition(ctx, m.formatter, oldCol, collation)
+		if _, ok := unmoved[i]; !ok {
+			add(AlterTableModifyColStmt(m.formatter, tableName, newDef+position))
+		} else if oldDef != newDef {
+			add(AlterTableModifyColStmt(m.formatter, tableName, newDef))
+		}
+	}
+
+	if pkChanged && toSch.GetPKCols().Size() > 0 {
+		pkCols := toSch.GetPKCols().GetColumnNames()
+		for i := range pkCols {
+			pkCols[i] = m.formatter.QuoteIdentifier(pkCols[i])
+		}
+		add(AlterTableAddPrimaryKeys(m.formatter, tableName, pkCols))
+	}
+
+	for _, idxDiff := range idxDiffs {
+		if idxDiff.DiffType == diff.SchDiffAdded || idxDiff.DiffType == diff.SchDiffModified {
+			if def, ok := GenerateCreateTableIndexDefinition(m.formatter, idxDiff.To); ok {
+				add(fmt.Sprintf("ALTER TABLE %s ADD %s;", m.formatter.QuoteIdentifier(tableName), strings.TrimSpace(def)))
+			}
+		}
+	}
+	for _, cd := range checkDiffs {
+		if cd.DiffType == diff.SchDiffAdded || cd.DiffType == diff.SchDiffModified {
+			add(AlterTableAddCheckStmt(m.formatter, tableName, cd.To))
+		}
+	}
+
+	if fromSch.GetCollation() != toSch.GetCollation() {
+		add(AlterTableCollateStmt(m.formatter, tableName, fromSch.GetCollation(), toSch.GetCollation()))
+	}
+	if fromSch.GetComment() != toSch.GetComment() {
+		add(fmt.Sprintf("ALTER TABLE %s COMMENT=%s;", m.formatter.QuoteIdentifier(tableName), QuoteComment(toSch.GetComment())))
+	}
+	if fromSch.GetTargetRowSize() != toSch.GetTargetRowSize() {
+		add(fmt.Sprintf("ALTER TABLE %s TARGET_ROW_SIZE=%d;", m.formatter.QuoteIdentifier(tableName), toSch.GetTargetRowSize()))
+	}
+
+	return nil
+}
+
+func (m *schemaMigration) addDatabaseCollationStatement(ctx *sql.Context, fromRoot, toRoot doltdb.RootValue, dbName string) error {
+	fromColl, err := fromRoot.GetCollation(ctx)
+	if err != nil {
+		return err
+	}
+	toColl, err := toRoot.GetCollation(ctx)
+	if err != nil {
+		return err
+	}
+	m.add(phaseAlterDatabase, SchemaObjectDatabase, dbName, AlterDatabaseCollateStmt(m.formatter, dbName, fromColl, toColl))
+	return nil
+}
+
+func (m *schemaMigration) dropForeignKey(fk doltdb.ForeignKey) {
+	m.add(phaseDropForeignKeys, SchemaObjectTable, fk.TableName.Name, AlterTableDropForeignKeyStmt(m.formatter, fk.TableName, fk.Name))
+}
+
+func (m *schemaMigration) addForeignKey(fk doltdb.ForeignKey, toSchemas map[doltdb.TableName]schema.Schema) {
+	def := GenerateCreateTableForeignKeyDefinition(m.formatter, fk, toSchemas[fk.TableName], toSchemas[fk.ReferencedTableName])
+	stmt := fmt.Sprintf("ALTER TABLE %s ADD %s;", QuoteTableName(fk.TableName), strings.TrimSpace(def))
+	m.add(phaseAddForeignKeys, SchemaObjectTable, fk.TableName.Name, stmt)
+}
+
+// addSchemaObjectStatements adds the statements that drop the schema objects of |fromObjects| that don't exist in
+// |toObjects| or whose definition changed, and create the schema objects of |toObjects| that are new or changed.
+func (m *schemaMigration) addSchemaObjectStatements(fromObjects, toObjects []SchemaObject) {
+	key := func(obj SchemaObject) string {
+		return obj.Type + "." + strings.ToLower(obj.Name)
+	}
+	fromByKey := make(map[string]SchemaObject)
+	for _, obj := range fromObjects {
+		fromByKey[key(obj)] = obj
+	}
+	toByKey := make(map[string]SchemaObject)
+	for _, obj := range toObjects {
+		toByKey[key(obj)] = obj
+	}
+
+	var dropped, created []SchemaObject
+	for k, obj := range fromByKey {
+		if to, ok := toByKey[k]; !ok || to.CreateStatement != obj.CreateStatement {
+			dropped = append(dropped, obj)
+		}
+	}
+	for k, obj := range toByKey {
+		if from, ok := fromByKey[k]; !ok || from.CreateStatement != obj.CreateStatement {
+			created = append(created, obj)
+		}
+	}
+
+	dropped = sortSchemaObjects(dropped)
+	for i := len(dropped) - 1; i >= 0; i-- {
+		obj := dropped[i]
+		m.add(phaseDropObjects, obj.Type, obj.Name, fmt.Sprintf("DROP %s %s;", strings.ToUpper(obj.Type), m.formatter.QuoteIdentifier(obj.Name)))
+	}
+	for _, obj := range sortSchemaObjects(created) {
+		stmt := strings.TrimSpace(obj.CreateStatement)
+		if !strings.HasSuffix(stmt, ";") {
+			stmt += ";"
+		}
+		m.add(phaseCreateObjects, obj.Type, obj.Name, stmt)
+	}
+}
+
+// sortSchemaObjects returns |objs| in the order in which they can be created: by type, in the order of
+// schemaObjectOrder, and then by name, except that views are created after the views they select from.
+func sortSchemaObjects(objs []SchemaObject) []SchemaObject {
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].Type != objs[j].Type {
+			return schemaObjectOrder[objs[i].Type] < schemaObjectOrder[objs[j].Type]
+		}
+		return strings.ToLower(objs[i].Name) < strings.ToLower(objs[j].Name)
+	})
+
+	var views []SchemaObject
+	for _, obj := range objs {
+		if obj.Type == SchemaObjectView {
+			views = append(views, obj)
+		}
+	}
+	if len(views) < 2 {
+		return objs
+	}
+
+	// views are ordered depth first by the other views whose names they reference
+	refs := make(map[string]*regexp.Regexp, len(views))
+	for _, view := range views {
+		refs[strings.ToLower(view.Name)] = nameReference(view.Name)
+	}
+	sorted := make([]SchemaObject, 0, len(objs))
+	visited := make(map[string]bool)
+	var visit func(view SchemaObject)
+	visit = func(view SchemaObject) {
+		name := strings.ToLower(view.Name)
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		for _, other := range views {
+			if !strings.EqualFold(other.Name, view.Name) && refs[strings.ToLower(other.Name)].MatchString(view.CreateStatement) {
+				visit(other)
+			}
+		}
+		sorted = append(sorted, view)
+	}
+	for _, obj := range objs {
+		if obj.Type == SchemaObjectView {
+			visit(obj)
+		} else {
+			sorted = append(sorted, obj)
+		}
+	}
+	return sorted
+}
+
+// nameReference returns a regular expression which matches statements that contain the identifier |name|.
+func nameReference(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(^|[^a-z0-9_$])` + regexp.QuoteMeta(name) + `($|[^a-z0-9_$])`)
+}
+
+// longestIncreasing returns the values of the longest increasing subsequence of |positions|.
+func longestIncreasing(positions []int) map[int]struct{} {
+	lengths := make([]int, len(positions))
+	prev := make([]int, len(positions))
+	end := -1
+	for i := range positions {
+		lengths[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if positions[j] < positions[i] && lengths[j]+1 > lengths[i] {
+				lengths[i], prev[i] = lengths[j]+1, j
+			}
+		}
+		if end < 0 || lengths[i] > lengths[end] {
+			end = i
+		}
+	}
+	seq := make(map[int]struct{})
+	for i := end; i >= 0; i = prev[i] {
+		seq[positions[i]] = struct{}{}
+	}
+	return seq
+}
+
+// orderRenames returns the renames of |renames|, from their old to their new name, in an order in which each is to
+// a name that isn't in use. |live| are the names in use before the renames, lower cased. When renames form a cycle,
+// such as when two columns swap names, one of them is first renamed to a temporary name.
+func orderRenames(renames [][2]string, live map[string]struct{}) [][2]string {
+	inUse := make(map[string]struct{}, len(live))
+	for name := range live {
+		inUse[name] = struct{}{}
+	}
+	reserved := make(map[string]struct{})
+	for _, r := range renames {
+		reserved[strings.ToLower(r[1])] = struct{}{}
+	}
+
+	var ordered [][2]string
+	pending := append([][2]string(nil), renames...)
+	rename := func(from, to string) {
+		ordered = append(ordered, [2]string{from, to})
+		delete(inUse, strings.ToLower(from))
+		inUse[strings.ToLower(to)] = struct{}{}
+	}
+
+	for len(pending) > 0 {
+		next := -1
+		for i, r := range pending {
+			// renames that only change the case of a name don't conflict with the name itself
+			if _, ok := inUse[strings.ToLower(r[1])]; !ok || strings.EqualFold(r[0], r[1]) {
+				next = i
+				break
+			}
+		}
+
+		if next >= 0 {
+			rename(pending[next][0], pending[next][1])
+			pending = append(pending[:next], pending[next+1:]...)
+			continue
+		}
+
+		// every remaining rename is to a name in use by another, so one is moved out of the way
+		tmp := pending[0][0] + "_tmp"
+		for i := 1; ; i++ {
+			_, used := inUse[strings.ToLower(tmp)]
+			_, taken := reserved[strings.ToLower(tmp)]
+			if !used && !taken {
+				break
+			}
+			tmp = fmt.Sprintf("%s_tmp%d", pending[0][0], i)
+		}
+		rename(pending[0][0], tmp)
+		pending[0][0] = tmp
+	}
+
+	return ordered
+}
+
+// getMigratedForeignKeys returns the foreign keys of the tables of |root| that are part of the database's schema.
+func getMigratedForeignKeys(ctx *sql.Context, root doltdb.RootValue) ([]doltdb.ForeignKey, error) {
+	fkc, err := root.GetForeignKeyCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var fks []doltdb.ForeignKey
+	for _, fk := range fkc.AllKeys() {
+		if isMigratedTable(fk.TableName.Name) {
+			fks = append(fks, fk)
+		}
+	}
+	return fks, nil
+}
+
+// pairColumnsByName returns the column differences |colDiffs|, ordered by |unionTags|, in which a column that's removed
+// and a column with the same name that's added, which happens when the same column is added on separate branches, are
+// matched as a modification of the column. The column is then kept, along with its data, rather than dropped and
+// added back.
+func pairColumnsByName(colDiffs map[uint64]diff.ColumnDifference, unionTags []uint64) (map[uint64]diff.ColumnDifference, []uint64) {
+	added := make(map[string]diff.ColumnDifference)
+	for _, tag := range unionTags {
+		if cd := colDiffs[tag]; cd.DiffType == diff.SchDiffAdded {
+			added[strings.ToLower(cd.New.Name)] = cd
+		}
+	}
+
+	paired := make(map[uint64]struct{})
+	for _, tag := range unionTags {
+		cd := colDiffs[tag]
+		if cd.DiffType != diff.SchDiffRemoved {
+			continue
+		}
+		if to, ok := added[strings.ToLower(cd.Old.Name)]; ok {
+			colDiffs[tag] = diff.ColumnDifference{DiffType: diff.SchDiffModified, Tag: tag, Old: cd.Old, New: to.New}
+			paired[to.Tag] = struct{}{}
+		}
+	}
+	if len(paired) == 0 {
+		return colDiffs, unionTags
+	}
+
+	tags := make([]uint64, 0, len(unionTags)-len(paired))
+	for _, tag := range unionTags {
+		if _, ok := paired[tag]; !ok {
+			tags = append(tags, tag)
+		}
+	}
+	return colDiffs, tags
+}
+
+// primaryKeyChanged returns whether the columns of the primary key of |toSch| differ from those of |fromSch|, given
+// the differences between their columns |colDiffs|. Changes to the definitions of the columns don't change the primary
+// key.
+func primaryKeyChanged(fromSch, toSch schema.Schema, colDiffs map[uint64]diff.ColumnDifference) bool {
+	fromPks, toPks := fromSch.GetPKCols().GetColumns(), toSch.GetPKCols().GetColumns()
+	if len(fromPks) != len(toPks) {
+		return true
+	}
+	for i, col := range fromPks {
+		cd, ok := colDiffs[col.Tag]
+		if !ok || cd.New == nil || cd.New.Name != toPks[i].Name {
+			return true
+		}
+	}
+	return false
+}
+
+// usesColumns returns whether any of |tags| are in |cols|.
+func usesColumns(cols map[uint64]struct{}, tags []uint64) bool {
+	for _, tag := range tags {
+		if _, ok := cols[tag]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isMigratedTable returns whether the table named |name| is part of a database's schema, rather than a system table
+// or an internal table of a full-text index.
+func isMigratedTable(name string) bool {
+	return !doltdb.HasDoltPrefix(name) && !doltdb.HasDoltCIPrefix(name) && !doltdb.IsFullTextTable(name)
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlutil
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// NestedQueryContext returns a copy of |ctx| for running queries inside of the query of |ctx|. Closing the iter of a
+// query ends the query of its context in the process list, which would cancel the outer query, so the returned context
+// doesn't track its queries.
+func NestedQueryContext(ctx *sql.Context) *sql.Context {
+	nested := ctx.WithContext(ctx.Context)
+	nested.ApplyOpts(sql.WithProcessList(sql.EmptyProcessList{}))
+	return nested
+}
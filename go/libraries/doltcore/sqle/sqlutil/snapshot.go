@@ -0,0 +1,107 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/overrides"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// ResultSnapshot reads all the rows of |queryResult| and returns a hash of them, which doesn't depend on their order.
+// It's the snapshot of a query's results checked by the expected_snapshot assertion of dolt_tests.
+func ResultSnapshot(sqlCtx *sql.Context, queryResult sql.RowIter) (string, error) {
+	var rowHashes []hash.Hash
+	var buf []byte
+	for {
+		row, err := queryResult.Next(sqlCtx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		buf = buf[:0]
+		for _, v := range row {
+			if v == nil {
+				buf = append(buf, 0)
+				continue
+			}
+			str, err := snapshotValueString(sqlCtx, v)
+			if err != nil {
+				return "", err
+			}
+			buf = append(buf, 1)
+			buf = binary.AppendUvarint(buf, uint64(len(str)))
+			buf = append(buf, str...)
+		}
+		rowHashes = append(rowHashes, hash.Of(buf))
+	}
+
+	sort.Slice(rowHashes, func(i, j int) bool {
+		return rowHashes[i].Less(rowHashes[j])
+	})
+	all := make([]byte, 0, len(rowHashes)*hash.ByteLen)
+	for _, h := range rowHashes {
+		all = append(all, h[:]...)
+	}
+	return hash.Of(all).String(), nil
+}
+
+// snapshotValueString returns the string a non-NULL value is hashed as in a snapshot.
+func snapshotValueString(sqlCtx *sql.Context, v interface{}) (string, error) {
+	v, err := sql.UnwrapAny(sqlCtx, v)
+	if err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case decimal.Decimal:
+		return v.String(), nil
+	case sql.JSONWrapper:
+		return types.JsonToMySqlString(sqlCtx, v)
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// BindQuery parses and binds |query|, without analyzing it.
+func BindQuery(ctx *sql.Context, catalog sql.Catalog, query string) (sql.Node, error) {
+	builder := planbuilder.New(ctx, catalog, nil)
+
+	parser := overrides.ParserFromContext(ctx)
+	parsed, _, _, err := parser.Parse(ctx, query, false)
+	if err != nil {
+		return nil, err
+	}
+
+	node, _, err := builder.BindOnly(parsed, query, nil)
+	return node, err
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
+)
+
+// RewriteSystemTime supports the SQL:2011 FOR SYSTEM_TIME clauses which select the versions of a table's rows over a
+// period of time: ALL, FROM ... TO ..., BETWEEN ... AND ... and CONTAINED IN (...). Tables of the first statement of
+// |query| with these clauses are rewritten to calls of the dolt_system_time table function, which reads the versions
+// from the first-parent commit history of HEAD, and the statement is returned with the rest of |query|. FOR
+// SYSTEM_TIME AS OF is the same as AS OF, and is left as is. Queries without these clauses, or which can't be parsed,
+// are returned unchanged, so the engine reports any parse errors.
+func RewriteSystemTime(ctx context.Context, query string, options ast.ParserOptions) (string, error) {
+	if !strings.Contains(strings.ToLower(query), "system_time") {
+		return query, nil
+	}
+	stmt, _, remainder, err := sql.DefaultMySQLParser.ParseWithOptions(ctx, query, ';', true, options)
+	if err != nil {
+		return query, nil
+	}
+	rewritten, err := RewriteSystemTimeStatement(stmt)
+	if err != nil || !rewritten {
+		return query, err
+	}
+	if remainder != "" {
+		return ast.String(stmt) + ";" + remainder, nil
+	}
+	return ast.String(stmt), nil
+}
+
+// RewriteSystemTimeStatement replaces the tables of |stmt| with a FOR SYSTEM_TIME period with calls of
+// dolt_system_time, and returns whether it replaced any.
+func RewriteSystemTimeStatement(stmt ast.Statement) (bool, error) {
+	rewritten := false
+	err := ast.Walk(func(node ast.SQLNode) (bool, error) {
+		var err error
+		switch n := node.(type) {
+		case ast.TableExprs:
+			for i := range n {
+				if n[i], err = systemTimeTableExpr(n[i], &rewritten); err != nil {
+					return false, err
+				}
+			}
+		case *ast.JoinTableExpr:
+			if n.LeftExpr, err = systemTimeTableExpr(n.LeftExpr, &rewritten); err != nil {
+				return false, err
+			}
+			if n.RightExpr, err = systemTimeTableExpr(n.RightExpr, &rewritten); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, stmt)
+	return rewritten, err
+}
+
+// systemTimeTableExpr returns the call of dolt_system_time that replaces |te|, if it's a table with a FOR SYSTEM_TIME
+// period, and sets |rewritten|, or returns |te| otherwise.
+func systemTimeTableExpr(te ast.TableExpr, rewritten *bool) (ast.TableExpr, error) {
+	ate, ok := te.(*ast.AliasedTableExpr)
+	if !ok || ate.AsOf == nil || ate.AsOf.Time != nil {
+		return te, nil
+	}
+	tn, ok := ate.Expr.(ast.TableName)
+	if !ok {
+		return nil, fmt.Errorf("FOR SYSTEM_TIME is only supported on tables")
+	}
+	if !tn.DbQualifier.IsEmpty() || !tn.SchemaQualifier.IsEmpty() {
+		return nil, fmt.Errorf("FOR SYSTEM_TIME is only supported on tables of the current database, but %s is qualified",
+			tn.Name.String())
+	}
+
+	var period string
+	switch asOf := ate.AsOf; {
+	case asOf.All:
+		period = dtablefunctions.SystemTimeAll
+	case asOf.StartInclusive && asOf.EndInclusive:
+		period = dtablefunctions.SystemTimeContained
+	case asOf.EndInclusive:
+		period = dtablefunctions.SystemTimeBetween
+	default:
+		period = dtablefunctions.SystemTimeFrom
+	}
+	exprs := ast.SelectExprs{
+		&ast.AliasedExpr{Expr: ast.NewStrVal([]byte(tn.Name.String()))},
+		&ast.AliasedExpr{Expr: ast.NewStrVal([]byte(period))},
+	}
+	if period != dtablefunctions.SystemTimeAll {
+		exprs = append(exprs, &ast.AliasedExpr{Expr: ate.AsOf.Start}, &ast.AliasedExpr{Expr: ate.AsOf.End})
+	}
+
+	alias := ate.As
+	if alias.IsEmpty() {
+		alias = tn.Name
+	}
+	*rewritten = true
+	return &ast.TableFuncExpr{Name: "dolt_system_time", Alias: alias, Exprs: exprs}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteSystemTime(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "select * from t for system_time all",
+			expected: "select * from dolt_system_time('t', 'all') as t",
+		},
+		{
+			query:    "select * from t for system_time from '2020-01-01' to now() as h",
+			expected: "select * from dolt_system_time('t', 'from', '2020-01-01', now()) as h",
+		},
+		{
+			query:    "select * from t for system_time between @a and @b",
+			expected: "select * from dolt_system_time('t', 'between', @a, @b) as t",
+		},
+		{
+			query:    "select * from t for system_time contained in ('2020-01-01', '2021-01-01')",
+			expected: "select * from dolt_system_time('t', 'contained', '2020-01-01', '2021-01-01') as t",
+		},
+		{
+			query:    "select * from a join b for system_time all on a.id = b.id",
+			expected: "select * from a join dolt_system_time('b', 'all') as b on a.id = b.id",
+		},
+		{
+			query:    "select * from (select * from t for system_time all) sq",
+			expected: "select * from (select * from dolt_system_time('t', 'all') as t) as sq",
+		},
+		{
+			query:    "select * from t for system_time all; select 1",
+			expected: "select * from dolt_system_time('t', 'all') as t; select 1",
+		},
+		{
+			query:    "select * from t for system_time as of '2020-01-01'",
+			expected: "select * from t for system_time as of '2020-01-01'",
+		},
+		{
+			query:    "select * from t",
+			expected: "select * from t",
+		},
+		{
+			query:    "select * from system_time where",
+			expected: "select * from system_time where",
+		},
+	}
+
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			rewritten, err := RewriteSystemTime(ctx, test.query, ast.ParserOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, rewritten)
+		})
+	}
+
+	_, err := RewriteSystemTime(ctx, "select * from db.t for system_time all", ast.ParserOptions{})
+	assert.Error(t, err)
+}
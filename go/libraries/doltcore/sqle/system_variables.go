@@ -223,6 +223,20 @@ var DoltSystemVariables = []sql.SystemVariable{
 		Type:    types.NewSystemBoolType("dolt_dont_merge_json"),
 		Default: int8(0),
 	},
+	&sql.MysqlSystemVariable{ // If true, concurrent changes to different lines of the same TEXT value are merged.
+		Name:    "dolt_merge_text_lines",
+		Dynamic: true,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Type:    types.NewSystemBoolType("dolt_merge_text_lines"),
+		Default: int8(0),
+	},
+	&sql.MysqlSystemVariable{ // If true, merges, cherry-picks and rebases reuse recorded conflict resolutions.
+		Name:    dsess.DoltRerereEnabled,
+		Dynamic: true,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Type:    types.NewSystemBoolType(dsess.DoltRerereEnabled),
+		Default: int8(0),
+	},
 	&sql.MysqlSystemVariable{
 		Name:    "dolt_optimize_json",
 		Dynamic: true,
@@ -527,6 +541,20 @@ func AddDoltSystemVariables() {
 			Type:    types.NewSystemBoolType("dolt_dont_merge_json"),
 			Default: int8(0),
 		},
+		&sql.MysqlSystemVariable{ // If true, concurrent changes to different lines of the same TEXT value are merged.
+			Name:    "dolt_merge_text_lines",
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+			Type:    types.NewSystemBoolType("dolt_merge_text_lines"),
+			Default: int8(0),
+		},
+		&sql.MysqlSystemVariable{ // If true, merges, cherry-picks and rebases reuse recorded conflict resolutions.
+			Name:    dsess.DoltRerereEnabled,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+			Type:    types.NewSystemBoolType(dsess.DoltRerereEnabled),
+			Default: int8(0),
+		},
 		&sql.MysqlSystemVariable{
 			Name:    dsess.DoltStatsEnabled,
 			Dynamic: true,
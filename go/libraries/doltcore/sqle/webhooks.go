@@ -0,0 +1,675 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	// WebhookEventCommit is the event of a branch moving to a new commit, or to a commit of another branch
+	WebhookEventCommit = "commit"
+	// WebhookEventMerge is the event of a branch moving to a new merge commit
+	WebhookEventMerge = "merge"
+	// WebhookEventBranchCreated is the event of a branch being created
+	WebhookEventBranchCreated = "branch_created"
+	// WebhookEventBranchDeleted is the event of a branch being deleted
+	WebhookEventBranchDeleted = "branch_deleted"
+	// WebhookEventTagCreated is the event of a tag being created
+	WebhookEventTagCreated = "tag_created"
+
+	// WebhookEventHeader is the header of a webhook request which holds the event of the payload
+	WebhookEventHeader = "X-Dolt-Event"
+	// WebhookDeliveryHeader is the header of a webhook request which holds the id of the delivery, which is the same
+	// for every attempt to deliver a payload
+	WebhookDeliveryHeader = "X-Dolt-Delivery"
+	// WebhookSignatureHeader is the header of a webhook request which holds the signature of the payload, when the
+	// webhook has a secret
+	WebhookSignatureHeader = "X-Dolt-Signature-256"
+)
+
+const (
+	webhookStatusPending   = "pending"
+	webhookStatusDelivered = "delivered"
+	webhookStatusFailed    = "failed"
+
+	webhookThread = "webhooks"
+	// webhookMaxAttempts is the number of attempts to deliver a payload before its delivery fails
+	webhookMaxAttempts = 8
+	// webhookRetryDelay is the delay before the first retry of a delivery, which doubles with each retry
+	webhookRetryDelay    = time.Second
+	webhookMaxRetryDelay = 10 * time.Minute
+	webhookTimeout       = 10 * time.Second
+	// webhookIdleWait is how long the delivery thread sleeps when there's nothing to deliver and it isn't woken up
+	webhookIdleWait = time.Hour
+	// webhookMaxFinishedDeliveries is the number of delivered and failed deliveries kept for dolt_webhook_deliveries
+	webhookMaxFinishedDeliveries = 1000
+)
+
+// Webhook is an HTTP endpoint which is sent a JSON payload when the branches and tags of the databases of a server
+// are updated.
+type Webhook struct {
+	Name string
+	URL  string
+	// Refs are the path.Match patterns of the refs whose updates are delivered, such as refs/heads/main or
+	// refs/tags/*, or nil for all branches and tags.
+	Refs []string
+	// Secret is the key the payloads are signed with using HMAC-SHA256, or empty if they aren't signed.
+	Secret string
+}
+
+func (wh Webhook) matches(rf ref.DoltRef) bool {
+	if len(wh.Refs) == 0 {
+		return true
+	}
+	for _, pattern := range wh.Refs {
+		if ok, _ := path.Match(pattern, rf.String()); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookPayload is the JSON payload POSTed to webhooks for an update of a branch or tag.
+type WebhookPayload struct {
+	Event    string `json:"event"`
+	Database string `json:"database"`
+	Ref      string `json:"ref"`
+	// OldHash is the commit the ref pointed to before the update, or empty if it was created
+	OldHash string `json:"old_hash"`
+	// NewHash is the commit the ref points to after the update, or empty if it was deleted
+	NewHash string `json:"new_hash"`
+	// Author is the author of the new commit, or the tagger of a new tag
+	Author  *WebhookAuthor `json:"author"`
+	Message string         `json:"message"`
+	// Tables are the tables changed between the old and the new commit of a branch
+	Tables []WebhookTableChange `json:"tables"`
+}
+
+// WebhookAuthor is the author of a commit or the tagger of a tag in a WebhookPayload.
+type WebhookAuthor struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+}
+
+// WebhookTableChange is a table changed by an update of a branch in a WebhookPayload.
+type WebhookTableChange struct {
+	Table string `json:"table"`
+	// Change is one of added, dropped, renamed or modified
+	Change string `json:"change"`
+}
+
+// WebhookSignature returns the value of the WebhookSignatureHeader of a request with the body |payload| to a webhook
+// with the secret |secret|, which is the hex encoded HMAC-SHA256 of the payload prefixed by sha256=.
+func WebhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDispatcher delivers the updates of the branches and tags of a server's databases to webhooks. A commit hook on
+// each database queues a delivery for each webhook whose refs match an updated ref, and a background thread POSTs the
+// payloads, retrying failed deliveries with exponential backoff. Deliveries are persisted to a directory as they're
+// queued and updated, so pending ones are resumed when the server restarts.
+type WebhookDispatcher struct {
+	// refHeads are the last commits seen of the branches and tags of each database
+	*refHeads
+	dir      string
+	webhooks []Webhook
+	client   *http.Client
+	wake     chan struct{}
+
+	mu         sync.Mutex
+	deliveries map[string]*webhookDelivery
+}
+
+// webhookDelivery is the persisted delivery of a payload to a webhook.
+type webhookDelivery struct {
+	ID            string          `json:"id"`
+	Webhook       string          `json:"webhook"`
+	URL           string          `json:"url"`
+	Database      string          `json:"database"`
+	Event         string          `json:"event"`
+	Ref           string          `json:"ref"`
+	OldHash       string          `json:"old_hash,omitempty"`
+	NewHash       string          `json:"new_hash,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	ResponseCode  int             `json:"response_code,omitempty"`
+	LastError     string          `json:"last_error,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+}
+
+func (wd *webhookDelivery) finished() bool {
+	return wd.Status != webhookStatusPending
+}
+
+// NewWebhookDispatcher creates a WebhookDispatcher for |webhooks| which persists its deliveries to |dir|, loading the
+// deliveries already in it. Pending deliveries to webhooks which are no longer configured fail.
+func NewWebhookDispatcher(dir string, webhooks []Webhook) (*WebhookDispatcher, error) {
+	d := &WebhookDispatcher{
+		dir:        dir,
+		webhooks:   webhooks,
+		client:     &http.Client{Timeout: webhookTimeout},
+		wake:       make(chan struct{}, 1),
+		deliveries: make(map[string]*webhookDelivery),
+	}
+	d.refHeads = newRefHeads(true, func(name string, _ *doltdb.DoltDB) doltdb.CommitHook {
+		return &WebhookHook{dispatcher: d, dbName: name}
+	})
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var wd webhookDelivery
+		if err = json.Unmarshal(data, &wd); err != nil {
+			logrus.Warnf("webhooks: skipping unreadable delivery %s: %v", e.Name(), err)
+			continue
+		}
+		d.deliveries[wd.ID] = &wd
+		if wd.finished() {
+			continue
+		}
+		if wh, ok := d.webhook(wd.Webhook); ok {
+			wd.URL = wh.URL
+		} else {
+			wd.Status = webhookStatusFailed
+			wd.LastError = fmt.Sprintf("webhook %s is no longer configured", wd.Webhook)
+			wd.NextAttemptAt = time.Time{}
+			wd.UpdatedAt = time.Now()
+			if err = d.persist(&wd); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return d, nil
+}
+
+func (d *WebhookDispatcher) webhook(name string) (Webhook, bool) {
+	for _, wh := range d.webhooks {
+		if wh.Name == name {
+			return wh, true
+		}
+	}
+	return Webhook{}, false
+}
+
+// RunBackgroundThread starts the thread which delivers the queued payloads during engine initialization.
+func (d *WebhookDispatcher) RunBackgroundThread(bThreads BackgroundThreads) error {
+	return bThreads.Add(webhookThread, func(ctx context.Context) {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-d.wake:
+			case <-timer.C:
+			}
+			wait := d.deliverDue(ctx)
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(wait)
+		}
+	})
+}
+
+// deliverDue attempts the pending deliveries whose next attempt is due, oldest first, and returns how long to wait
+// until the next attempt of the deliveries still pending.
+func (d *WebhookDispatcher) deliverDue(ctx context.Context) time.Duration {
+	now := time.Now()
+	d.mu.Lock()
+	var due []webhookDelivery
+	for _, wd := range d.deliveries {
+		if !wd.finished() && !wd.NextAttemptAt.After(now) {
+			due = append(due, *wd)
+		}
+	}
+	d.mu.Unlock()
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].CreatedAt.Before(due[j].CreatedAt)
+	})
+
+	for i := range due {
+		wd := &due[i]
+		wh, ok := d.webhook(wd.Webhook)
+		if !ok {
+			continue
+		}
+		code, err := d.post(ctx, wh, wd)
+		if ctx.Err() != nil {
+			// the server is shutting down, the delivery is attempted again when it's restarted
+			break
+		}
+		wd.Attempts++
+		wd.ResponseCode = code
+		wd.UpdatedAt = time.Now()
+		if err == nil {
+			wd.Status = webhookStatusDelivered
+			wd.LastError = ""
+			wd.NextAttemptAt = time.Time{}
+		} else {
+			wd.LastError = err.Error()
+			if wd.Attempts >= webhookMaxAttempts {
+				wd.Status = webhookStatusFailed
+				wd.NextAttemptAt = time.Time{}
+				logrus.Warnf("webhooks: giving up delivering %s of %s to %s after %d attempts: %v", wd.Event, wd.Ref, wd.Webhook, wd.Attempts, err)
+			} else {
+				wd.NextAttemptAt = wd.UpdatedAt.Add(webhookBackoff(wd.Attempts))
+			}
+		}
+		d.update(wd)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	wait := webhookIdleWait
+	for _, wd := range d.deliveries {
+		if !wd.finished() {
+			if until := time.Until(wd.NextAttemptAt); until < wait {
+				wait = max(until, 0)
+			}
+		}
+	}
+	return wait
+}
+
+// webhookBackoff returns the delay before the next attempt of a delivery which failed |attempts| times.
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookRetryDelay << (attempts - 1)
+	if delay <= 0 || delay > webhookMaxRetryDelay {
+		return webhookMaxRetryDelay
+	}
+	return delay
+}
+
+// post sends the payload of |wd| to |wh|, returning the status code of the response, if any, and an error if the
+// payload wasn't accepted.
+func (d *WebhookDispatcher) post(ctx context.Context, wh Webhook, wd *webhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(wd.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, wd.Event)
+	req.Header.Set(WebhookDeliveryHeader, wd.ID)
+	if wh.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, WebhookSignature(wh.Secret, wd.Payload))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// enqueue persists a pending delivery of |payload| to each webhook matching |rf|, and wakes up the delivery thread.
+func (d *WebhookDispatcher) enqueue(rf ref.DoltRef, payload WebhookPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	queued := false
+	for _, wh := range d.webhooks {
+		if !wh.matches(rf) {
+			continue
+		}
+		wd := &webhookDelivery{
+			ID:            uuid.NewString(),
+			Webhook:       wh.Name,
+			URL:           wh.URL,
+			Database:      payload.Database,
+			Event:         payload.Event,
+			Ref:           payload.Ref,
+			OldHash:       payload.OldHash,
+			NewHash:       payload.NewHash,
+			Payload:       data,
+			Status:        webhookStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+			NextAttemptAt: now,
+		}
+		if err = d.persist(wd); err != nil {
+			return err
+		}
+		d.mu.Lock()
+		d.deliveries[wd.ID] = wd
+		d.mu.Unlock()
+		queued = true
+	}
+	if queued {
+		select {
+		case d.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// update persists the attempt of a delivery, and removes the oldest finished deliveries once there are more than
+// webhookMaxFinishedDeliveries of them.
+func (d *WebhookDispatcher) update(wd *webhookDelivery) {
+	if err := d.persist(wd); err != nil {
+		logrus.Errorf("webhooks: failed to persist delivery %s: %v", wd.ID, err)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deliveries[wd.ID] = wd
+
+	var finished []*webhookDelivery
+	for _, e := range d.deliveries {
+		if e.finished() {
+			finished = append(finished, e)
+		}
+	}
+	if len(finished) <= webhookMaxFinishedDeliveries {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].UpdatedAt.Before(finished[j].UpdatedAt)
+	})
+	for _, e := range finished[:len(finished)-webhookMaxFinishedDeliveries] {
+		delete(d.deliveries, e.ID)
+		if err := os.Remove(d.deliveryPath(e.ID)); err != nil && !os.IsNotExist(err) {
+			logrus.Warnf("webhooks: failed to remove delivery %s: %v", e.ID, err)
+		}
+	}
+}
+
+func (d *WebhookDispatcher) deliveryPath(id string) string {
+	return filepath.Join(d.dir, id+".json")
+}
+
+// persist writes |wd| to its file in the dispatcher's directory, replacing the previous version of the file
+// atomically.
+func (d *WebhookDispatcher) persist(wd *webhookDelivery) error {
+	data, err := json.Marshal(wd)
+	if err != nil {
+		return err
+	}
+	tmp := d.deliveryPath(wd.ID) + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, d.deliveryPath(wd.ID))
+}
+
+// databaseDeliveries returns the deliveries of the updates of the refs of the database |dbName|.
+func (d *WebhookDispatcher) databaseDeliveries(dbName string) []dtables.WebhookDelivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var res []dtables.WebhookDelivery
+	for _, wd := range d.deliveries {
+		if !strings.EqualFold(wd.Database, dbName) {
+			continue
+		}
+		res = append(res, dtables.WebhookDelivery{
+			ID:            wd.ID,
+			Webhook:       wd.Webhook,
+			URL:           wd.URL,
+			Event:         wd.Event,
+			Ref:           wd.Ref,
+			OldHash:       wd.OldHash,
+			NewHash:       wd.NewHash,
+			Status:        wd.Status,
+			Attempts:      wd.Attempts,
+			ResponseCode:  wd.ResponseCode,
+			LastError:     wd.LastError,
+			CreatedAt:     wd.CreatedAt,
+			UpdatedAt:     wd.UpdatedAt,
+			NextAttemptAt: wd.NextAttemptAt,
+		})
+	}
+	return res
+}
+
+func (d *WebhookDispatcher) anyMatches(rf ref.DoltRef) bool {
+	for _, wh := range d.webhooks {
+		if wh.matches(rf) {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookHook is the CommitHook of a WebhookDispatcher for a database, which queues the deliveries of the updates of
+// its branches and tags.
+type WebhookHook struct {
+	dispatcher *WebhookDispatcher
+	dbName     string
+}
+
+var _ doltdb.CommitHook = (*WebhookHook)(nil)
+var _ dtables.WebhookDeliveryLister = (*WebhookHook)(nil)
+
+// Execute implements CommitHook, queues the deliveries of an update of a branch or tag
+func (h *WebhookHook) Execute(ctx context.Context, ds datas.Dataset, db *doltdb.DoltDB) (func(context.Context) error, error) {
+	rf, err := ref.Parse(ds.ID())
+	if err != nil || (rf.GetType() != ref.BranchRefType && rf.GetType() != ref.TagRefType) {
+		return nil, nil
+	}
+	if !h.dispatcher.anyMatches(rf) {
+		return nil, nil
+	}
+
+	payload, err := h.payload(ctx, ds, db, rf)
+	if err == nil && payload != nil {
+		err = h.dispatcher.enqueue(rf, *payload)
+	}
+	if err != nil {
+		logrus.Errorf("webhooks: failed to queue the update of %s of database %s: %v", rf.String(), h.dbName, err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// payload returns the payload of the update of |rf| to the head of |ds|, or nil if the ref didn't change.
+func (h *WebhookHook) payload(ctx context.Context, ds datas.Dataset, db *doltdb.DoltDB, rf ref.DoltRef) (*WebhookPayload, error) {
+	isTag := rf.GetType() == ref.TagRefType
+	var head hash.Hash
+	var tagMeta *datas.TagMeta
+	if addr, ok := ds.MaybeHeadAddr(); ok {
+		head = addr
+		if isTag {
+			var err error
+			if tagMeta, head, err = ds.HeadTag(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	prev, hadPrev := h.dispatcher.swap(h.dbName, rf, head)
+	if hadPrev && prev == head {
+		return nil, nil
+	}
+	payload := &WebhookPayload{
+		Database: h.dbName,
+		Ref:      rf.String(),
+		NewHash:  head.String(),
+		Tables:   []WebhookTableChange{},
+	}
+	if hadPrev {
+		payload.OldHash = prev.String()
+	}
+	if head.IsEmpty() {
+		payload.NewHash = ""
+	}
+
+	switch {
+	case isTag && head.IsEmpty():
+		// deleted tags aren't delivered
+		return nil, nil
+	case isTag:
+		if hadPrev {
+			return nil, nil
+		}
+		payload.Event = WebhookEventTagCreated
+		payload.Author = &WebhookAuthor{Name: tagMeta.Name, Email: tagMeta.Email, Date: tagMeta.Time().UTC()}
+		payload.Message = tagMeta.Description
+		return payload, nil
+	case head.IsEmpty():
+		if !hadPrev {
+			return nil, nil
+		}
+		payload.Event = WebhookEventBranchDeleted
+		return payload, nil
+	}
+
+	cm, err := webhookReadCommit(ctx, db, head)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload.Author = &WebhookAuthor{Name: meta.Author.Name, Email: meta.Author.Email, Date: meta.Author.Date.Time().UTC()}
+	payload.Message = meta.Description
+
+	if !hadPrev {
+		payload.Event = WebhookEventBranchCreated
+		return payload, nil
+	}
+	payload.Event = WebhookEventCommit
+	if cm.NumParents() > 1 {
+		payload.Event = WebhookEventMerge
+	}
+	if payload.Tables, err = webhookTableChanges(ctx, db, prev, cm); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func webhookReadCommit(ctx context.Context, db *doltdb.DoltDB, h hash.Hash) (*doltdb.Commit, error) {
+	optCm, err := db.ReadCommit(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCm.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm, nil
+}
+
+// webhookTableChanges returns the tables changed between the commit |prev| and |cm|.
+func webhookTableChanges(ctx context.Context, db *doltdb.DoltDB, prev hash.Hash, cm *doltdb.Commit) ([]WebhookTableChange, error) {
+	prevCm, err := webhookReadCommit(ctx, db, prev)
+	if err != nil {
+		return nil, err
+	}
+	fromRoot, err := prevCm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []WebhookTableChange{}
+	for _, td := range deltas {
+		var change string
+		switch {
+		case td.IsAdd():
+			change = "added"
+		case td.IsDrop():
+			change = "dropped"
+		case td.IsRename():
+			change = "renamed"
+		default:
+			changed, err := td.HasChanges()
+			if err != nil {
+				return nil, err
+			}
+			if !changed {
+				continue
+			}
+			change = "modified"
+		}
+		changes = append(changes, WebhookTableChange{Table: td.CurName(), Change: change})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Table < changes[j].Table
+	})
+	return changes, nil
+}
+
+// WebhookDeliveries implements dtables.WebhookDeliveryLister
+func (h *WebhookHook) WebhookDeliveries() []dtables.WebhookDelivery {
+	return h.dispatcher.databaseDeliveries(h.dbName)
+}
+
+func (*WebhookHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+func (*WebhookHook) ExecuteForReplicaWrite() bool {
+	return false
+}
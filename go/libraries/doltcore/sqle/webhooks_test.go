@@ -0,0 +1,193 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+type webhookRequest struct {
+	event     string
+	signature string
+	payload   WebhookPayload
+	body      []byte
+}
+
+// webhookListener is a local HTTP listener which records the webhook requests it receives, failing the first
+// |failures| of them.
+type webhookListener struct {
+	mu       sync.Mutex
+	failures int
+	requests []webhookRequest
+}
+
+func (l *webhookListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.failures > 0 {
+		l.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	req := webhookRequest{event: r.Header.Get(WebhookEventHeader), signature: r.Header.Get(WebhookSignatureHeader), body: body}
+	_ = json.Unmarshal(body, &req.payload)
+	l.requests = append(l.requests, req)
+}
+
+func (l *webhookListener) received(n int) func() bool {
+	return func() bool {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return len(l.requests) >= n
+	}
+}
+
+func TestWebhookHook(t *testing.T) {
+	ctx := context.Background()
+	listener := &webhookListener{failures: 1}
+	srv := httptest.NewServer(listener)
+	defer srv.Close()
+
+	ddb, err := doltdb.LoadDoltDB(ctx, types.Format_Default, doltdb.InMemDoltDB, filesys.LocalFS)
+	require.NoError(t, err)
+	require.NoError(t, ddb.WriteEmptyRepo(ctx, "main", "Bill Billerson", "bigbillieb@fake.horse"))
+
+	dir := t.TempDir()
+	webhooks := []Webhook{{Name: "ci", URL: srv.URL, Refs: []string{"refs/heads/*", "refs/tags/*"}, Secret: "s3cret"}}
+	dispatcher, err := NewWebhookDispatcher(dir, webhooks)
+	require.NoError(t, err)
+	hook, err := dispatcher.install(ctx, "mydb", ddb)
+	require.NoError(t, err)
+	ddb.PrependCommitHooks(ctx, hook)
+
+	bThreads := sql.NewBackgroundThreads()
+	defer bThreads.Shutdown()
+	require.NoError(t, dispatcher.RunBackgroundThread(bThreads))
+
+	// commit a new table to main
+	head := resolveWebhookTestCommit(t, ddb, "main")
+	oldHash, err := head.HashOf()
+	require.NoError(t, err)
+	root, err := head.GetRootValue(ctx)
+	require.NoError(t, err)
+	sch := createTestSchema(t)
+	tbl, err := createHooksTestTable(ddb.ValueReadWriter(), ddb.NodeStore(), sch, createTestRowData(t, ddb.ValueReadWriter(), ddb.NodeStore(), sch))
+	require.NoError(t, err)
+	root, err = root.PutTable(ctx, doltdb.TableName{Name: "test"}, tbl)
+	require.NoError(t, err)
+	_, valHash, err := ddb.WriteRootValue(ctx, root)
+	require.NoError(t, err)
+	meta, err := datas.NewCommitMeta("Bill Billerson", "bigbillieb@fake.horse", "add test")
+	require.NoError(t, err)
+	commit, err := ddb.Commit(ctx, valHash, ref.NewBranchRef("main"), meta)
+	require.NoError(t, err)
+	newHash, err := commit.HashOf()
+	require.NoError(t, err)
+
+	// the first attempt fails, and the delivery is retried
+	require.Eventually(t, listener.received(1), 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, ddb.NewBranchAtCommit(ctx, ref.NewBranchRef("feature"), commit, nil))
+	require.NoError(t, ddb.DeleteBranch(ctx, ref.NewBranchRef("feature"), nil))
+	require.NoError(t, ddb.NewTagAtCommit(ctx, ref.NewTagRef("v1"), commit, datas.NewTagMeta("Bill Billerson", "bigbillieb@fake.horse", "release v1")))
+	require.Eventually(t, listener.received(4), 10*time.Second, 10*time.Millisecond)
+
+	listener.mu.Lock()
+	requests := listener.requests
+	listener.mu.Unlock()
+
+	assert.Equal(t, WebhookEventCommit, requests[0].event)
+	assert.Equal(t, WebhookSignature("s3cret", requests[0].body), requests[0].signature)
+	assert.Equal(t, WebhookPayload{
+		Event:    WebhookEventCommit,
+		Database: "mydb",
+		Ref:      "refs/heads/main",
+		OldHash:  oldHash.String(),
+		NewHash:  newHash.String(),
+		Author:   requests[0].payload.Author,
+		Message:  "add test",
+		Tables:   []WebhookTableChange{{Table: "test", Change: "added"}},
+	}, requests[0].payload)
+	require.NotNil(t, requests[0].payload.Author)
+	assert.Equal(t, "Bill Billerson", requests[0].payload.Author.Name)
+
+	assert.Equal(t, WebhookEventBranchCreated, requests[1].payload.Event)
+	assert.Equal(t, "refs/heads/feature", requests[1].payload.Ref)
+	assert.Equal(t, "", requests[1].payload.OldHash)
+	assert.Equal(t, newHash.String(), requests[1].payload.NewHash)
+	assert.Equal(t, WebhookEventBranchDeleted, requests[2].payload.Event)
+	assert.Equal(t, newHash.String(), requests[2].payload.OldHash)
+	assert.Equal(t, "", requests[2].payload.NewHash)
+	assert.Equal(t, WebhookEventTagCreated, requests[3].payload.Event)
+	assert.Equal(t, "refs/tags/v1", requests[3].payload.Ref)
+	assert.Equal(t, "release v1", requests[3].payload.Message)
+
+	deliveries := hook.(*WebhookHook).WebhookDeliveries()
+	require.Len(t, deliveries, 4)
+	for _, d := range deliveries {
+		assert.Equal(t, webhookStatusDelivered, d.Status)
+		assert.Equal(t, http.StatusOK, d.ResponseCode)
+		if d.Event == WebhookEventCommit {
+			assert.Equal(t, 2, d.Attempts)
+		} else {
+			assert.Equal(t, 1, d.Attempts)
+		}
+	}
+
+	// deliveries are persisted, and the deliveries of webhooks which were removed from the configuration fail
+	reloaded, err := NewWebhookDispatcher(dir, webhooks)
+	require.NoError(t, err)
+	assert.Len(t, reloaded.databaseDeliveries("mydb"), 4)
+	assert.Empty(t, reloaded.databaseDeliveries("otherdb"))
+
+	pending := &webhookDelivery{ID: "pending", Webhook: "removed", Database: "mydb", Status: webhookStatusPending, CreatedAt: time.Now()}
+	require.NoError(t, reloaded.persist(pending))
+	reloaded, err = NewWebhookDispatcher(dir, webhooks)
+	require.NoError(t, err)
+	assert.Equal(t, webhookStatusFailed, reloaded.deliveries["pending"].Status)
+}
+
+func TestWebhookMatches(t *testing.T) {
+	wh := Webhook{Refs: []string{"refs/heads/main", "refs/tags/v*"}}
+	assert.True(t, wh.matches(ref.NewBranchRef("main")))
+	assert.False(t, wh.matches(ref.NewBranchRef("feature")))
+	assert.True(t, wh.matches(ref.NewTagRef("v1.0")))
+	assert.False(t, wh.matches(ref.NewTagRef("nightly")))
+	assert.True(t, Webhook{}.matches(ref.NewBranchRef("feature")))
+}
+
+func resolveWebhookTestCommit(t *testing.T, ddb *doltdb.DoltDB, branch string) *doltdb.Commit {
+	cm, err := ddb.ResolveCommitRef(context.Background(), ref.NewBranchRef(branch))
+	require.NoError(t, err)
+	return cm
+}
@@ -38,29 +38,68 @@ type SqlDiffWriter struct {
 	autocommitOff        bool
 }
 
-var _ diff.SqlRowDiffWriter = SqlDiffWriter{}
+var _ diff.SqlRowDiffWriter = &SqlDiffWriter{}
 
-func NewSqlDiffWriter(tableName string, schema schema.Schema, wr io.WriteCloser) *SqlDiffWriter {
+// NewSqlDiffWriter returns a new SqlDiffWriter for the table with the writer given. When |autocommitOff| is true,
+// autocommit is turned off before the first statement and the statements are committed when the writer is closed.
+func NewSqlDiffWriter(tableName string, schema schema.Schema, wr io.WriteCloser, autocommitOff bool) *SqlDiffWriter {
 	return &SqlDiffWriter{
 		tableName:       tableName,
 		sch:             schema,
 		writtenFirstRow: false,
 		writeCloser:     wr,
+		autocommitOff:   autocommitOff,
 	}
 }
 
-func (w SqlDiffWriter) WriteRow(ctx *sql.Context, row sql.Row, rowDiffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+func (w *SqlDiffWriter) WriteRow(ctx *sql.Context, row sql.Row, rowDiffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
 	stmt, err := sqlfmt.GenerateDataDiffStatement(ctx, w.tableName, w.sch, row, rowDiffType, colDiffTypes)
 	if err != nil {
 		return err
 	}
+
+	if stmt != "" {
+		if err := w.maybeWriteAutocommitOff(); err != nil {
+			return err
+		}
+	}
+
 	return iohelp.WriteLine(w.writeCloser, stmt)
 }
 
-func (w SqlDiffWriter) WriteCombinedRow(ctx *sql.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+// WriteStatement writes |stmt| as is, for statements that are not generated from a row diff.
+func (w *SqlDiffWriter) WriteStatement(stmt string) error {
+	if err := w.maybeWriteAutocommitOff(); err != nil {
+		return err
+	}
+	return iohelp.WriteLine(w.writeCloser, stmt)
+}
+
+func (w *SqlDiffWriter) WriteCombinedRow(ctx *sql.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
 	return fmt.Errorf("sql format is unable to output diffs for combined rows")
 }
 
-func (w SqlDiffWriter) Close(ctx context.Context) error {
+func (w *SqlDiffWriter) maybeWriteAutocommitOff() error {
+	if w.writtenAutocommitOff || !w.autocommitOff {
+		return nil
+	}
+
+	if err := iohelp.WriteLine(w.writeCloser, "SET AUTOCOMMIT=0;"); err != nil {
+		return err
+	}
+
+	w.writtenAutocommitOff = true
+
+	return nil
+}
+
+func (w *SqlDiffWriter) Close(ctx context.Context) error {
+	// the statements written with autocommit turned off have to be committed by a COMMIT statement
+	if w.writtenAutocommitOff {
+		if err := iohelp.WriteLine(w.writeCloser, "COMMIT;"); err != nil {
+			return err
+		}
+	}
+
 	return w.writeCloser.Close()
 }
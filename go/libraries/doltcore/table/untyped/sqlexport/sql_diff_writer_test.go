@@ -0,0 +1,99 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlexport
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+)
+
+func TestSqlDiffWriter(t *testing.T) {
+	sch, err := dtestutils.Schema()
+	require.NoError(t, err)
+
+	type diffRow struct {
+		row      sql.Row
+		rowDiff  diff.ChangeType
+		colDiffs []diff.ChangeType
+	}
+
+	none := []diff.ChangeType{diff.None, diff.None, diff.None, diff.None, diff.None}
+	rows := []diffRow{
+		{
+			row:      sql.Row{"00000000-0000-0000-0000-000000000000", "some guy", 100, 0, "normie"},
+			rowDiff:  diff.Added,
+			colDiffs: none,
+		},
+		{
+			row:      sql.Row{"00000000-0000-0000-0000-000000000001", "guy personson", 0, 1, "officially a person"},
+			rowDiff:  diff.ModifiedNew,
+			colDiffs: []diff.ChangeType{diff.None, diff.None, diff.ModifiedNew, diff.None, diff.None},
+		},
+		{
+			row:      sql.Row{"00000000-0000-0000-0000-000000000002", "other guy", 1, 0, nil},
+			rowDiff:  diff.Removed,
+			colDiffs: none,
+		},
+	}
+	statements := "INSERT INTO `people` (`id`,`name`,`age`,`is_married`,`title`) " +
+		`VALUES ('00000000-0000-0000-0000-000000000000','some guy',100,0,'normie');` + "\n" +
+		"UPDATE `people` SET `age`=0 WHERE `id`='00000000-0000-0000-0000-000000000001';\n" +
+		"DELETE FROM `people` WHERE `id`='00000000-0000-0000-0000-000000000002';\n"
+
+	tests := []struct {
+		name           string
+		autocommitOff  bool
+		rows           []diffRow
+		expectedOutput string
+	}{
+		{
+			name:           "autocommit",
+			rows:           rows,
+			expectedOutput: statements,
+		},
+		{
+			name:           "autocommit off",
+			autocommitOff:  true,
+			rows:           rows,
+			expectedOutput: "SET AUTOCOMMIT=0;\n" + statements + "COMMIT;\n",
+		},
+		{
+			name:           "autocommit off without rows",
+			autocommitOff:  true,
+			expectedOutput: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			var stringWr StringBuilderCloser
+			w := NewSqlDiffWriter("people", sch, &stringWr, tt.autocommitOff)
+
+			for _, r := range tt.rows {
+				assert.NoError(t, w.WriteRow(ctx, r.row, r.rowDiff, r.colDiffs))
+			}
+
+			assert.NoError(t, w.Close(ctx))
+			assert.Equal(t, tt.expectedOutput, stringWr.String())
+		})
+	}
+}
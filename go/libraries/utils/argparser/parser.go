@@ -166,6 +166,15 @@ func (ap *ArgParser) SupportsStringList(name, abbrev, valDesc, desc string) *Arg
 	return ap
 }
 
+// SupportsRepeatableString adds support for a new string argument which can be given more than once, with the
+// description given. Unlike a string list, each occurrence takes exactly one value, which is never split on commas.
+func (ap *ArgParser) SupportsRepeatableString(name, abbrev, valDesc, desc string) *ArgParser {
+	opt := &Option{name, abbrev, valDesc, OptionalRepeatableValue, desc, nil, false}
+	ap.SupportOption(opt)
+
+	return ap
+}
+
 // SupportsOptionalString adds support for a new string argument with the description given and optional empty value.
 func (ap *ArgParser) SupportsOptionalString(name, abbrev, valDesc, desc string) *ArgParser {
 	opt := &Option{name, abbrev, valDesc, OptionalEmptyValue, desc, nil, false}
@@ -260,7 +269,7 @@ func (ap *ArgParser) matchModalOptions(arg string) (matches []*Option, rest stri
 func (ap *ArgParser) sortedValueOptions() []string {
 	vos := make([]string, 0, len(ap.Supported))
 	for s, opt := range ap.nameOrAbbrevToOpt {
-		if (opt.OptType == OptionalValue || opt.OptType == OptionalEmptyValue || opt.OptType == RequiredValue) && s != "" {
+		if (opt.OptType == OptionalValue || opt.OptType == OptionalEmptyValue || opt.OptType == RequiredValue || opt.OptType == OptionalRepeatableValue) && s != "" {
 			vos = append(vos, s)
 		}
 	}
@@ -442,7 +451,7 @@ func (ap *ArgParser) parseToken(args []string, index int, positionalArgs []strin
 		return 0, nil, nil, UnknownArgumentParam{name: arg}
 	}
 
-	if _, exists := namedArgs[opt.Name]; exists {
+	if _, exists := namedArgs[opt.Name]; exists && opt.OptType != OptionalRepeatableValue {
 		//already provided
 		return 0, nil, nil, errors.New("error: multiple values provided for `" + opt.Name + "'")
 	}
@@ -487,6 +496,11 @@ func (ap *ArgParser) parseToken(args []string, index int, positionalArgs []strin
 		value = new(string)
 	}
 
+	if prev, exists := namedArgs[opt.Name]; exists && opt.OptType == OptionalRepeatableValue {
+		namedArgs[opt.Name] = prev + repeatedValueSep + *value
+		return index, positionalArgs, namedArgs, nil
+	}
+
 	namedArgs[opt.Name] = *value
 	return index, positionalArgs, namedArgs, nil
 }
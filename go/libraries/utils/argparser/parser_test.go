@@ -309,3 +309,34 @@ func TestGetFlagCountErrorHandling(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, 0, count)
 }
+
+func TestRepeatableStrings(t *testing.T) {
+	ap := NewArgParserWithVariableArgs("test")
+	ap.SupportsRepeatableString("param", "p", "param", "")
+	ap.SupportsFlag("flag", "f", "flag")
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedParams []string
+		expectedArgs   []string
+	}{
+		{"single", []string{"--param", "a=1"}, []string{"a=1"}, []string{}},
+		{"commas aren't split", []string{"--param", "a=1,b=2"}, []string{"a=1,b=2"}, []string{}},
+		{"repeated", []string{"--param", "a=1,2", "-p", "b=3", "--param=c"}, []string{"a=1,2", "b=3", "c"}, []string{}},
+		{"one value per occurrence", []string{"-p", "a", "b", "-f", "-p", ""}, []string{"a", ""}, []string{"b"}},
+		{"not given", []string{"-f"}, nil, []string{}},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			apr, err := ap.Parse(test.args)
+			require.NoError(t, err)
+
+			params, ok := apr.GetRepeatedValues("param")
+			assert.Equal(t, test.expectedParams != nil, ok)
+			assert.Equal(t, test.expectedParams, params)
+			assert.Equal(t, test.expectedArgs, apr.Args)
+		})
+	}
+}
@@ -118,6 +118,19 @@ func (res *ArgParseResults) GetValueList(name string) ([]string, bool) {
 	return strings.Split(val, ","), ok
 }
 
+// repeatedValueSep separates the values of a repeatable option, which can't contain it since they're command line
+// arguments.
+const repeatedValueSep = "\x00"
+
+// GetRepeatedValues returns each value given for a repeatable option, in the order they were given.
+func (res *ArgParseResults) GetRepeatedValues(name string) ([]string, bool) {
+	val, ok := res.options[name]
+	if !ok {
+		return nil, false
+	}
+	return strings.Split(val, repeatedValueSep), ok
+}
+
 func (res *ArgParseResults) GetValues(names ...string) map[string]string {
 	vals := make(map[string]string)
 
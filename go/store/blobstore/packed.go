@@ -0,0 +1,110 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrPackedBlobstoreReadOnly is returned by every write operation on a PackedBlobstore.
+var ErrPackedBlobstoreReadOnly = errors.New("cannot write to a packed blobstore")
+
+// ErrPackedBlobRange is returned by PackedBlobstore.Get for a BlobRange which doesn't lie within its blob.
+var ErrPackedBlobRange = errors.New("blob range out of bounds")
+
+// PackedBlob is the location of a single blob within the file backing a PackedBlobstore.
+type PackedBlob struct {
+	Offset int64
+	Size   int64
+}
+
+// PackedBlobstore is a read-only Blobstore whose blobs are stored one after another within a single file, at offsets
+// recorded elsewhere.
+type PackedBlobstore struct {
+	path  string
+	blobs map[string]PackedBlob
+}
+
+var _ Blobstore = &PackedBlobstore{}
+
+// NewPackedBlobstore returns a PackedBlobstore serving |blobs| from the file at |path|.
+func NewPackedBlobstore(path string, blobs map[string]PackedBlob) *PackedBlobstore {
+	return &PackedBlobstore{path: path, blobs: blobs}
+}
+
+func (bs *PackedBlobstore) Path() string {
+	return bs.path
+}
+
+func (bs *PackedBlobstore) Exists(ctx context.Context, key string) (bool, error) {
+	_, ok := bs.blobs[key]
+	return ok, nil
+}
+
+// Get returns a reader for the portion of the blob keyed by |key| given by |br|. The contents of a PackedBlobstore
+// never change, so the version returned is always empty.
+func (bs *PackedBlobstore) Get(ctx context.Context, key string, br BlobRange) (io.ReadCloser, uint64, string, error) {
+	blob, ok := bs.blobs[key]
+	if !ok {
+		return nil, 0, "", NotFound{key}
+	}
+
+	// the blobs of a PackedBlobstore share a file, so a range past the end of a blob would read the blobs after it
+	offset, length := br.offset, br.length
+	if offset < 0 {
+		offset += blob.Size
+	}
+	if length == 0 {
+		length = blob.Size - offset
+	}
+	if offset < 0 || offset > blob.Size || length > blob.Size-offset {
+		return nil, 0, "", fmt.Errorf("%w: offset %d and length %d for blob %s of size %d", ErrPackedBlobRange, br.offset, br.length, key, blob.Size)
+	}
+
+	f, err := os.Open(bs.path)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return packedBlobReadCloser{
+		Reader: io.NewSectionReader(f, blob.Offset+offset, length),
+		f:      f,
+	}, uint64(blob.Size), "", nil
+}
+
+func (bs *PackedBlobstore) Put(ctx context.Context, key string, totalSize int64, reader io.Reader) (string, error) {
+	return "", ErrPackedBlobstoreReadOnly
+}
+
+func (bs *PackedBlobstore) CheckAndPut(ctx context.Context, expectedVersion, key string, totalSize int64, reader io.Reader) (string, error) {
+	return "", ErrPackedBlobstoreReadOnly
+}
+
+func (bs *PackedBlobstore) Concatenate(ctx context.Context, key string, sources []string) (string, error) {
+	return "", ErrPackedBlobstoreReadOnly
+}
+
+type packedBlobReadCloser struct {
+	io.Reader
+	f *os.File
+}
+
+func (rc packedBlobReadCloser) Close() error {
+	return rc.f.Close()
+}
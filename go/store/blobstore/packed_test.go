@@ -0,0 +1,81 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackedBlobstore(t *testing.T) {
+	ctx := context.Background()
+	first := rangeData(0, 1024)
+	second := rangeData(1024, 4096)
+
+	path := filepath.Join(t.TempDir(), "packed")
+	header := []byte("header")
+	contents := append(append(append([]byte{}, header...), first...), second...)
+	require.NoError(t, os.WriteFile(path, contents, 0644))
+
+	bs := NewPackedBlobstore(path, map[string]PackedBlob{
+		"first":  {Offset: int64(len(header)), Size: int64(len(first))},
+		"second": {Offset: int64(len(header) + len(first)), Size: int64(len(second))},
+	})
+
+	ok, err := bs.Exists(ctx, "first")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = bs.Exists(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	data, _, err := GetBytes(ctx, bs, "first", AllRange)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(first, data))
+	data, _, err = GetBytes(ctx, bs, "second", AllRange)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(second, data))
+	data, _, err = GetBytes(ctx, bs, "second", NewBlobRange(2, 4))
+	require.NoError(t, err)
+	assert.Equal(t, second[2:6], data)
+	data, _, err = GetBytes(ctx, bs, "second", NewBlobRange(-4, 0))
+	require.NoError(t, err)
+	assert.Equal(t, second[len(second)-4:], data)
+
+	size := int64(len(first))
+	_, _, err = GetBytes(ctx, bs, "first", NewBlobRange(size-4, 8))
+	assert.ErrorIs(t, err, ErrPackedBlobRange)
+	_, _, err = GetBytes(ctx, bs, "first", NewBlobRange(size+1, 0))
+	assert.ErrorIs(t, err, ErrPackedBlobRange)
+	_, _, err = GetBytes(ctx, bs, "first", NewBlobRange(-size-1, 0))
+	assert.ErrorIs(t, err, ErrPackedBlobRange)
+	data, _, err = GetBytes(ctx, bs, "first", NewBlobRange(size-4, 4))
+	require.NoError(t, err)
+	assert.Equal(t, first[size-4:], data)
+
+	_, _, err = GetBytes(ctx, bs, "missing", AllRange)
+	assert.True(t, IsNotFoundError(err))
+
+	_, err = PutBytes(ctx, bs, "third", []byte("data"))
+	assert.ErrorIs(t, err, ErrPackedBlobstoreReadOnly)
+	_, err = bs.Concatenate(ctx, "third", []string{"first", "second"})
+	assert.ErrorIs(t, err, ErrPackedBlobstoreReadOnly)
+}
@@ -137,6 +137,10 @@ func typeString(value types.Value) string {
 			typeString = "StashList"
 		case serial.StashFileID:
 			typeString = "Stash"
+		case serial.NotesFileID:
+			typeString = "Notes"
+		case serial.NoteFileID:
+			typeString = "Note"
 		case serial.TagFileID:
 			typeString = "Tag"
 		case serial.WorkingSetFileID:
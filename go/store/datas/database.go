@@ -101,12 +101,20 @@ type Database interface {
 	// The dataset reference keys access to the value.
 	SetTuple(ctx context.Context, ds Dataset, val []byte) (Dataset, error)
 
+	// UpdateTuple puts |val| into the chunkstore like SetTuple, but fails with ErrOptimisticLockFailed if the dataset
+	// has moved since |ds| was read.
+	UpdateTuple(ctx context.Context, ds Dataset, val []byte) (Dataset, error)
+
 	// UpdateStashList updates the stash list dataset only with given address hash to the updated stash list.
 	// The new/updated stash list address should be obtained before calling this function depending on
 	// whether add or remove a stash actions have been performed. This function does not perform any actions
 	// on the stash list itself.
 	UpdateStashList(ctx context.Context, ds Dataset, stashListAddr hash.Hash) (Dataset, error)
 
+	// UpdateNotes points the notes dataset |ds| at |notesAddr|, the address of an updated set of Notes. It fails with
+	// ErrOptimisticLockFailed if the dataset has moved since |ds| was read.
+	UpdateNotes(ctx context.Context, ds Dataset, notesAddr hash.Hash) (Dataset, error)
+
 	// SetStatsRef updates the singleton statisics ref for this database.
 	SetStatsRef(context.Context, Dataset, hash.Hash) (Dataset, error)
 
@@ -298,7 +298,7 @@ func (db *database) doSetHead(ctx context.Context, ds Dataset, addr hash.Hash, w
 
 				if _, ok := targetCmt.(types.SerialMessage); ok {
 					// TODO - construct new meta instance rather than using the default
-					updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil)
+					updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil, nil)
 					ref, err := db.WriteValue(ctx, types.SerialMessage(updateWS))
 					if err != nil {
 						return prolly.AddressMap{}, err
@@ -311,7 +311,7 @@ func (db *database) doSetHead(ctx context.Context, ds Dataset, addr hash.Hash, w
 				}
 			} else {
 				// TODO - construct new meta instance rather than using the default
-				updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil)
+				updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil, nil)
 				ref, err := db.WriteValue(ctx, types.SerialMessage(updateWS))
 				if err != nil {
 					return prolly.AddressMap{}, err
@@ -452,7 +452,7 @@ func (db *database) doFastForward(ctx context.Context, ds Dataset, newHeadAddr h
 					}
 
 					// TODO - construct new meta instance rather than using the default
-					updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil)
+					updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil, nil)
 					ref, err := db.WriteValue(ctx, types.SerialMessage(updateWS))
 					if err != nil {
 						return prolly.AddressMap{}, err
@@ -464,7 +464,7 @@ func (db *database) doFastForward(ctx context.Context, ds Dataset, newHeadAddr h
 					return prolly.AddressMap{}, errors.New("Modern Dolt Database required.")
 				}
 			} else {
-				updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil)
+				updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil, nil)
 				ref, err := db.WriteValue(ctx, types.SerialMessage(updateWS))
 				if err != nil {
 					return prolly.AddressMap{}, err
@@ -631,6 +631,33 @@ func (db *database) SetTuple(ctx context.Context, ds Dataset, val []byte) (Datas
 	})
 }
 
+// UpdateTuple is SetTuple as a compare-and-set against the head |ds| was read with, for tuples which are read, edited
+// and written back by concurrent sessions.
+func (db *database) UpdateTuple(ctx context.Context, ds Dataset, val []byte) (Dataset, error) {
+	currAddr, _ := ds.MaybeHeadAddr()
+	tupleAddr, _, err := newTuple(ctx, db, val)
+	if err != nil {
+		return Dataset{}, err
+	}
+	return db.doHeadUpdate(ctx, ds, func(ds Dataset) error {
+		return db.update(ctx, func(ctx context.Context, am prolly.AddressMap) (prolly.AddressMap, error) {
+			curr, err := am.Get(ctx, ds.ID())
+			if err != nil {
+				return prolly.AddressMap{}, err
+			}
+			if curr != currAddr {
+				return prolly.AddressMap{}, ErrOptimisticLockFailed
+			}
+			ae := am.Editor()
+			err = ae.Update(ctx, ds.ID(), tupleAddr)
+			if err != nil {
+				return prolly.AddressMap{}, err
+			}
+			return ae.Flush(ctx)
+		})
+	})
+}
+
 func (db *database) SetStatsRef(ctx context.Context, ds Dataset, mapAddr hash.Hash) (Dataset, error) {
 	statAddr, _, err := newStat(ctx, db, mapAddr)
 	if err != nil {
@@ -667,6 +694,29 @@ func (db *database) UpdateStashList(ctx context.Context, ds Dataset, stashListAd
 	})
 }
 
+// UpdateNotes points the notes dataset |ds| at |notesAddr|. Unlike UpdateStashList, this is a compare-and-set against
+// the head |ds| was read with, since notes can be written concurrently by pushes and fetches.
+func (db *database) UpdateNotes(ctx context.Context, ds Dataset, notesAddr hash.Hash) (Dataset, error) {
+	currAddr, _ := ds.MaybeHeadAddr()
+	return db.doHeadUpdate(ctx, ds, func(ds Dataset) error {
+		return db.update(ctx, func(ctx context.Context, am prolly.AddressMap) (prolly.AddressMap, error) {
+			curr, err := am.Get(ctx, ds.ID())
+			if err != nil {
+				return prolly.AddressMap{}, err
+			}
+			if curr != currAddr {
+				return prolly.AddressMap{}, ErrOptimisticLockFailed
+			}
+			ae := am.Editor()
+			err = ae.Update(ctx, ds.ID(), notesAddr)
+			if err != nil {
+				return prolly.AddressMap{}, err
+			}
+			return ae.Flush(ctx)
+		})
+	})
+}
+
 func (db *database) UpdateWorkingSet(ctx context.Context, ds Dataset, workingSetSpec WorkingSetSpec, prevHash hash.Hash) (Dataset, error) {
 	return db.doHeadUpdate(
 		ctx,
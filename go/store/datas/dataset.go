@@ -158,6 +158,7 @@ type WorkingSetHead struct {
 	StagedAddr  *hash.Hash
 	MergeState  *MergeState
 	RebaseState *RebaseState
+	BisectState *BisectState
 	WorkingAddr hash.Hash
 }
 
@@ -211,6 +212,29 @@ func (rs *RebaseState) SkipVerification(_ context.Context) bool {
 	return rs.skipVerification
 }
 
+type BisectState struct {
+	branch           string
+	badCommitHash    string
+	goodCommitHashes []string
+	skippedHashes    []string
+}
+
+func (bs *BisectState) Branch() string {
+	return bs.branch
+}
+
+func (bs *BisectState) BadCommitHash() string {
+	return bs.badCommitHash
+}
+
+func (bs *BisectState) GoodCommitHashes() []string {
+	return bs.goodCommitHashes
+}
+
+func (bs *BisectState) SkippedCommitHashes() []string {
+	return bs.skippedHashes
+}
+
 type MergeState struct {
 	preMergeWorkingAddr    *hash.Hash // the pre-merge working root hash
 	preMergeHeadCommitAddr *hash.Hash // the pre-merge HEAD commit hash
@@ -394,6 +418,27 @@ func (h serialWorkingSetHead) HeadWorkingSet() (*WorkingSetHead, error) {
 		)
 	}
 
+	bisectState, err := h.msg.TryBisectState(nil)
+	if err != nil {
+		return nil, err
+	}
+	if bisectState != nil {
+		goodHashes := make([]string, bisectState.GoodCommitHashesLength())
+		for i := range goodHashes {
+			goodHashes[i] = string(bisectState.GoodCommitHashes(i))
+		}
+		skippedHashes := make([]string, bisectState.SkippedCommitHashesLength())
+		for i := range skippedHashes {
+			skippedHashes[i] = string(bisectState.SkippedCommitHashes(i))
+		}
+		ret.BisectState = NewBisectState(
+			string(bisectState.Branch()),
+			string(bisectState.BadCommitHash()),
+			goodHashes,
+			skippedHashes,
+		)
+	}
+
 	return &ret, nil
 }
 
@@ -463,6 +508,10 @@ func newTupleHead(sm types.SerialMessage, addr hash.Hash) serialStashListHead {
 	return serialStashListHead{sm, addr}
 }
 
+func newNotesHead(sm types.SerialMessage, addr hash.Hash) serialStashListHead {
+	return serialStashListHead{sm, addr}
+}
+
 // Dataset is a named value within a Database. Different head values may be stored in a dataset. Most commonly, this is
 // a commit, but other values are also supported in some cases.
 type Dataset struct {
@@ -527,6 +576,8 @@ func newHead(ctx context.Context, head types.Value, addr hash.Hash) (dsHead, err
 		return newStatisticHead(sm, addr), nil
 	case serial.TupleFileID:
 		return newTupleHead(sm, addr), nil
+	case serial.NotesFileID:
+		return newNotesHead(sm, addr), nil
 	default:
 		return nil, fmt.Errorf("database: fetched head at %v but it was not a recognized serial message type", addr)
 	}
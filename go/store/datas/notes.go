@@ -0,0 +1,228 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	flatbuffers "github.com/dolthub/flatbuffers/v23/go"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+var ErrNoteNotFound = errors.New("no note found")
+
+// Note is a message attached to a commit after the fact, along with who attached it and when. Notes are stored
+// outside of the commit graph, so adding, changing or removing one never changes a commit's hash.
+type Note struct {
+	Name      string
+	Email     string
+	Message   string
+	Timestamp uint64
+}
+
+// NewNote returns a Note with the given author and message, timestamped with the current time.
+func NewNote(name, email, message string) *Note {
+	return &Note{
+		Name:      strings.TrimSpace(name),
+		Email:     strings.TrimSpace(email),
+		Message:   strings.TrimSpace(message),
+		Timestamp: uint64(CommitNow().UnixMilli()),
+	}
+}
+
+// Time returns the time at which the note was written.
+func (n *Note) Time() time.Time {
+	return time.UnixMilli(int64(n.Timestamp))
+}
+
+// WriteNote writes |note| to |vw| and returns its address.
+func WriteNote(ctx context.Context, vw types.ValueWriter, note *Note) (hash.Hash, error) {
+	r, err := vw.WriteValue(ctx, types.SerialMessage(note_flatbuffer(note)))
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	return r.TargetHash(), nil
+}
+
+// LoadNote reads the note at |addr| from |vr|.
+func LoadNote(ctx context.Context, vr types.ValueReader, addr hash.Hash) (*Note, error) {
+	val, err := vr.MustReadValue(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := []byte(val.(types.SerialMessage))
+	if serial.GetFileID(bs) != serial.NoteFileID {
+		return nil, fmt.Errorf("expected note file id, got: %s", serial.GetFileID(bs))
+	}
+	var msg serial.Note
+	err = serial.InitNoteRoot(&msg, bs, serial.MessagePrefixSz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Note{
+		Name:      string(msg.Name()),
+		Email:     string(msg.Email()),
+		Message:   string(msg.Message()),
+		Timestamp: msg.TimestampMillis(),
+	}, nil
+}
+
+func note_flatbuffer(note *Note) serial.Message {
+	builder := flatbuffers.NewBuilder(1024)
+	nameOff := builder.CreateString(note.Name)
+	emailOff := builder.CreateString(note.Email)
+	messageOff := builder.CreateString(note.Message)
+
+	serial.NoteStart(builder)
+	serial.NoteAddName(builder, nameOff)
+	serial.NoteAddEmail(builder, emailOff)
+	serial.NoteAddMessage(builder, messageOff)
+	serial.NoteAddTimestampMillis(builder, note.Timestamp)
+	return serial.FinishMessage(builder, serial.NoteEnd(builder), []byte(serial.NoteFileID))
+}
+
+// Notes is the set of notes stored under a notes ref. It maps the address of each annotated commit to the address of
+// its Note. The commits are keyed by their hash strings rather than referenced, so notes can be attached to commits
+// the database doesn't have, and don't keep the commits they annotate from being collected.
+type Notes struct {
+	am   prolly.AddressMap
+	addr hash.Hash
+}
+
+// Addr returns the address of the serialized notes map, or the empty hash if it hasn't been written yet.
+func (n *Notes) Addr() hash.Hash {
+	return n.addr
+}
+
+func (n *Notes) Count() (int, error) {
+	return n.am.Count()
+}
+
+// Get returns the address of the note attached to |commit|, or ErrNoteNotFound if there isn't one.
+func (n *Notes) Get(ctx context.Context, commit hash.Hash) (hash.Hash, error) {
+	addr, err := n.am.Get(ctx, commit.String())
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	if addr.IsEmpty() {
+		return hash.Hash{}, ErrNoteNotFound
+	}
+	return addr, nil
+}
+
+// IterAll calls |cb| with the address of each annotated commit and the address of its note, ordered by commit address.
+func (n *Notes) IterAll(ctx context.Context, cb func(commit, note hash.Hash) error) error {
+	return n.am.IterAll(ctx, func(key string, addr hash.Hash) error {
+		commit, ok := hash.MaybeParse(key)
+		if !ok {
+			return fmt.Errorf("invalid commit address in notes: %s", key)
+		}
+		return cb(commit, addr)
+	})
+}
+
+// Update attaches each of the notes in |set| to its commit, replacing any note already there, and removes the notes
+// attached to each commit in |remove|. It writes the updated notes to |vw| and returns their new address.
+func (n *Notes) Update(ctx context.Context, vw types.ValueWriter, set map[hash.Hash]hash.Hash, remove []hash.Hash) (hash.Hash, error) {
+	ame := n.am.Editor()
+	for commit, note := range set {
+		if err := ame.Update(ctx, commit.String(), note); err != nil {
+			return hash.Hash{}, err
+		}
+	}
+	for _, commit := range remove {
+		if err := ame.Delete(ctx, commit.String()); err != nil {
+			return hash.Hash{}, err
+		}
+	}
+
+	var err error
+	n.am, err = ame.Flush(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+
+	r, err := vw.WriteValue(ctx, types.SerialMessage(notes_flatbuffer(n.am)))
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	n.addr = r.TargetHash()
+	return n.addr, nil
+}
+
+// LoadNotes returns the Notes stored in |ds|, or an empty set of notes if the dataset has no head yet.
+func LoadNotes(ctx context.Context, ns tree.NodeStore, vr types.ValueReader, ds Dataset) (*Notes, error) {
+	addr, hasHead := ds.MaybeHeadAddr()
+	if !hasHead {
+		am, err := prolly.NewEmptyAddressMap(ns)
+		if err != nil {
+			return nil, err
+		}
+		return &Notes{am: am}, nil
+	}
+	return LoadNotesAddr(ctx, ns, vr, addr)
+}
+
+// LoadNotesAddr returns the Notes serialized at |addr|.
+func LoadNotesAddr(ctx context.Context, ns tree.NodeStore, vr types.ValueReader, addr hash.Hash) (*Notes, error) {
+	val, err := vr.MustReadValue(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	am, err := parse_notes([]byte(val.(types.SerialMessage)), ns)
+	if err != nil {
+		return nil, err
+	}
+	return &Notes{am: am, addr: addr}, nil
+}
+
+func notes_flatbuffer(am prolly.AddressMap) serial.Message {
+	builder := flatbuffers.NewBuilder(1024)
+	ambytes := []byte(tree.ValueFromNode(am.Node()).(types.SerialMessage))
+	voff := builder.CreateByteVector(ambytes)
+	serial.NotesStart(builder)
+	serial.NotesAddAddressMap(builder, voff)
+	return serial.FinishMessage(builder, serial.NotesEnd(builder), []byte(serial.NotesFileID))
+}
+
+func parse_notes(bs []byte, ns tree.NodeStore) (prolly.AddressMap, error) {
+	if serial.GetFileID(bs) != serial.NotesFileID {
+		return prolly.AddressMap{}, fmt.Errorf("expected notes file id, got: %s", serial.GetFileID(bs))
+	}
+	msg, err := serial.TryGetRootAsNotes(bs, serial.MessagePrefixSz)
+	if err != nil {
+		return prolly.AddressMap{}, err
+	}
+	node, fileId, err := tree.NodeFromBytes(msg.AddressMapBytes())
+	if err != nil {
+		return prolly.AddressMap{}, err
+	}
+	if fileId != serial.AddressMapFileID {
+		return prolly.AddressMap{}, fmt.Errorf("unexpected file ID, expected %s, got %s", serial.AddressMapFileID, fileId)
+	}
+	return prolly.NewAddressMap(node, ns)
+}
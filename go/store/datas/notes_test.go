@@ -0,0 +1,82 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func TestNotes(t *testing.T) {
+	ctx := context.Background()
+	stg := &chunks.MemoryStorage{}
+	db := NewDatabase(stg.NewViewWithDefaultFormat()).(*database)
+	defer db.Close()
+
+	ds, err := db.GetDataset(ctx, "refs/notes/commits")
+	require.NoError(t, err)
+	notes, err := LoadNotes(ctx, db.nodeStore(), db, ds)
+	require.NoError(t, err)
+	count, err := notes.Count()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	c1, c2 := hash.Of([]byte("commit one")), hash.Of([]byte("commit two"))
+	n1, err := WriteNote(ctx, db, NewNote("Bill Billerson", "bill@billerson.com", "  signed off  "))
+	require.NoError(t, err)
+	n2, err := WriteNote(ctx, db, NewNote("Bill Billerson", "bill@billerson.com", "ticket 123"))
+	require.NoError(t, err)
+
+	addr, err := notes.Update(ctx, db, map[hash.Hash]hash.Hash{c1: n1, c2: n2}, nil)
+	require.NoError(t, err)
+	ds, err = db.UpdateNotes(ctx, ds, addr)
+	require.NoError(t, err)
+
+	notes, err = LoadNotes(ctx, db.nodeStore(), db, ds)
+	require.NoError(t, err)
+	assert.Equal(t, addr, notes.Addr())
+	got, err := notes.Get(ctx, c1)
+	require.NoError(t, err)
+	assert.Equal(t, n1, got)
+	note, err := LoadNote(ctx, db, got)
+	require.NoError(t, err)
+	assert.Equal(t, "signed off", note.Message)
+	assert.Equal(t, "bill@billerson.com", note.Email)
+
+	_, err = notes.Update(ctx, db, nil, []hash.Hash{c1})
+	require.NoError(t, err)
+	_, err = notes.Get(ctx, c1)
+	assert.ErrorIs(t, err, ErrNoteNotFound)
+	var commits []hash.Hash
+	err = notes.IterAll(ctx, func(commit, note hash.Hash) error {
+		commits = append(commits, commit)
+		assert.Equal(t, n2, note)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []hash.Hash{c2}, commits)
+
+	// |ds| was read before the notes moved, so writing through it again fails
+	_, err = db.UpdateNotes(ctx, ds, notes.Addr())
+	require.NoError(t, err)
+	_, err = db.UpdateNotes(ctx, ds, addr)
+	assert.ErrorIs(t, err, ErrOptimisticLockFailed)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/store/chunks"
+)
+
+func TestUpdateTuple(t *testing.T) {
+	ctx := context.Background()
+	stg := &chunks.MemoryStorage{}
+	db := NewDatabase(stg.NewViewWithDefaultFormat()).(*database)
+	defer db.Close()
+
+	ds, err := db.GetDataset(ctx, "refs/tuples/test")
+	require.NoError(t, err)
+	first, err := db.UpdateTuple(ctx, ds, []byte("first"))
+	require.NoError(t, err)
+	tup, err := LoadTuple(ctx, db.nodeStore(), db, first)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("first"), tup.Bytes())
+
+	// |ds| was read before the tuple was written, so writing through it again fails
+	_, err = db.UpdateTuple(ctx, ds, []byte("second"))
+	assert.ErrorIs(t, err, ErrOptimisticLockFailed)
+
+	second, err := db.UpdateTuple(ctx, first, []byte("second"))
+	require.NoError(t, err)
+	tup, err = LoadTuple(ctx, db.nodeStore(), db, second)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), tup.Bytes())
+	_, err = db.UpdateTuple(ctx, first, []byte("third"))
+	assert.ErrorIs(t, err, ErrOptimisticLockFailed)
+}
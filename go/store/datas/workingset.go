@@ -39,6 +39,7 @@ type WorkingSetSpec struct {
 	Meta        *WorkingSetMeta
 	MergeState  *MergeState
 	RebaseState *RebaseState
+	BisectState *BisectState
 	WorkingRoot types.Ref
 	StagedRoot  types.Ref
 }
@@ -66,9 +67,10 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 	stagedRef := workingSetSpec.StagedRoot
 	mergeState := workingSetSpec.MergeState
 	rebaseState := workingSetSpec.RebaseState
+	bisectState := workingSetSpec.BisectState
 
 	stagedAddr := stagedRef.TargetHash()
-	data := workingset_flatbuffer(workingRef.TargetHash(), &stagedAddr, mergeState, rebaseState, meta)
+	data := workingset_flatbuffer(workingRef.TargetHash(), &stagedAddr, mergeState, rebaseState, bisectState, meta)
 
 	r, err := db.WriteValue(ctx, types.SerialMessage(data))
 	if err != nil {
@@ -84,10 +86,10 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 }
 
 // workingset_flatbuffer creates a flatbuffer message for working set metadata.
-func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *MergeState, rebaseState *RebaseState, meta *WorkingSetMeta) serial.Message {
+func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *MergeState, rebaseState *RebaseState, bisectState *BisectState, meta *WorkingSetMeta) serial.Message {
 	builder := flatbuffers.NewBuilder(1024)
 	workingoff := builder.CreateByteVector(working[:])
-	var stagedOff, mergeStateOff, rebaseStateOffset flatbuffers.UOffsetT
+	var stagedOff, mergeStateOff, rebaseStateOffset, bisectStateOffset flatbuffers.UOffsetT
 	if staged != nil {
 		stagedOff = builder.CreateByteVector((*staged)[:])
 	}
@@ -136,6 +138,32 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		rebaseStateOffset = serial.RebaseStateEnd(builder)
 	}
 
+	if bisectState != nil {
+		branchOffset := builder.CreateString(bisectState.branch)
+		var badOffset, goodOffset, skippedOffset flatbuffers.UOffsetT
+		if bisectState.badCommitHash != "" {
+			badOffset = builder.CreateString(bisectState.badCommitHash)
+		}
+		if len(bisectState.goodCommitHashes) > 0 {
+			goodOffset = SerializeStringVector(builder, bisectState.goodCommitHashes)
+		}
+		if len(bisectState.skippedHashes) > 0 {
+			skippedOffset = SerializeStringVector(builder, bisectState.skippedHashes)
+		}
+		serial.BisectStateStart(builder)
+		serial.BisectStateAddBranch(builder, branchOffset)
+		if badOffset != 0 {
+			serial.BisectStateAddBadCommitHash(builder, badOffset)
+		}
+		if goodOffset != 0 {
+			serial.BisectStateAddGoodCommitHashes(builder, goodOffset)
+		}
+		if skippedOffset != 0 {
+			serial.BisectStateAddSkippedCommitHashes(builder, skippedOffset)
+		}
+		bisectStateOffset = serial.BisectStateEnd(builder)
+	}
+
 	var nameOff, emailOff, descOff flatbuffers.UOffsetT
 	if meta != nil {
 		nameOff = builder.CreateString(meta.Name)
@@ -154,6 +182,9 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 	if rebaseStateOffset != 0 {
 		serial.WorkingSetAddRebaseState(builder, rebaseStateOffset)
 	}
+	if bisectStateOffset != 0 {
+		serial.WorkingSetAddBisectState(builder, bisectStateOffset)
+	}
 
 	if meta != nil {
 		serial.WorkingSetAddName(builder, nameOff)
@@ -204,3 +235,12 @@ func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch
 		skipVerification:           skipVerification,
 	}
 }
+
+func NewBisectState(branch string, badCommitHash string, goodCommitHashes []string, skippedCommitHashes []string) *BisectState {
+	return &BisectState{
+		branch:           branch,
+		badCommitHash:    badCommitHash,
+		goodCommitHashes: goodCommitHashes,
+		skippedHashes:    skippedCommitHashes,
+	}
+}
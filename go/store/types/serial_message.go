@@ -85,6 +85,23 @@ func (sm SerialMessage) HumanReadableStringAtIndentationLevel(level int) string
 		printWithIndendationLevel(level, ret, "StashList{%s}",
 			SerialMessage(mapbytes).HumanReadableStringAtIndentationLevel(level+1))
 		return ret.String()
+	case serial.NotesFileID:
+		msg, _ := serial.TryGetRootAsNotes([]byte(sm), serial.MessagePrefixSz)
+		ret := &strings.Builder{}
+		mapbytes := msg.AddressMapBytes()
+		printWithIndendationLevel(level, ret, "Notes{%s}",
+			SerialMessage(mapbytes).HumanReadableStringAtIndentationLevel(level+1))
+		return ret.String()
+	case serial.NoteFileID:
+		msg, _ := serial.TryGetRootAsNote(sm, serial.MessagePrefixSz)
+		ret := &strings.Builder{}
+		printWithIndendationLevel(level, ret, "{\n")
+		printWithIndendationLevel(level, ret, "\tName: %s\n", msg.Name())
+		printWithIndendationLevel(level, ret, "\tEmail: %s\n", msg.Email())
+		printWithIndendationLevel(level, ret, "\tTimestamp: %d\n", msg.TimestampMillis())
+		printWithIndendationLevel(level, ret, "\tMessage: %s\n", msg.Message())
+		printWithIndendationLevel(level, ret, "}")
+		return ret.String()
 	case serial.StashFileID:
 		msg, _ := serial.TryGetRootAsStash(sm, serial.MessagePrefixSz)
 		ret := &strings.Builder{}
@@ -609,6 +626,16 @@ func (sm SerialMessage) WalkAddrs(nbf *NomsBinFormat, cb func(addr hash.Hash) er
 			mapbytes := msg.AddressMapBytes()
 			return SerialMessage(mapbytes).WalkAddrs(nbf, cb)
 		}
+	case serial.NotesFileID:
+		var msg serial.Notes
+		err := serial.InitNotesRoot(&msg, sm, serial.MessagePrefixSz)
+		if err != nil {
+			return err
+		}
+		if msg.AddressMapLength() > 0 {
+			mapbytes := msg.AddressMapBytes()
+			return SerialMessage(mapbytes).WalkAddrs(nbf, cb)
+		}
 	case serial.StatisticFileID:
 		var msg serial.Statistic
 		err := serial.InitStatisticRoot(&msg, sm, serial.MessagePrefixSz)
@@ -769,7 +796,7 @@ func (sm SerialMessage) WalkAddrs(nbf *NomsBinFormat, cb func(addr hash.Hash) er
 				return err
 			}
 		}
-	case serial.TableSchemaFileID, serial.ForeignKeyCollectionFileID, serial.TupleFileID:
+	case serial.TableSchemaFileID, serial.ForeignKeyCollectionFileID, serial.TupleFileID, serial.NoteFileID:
 		// no further references from these file types
 		return nil
 	case serial.ProllyTreeNodeFileID, serial.AddressMapFileID, serial.MergeArtifactsFileID, serial.BlobFileID, serial.CommitClosureFileID, serial.VectorIndexNodeFileID:
@@ -0,0 +1,176 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql -q "CREATE TABLE t (pk int primary key, v varchar(20))"
+    dolt sql -q "INSERT INTO t VALUES (1, 'a'), (2, 'b')"
+    dolt commit -Am "create t"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "apply: format-patch writes one file per commit" {
+    dolt sql -q "UPDATE t SET v = 'x' WHERE pk = 1"
+    dolt commit -am "update one"
+    dolt sql -q "INSERT INTO t VALUES (3, 'c')"
+    dolt commit --author "Jane Doe <jane@doe.com>" -am "Insert three!"
+
+    run dolt format-patch HEAD~2 -o patches
+    [ "$status" -eq 0 ]
+    [ "${lines[0]}" = "patches/0001-update-one.sql" ]
+    [ "${lines[1]}" = "patches/0002-insert-three.sql" ]
+
+    run cat patches/0002-insert-three.sql
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "# Author: Jane Doe <jane@doe.com>" ]] || false
+    [[ "$output" =~ "#     Insert three!" ]] || false
+    [[ "$output" =~ "INSERT INTO \`t\` (\`pk\`,\`v\`) VALUES (3,'c');" ]] || false
+
+    run dolt format-patch --stdout HEAD~1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Insert three!" ]] || false
+    [[ ! "$output" =~ "update one" ]] || false
+
+    run dolt format-patch --stdout -o patches HEAD~1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "mutually exclusive" ]] || false
+}
+
+@test "apply: patches run with dolt sql" {
+    dolt branch base
+    dolt sql -q "UPDATE t SET v = 'x' WHERE pk = 1"
+    dolt commit -am "update one"
+    dolt format-patch base -o patches
+
+    dolt checkout base
+    dolt sql < patches/0001-update-one.sql
+
+    run dolt sql -q "SELECT v FROM t WHERE pk = 1" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "x" ]
+}
+
+@test "apply: am recreates commits with their author and message" {
+    dolt branch base
+    dolt sql -q "UPDATE t SET v = 'x' WHERE pk = 1"
+    dolt commit --author "Jane Doe <jane@doe.com>" -am "update one"
+    dolt sql -q "CREATE TABLE u (id int primary key)"
+    dolt commit -Am "create u"
+    dolt format-patch base -o patches
+
+    dolt checkout base
+    run dolt am patches/0001-update-one.sql patches/0002-create-u.sql
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Applying: update one" ]] || false
+    [[ "$output" =~ "Applying: create u" ]] || false
+
+    run dolt sql -q "SELECT author, author_email, message FROM dolt_log LIMIT 2" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "Bats Tests,bats@email.fake,create u" ]
+    [ "${lines[2]}" = "Jane Doe,jane@doe.com,update one" ]
+
+    run dolt diff main base
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "apply: am reads patches from standard input" {
+    dolt branch base
+    dolt sql -q "UPDATE t SET v = 'x' WHERE pk = 1"
+    dolt commit -am "update one"
+    dolt sql -q "DELETE FROM t WHERE pk = 2"
+    dolt commit -am "delete two"
+
+    dolt format-patch --stdout base > all.sql
+    dolt checkout base
+    run dolt am < all.sql
+    [ "$status" -eq 0 ]
+
+    run dolt log --oneline -n 2
+    [ "$status" -eq 0 ]
+    [[ "${lines[0]}" =~ "delete two" ]] || false
+    [[ "${lines[1]}" =~ "update one" ]] || false
+}
+
+@test "apply: apply leaves changes uncommitted" {
+    dolt sql -q "UPDATE t SET v = 'x' WHERE pk = 1"
+    dolt diff -r sql > change.sql
+    dolt reset --hard
+
+    run dolt apply change.sql
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "SELECT v FROM t WHERE pk = 1" -r csv
+    [ "${lines[1]}" = "x" ]
+
+    run dolt status
+    [[ "$output" =~ "modified:" ]] || false
+
+    dolt reset --hard
+    dolt apply < change.sql
+    run dolt sql -q "SELECT v FROM t WHERE pk = 1" -r csv
+    [ "${lines[1]}" = "x" ]
+}
+
+@test "apply: conflicts are resolved like a merge, and with cherry-pick for am" {
+    dolt branch base
+    dolt sql -q "UPDATE t SET v = 'main' WHERE pk = 1"
+    dolt commit -am "change main"
+    dolt checkout -b feature base
+    dolt sql -q "UPDATE t SET v = 'feature' WHERE pk = 1"
+    dolt commit --author "Jane Doe <jane@doe.com>" -am "change feature"
+    dolt format-patch base -o patches
+    dolt checkout main
+
+    run dolt apply patches/0001-change-feature.sql
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "dolt merge --abort" ]] || false
+
+    run dolt sql -q "SELECT is_merging FROM dolt_merge_status" -r csv
+    [ "${lines[1]}" = "true" ]
+
+    dolt merge --abort
+    run dolt sql -q "SELECT v FROM t WHERE pk = 1" -r csv
+    [ "${lines[1]}" = "main" ]
+
+    run dolt am patches/0001-change-feature.sql
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Unable to apply patch 'change feature' cleanly" ]] || false
+
+    dolt conflicts resolve --theirs t
+    dolt add t
+    dolt cherry-pick --continue
+
+    run dolt sql -q "SELECT author, message FROM dolt_log LIMIT 1" -r csv
+    [ "${lines[1]}" = "Jane Doe,change feature" ]
+    run dolt sql -q "SELECT v FROM t WHERE pk = 1" -r csv
+    [ "${lines[1]}" = "feature" ]
+}
+
+@test "apply: errors" {
+    run dolt apply missing.sql
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unable to read patch 'missing.sql'" ]] || false
+
+    echo 'DELETE FROM `t` WHERE `pk`=5;' > bad.sql
+    run dolt apply bad.sql
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "patch does not apply: statement changed no rows" ]] || false
+
+    run dolt am bad.sql
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "cannot commit a patch without a header" ]] || false
+
+    dolt sql -q "INSERT INTO t VALUES (3, 'c')"
+    dolt commit -am "insert three"
+    dolt format-patch --stdout HEAD~1 > good.sql
+    dolt sql -q "INSERT INTO t VALUES (4, 'd')"
+    run dolt am good.sql
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "cannot apply patches with uncommitted changes" ]] || false
+}
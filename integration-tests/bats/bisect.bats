@@ -0,0 +1,93 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql -q "CREATE TABLE t (pk int primary key, v int)"
+    dolt commit -Am "create t"
+    dolt sql -q "INSERT INTO t VALUES (1, 1)"
+    dolt commit -am "one"
+    dolt sql -q "INSERT INTO t VALUES (2, -2)"
+    dolt commit -am "two"
+    dolt sql -q "INSERT INTO t VALUES (3, 3)"
+    dolt commit -am "three"
+    dolt sql -q "INSERT INTO dolt_tests VALUES ('no negatives', 'checks', 'SELECT COUNT(*) FROM t WHERE v < 0', 'expected_single_value', '==', '0')"
+    dolt commit -Am "add tests"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "bisect: no bisect in progress" {
+    run dolt bisect good
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "no bisect in progress" ]] || false
+
+    run dolt bisect
+    [ "$status" -eq 1 ]
+}
+
+@test "bisect: manually marking commits" {
+    run dolt bisect start
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "waiting for both good and bad commits" ]] || false
+
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "dolt_bisect_main" ]
+
+    dolt bisect bad
+    run dolt bisect good HEAD~4
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Bisecting: " ]] || false
+    [[ "$output" =~ "] two" ]] || false
+
+    run dolt bisect bad
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "] one" ]] || false
+
+    run dolt bisect good
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "is the first bad commit" ]] || false
+    [[ "$output" =~ "two" ]] || false
+
+    run dolt bisect reset
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "bisect reset" ]] || false
+
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "main" ]
+
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "dolt_bisect_main" ]] || false
+}
+
+@test "bisect: run uses dolt_tests to find the first bad commit" {
+    dolt bisect start HEAD HEAD~4
+
+    run dolt bisect run checks
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "is the first bad commit" ]] || false
+    [[ "$output" =~ "two" ]] || false
+
+    run dolt log -n 1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "two" ]] || false
+
+    dolt bisect reset
+    run dolt sql -q "SELECT COUNT(*) FROM t" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+}
+
+@test "bisect: cannot start with uncommitted changes" {
+    dolt sql -q "INSERT INTO t VALUES (4, 4)"
+    run dolt bisect start
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "cannot start a bisect with uncommitted changes" ]] || false
+}
@@ -183,3 +183,35 @@ SQL
     [[ "${lines[9]}" =~ "| sub  | 2   |" ]] || false
     [[ "${lines[10]}" =~ "| zzz  | 4   |" ]] || false
 }
+
+@test "blame: --cells annotates each column of each row" {
+    stash_current_dolt_user
+    set_dolt_user "Sally Shoes", "bats-5@email.fake"
+    dolt sql -q "alter table blame_test add column age int"
+    dolt sql -q "update blame_test set age = 30 where pk = 1"
+    dolt commit -am "add ages"
+    restore_stashed_dolt_user
+
+    run dolt blame --cells blame_test
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" =~ "pk".*"column_name".*"commit".*"author".*"author_email".*"author_date".*"message" ]] || false
+    [[ "${lines[3]}" =~ "| 1  | pk ".*"| Thomas Foolery, | bats-1@email.fake | ".*"create blame_test table" ]] || false
+    [[ "${lines[4]}" =~ "| 1  | name ".*"| Thomas Foolery, | bats-1@email.fake | ".*"create blame_test table" ]] || false
+    [[ "${lines[5]}" =~ "| 1  | age ".*"| Sally Shoes, ".*"add ages" ]] || false
+    [[ "${lines[6]}" =~ "| 2  | pk ".*"| Richard Tracy, ".*"add richard to blame_test" ]] || false
+    [[ "${lines[7]}" =~ "| 2  | name ".*"| Harry Wombat, ".*"replace richard with harry" ]] || false
+    [[ "${lines[8]}" =~ "| 2  | age ".*"| Sally Shoes, ".*"add ages" ]] || false
+}
+
+@test "blame: --cells works with a commit ref" {
+    run dolt blame --cells HEAD~2 blame_test
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| 2  | pk ".*"| Richard Tracy, ".*"add richard to blame_test" ]] || false
+    [[ "$output" =~ "| 2  | name ".*"| Richard Tracy, ".*"add richard to blame_test" ]] || false
+    [[ ! "$output" =~ "Harry Wombat" ]] || false
+    [[ ! "$output" =~ "Johnny Moolah" ]] || false
+
+    run dolt blame --cells HEAD~4 blame_test
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table not found" ]] || false
+}
@@ -0,0 +1,154 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    BUNDLES="$BATS_TMPDIR/dolt-bundles-$$"
+    rm -rf "$BUNDLES"
+    mkdir "$BUNDLES"
+
+    dolt sql -q "CREATE TABLE t (pk int primary key, c int)"
+    dolt sql -q "INSERT INTO t VALUES (1, 1), (2, 2)"
+    dolt commit -Am "create t"
+}
+
+teardown() {
+    assert_feature_version
+    rm -rf "$BUNDLES"
+    teardown_common
+}
+
+@test "bundle: create and list-heads" {
+    dolt tag v1
+    dolt branch other
+
+    run dolt bundle create "$BUNDLES/repo.bundle" main v1 refs/heads/other
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "with 3 refs" ]] || false
+
+    head=$(dolt sql -r csv -q "SELECT hashof('main')" | tail -n 1)
+    run dolt bundle list-heads "$BUNDLES/repo.bundle"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "$head refs/heads/main" ]] || false
+    [[ "$output" =~ "$head refs/heads/other" ]] || false
+    [[ "$output" =~ "refs/tags/v1" ]] || false
+}
+
+@test "bundle: create with --all includes every branch and tag" {
+    dolt tag v1
+    dolt branch other
+
+    run dolt bundle create --all "$BUNDLES/repo.bundle"
+    [ "$status" -eq 0 ]
+
+    run dolt bundle list-heads "$BUNDLES/repo.bundle"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+}
+
+@test "bundle: create errors" {
+    run dolt bundle create "$BUNDLES/repo.bundle"
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "either --all or at least one ref" ]] || false
+
+    run dolt bundle create "$BUNDLES/repo.bundle" nosuchbranch
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "'nosuchbranch' is not a branch, tag, or ref" ]] || false
+
+    run dolt bundle create "$BUNDLES/repo.bundle" main --base main
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "already contained in the base of the bundle" ]] || false
+    [ ! -f "$BUNDLES/repo.bundle" ]
+}
+
+@test "bundle: clone a bundle" {
+    dolt branch other
+    dolt bundle create "$BUNDLES/repo.bundle" main other
+
+    cd "$BUNDLES"
+    run dolt clone repo.bundle
+    [ "$status" -eq 0 ]
+
+    cd repo
+    run dolt sql -q "SELECT * FROM t" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2,2" ]] || false
+
+    run dolt branch -a
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "remotes/origin/main" ]] || false
+    [[ "$output" =~ "remotes/origin/other" ]] || false
+
+    run dolt remote -v
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "bundle://" ]] || false
+}
+
+@test "bundle: incremental bundles can be fetched and pulled but not cloned" {
+    dolt bundle create "$BUNDLES/full.bundle" main
+    base=$(dolt sql -r csv -q "SELECT hashof('main')" | tail -n 1)
+
+    dolt sql -q "INSERT INTO t VALUES (3, 3)"
+    dolt commit -am "add a row"
+    dolt bundle create "$BUNDLES/incremental.bundle" main --base "$base"
+
+    run dolt bundle verify "$BUNDLES/incremental.bundle"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "The bundle requires 1 commit" ]] || false
+    [[ "$output" =~ "$base" ]] || false
+    [[ "$output" =~ "is okay" ]] || false
+
+    cd "$BUNDLES"
+    run dolt clone incremental.bundle
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "cannot clone an incremental bundle" ]] || false
+
+    dolt clone full.bundle repo
+    cd repo
+    dolt remote add incremental ../incremental.bundle
+    dolt fetch incremental
+    run dolt pull incremental main
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "SELECT * FROM t" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3,3" ]] || false
+}
+
+@test "bundle: verify reports missing prerequisites" {
+    base=$(dolt sql -r csv -q "SELECT hashof('main')" | tail -n 1)
+    dolt sql -q "INSERT INTO t VALUES (3, 3)"
+    dolt commit -am "add a row"
+    dolt bundle create "$BUNDLES/incremental.bundle" main --base "$base"
+
+    mkdir "$BUNDLES/other"
+    cd "$BUNDLES/other"
+    dolt init
+    run dolt bundle verify ../incremental.bundle
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "lacks these prerequisite commits" ]] || false
+    [[ "$output" =~ "$base" ]] || false
+}
+
+@test "bundle: bundles are read-only" {
+    dolt bundle create "$BUNDLES/repo.bundle" main
+    dolt remote add bundle "$BUNDLES/repo.bundle"
+    dolt commit --allow-empty -m "empty"
+
+    run dolt push bundle main
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "cannot write to a packed blobstore" ]] || false
+}
+
+@test "bundle: verify and list-heads reject files that aren't bundles" {
+    echo "not a bundle" > "$BUNDLES/bad.bundle"
+
+    run dolt bundle verify "$BUNDLES/bad.bundle"
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "not a bundle file" ]] || false
+
+    run dolt bundle list-heads "$BUNDLES/bad.bundle"
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "not a bundle file" ]] || false
+}
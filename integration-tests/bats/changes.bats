@@ -0,0 +1,92 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+load $BATS_TEST_DIRNAME/helper/query-server-common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v varchar(20));
+CALL dolt_commit('-Am', 'create t');
+INSERT INTO t VALUES (1, 'a'), (2, 'b');
+CALL dolt_commit('-am', 'insert rows');
+SQL
+}
+
+teardown() {
+    stop_sql_server 1
+    assert_feature_version
+    teardown_common
+}
+
+@test "changes: dolt_changes returns row events after a commit" {
+    since=$(dolt sql -r csv -q "SELECT hashof('HEAD~1')" | tail -n 1)
+    dolt sql <<SQL
+UPDATE t SET v = 'a2' WHERE pk = 1;
+DELETE FROM t WHERE pk = 2;
+CALL dolt_commit('-am', 'update and delete');
+SQL
+
+    run dolt sql -r csv -q "SELECT table_name, op, \`before\`, \`after\` FROM dolt_changes('main', '$since')"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 5 ]
+    [[ "${lines[1]}" =~ 't,c,,"{""v"": ""a"", ""pk"": 1}"' ]] || false
+    [[ "${lines[3]}" =~ 't,u,"{""v"": ""a"", ""pk"": 1}","{""v"": ""a2"", ""pk"": 1}"' ]] || false
+    [[ "${lines[4]}" =~ 't,d,"{""v"": ""b"", ""pk"": 2}",' ]] || false
+
+    run dolt sql -r csv -q "SELECT count(*) FROM dolt_changes('main', hashof('main'), 1)"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "0" ]
+}
+
+@test "changes: dolt_changes waits for a commit with changes" {
+    start_sql_server
+    since=$(dolt sql -r csv -q "SELECT hashof('main')" | tail -n 1)
+
+    dolt sql -r csv -q "SELECT op, \`after\` FROM dolt_changes('main', '$since', 30)" > waited.csv &
+    waiter=$!
+    sleep 1
+    dolt sql -q "CALL dolt_commit('--allow-empty', '-m', 'no changes')"
+    sleep 1
+    dolt sql -q "INSERT INTO t VALUES (3, 'c'); CALL dolt_commit('-am', 'insert three')"
+    wait $waiter
+
+    run cat waited.csv
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[1]}" =~ 'c,"{""v"": ""c"", ""pk"": 3}"' ]] || false
+}
+
+@test "changes: sql-server writes committed changes to the change data capture file" {
+    PORT=$( definePORT )
+    cat > server.yaml <<EOF
+listener:
+  port: $PORT
+
+change_data_capture:
+  path: changes.jsonl
+  branches: [main]
+EOF
+    start_sql_server_with_args_no_port --config server.yaml
+
+    dolt sql <<SQL
+INSERT INTO t VALUES (3, 'c');
+CALL dolt_commit('-am', 'insert three');
+CALL dolt_checkout('-b', 'other');
+INSERT INTO t VALUES (4, 'd');
+CALL dolt_commit('-am', 'insert four on other');
+SQL
+
+    for i in {1..50}; do
+        [ -s changes.jsonl ] && break
+        sleep 0.1
+    done
+    stop_sql_server 1
+
+    run cat changes.jsonl
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ '"after": {"v": "c", "pk": 3}' ]] || false
+    [[ "$output" =~ '"op": "c"' ]] || false
+    [[ "$output" =~ '"branch": "main"' ]] || false
+    [[ "$output" =~ '"table": "t"' ]] || false
+    [[ ! "$output" =~ '"pk": 4' ]] || false
+}
@@ -0,0 +1,213 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+load $BATS_TEST_DIRNAME/helper/query-server-common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v varchar(20));
+INSERT INTO dolt_tests VALUES ('has rows', 'checks', 'select * from t', 'expected_rows', '>', '0');
+SQL
+    dolt sql --save "count t" -q "select count(*) from t"
+    dolt add -A
+    dolt commit -m "create t"
+
+    cat > workflow.yaml <<EOF
+name: checks
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: verify
+    steps:
+      - name: count t
+        saved_query_name: count t
+        expected_rows: "== 1"
+      - name: dolt tests
+        dolt_test_groups:
+          - checks
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+}
+
+teardown() {
+    stop_sql_server 1
+    assert_feature_version
+    teardown_common
+}
+
+start_ci_server() {
+    PORT=$( definePORT )
+    cat > server.yaml <<EOF
+listener:
+  port: $PORT
+
+behavior:
+  run_ci_workflows: true
+EOF
+    start_sql_server_with_args_no_port --config server.yaml
+}
+
+wait_for_runs() {
+    for i in {1..100}; do
+        run dolt sql -r csv -q "SELECT count(*) FROM dolt_ci_runs"
+        if [ "$status" -eq 0 ] && [ "${lines[1]}" -ge "$1" ]; then
+            return 0
+        fi
+        sleep 0.1
+    done
+    return 1
+}
+
+@test "ci-server: workflows don't run unless enabled in the server config" {
+    start_sql_server
+
+    dolt sql <<SQL
+INSERT INTO t VALUES (1, 'a');
+CALL dolt_commit('-am', 'insert one');
+SQL
+    run wait_for_runs 1
+    [ "$status" -eq 1 ]
+}
+
+@test "ci-server: workflows run on commits to triggering branches" {
+    start_ci_server
+
+    # the dolt test fails, since t is empty
+    dolt sql -q "CALL dolt_commit('--allow-empty', '-m', 'empty')"
+    wait_for_runs 1
+
+    dolt sql <<SQL
+INSERT INTO t VALUES (1, 'a');
+CALL dolt_commit('-am', 'insert one');
+SQL
+    wait_for_runs 2
+
+    run dolt sql -r csv -q "SELECT id, workflow, event, branch, status, error FROM dolt_ci_runs ORDER BY id"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "1,checks,push,main,failed," ]] || false
+    [[ "${lines[2]}" = "2,checks,push,main,passed," ]] || false
+
+    run dolt sql -r csv -q "SELECT commit = hashof('main') FROM dolt_ci_runs WHERE id = 2"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "1" ]] || false
+
+    run dolt sql -r csv -q "SELECT run_id, step_index, job, step, status, output FROM dolt_ci_step_results ORDER BY run_id, step_index"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "1,0,verify,count t,passed," ]] || false
+    [[ "${lines[2]}" =~ "1,1,verify,dolt tests,failed,has rows:" ]] || false
+    [[ "${lines[3]}" = "2,0,verify,count t,passed," ]] || false
+    [[ "${lines[4]}" = "2,1,verify,dolt tests,passed," ]] || false
+}
+
+@test "ci-server: workflows run on merges and not on other branches" {
+    start_ci_server
+
+    dolt sql <<SQL
+CALL dolt_checkout('-b', 'feature');
+INSERT INTO t VALUES (1, 'a');
+CALL dolt_commit('-am', 'insert one');
+CALL dolt_checkout('main');
+INSERT INTO t VALUES (2, 'b');
+CALL dolt_commit('-am', 'insert two');
+SQL
+    wait_for_runs 1
+
+    dolt sql -q "CALL dolt_merge('feature', '-m', 'merge feature')"
+    wait_for_runs 2
+
+    run dolt sql -r csv -q "SELECT id, event, branch, status FROM dolt_ci_runs ORDER BY id"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[1]}" = "1,push,main,passed" ]] || false
+    [[ "${lines[2]}" = "2,merge,main,passed" ]] || false
+}
+
+@test "ci-server: failed steps record their errors" {
+    dolt sql -q "UPDATE dolt_query_catalog SET query = 'select * from missing' WHERE name = 'count t'"
+    dolt commit -am "break saved query"
+    start_ci_server
+
+    dolt sql -q "CALL dolt_commit('--allow-empty', '-m', 'empty')"
+    wait_for_runs 1
+
+    run dolt sql -r csv -q "SELECT status FROM dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "failed" ]] || false
+
+    run dolt sql -r csv -q "SELECT output FROM dolt_ci_step_results WHERE step = 'count t'"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "table not found: missing" ]] || false
+}
+
+@test "ci-server: scheduled workflows run against their branches" {
+    dolt branch other
+    cat > nightly.yaml <<EOF
+name: nightly
+on:
+  schedule:
+    - cron: "* * * * *"
+      branches:
+        - other
+jobs:
+  - name: verify
+    steps:
+      - name: dolt tests
+        dolt_test_groups:
+          - checks
+EOF
+    dolt ci import ./nightly.yaml
+    start_ci_server
+
+    # schedules run at the start of each minute
+    for i in {1..75}; do
+        run dolt sql -r csv -q "SELECT count(*) FROM dolt_ci_runs"
+        if [ "$status" -eq 0 ] && [ "${lines[1]}" -ge 1 ]; then
+            break
+        fi
+        sleep 1
+    done
+
+    run dolt sql -r csv -q "SELECT workflow, event, branch, status, commit = hashof('other') FROM dolt_ci_runs WHERE id = 1"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "nightly,schedule,other,failed,1" ]] || false
+}
+
+@test "ci-server: pull request activity runs pull_request workflows against the source commit" {
+    cat > review.yaml <<EOF2
+name: review
+on:
+  pull_request:
+    branches:
+      - main
+    activities:
+      - opened
+jobs:
+  - name: verify
+    steps:
+      - name: count t
+        saved_query_name: count t
+        expected_rows: "== 1"
+EOF2
+    dolt ci import ./review.yaml
+    dolt checkout -b feature
+    dolt sql -q "INSERT INTO t VALUES (1, 'a')"
+    dolt commit -am "insert one"
+    dolt checkout main
+    start_ci_server
+
+    dolt sql -q "CALL dolt_pr_open('-m', 'insert one', 'feature', 'main')"
+    wait_for_runs 1
+
+    run dolt sql -r csv -q "SELECT id, workflow, event, branch, status, commit = hashof('feature') FROM dolt_ci_runs ORDER BY id"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[1]}" = "1,review,pull_request,feature,passed,1" ]] || false
+
+    run dolt sql -r csv -q "SELECT activity, workflows FROM dolt_pull_request_events"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "opened,review" ]] || false
+}
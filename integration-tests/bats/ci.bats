@@ -618,6 +618,81 @@ EOF
     [[ "$output" =~ "- \"group_b\"" ]] || false
 }
 
+@test "ci: import and export schedule events" {
+    cat > workflow.yaml <<EOF
+name: wf_nightly
+on:
+  schedule:
+    - cron: "0 2 * * *"
+    - cron: "30 4 * * mon-fri"
+      branches:
+        - main
+jobs:
+  - name: validate tables
+    steps:
+      - name: ensure tables listed
+        saved_query_name: get tables
+EOF
+
+    dolt ci init
+    dolt ci import ./workflow.yaml
+
+    run dolt sql -r csv -q "select event_type, cron from dolt_ci_workflow_events order by cron"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "4,0 2 * * *" ]] || false
+    [[ "${lines[2]}" = "4,30 4 * * mon-fri" ]] || false
+
+    run dolt ci export "wf_nightly"
+    [ "$status" -eq 0 ]
+    run cat wf_nightly.yaml
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "schedule:" ]] || false
+    [[ "$output" =~ "- cron: \"0 2 * * *\"" ]] || false
+    [[ "$output" =~ "- cron: \"30 4 * * mon-fri\"" ]] || false
+    [[ "$output" =~ "- \"main\"" ]] || false
+
+    run dolt ci import ./workflow.yaml
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Dolt CI Workflow 'wf_nightly' up to date." ]] || false
+
+    # schedules are replaced when a workflow is updated
+    cat > workflow.yaml <<EOF
+name: wf_nightly
+on:
+  schedule:
+    - cron: "@hourly"
+jobs:
+  - name: validate tables
+    steps:
+      - name: ensure tables listed
+        saved_query_name: get tables
+EOF
+    dolt ci import ./workflow.yaml
+    run dolt sql -r csv -q "select cron from dolt_ci_workflow_events"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[1]}" = "@hourly" ]] || false
+}
+
+@test "ci: import command will error on invalid cron expressions" {
+    cat > workflow.yaml <<EOF
+name: wf_nightly
+on:
+  schedule:
+    - cron: "0 25 * * *"
+jobs:
+  - name: validate tables
+    steps:
+      - name: ensure tables listed
+        saved_query_name: get tables
+EOF
+
+    dolt ci init
+    run dolt ci import ./workflow.yaml
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "invalid cron expression '0 25 * * *': invalid value in hour field: 25" ]] || false
+}
+
 @test "ci: export errors on invalid workflow" {
     dolt ci init
     run dolt ci export invalid
@@ -964,6 +1039,56 @@ EOF
     [[ "$output" =~ "    - error: Assertion failed: expected column count less than 5, got 8" ]] || false
 }
 
+@test "ci: ci run writes junit and json reports" {
+    cat > workflow.yaml <<EOF
+name: workflow
+on:
+  push: {}
+jobs:
+  - name: queries
+    steps:
+      - name: expect rows
+        saved_query_name: main
+        expected_rows: "== 2"
+  - name: tests
+    steps:
+      - name: dolt tests
+        dolt_test_groups:
+          - "*"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    dolt sql --save "main" -q "select * from dolt_commits;"
+    dolt sql -q "insert into dolt_tests values ('one row', 'g1', 'select 1', 'expected_rows', '==', '1');"
+    dolt sql -q "insert into dolt_tests values ('two', 'g1', 'select 1', 'expected_single_value', '==', '2');"
+
+    run dolt ci run workflow --report-file report.xml
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Running workflow: workflow" ]] || false
+    run cat report.xml
+    [[ "$output" =~ '<testsuites name="workflow" tests="2" failures="2"' ]] || false
+    [[ "$output" =~ '<testsuite name="queries" tests="1" failures="1"' ]] || false
+    [[ "$output" =~ '<testcase name="expect rows" classname="queries" time="' ]] || false
+    [[ "$output" =~ '<property name="expected" value="expected_rows == 2"></property>' ]] || false
+    [[ "$output" =~ '<property name="actual" value="3"></property>' ]] || false
+    [[ "$output" =~ '<failure message="Assertion failed: expected row count 2, got 3" type="AssertionFailure">' ]] || false
+    [[ "$output" =~ '<testsuite name="tests" tests="1" failures="1"' ]] || false
+    [[ "$output" =~ '<property name="expected" value="two: expected_single_value == 2"></property>' ]] || false
+    [[ "$output" =~ '<property name="actual" value="two: 1"></property>' ]] || false
+
+    run dolt ci run workflow --report json --report-file report.json
+    [ "$status" -eq 1 ]
+    run cat report.json
+    [[ "$output" =~ '"name": "expect rows"' ]] || false
+    [[ "$output" =~ '"status": "failed"' ]] || false
+    [[ "$output" =~ '"actual": "3"' ]] || false
+    [[ "$output" =~ '"duration_ms": ' ]] || false
+
+    run dolt ci run workflow --report json
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--report requires --report-file" ]] || false
+}
+
 @test "ci: ci run fails on bad query" {
     cat > workflow.yaml <<EOF
 name: workflow
@@ -468,3 +468,48 @@ SQL
     [ "$status" -eq 0 ]
     ! [[ "$output" =~ "rebase in progress" ]] || false
 }
+
+@test "commit_verification: change assertions are verified on commit, fast-forward merge and push" {
+    mkdir ../remote
+    dolt remote add origin file://../remote
+    dolt push origin main
+
+    dolt sql -q "SET @@PERSIST.dolt_commit_verification_groups = '*';"
+    dolt sql <<SQL
+INSERT INTO dolt_tests (test_name, test_group, test_query, assertion_type, assertion_comparator, assertion_value) VALUES
+('no_deletes', 'unit', 'SELECT COUNT(*) FROM dolt_diff(@dolt_change_from, @dolt_change_to, "users") WHERE diff_type = "removed"', 'expected_change_single_value', '==', '0');
+SQL
+    dolt add .
+    dolt commit -m "Add change test"
+
+    dolt checkout -b feature
+    dolt sql -q "DELETE FROM users WHERE id = 1"
+    dolt add .
+
+    run dolt commit -m "Delete Alice"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "commit verification failed" ]] || false
+    [[ "$output" =~ "no_deletes (Assertion failed: expected_change_single_value equal to 0, got 1)" ]] || false
+
+    dolt commit --skip-verification -m "Delete Alice"
+    dolt checkout main
+
+    run dolt merge feature
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "commit verification failed" ]] || false
+    [[ "$output" =~ "no_deletes" ]] || false
+
+    run dolt sql -r csv -q "SELECT COUNT(*) FROM users"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1" ]] || false
+
+    dolt merge --skip-verification feature
+
+    run dolt push origin main
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "commit verification failed" ]] || false
+    [[ "$output" =~ "no_deletes" ]] || false
+
+    run dolt push --skip-verification origin main
+    [ "$status" -eq 0 ]
+}
@@ -23,3 +23,142 @@ teardown() {
     [[ $output =~ "| test1     | test1           | select 1 | PASS   |         |" ]] || false
     [[ $output =~ "| test2     | test2           | select 2 | PASS   |         |" ]] || false
 }
+
+@test "dolt-test-run: dolt test run runs tests and groups" {
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key);
+INSERT INTO dolt_tests VALUES ('has rows', 'checks', 'select * from t', 'expected_rows', '>', '0');
+INSERT INTO dolt_tests VALUES ('one column', 'checks', 'select * from t', 'expected_columns', '==', '1');
+INSERT INTO dolt_tests VALUES ('constant', NULL, 'select 2', 'expected_single_value', '==', '2');
+SQL
+
+    run dolt test run
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "- test: constant (group: ungrouped) - PASS" ]] || false
+    [[ "$output" =~ "- test: has rows (group: checks) - FAIL" ]] || false
+    [[ "$output" =~ "  - error: Assertion failed: expected_rows greater than 0, got 0" ]] || false
+    [[ "$output" =~ "- test: one column (group: checks) - FAIL" ]] || false
+    [[ "$output" =~ "1 passed, 2 failed" ]] || false
+
+    dolt sql -q "INSERT INTO t VALUES (1)"
+    run dolt test run checks
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "- test: has rows (group: checks) - PASS" ]] || false
+    [[ "$output" =~ "- test: one column (group: checks) - PASS" ]] || false
+    [[ ! "$output" =~ "constant" ]] || false
+    [[ "$output" =~ "2 passed, 0 failed" ]] || false
+
+    run dolt test run constant 'has rows'
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2 passed, 0 failed" ]] || false
+
+    run dolt test run missing
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "could not find tests for argument: missing" ]] || false
+}
+
+@test "dolt-test-run: dolt test run writes junit and json reports" {
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key);
+INSERT INTO dolt_tests VALUES ('has rows', 'checks', 'select * from t', 'expected_rows', '>', '0');
+INSERT INTO dolt_tests VALUES ('constant', NULL, 'select 2', 'expected_single_value', '==', '2');
+SQL
+
+    run dolt test run --report-file report.xml
+    [ "$status" -eq 1 ]
+    run cat report.xml
+    [[ "$output" =~ '<testsuites name="dolt_tests" tests="2" failures="1"' ]] || false
+    [[ "$output" =~ '<testsuite name="checks" tests="1" failures="1"' ]] || false
+    [[ "$output" =~ '<testsuite name="ungrouped" tests="1" failures="0"' ]] || false
+    [[ "$output" =~ '<testcase name="has rows" classname="checks" time="' ]] || false
+    [[ "$output" =~ '<property name="expected" value="expected_rows &gt; 0"></property>' ]] || false
+    [[ "$output" =~ '<property name="actual" value="0"></property>' ]] || false
+    [[ "$output" =~ '<failure message="Assertion failed: expected_rows greater than 0, got 0" type="AssertionFailure">' ]] || false
+
+    run dolt test run checks --report json --report-file report.json
+    [ "$status" -eq 1 ]
+    run cat report.json
+    [[ "$output" =~ '"name": "has rows"' ]] || false
+    [[ "$output" =~ '"status": "failed"' ]] || false
+    [[ "$output" =~ '"expected": "expected_rows > 0"' ]] || false
+    [[ "$output" =~ '"actual": "0"' ]] || false
+    [[ "$output" =~ '"duration_ms": ' ]] || false
+    [[ ! "$output" =~ "constant" ]] || false
+
+    run dolt test run --report json
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--report requires --report-file" ]] || false
+
+    run dolt test run --report xml --report-file report.xml
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "invalid report format 'xml'" ]] || false
+}
+
+@test "dolt-test-run: expected_error, expected_snapshot and expected_duration_ms assertions" {
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v int, CHECK (v > 0));
+INSERT INTO t VALUES (1, 1);
+INSERT INTO dolt_tests VALUES ('rejects negative', 'checks', 'insert into t values (2, -1)', 'expected_error', '==', 'Check constraint');
+INSERT INTO dolt_tests VALUES ('t snapshot', 'checks', 'select * from t', 'expected_snapshot', '==', NULL);
+INSERT INTO dolt_tests VALUES ('fast', 'checks', 'select * from t', 'expected_duration_ms', '<', '10000');
+SQL
+
+    run dolt test run checks
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "expected_snapshot has no recorded snapshot" ]] || false
+
+    run dolt sql -r csv -q "CALL dolt_test_snapshot('checks')"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "t snapshot," ]] || false
+
+    run dolt test run checks
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3 passed, 0 failed" ]] || false
+
+    # the rejected insert isn't kept
+    run dolt sql -r csv -q "SELECT count(*) FROM t"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "1" ]] || false
+
+    dolt sql -q "UPDATE t SET v = 2"
+    run dolt test run checks --report json --report-file report.json
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "- test: t snapshot (group: checks) - FAIL" ]] || false
+    run cat report.json
+    [[ "$output" =~ '"expected": "expected_snapshot == ' ]] || false
+}
+
+@test "dolt-test-run: dolt_test_fixtures and isolated test runs" {
+    dolt sql <<SQL
+CREATE TABLE accounts (id int primary key, balance int, CHECK (balance >= 0));
+CALL dolt_commit('-Am', 'create accounts');
+INSERT INTO dolt_test_fixtures VALUES ('accounts', 'INSERT INTO accounts VALUES (1, 10), (2, 20)', NULL);
+INSERT INTO dolt_tests VALUES ('two accounts', 'accounts', 'SELECT * FROM accounts', 'expected_rows', '==', '2');
+SQL
+
+    run dolt test run --isolated accounts
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1 passed, 0 failed" ]] || false
+
+    run dolt sql -r csv -q "SELECT count(*) FROM accounts"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "0" ]] || false
+
+    # without --isolated, the changes of the setup are discarded too
+    run dolt test run accounts
+    [ "$status" -eq 0 ]
+    run dolt sql -r csv -q "SELECT count(*) FROM accounts"
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "0" ]] || false
+
+    # isolated tests don't see the uncommitted changes of the working set, and leave no branch behind
+    dolt sql -q "INSERT INTO accounts VALUES (5, 50)"
+    run dolt test run --isolated accounts
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1 passed, 0 failed" ]] || false
+    run dolt sql -r csv -q "SELECT count(*) FROM accounts"
+    [[ "${lines[1]}" = "1" ]] || false
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "dolt_test_run_" ]] || false
+}
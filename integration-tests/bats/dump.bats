@@ -985,6 +985,153 @@ SQL
     # need to test binary, bit and blob types
 }
 
+@test "dump: incremental dump between two revisions" {
+    dolt sql <<SQL
+CREATE TABLE parent (id int primary key, name varchar(20));
+CREATE TABLE child (id int primary key, pid int, CONSTRAINT fk_parent FOREIGN KEY (pid) REFERENCES parent (id));
+CREATE TABLE gone (id int primary key);
+INSERT INTO parent VALUES (1, 'one'), (2, 'two'), (3, 'three');
+INSERT INTO child VALUES (1, 1), (2, 2);
+SQL
+    dolt commit -Am "base"
+    dolt tag base
+
+    dolt sql <<SQL
+UPDATE parent SET name = 'uno' WHERE id = 1;
+DELETE FROM child WHERE id = 2;
+DELETE FROM parent WHERE id = 2;
+INSERT INTO parent VALUES (4, 'four');
+ALTER TABLE child ADD COLUMN note varchar(10);
+UPDATE child SET note = 'x' WHERE id = 1;
+DROP TABLE gone;
+CREATE TABLE fresh (id int primary key, s text);
+INSERT INTO fresh VALUES (1, 'hello');
+CREATE VIEW v AS SELECT * FROM parent;
+SQL
+    dolt commit -Am "changes"
+
+    run dolt dump --from base --to HEAD
+    [ "$status" -eq 0 ]
+    [ -f doltdump.sql ]
+
+    run cat doltdump.sql
+    [[ "$output" =~ 'DROP TABLE `gone`;' ]] || false
+    [[ "$output" =~ 'ALTER TABLE `child` ADD `note` varchar(10);' ]] || false
+    [[ "$output" =~ "UPDATE \`parent\` SET \`name\`='uno' WHERE \`id\`=1;" ]] || false
+    [[ "$output" =~ 'DELETE FROM `parent` WHERE `id`=2;' ]] || false
+    [[ "$output" =~ "INSERT INTO \`fresh\` (\`id\`,\`s\`) VALUES (1,'hello');" ]] || false
+    [[ "$output" =~ "CREATE VIEW v AS SELECT * FROM parent;" ]] || false
+    [[ ! "$output" =~ "three" ]] || false
+
+    # the dump brings the base revision up to date with HEAD
+    dolt checkout -b applied base
+    dolt sql < doltdump.sql
+    dolt add -A
+    run dolt diff --cached main --stat
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "dump: incremental dump does not fire existing triggers while loading data" {
+    dolt sql <<SQL
+CREATE TABLE t (id int primary key, v int);
+CREATE TABLE log (id int primary key);
+CREATE TRIGGER log_t AFTER INSERT ON t FOR EACH ROW INSERT INTO log VALUES (new.id);
+INSERT INTO t VALUES (1, 1);
+SQL
+    dolt commit -Am "base"
+    dolt tag base
+    dolt sql -q "INSERT INTO t VALUES (2, 2);"
+    dolt commit -Am "changes"
+
+    run dolt dump --from base --to HEAD
+    [ "$status" -eq 0 ]
+
+    run cat doltdump.sql
+    [[ "$output" =~ 'DROP TRIGGER IF EXISTS `log_t`;' ]] || false
+    [[ "$output" =~ "delimiter END_STATEMENT" ]] || false
+
+    dolt checkout -b applied base
+    dolt sql < doltdump.sql
+    dolt add -A
+    run dolt diff --cached main --stat
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+    run dolt sql -q "SHOW TRIGGERS" -r csv
+    [[ "$output" =~ "log_t" ]] || false
+}
+
+@test "dump: incremental dump rewrites tables with a changed primary key" {
+    dolt sql -q "CREATE TABLE t (id int primary key, v int); INSERT INTO t VALUES (1, 1), (2, 2);"
+    dolt commit -Am "base"
+    dolt sql -q "ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (id, v); INSERT INTO t VALUES (3, 3);"
+
+    run dolt dump --from HEAD --no-create-db
+    [ "$status" -eq 0 ]
+
+    run cat doltdump.sql
+    [[ "$output" =~ 'ALTER TABLE `t` ADD PRIMARY KEY (`id`,`v`);' ]] || false
+    [[ "$output" =~ 'DELETE FROM `t`;' ]] || false
+    [[ "$output" =~ 'INSERT INTO `t` (`id`,`v`) VALUES (1,1);' ]] || false
+    [[ "$output" =~ 'INSERT INTO `t` (`id`,`v`) VALUES (3,3);' ]] || false
+
+    run dolt dump --from HEAD -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Incompatible schema change, skipping data changes for table 't'" ]] || false
+    [ ! -f doltdump/t.csv ]
+}
+
+@test "dump: incremental dump with --no-autocommit and --schema-only" {
+    dolt sql -q "CREATE TABLE t (id int primary key, v int); INSERT INTO t VALUES (1, 1);"
+    dolt commit -Am "base"
+    dolt sql -q "ALTER TABLE t ADD COLUMN w int; INSERT INTO t VALUES (2, 2, 2);"
+
+    run dolt dump --from HEAD --no-autocommit
+    [ "$status" -eq 0 ]
+    run cat doltdump.sql
+    [[ "$output" =~ "SET AUTOCOMMIT=0;" ]] || false
+    [[ "$output" =~ "COMMIT;" ]] || false
+
+    run dolt dump --from HEAD --schema-only
+    [ "$status" -eq 0 ]
+    run cat doltdump_schema_only.sql
+    [[ "$output" =~ 'ALTER TABLE `t` ADD `w` int;' ]] || false
+    [[ ! "$output" =~ "INSERT" ]] || false
+}
+
+@test "dump: incremental dump to csv and json files" {
+    dolt sql -q "CREATE TABLE t (id int primary key, v int); CREATE TABLE same (id int primary key); INSERT INTO t VALUES (1, 1), (2, 2); INSERT INTO same VALUES (1);"
+    dolt commit -Am "base"
+    dolt sql -q "UPDATE t SET v = 10 WHERE id = 1; DELETE FROM t WHERE id = 2; INSERT INTO t VALUES (3, 3);"
+
+    run dolt dump --from HEAD -r csv
+    [ "$status" -eq 0 ]
+    [ -f doltdump/t.csv ]
+    [ ! -f doltdump/same.csv ]
+    run cat doltdump/t.csv
+    [ "${lines[0]}" = "id,v,diff_type" ]
+    [[ "$output" =~ "1,10,modified" ]] || false
+    [[ "$output" =~ "2,2,removed" ]] || false
+    [[ "$output" =~ "3,3,added" ]] || false
+
+    run dolt dump --from HEAD -r json -d json_dump
+    [ "$status" -eq 0 ]
+    run cat json_dump/t.json
+    [[ "$output" =~ '"diff_type":"modified"' ]] || false
+}
+
+@test "dump: incremental dump with invalid arguments" {
+    dolt sql -q "CREATE TABLE t (id int primary key);"
+    dolt commit -Am "base"
+
+    run dolt dump --to HEAD
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "--to requires --from" ]] || false
+
+    run dolt dump --from nonexistent
+    [ "$status" -ne 0 ]
+}
+
 function create_tables() {
   dolt sql -q "CREATE TABLE new_table(pk int primary key);"
   dolt sql -q "CREATE TABLE warehouse(warehouse_id int primary key, warehouse_name varchar(100));"
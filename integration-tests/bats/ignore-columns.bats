@@ -0,0 +1,65 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (id int primary key, val varchar(20), updated_at int);
+INSERT INTO t VALUES (1, 'one', 100), (2, 'two', 100);
+INSERT INTO dolt_ignore_columns VALUES ('t', 'updated_at');
+SQL
+    dolt add .
+    dolt commit -m "create t"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "ignore-columns: status and diff skip changes to ignored columns" {
+    dolt sql -q "UPDATE t SET updated_at = 200"
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+
+    run dolt diff
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+
+    dolt sql -q "UPDATE t SET val = 'TWO', updated_at = 300 WHERE id = 2"
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "modified:         t" ]] || false
+
+    run dolt diff
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| < | 2  | two | 100        |" ]] || false
+    [[ "$output" =~ "| > | 2  | TWO | 300        |" ]] || false
+    [[ ! "$output" =~ "| 1  |" ]] || false
+
+    run dolt diff --stat
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1 Row Modified" ]] || false
+}
+
+@test "ignore-columns: concurrent changes to ignored columns merge cleanly" {
+    dolt branch other
+    dolt sql -q "UPDATE t SET val = 'ONE', updated_at = 200 WHERE id = 1"
+    dolt commit -am "update on main"
+    dolt checkout other
+    dolt sql -q "UPDATE t SET updated_at = 300 WHERE id = 1"
+    dolt commit -am "update on other"
+    dolt checkout main
+
+    run dolt merge other
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "CONFLICT" ]] || false
+
+    run dolt sql -q "SELECT * FROM t WHERE id = 1" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,ONE,200" ]] || false
+}
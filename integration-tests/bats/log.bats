@@ -1108,4 +1108,58 @@ export NO_COLOR=1
     [[ "$output" =~ "A table for br1" ]] || false
     ! [[ "$output" =~ "Initialize data repository" ]] || false
     ! [[ "$output" =~ "commit 1 br2" ]] || false
-}
\ No newline at end of file
+}
+@test "log: --row shows the history of a row" {
+    dolt sql -q "create table t (pk1 int, pk2 varchar(10), v varchar(10), primary key (pk1, pk2))"
+    dolt sql -q "insert into t values (1, 'a', 'first'), (2, 'b', 'other')"
+    dolt commit -Am "insert rows"
+    dolt sql -q "update t set v = 'second' where pk1 = 1"
+    dolt commit --author "Jane Doe <jane@doe.com>" -am "update row"
+    dolt sql -q "update t set v = 'changed' where pk1 = 2"
+    dolt commit -am "update other row"
+    dolt sql -q "delete from t where pk1 = 1"
+    dolt commit -am "delete row"
+
+    run dolt log --row t 1 a
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "delete row" ]] || false
+    [[ "$output" =~ "removed" ]] || false
+    [[ "$output" =~ "Author: Jane Doe <jane@doe.com>" ]] || false
+    [[ "$output" =~ "v: second" ]] || false
+    [[ "$output" =~ "v: first" ]] || false
+    ! [[ "$output" =~ "update other row" ]] || false
+    ! [[ "$output" =~ "Initialize data repository" ]] || false
+
+    run dolt log --row --oneline t 1 a
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[0]}" =~ "delete row" ]] || false
+    [[ "${lines[1]}" =~ "update row" ]] || false
+    [[ "${lines[2]}" =~ "insert rows" ]] || false
+
+    run dolt log --row --oneline -n 1 t 2 b
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "${lines[0]}" =~ "update other row" ]] || false
+
+    run dolt log --row t 3 c
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "log: --row errors" {
+    dolt sql -q "create table t (pk int primary key)"
+    dolt commit -Am "create t"
+
+    run dolt log --row t
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--row requires a table and the primary key values of a row" ]] || false
+
+    run dolt log --row t 1 2
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table t has 1 primary key columns, but 2 values were given" ]] || false
+
+    run dolt log --row missing 1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table not found: missing" ]] || false
+}
@@ -0,0 +1,58 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE inventory (id int primary key, qty int, note varchar(20));
+INSERT INTO inventory VALUES (1, 10, 'base');
+SQL
+    dolt add .
+    dolt commit -m "create inventory"
+    dolt branch feature
+
+    dolt sql -q "UPDATE inventory SET qty = 12, note = 'ours' WHERE id = 1"
+    dolt commit -am "update on main"
+    dolt checkout feature
+    dolt sql -q "UPDATE inventory SET qty = 7, note = 'theirs' WHERE id = 1"
+    dolt commit -am "update on feature"
+    dolt checkout main
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "merge-policies: concurrent updates conflict without a policy" {
+    run dolt merge feature
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "CONFLICT (content): Merge conflict in inventory" ]] || false
+}
+
+@test "merge-policies: policies resolve concurrent updates" {
+    dolt sql <<SQL
+INSERT INTO dolt_merge_policies VALUES ('inventory', 'qty', 'sum_deltas', NULL), ('inventory', 'note', 'prefer_theirs', NULL);
+SQL
+    dolt add dolt_merge_policies
+    dolt commit -m "add merge policies"
+
+    run dolt merge feature -m "merge feature"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "CONFLICT" ]] || false
+
+    run dolt sql -q "SELECT qty, note FROM inventory" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "9,theirs" ]] || false
+}
+
+@test "merge-policies: invalid policies are rejected" {
+    run dolt sql -q "INSERT INTO dolt_merge_policies VALUES ('inventory', 'qty', 'newest', NULL)"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Check constraint" ]] || false
+
+    run dolt sql -q "INSERT INTO dolt_merge_policies VALUES ('inventory', 'qty', 'expression', NULL)"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Check constraint" ]] || false
+}
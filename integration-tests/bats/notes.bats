@@ -0,0 +1,159 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql -q "CREATE TABLE t (pk int primary key)"
+    dolt commit -Am "create t"
+    dolt sql -q "INSERT INTO t VALUES (1)"
+    dolt commit -am "insert 1"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "notes: add, show, list, and remove" {
+    head=$(dolt sql -r csv -q "SELECT hashof('HEAD')" | tail -n 1)
+    parent=$(dolt sql -r csv -q "SELECT hashof('HEAD~1')" | tail -n 1)
+
+    run dolt notes list
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+
+    dolt notes add -m "signed off by QA"
+    dolt notes add --author "John Doe <john@doe.com>" -m "ticket 123" HEAD~1
+
+    run dolt notes show
+    [ "$status" -eq 0 ]
+    [ "$output" = "signed off by QA" ]
+
+    run dolt notes show HEAD~1
+    [ "$status" -eq 0 ]
+    [ "$output" = "ticket 123" ]
+
+    run dolt notes list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "$output" =~ "$head signed off by QA" ]] || false
+    [[ "$output" =~ "$parent ticket 123" ]] || false
+
+    run dolt sql -r csv -q "SELECT author, email, note FROM dolt_notes WHERE commit_hash = '$parent'"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "John Doe,john@doe.com,ticket 123" ]] || false
+
+    dolt notes remove HEAD~1
+    run dolt notes show HEAD~1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "no note found for commit $parent" ]] || false
+
+    run dolt notes remove HEAD~1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "commit $parent has no note" ]] || false
+}
+
+@test "notes: replacing a note requires -f" {
+    head=$(dolt sql -r csv -q "SELECT hashof('HEAD')" | tail -n 1)
+    dolt notes add -m "first"
+
+    run dolt notes add -m "second"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "commit $head already has a note, use -f to replace it" ]] || false
+
+    dolt notes add -f -m "second"
+    run dolt notes show
+    [ "$status" -eq 0 ]
+    [ "$output" = "second" ]
+}
+
+@test "notes: adding a note doesn't change the commit or the working set" {
+    head=$(dolt sql -r csv -q "SELECT hashof('HEAD')" | tail -n 1)
+    dolt notes add -m "audited"
+
+    run dolt sql -r csv -q "SELECT hashof('HEAD')"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "$head" ]] || false
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+}
+
+@test "notes: log --notes" {
+    dolt notes add -m "checked by ci" HEAD~1
+
+    run dolt log --notes
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Notes:" ]] || false
+    [[ "$output" =~ "checked by ci" ]] || false
+
+    run dolt log
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "Notes:" ]] || false
+
+    run dolt sql -r csv -q "SELECT message, notes FROM dolt_log('--notes') WHERE notes IS NOT NULL"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "create t,checked by ci" ]] || false
+}
+
+@test "notes: subcommand errors" {
+    run dolt notes add
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "a note message must be given with -m" ]] || false
+
+    run dolt notes frobnicate
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unknown notes subcommand 'frobnicate'" ]] || false
+
+    run dolt notes show HEAD HEAD~1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "show takes at most one commit" ]] || false
+}
+
+@test "notes: push, fetch, and clone notes" {
+    mkdir remote
+    dolt remote add origin file://remote
+    dolt push origin main
+    dolt notes add -m "from the first repo"
+
+    run dolt push origin refs/notes/commits
+    [ "$status" -eq 0 ]
+
+    run dolt push origin refs/notes/commits
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Everything up-to-date" ]] || false
+
+    cd $BATS_TMPDIR
+    rm -rf "notes-clone-$$"
+    dolt clone file://$BATS_TMPDIR/dolt-repo-$$/remote "notes-clone-$$"
+    cd "notes-clone-$$"
+
+    run dolt notes show
+    [ "$status" -eq 0 ]
+    [ "$output" = "from the first repo" ]
+
+    dolt notes add -m "from the clone" HEAD~1
+    dolt push origin refs/notes/commits
+
+    cd $BATS_TMPDIR/dolt-repo-$$
+    dolt fetch
+    run dolt notes list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "$output" =~ "from the first repo" ]] || false
+    [[ "$output" =~ "from the clone" ]] || false
+
+    rm -rf "$BATS_TMPDIR/notes-clone-$$"
+}
+
+@test "notes: only the default notes ref can be pushed" {
+    mkdir remote
+    dolt remote add origin file://remote
+    dolt notes add -m "note"
+
+    run dolt push origin refs/notes/other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "only 'refs/notes/commits' can be pushed" ]] || false
+}
@@ -0,0 +1,133 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v int);
+INSERT INTO t VALUES (1, 1);
+CALL dolt_commit('-Am', 'create t');
+CALL dolt_branch('feature');
+CREATE USER alice@'%' IDENTIFIED BY 'pw';
+CREATE USER bob@'%' IDENTIFIED BY 'pw';
+GRANT ALL ON *.* TO alice@'%';
+GRANT ALL ON *.* TO bob@'%';
+SQL
+    dolt checkout feature
+    dolt sql -q "INSERT INTO t VALUES (2, 2)"
+    dolt commit -am "insert on feature"
+    dolt checkout main
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+as_user() {
+    local user="$1"
+    shift
+    dolt --user "$user" --password pw "$@"
+}
+
+@test "pull-requests: protected branches need approvals of the latest commit from other users" {
+    dolt sql -q "INSERT INTO dolt_protected_branches VALUES ('main', 1)"
+    dolt commit -Am "protect main"
+
+    run as_user alice sql -r csv -q "CALL dolt_pr_open('-m', 'add row 2', '--reviewers', 'bob', 'feature', 'main')"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1" ]] || false
+
+    run as_user alice sql -q "CALL dolt_pr_approve('1')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "can't be approved by its author" ]] || false
+
+    run as_user alice sql -q "CALL dolt_pr_merge('1')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "needs 1 approvals of its latest commit to merge into protected branch main, but has 0" ]] || false
+
+    as_user bob sql -q "CALL dolt_pr_approve('1')"
+    run dolt sql -r csv -q "SELECT author, reviewers, approvals, status FROM dolt_pull_requests"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "alice,bob,1,open" ]] || false
+
+    # a new commit on the source branch needs to be approved again
+    dolt checkout feature
+    dolt sql -q "INSERT INTO t VALUES (3, 3)"
+    dolt commit -am "insert again on feature"
+    dolt checkout main
+
+    run as_user alice sql -q "CALL dolt_pr_merge('1')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "but has 0" ]] || false
+
+    as_user bob sql -q "CALL dolt_pr_approve('1')"
+    as_user alice sql -q "CALL dolt_pr_merge('1')"
+
+    run dolt sql -r csv -q "SELECT status FROM dolt_pull_requests"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "merged" ]] || false
+
+    run dolt sql -r csv -q "SELECT count(*) FROM t"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+
+    run dolt log -n 1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Merge pull request #1 from feature into main" ]] || false
+
+    run dolt sql -r csv -q "SELECT reviewer, kind FROM dolt_pull_request_reviews ORDER BY id"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "bob,approval" ]
+    [ "${lines[2]}" = "bob,approval" ]
+}
+
+@test "pull-requests: events trigger pull_request workflows on the target branch" {
+    cat > workflow.yaml <<EOF
+name: validate
+on:
+  pull_request:
+    branches:
+      - main
+    activities:
+      - opened
+      - synchronized
+jobs:
+  - name: validate tables
+    steps:
+      - name: assert expected tables exist
+        saved_query_name: show tables
+        expected_rows: "== 1"
+EOF
+    cat > other.yaml <<EOF
+name: other
+on:
+  pull_request:
+    branches:
+      - other
+jobs:
+  - name: validate tables
+    steps:
+      - name: assert expected tables exist
+        saved_query_name: show tables
+        expected_rows: "== 1"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    dolt ci import ./other.yaml
+
+    dolt sql -q "CALL dolt_pr_open('-m', 'add row 2', 'feature', 'main')"
+    dolt checkout feature
+    dolt sql -q "INSERT INTO t VALUES (3, 3)"
+    dolt commit -am "insert again on feature"
+    dolt checkout main
+    dolt sql -q "CALL dolt_pr_comment('1', '-m', 'added another row')"
+    dolt sql -q "CALL dolt_pr_merge('1')"
+
+    run dolt sql -r csv -q "SELECT activity, workflows FROM dolt_pull_request_events ORDER BY id"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "opened,validate" ]
+    [ "${lines[2]}" = "synchronized,validate" ]
+    [ "${lines[3]}" = "closed," ]
+}
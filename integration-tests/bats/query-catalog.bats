@@ -130,4 +130,50 @@ EOF
     run dolt sql --list-saved -r csv
     [ "$status" -eq 0 ]
     [[ "$output" =~ "$EXPECTED" ]] || false
-}
\ No newline at end of file
+}
+
+@test "query-catalog: can execute saved queries with parameters" {
+    dolt sql <<SQL
+INSERT INTO dolt_query_catalog VALUES ('params', 1, 'params', 'select pk, c1 from one_pk where c1 >= :min_c1 and pk <= :max_pk order by pk', '');
+INSERT INTO dolt_query_catalog_parameters VALUES ('params', 'min_c1', 1, 'bigint', NULL), ('params', 'max_pk', 2, 'bigint', '2');
+SQL
+
+    run dolt sql -x params --param min_c1=10 -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "pk,c1" ]] || false
+    [[ "$output" =~ "1,10" ]] || false
+    [[ "$output" =~ "2,20" ]] || false
+    [[ ! "$output" =~ "3,30" ]] || false
+
+    run dolt sql -x params --param min_c1=20 --param max_pk=3 -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2,20" ]] || false
+    [[ "$output" =~ "3,30" ]] || false
+    [[ ! "$output" =~ "1,10" ]] || false
+
+    # each --param takes a single value, which can contain commas
+    dolt sql -q "INSERT INTO dolt_query_catalog VALUES ('echo', 2, 'echo', 'select :val as val', ''); INSERT INTO dolt_query_catalog_parameters VALUES ('echo', 'val', 1, 'text', NULL);"
+    run dolt sql -x echo --param "val=a,b=c" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "a,b=c" ]] || false
+
+    run dolt sql -x params --param min_c1=20 max_pk=3 -r csv
+    [ "$status" -ne 0 ]
+
+    run dolt sql -r csv -q "select * from dolt_query_run('params', 30)"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "3,30" ]] || false
+    [[ "$output" =~ "pk,c1" ]] || false
+
+    run dolt sql -x params
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "missing value for parameter min_c1 of saved query params" ]] || false
+
+    run dolt sql -x params --param min_c1="0 or 1=1"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "invalid value for parameter min_c1" ]] || false
+
+    run dolt sql -q "select 1" --param min_c1=10
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--param is only used with --execute" ]] || false
+}
@@ -0,0 +1,97 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v int);
+INSERT INTO t VALUES (1, 1), (2, 2);
+SQL
+    dolt add .
+    dolt commit -m "create t"
+    dolt branch other
+
+    dolt sql -q "UPDATE t SET v = 10 WHERE pk = 1"
+    dolt commit -am "update on main"
+    dolt checkout other
+    dolt sql -q "UPDATE t SET v = 20 WHERE pk = 1"
+    dolt commit -am "update on other"
+    dolt checkout main
+
+    dolt sql -q "SET @@PERSIST.dolt_rerere_enabled = 1"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+# record_resolution resolves the conflict on pk 1 with v = 15, commits it, and resets main to before the merge
+record_resolution() {
+    run dolt merge other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "CONFLICT (content): Merge conflict in t" ]] || false
+
+    dolt conflicts resolve --ours t
+    dolt sql -q "UPDATE t SET v = 15 WHERE pk = 1"
+    dolt commit -am "resolve conflict"
+    dolt reset --hard HEAD~1
+}
+
+@test "rerere: resolutions are reused by merge" {
+    record_resolution
+
+    run dolt merge other -m "merge other"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "CONFLICT" ]] || false
+
+    run dolt sql -q "SELECT v FROM t WHERE pk = 1" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "15" ]] || false
+}
+
+@test "rerere: status shows pending conflicts" {
+    run dolt rerere status
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+
+    run dolt merge other
+    [ "$status" -eq 1 ]
+
+    run dolt rerere status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "t (1)" ]] || false
+
+    dolt rerere clear
+    run dolt rerere status
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "rerere: forget removes recorded resolutions" {
+    record_resolution
+
+    run dolt sql -q "SELECT table_name, row_key, status FROM dolt_rerere" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "t,1,resolved" ]] || false
+
+    dolt rerere forget t
+    run dolt sql -q "SELECT count(*) FROM dolt_rerere" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "0" ]] || false
+
+    run dolt merge other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "CONFLICT (content): Merge conflict in t" ]] || false
+}
+
+@test "rerere: invalid arguments" {
+    run dolt rerere forget
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "forget requires at least one table" ]] || false
+
+    run dolt rerere bogus
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unknown rerere subcommand 'bogus'" ]] || false
+}
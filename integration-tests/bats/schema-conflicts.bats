@@ -105,3 +105,49 @@ setup_schema_conflict() {
     [ "$status" -eq 0 ]
     [[ "$output" =~ "datetime" ]] || false
 }
+
+@test "schema-conflicts: compatible type changes merge with data changes" {
+    dolt sql -q "create table t (pk int primary key, c0 int, c1 varchar(10), c2 decimal(4,2));"
+    dolt sql -q "insert into t values (1, 1, 'one', 1.5), (2, 2, 'two', 2.5);"
+    dolt commit -Am "new table t"
+    dolt branch other
+    dolt sql -q "alter table t modify c0 bigint, rename column c1 to name"
+    dolt sql -q "update t set c0 = 3000000000 where pk = 1"
+    dolt commit -am "alter table t on branch main"
+    dolt checkout other
+    dolt sql -q "alter table t modify c1 varchar(20), modify c2 decimal(8,4)"
+    dolt sql -q "update t set c1 = 'a longer name', c2 = 2.1234 where pk = 2"
+    dolt commit -am "alter table t on branch other"
+    dolt checkout main
+
+    run dolt merge other
+    [ "$status" -eq 0 ]
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "pk,c0,name,c2" ]] || false
+    [[ "$output" =~ "1,3000000000,one,1.5000" ]] || false
+    [[ "$output" =~ "2,2,a longer name,2.1234" ]] || false
+    run dolt schema show t
+    [[ "$output" =~ "\`name\` varchar(20)" ]] || false
+}
+
+@test "schema-conflicts: divergent column changes are described" {
+    dolt sql -q "create table t (pk int primary key, c0 int, c1 int);"
+    dolt commit -Am "new table t"
+    dolt branch other
+    dolt sql -q "alter table t modify c0 bigint, rename column c1 to ours"
+    dolt commit -am "alter table t on branch main"
+    dolt checkout other
+    dolt sql -q "alter table t modify c0 varchar(20), rename column c1 to theirs"
+    dolt commit -am "alter table t on branch other"
+    dolt checkout main
+
+    run dolt merge other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "CONFLICT (schema)" ]] || false
+
+    run dolt sql -q "select description from dolt_schema_conflicts" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "type changed from int to bigint on our branch and to varchar(20) on their branch" ]] || false
+    [[ "$output" =~ "renamed from c1 to ours on our branch and to theirs on their branch" ]] || false
+}
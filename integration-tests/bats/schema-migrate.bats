@@ -0,0 +1,105 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE parent (id int primary key, name varchar(20));
+CREATE TABLE child (id int primary key, pid int, a int, b int, CONSTRAINT fk_parent FOREIGN KEY (pid) REFERENCES parent (id));
+INSERT INTO parent VALUES (1, 'one');
+INSERT INTO child VALUES (1, 1, 10, 20);
+SQL
+    dolt commit -Am "initial schema"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "schema-migrate: no changes prints nothing" {
+    run dolt schema migrate
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "schema-migrate: script applies the changes between revisions" {
+    dolt sql <<SQL
+ALTER TABLE child RENAME COLUMN a TO tmp;
+ALTER TABLE child RENAME COLUMN b TO a;
+ALTER TABLE child RENAME COLUMN tmp TO b;
+ALTER TABLE parent MODIFY COLUMN name varchar(50);
+ALTER TABLE child ADD INDEX a_idx (a);
+CREATE VIEW v AS SELECT * FROM child;
+SQL
+    dolt commit -Am "change schema"
+
+    run dolt schema migrate --from HEAD~1 --to HEAD
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ 'ALTER TABLE `child` RENAME COLUMN `a` TO `a_tmp`;' ]] || false
+    [[ "$output" =~ 'ALTER TABLE `parent` MODIFY COLUMN `name` varchar(50);' ]] || false
+    [[ "$output" =~ 'ALTER TABLE `child` ADD KEY `a_idx` (`a`);' ]] || false
+    [[ "$output" =~ 'CREATE VIEW v AS SELECT * FROM child;' ]] || false
+    dolt schema migrate --from HEAD~1 --to HEAD > up.sql
+
+    # the script brings the old revision to the new one, and keeps the data of renamed columns
+    dolt checkout -b old HEAD~1
+    dolt sql < up.sql
+    run dolt schema migrate --from WORKING --to main
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+    run dolt sql -q "SELECT a, b FROM child" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "20,10" ]] || false
+}
+
+@test "schema-migrate: --down reverts the changes" {
+    dolt sql <<SQL
+ALTER TABLE child DROP COLUMN b;
+CREATE TABLE other (id int primary key, cid int, CONSTRAINT fk_child FOREIGN KEY (cid) REFERENCES child (id));
+SQL
+
+    run dolt schema migrate --down
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ 'DROP TABLE `other`;' ]] || false
+    [[ "$output" =~ 'ALTER TABLE `child` ADD `b` int;' ]] || false
+    dolt schema migrate --down > down.sql
+
+    dolt sql < down.sql
+    run dolt schema migrate --from HEAD
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "schema-migrate: triggers and procedures are wrapped in delimiters" {
+    dolt sql <<SQL
+delimiter //
+CREATE TRIGGER trg BEFORE INSERT ON child FOR EACH ROW BEGIN SET new.a = 1; SET new.b = 2; END//
+delimiter ;
+SQL
+
+    run dolt schema migrate
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "delimiter END_STATEMENT" ]] || false
+    [[ "$output" =~ "SET new.b = 2; END" ]] || false
+    [[ "$output" =~ "delimiter ;" ]] || false
+
+    run dolt schema migrate --down
+    [ "$status" -eq 0 ]
+    [ "$output" = 'DROP TRIGGER `trg`;' ]
+
+    # the delimited script can be run as is
+    dolt schema migrate > up.sql
+    dolt sql -q "DROP TRIGGER trg"
+    dolt sql < up.sql
+    dolt sql -q "INSERT INTO child VALUES (2, 1, 0, 0)"
+    run dolt sql -q "SELECT a, b FROM child WHERE id = 2" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,2" ]] || false
+}
+
+@test "schema-migrate: invalid revision" {
+    run dolt schema migrate --from nonexistent
+    [ "$status" -ne 0 ]
+}
@@ -0,0 +1,108 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+load $BATS_TEST_DIRNAME/helper/query-server-common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v varchar(20));
+INSERT INTO t VALUES (1, 'a'), (2, 'b');
+CALL dolt_commit('-Am', 'create t', '--date', '2020-01-01T00:00:00');
+UPDATE t SET v = 'a2' WHERE pk = 1;
+CALL dolt_commit('-am', 'update one', '--date', '2021-01-01T00:00:00');
+DELETE FROM t WHERE pk = 2;
+CALL dolt_commit('-am', 'delete two', '--date', '2022-01-01T00:00:00');
+SQL
+}
+
+teardown() {
+    stop_sql_server 1
+    assert_feature_version
+    teardown_common
+}
+
+@test "system-time: FOR SYSTEM_TIME ALL returns every version with its period" {
+    run dolt sql -r csv -q "SELECT * FROM t FOR SYSTEM_TIME ALL ORDER BY pk, valid_from"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "pk,v,valid_from,valid_to" ]] || false
+    [[ "$output" =~ "1,a,2020-01-01 00:00:00.000,2021-01-01 00:00:00.000" ]] || false
+    [[ "$output" =~ "1,a2,2021-01-01 00:00:00.000," ]] || false
+    [[ "$output" =~ "2,b,2020-01-01 00:00:00.000,2022-01-01 00:00:00.000" ]] || false
+    [ "${#lines[@]}" -eq 4 ]
+}
+
+@test "system-time: FROM, BETWEEN and CONTAINED IN periods" {
+    run dolt sql -r csv -q "SELECT pk, v FROM t FOR SYSTEM_TIME FROM '2020-06-01' TO '2021-01-01' ORDER BY pk"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,a" ]
+    [ "${lines[2]}" = "2,b" ]
+    [ "${#lines[@]}" -eq 3 ]
+
+    run dolt sql -r csv -q "SELECT pk, v FROM t FOR SYSTEM_TIME BETWEEN '2020-06-01' AND '2021-01-01' ORDER BY pk, v"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,a" ]
+    [ "${lines[2]}" = "1,a2" ]
+    [ "${lines[3]}" = "2,b" ]
+
+    run dolt sql -r csv -q "SELECT pk, v FROM t FOR SYSTEM_TIME CONTAINED IN ('2020-01-01', '2021-01-01')"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,a" ]
+    [ "${#lines[@]}" -eq 2 ]
+}
+
+@test "system-time: batch mode and views" {
+    dolt sql <<SQL
+CREATE VIEW t_history AS SELECT pk, v, valid_to FROM t FOR SYSTEM_TIME ALL;
+SQL
+    run dolt sql -r csv <<SQL
+SELECT count(*) FROM t FOR SYSTEM_TIME ALL;
+SELECT pk, v FROM t_history WHERE valid_to IS NULL;
+SQL
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+    [[ "$output" =~ "1,a2" ]] || false
+}
+
+@test "system-time: uncommitted changes aren't part of the history" {
+    dolt sql -q "UPDATE t SET v = 'uncommitted' WHERE pk = 1"
+    run dolt sql -r csv -q "SELECT v FROM t FOR SYSTEM_TIME ALL WHERE pk = 1 ORDER BY valid_from"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "uncommitted" ]] || false
+}
+
+@test "system-time: keyless tables" {
+    dolt sql -q "CREATE TABLE keyless (v int)"
+    dolt sql -q "INSERT INTO keyless VALUES (1), (1)"
+    dolt commit -Am "create keyless"
+    dolt sql -q "DELETE FROM keyless LIMIT 1"
+    dolt commit -am "delete a copy"
+    run dolt sql -r csv -q "SELECT v, valid_to IS NULL FROM keyless FOR SYSTEM_TIME ALL ORDER BY valid_to"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,true" ]
+    [ "${lines[2]}" = "1,false" ]
+    [ "${#lines[@]}" -eq 3 ]
+}
+
+@test "system-time: unsupported tables" {
+    run dolt sql -q "SELECT * FROM dolt_repo_$$.t FOR SYSTEM_TIME ALL"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "only supported on tables of the current database" ]] || false
+}
+
+@test "system-time: FOR SYSTEM_TIME periods in sql-server" {
+    start_sql_server
+
+    run dolt sql -r csv -q "SELECT pk, v FROM t FOR SYSTEM_TIME ALL WHERE valid_to IS NULL"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,a2" ]
+    [ "${#lines[@]}" -eq 2 ]
+
+    run dolt sql -r csv <<SQL
+SELECT count(*) FROM t FOR SYSTEM_TIME BETWEEN '2020-06-01' AND '2021-01-01';
+SELECT count(*) FROM t;
+SQL
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+    [[ "$output" =~ "1" ]] || false
+}
@@ -0,0 +1,129 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+load $BATS_TEST_DIRNAME/helper/query-server-common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (pk int primary key, v varchar(20));
+CALL dolt_commit('-Am', 'create t');
+SQL
+
+    # a local listener which appends the event header and body of each request to requests.log, failing the first
+    # request to have it retried
+    LISTENER_PORT=$( definePORT )
+    python3 -c '
+import http.server, sys
+failed = False
+class Handler(http.server.BaseHTTPRequestHandler):
+    def do_POST(self):
+        global failed
+        body = self.rfile.read(int(self.headers["Content-Length"]))
+        if not failed:
+            failed = True
+            self.send_response(503)
+            self.end_headers()
+            return
+        with open("requests.log", "a") as f:
+            f.write(self.headers["X-Dolt-Event"] + " " + str(self.headers["X-Dolt-Signature-256"]) + " " + body.decode() + "\n")
+        self.send_response(200)
+        self.end_headers()
+    def log_message(self, *args):
+        pass
+http.server.HTTPServer(("127.0.0.1", int(sys.argv[1])), Handler).serve_forever()
+' "$LISTENER_PORT" &
+    LISTENER_PID=$!
+}
+
+teardown() {
+    stop_sql_server 1
+    kill $LISTENER_PID || :
+    assert_feature_version
+    teardown_common
+}
+
+wait_for_requests() {
+    for i in {1..100}; do
+        if [ -f requests.log ] && [ "$(wc -l < requests.log)" -ge "$1" ]; then
+            return 0
+        fi
+        sleep 0.1
+    done
+    return 1
+}
+
+@test "webhooks: updates of matching branches and tags are delivered" {
+    PORT=$( definePORT )
+    cat > server.yaml <<EOF
+listener:
+  port: $PORT
+
+webhooks:
+  - name: ci
+    url: http://127.0.0.1:$LISTENER_PORT/hook
+    refs: [refs/heads/main, refs/tags/*]
+    secret: s3cret
+EOF
+    start_sql_server_with_args_no_port --config server.yaml
+
+    dolt sql <<SQL
+INSERT INTO t VALUES (1, 'a');
+CALL dolt_commit('-am', 'insert one');
+CALL dolt_branch('feature');
+CALL dolt_tag('v1', '-m', 'release v1');
+SQL
+    wait_for_requests 2
+
+    # the first attempt to deliver the commit fails, so it's delivered after the tag
+    run grep '"event":"commit"' requests.log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ 'commit sha256=' ]] || false
+    [[ "$output" =~ '"ref":"refs/heads/main"' ]] || false
+    [[ "$output" =~ '"message":"insert one"' ]] || false
+    [[ "$output" =~ '"tables":[{"table":"t","change":"modified"}]' ]] || false
+
+    run grep '"event":"tag_created"' requests.log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '"ref":"refs/tags/v1"' ]] || false
+    [[ "$output" =~ '"message":"release v1"' ]] || false
+
+    run grep "feature" requests.log
+    [ "$status" -eq 1 ]
+
+    run dolt sql -r csv -q "SELECT event, ref, status, attempts, response_code FROM dolt_webhook_deliveries ORDER BY created_at"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "commit,refs/heads/main,delivered,2,200" ]
+    [ "${lines[2]}" = "tag_created,refs/tags/v1,delivered,1,200" ]
+}
+
+@test "webhooks: deliveries are persisted across restarts" {
+    PORT=$( definePORT )
+    cat > server.yaml <<EOF
+listener:
+  port: $PORT
+
+webhooks:
+  - url: http://127.0.0.1:$LISTENER_PORT/hook
+EOF
+    start_sql_server_with_args_no_port --config server.yaml
+    dolt sql <<SQL
+CALL dolt_branch('feature');
+CALL dolt_branch('-d', 'feature');
+SQL
+    wait_for_requests 2
+    stop_sql_server 1
+
+    start_sql_server_with_args_no_port --config server.yaml
+    run dolt sql -r csv -q "SELECT webhook, event, ref, status FROM dolt_webhook_deliveries ORDER BY created_at"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "http://127.0.0.1:$LISTENER_PORT/hook,branch_created,refs/heads/feature,delivered" ]
+    [ "${lines[2]}" = "http://127.0.0.1:$LISTENER_PORT/hook,branch_deleted,refs/heads/feature,delivered" ]
+    [ -d .doltcfg/webhooks ]
+}
+
+@test "webhooks: dolt_webhook_deliveries is empty without webhooks" {
+    run dolt sql -r csv -q "SELECT count(*) FROM dolt_webhook_deliveries"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "0" ]
+}
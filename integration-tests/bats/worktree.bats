@@ -0,0 +1,211 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    WORKTREES="$BATS_TMPDIR/dolt-worktrees-$$"
+    rm -rf "$WORKTREES"
+    mkdir "$WORKTREES"
+
+    dolt sql -q "CREATE TABLE t (pk int primary key)"
+    dolt commit -Am "create t"
+}
+
+teardown() {
+    assert_feature_version
+    rm -rf "$WORKTREES"
+    teardown_common
+}
+
+@test "worktree: add creates a new branch named after the directory" {
+    run dolt worktree add "$WORKTREES/feature"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "with branch 'feature' checked out" ]] || false
+
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "feature" ]] || false
+
+    cd "$WORKTREES/feature"
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "feature" ]
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+
+    # The worktree has no chunk store of its own
+    [ ! -d .dolt/noms ]
+}
+
+@test "worktree: commits are shared between worktrees" {
+    dolt branch existing
+    dolt worktree add "$WORKTREES/wt" existing
+
+    cd "$WORKTREES/wt"
+    dolt sql -q "INSERT INTO t VALUES (1)"
+    dolt commit -am "commit in worktree"
+
+    cd -
+    run dolt log existing -n 1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "commit in worktree" ]] || false
+
+    # the main worktree's working set is untouched
+    run dolt sql -q "SELECT COUNT(*) FROM t" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "0" ]] || false
+}
+
+@test "worktree: add -b creates a branch at a start point" {
+    dolt sql -q "INSERT INTO t VALUES (1)"
+    dolt commit -am "second"
+
+    run dolt worktree add -b older "$WORKTREES/older" HEAD~1
+    [ "$status" -eq 0 ]
+
+    cd "$WORKTREES/older"
+    run dolt log -n 1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "create t" ]] || false
+}
+
+@test "worktree: a branch can only be checked out in one worktree" {
+    run dolt worktree add "$WORKTREES/wt" main
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'main' is already checked out at" ]] || false
+
+    dolt worktree add "$WORKTREES/feature"
+
+    run dolt worktree add "$WORKTREES/other" feature
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    run dolt checkout feature
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    run dolt branch -D feature
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    cd "$WORKTREES/feature"
+    run dolt checkout main
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'main' is already checked out at" ]] || false
+}
+
+@test "worktree: sql procedures can't check out or delete a branch checked out in another worktree" {
+    dolt worktree add "$WORKTREES/feature"
+
+    run dolt sql -q "CALL dolt_checkout('feature')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    run dolt sql -q "CALL dolt_checkout('-B', 'feature')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    run dolt sql -q "CALL dolt_branch('-D', 'feature')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    dolt branch other
+    run dolt sql -q "CALL dolt_checkout('other'); SELECT active_branch();"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "other" ]] || false
+}
+
+@test "worktree: a branch checked out in another worktree can only be renamed with --force" {
+    dolt worktree add "$WORKTREES/feature"
+
+    run dolt branch -m feature renamed
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    run dolt sql -q "CALL dolt_branch('-m', 'feature', 'renamed')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "'feature' is already checked out at '$WORKTREES/feature'" ]] || false
+
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "feature" ]] || false
+    [[ ! "$output" =~ "renamed" ]] || false
+
+    dolt branch other
+    run dolt branch -m other renamed
+    [ "$status" -eq 0 ]
+
+    run dolt branch -m -f feature forced
+    [ "$status" -eq 0 ]
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "forced" ]] || false
+}
+
+@test "worktree: list" {
+    dolt worktree add "$WORKTREES/feature"
+
+    run dolt worktree list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[0]}" =~ "[main]" ]] || false
+    [[ "${lines[1]}" =~ "$WORKTREES/feature" ]] || false
+    [[ "${lines[1]}" =~ "[feature]" ]] || false
+
+    cd "$WORKTREES/feature"
+    run dolt worktree list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+}
+
+@test "worktree: remove" {
+    dolt worktree add "$WORKTREES/feature"
+
+    run dolt worktree remove main-does-not-exist
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "is not a working tree" ]] || false
+
+    run dolt worktree remove .
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "the main working tree cannot be removed" ]] || false
+
+    cd "$WORKTREES/feature"
+    dolt sql -q "INSERT INTO t VALUES (1)"
+    cd -
+
+    run dolt worktree remove feature
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "contains modified or untracked files" ]] || false
+
+    run dolt worktree remove -f "$WORKTREES/feature"
+    [ "$status" -eq 0 ]
+    [ ! -d "$WORKTREES/feature" ]
+
+    run dolt worktree list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    # once the worktree is gone, its branch can be checked out again
+    run dolt checkout feature
+    [ "$status" -eq 0 ]
+}
+
+@test "worktree: prune" {
+    dolt worktree add "$WORKTREES/feature"
+    rm -rf "$WORKTREES/feature"
+
+    run dolt worktree list
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "prunable" ]] || false
+
+    run dolt worktree prune
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Removing worktree 'feature'" ]] || false
+
+    run dolt worktree list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+}
@@ -18,6 +18,7 @@ export const logTests = [
         author: "mysql-test-runner",
         author_email: "mysql-test-runner@liquidata.co",
         author_date: "",
+        notes: null,
       },
     ],
     matcher: logsMatcher,
@@ -39,6 +40,7 @@ export const logTests = [
         author: "Dolt",
         author_email: "dolt@dolthub.com",
         author_date: "",
+        notes: null,
       },
       {
         commit_hash: "",
@@ -53,6 +55,7 @@ export const logTests = [
         author: "mysql-test-runner",
         author_email: "mysql-test-runner@liquidata.co",
         author_date: "",
+        notes: null,
       },
     ],
     matcher: logsMatcher,
@@ -74,6 +77,7 @@ export const logTests = [
         author: "Dolt",
         author_email: "dolt@dolthub.com",
         author_date: "",
+        notes: null,
       },
     ],
     matcher: logsMatcher,
@@ -34,6 +34,7 @@ export const mergeTests = [
         author: "dolt",
         author_email: "dolt@%",
         author_date: "",
+        notes: null,
       },
       {
         commit_hash: "",
@@ -48,6 +49,7 @@ export const mergeTests = [
         author: "Dolt",
         author_email: "dolt@dolthub.com",
         author_date: "",
+        notes: null,
       },
       {
         commit_hash: "",
@@ -62,6 +64,7 @@ export const mergeTests = [
         author: "mysql-test-runner",
         author_email: "mysql-test-runner@liquidata.co",
         author_date: "",
+        notes: null,
       },
     ],
     matcher: logsMatcher,